@@ -103,13 +103,7 @@ func (vdd VestingDelegationDecorator) validateMsg(ctx sdk.Context, msg sdk.Msg)
 		}
 
 		balance := vdd.bk.GetBalance(ctx, addr, bondDenom)
-		unvestedOnly := clawbackAccount.GetUnvestedOnly(ctx.BlockTime())
-		spendable, hasNeg := sdk.Coins{balance}.SafeSub(unvestedOnly...)
-		if hasNeg {
-			spendable = sdk.NewCoins()
-		}
-
-		vested := spendable.AmountOf(bondDenom)
+		vested := clawbackAccount.GetDelegatableBalance(ctx.BlockTime(), balance).Amount
 		if vested.LT(delegateMsg.Amount.Amount) {
 			return errorsmod.Wrapf(
 				vestingtypes.ErrInsufficientVestedCoins,
@@ -34,6 +34,7 @@ type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
+	IncrementTransientSenderTxCount(ctx sdk.Context, sender sdk.AccAddress) uint64
 }
 
 // DynamicFeeEVMKeeper is a subset of EVMKeeper interface that supports dynamic fee checker
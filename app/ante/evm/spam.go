@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evm
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// EthSpamProtectionDecorator protects the chain against spam targeting specific
+// contracts. It checks that the transaction's fee is at least as large as the
+// min gas price defined by governance for the called contract (or contract method),
+// and limits the number of transactions a single sender can get accepted into
+// the mempool on each block.
+//
+// The contract min gas prices apply to both CheckTx and DeliverTx, while the
+// sender limit only applies to CheckTx, as it is not part of consensus.
+type EthSpamProtectionDecorator struct {
+	feesKeeper FeeMarketKeeper
+	evmKeeper  EVMKeeper
+}
+
+// NewEthSpamProtectionDecorator creates a new EthSpamProtectionDecorator instance
+// used only for Ethereum transactions.
+func NewEthSpamProtectionDecorator(fk FeeMarketKeeper, ek EVMKeeper) EthSpamProtectionDecorator {
+	return EthSpamProtectionDecorator{feesKeeper: fk, evmKeeper: ek}
+}
+
+// AnteHandle ensures that the effective fee of transactions calling a contract is
+// greater than the contract's min gas price * gas limit, and that the sender has
+// not exceeded the max number of transactions per block during CheckTx.
+func (spd EthSpamProtectionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeMarketParams := spd.feesKeeper.GetParams(ctx)
+
+	// short-circuit if no spam protection is configured
+	if len(feeMarketParams.ContractMinGasPrices) == 0 && feeMarketParams.MaxTxsPerSenderPerBlock == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	evmParams := spd.evmKeeper.GetParams(ctx)
+	chainCfg := evmParams.GetChainConfig()
+	ethCfg := chainCfg.EthereumConfig(spd.evmKeeper.ChainID())
+	baseFee := spd.evmKeeper.GetBaseFee(ctx, ethCfg)
+
+	// the sender limit is not checked during ReCheckTx, as the transactions were
+	// already accounted for when they first entered the mempool
+	checkSenderLimit := feeMarketParams.MaxTxsPerSenderPerBlock > 0 && ctx.IsCheckTx() && !ctx.IsReCheckTx() && !simulate
+
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrUnknownRequest,
+				"invalid message type %T, expected %T",
+				msg, (*evmtypes.MsgEthereumTx)(nil),
+			)
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data %s", ethMsg.Hash)
+		}
+
+		if to := txData.GetTo(); to != nil {
+			minGasPrice, found := feeMarketParams.GetContractMinGasPrice(*to, txData.GetData())
+			if found && minGasPrice.IsPositive() {
+				feeAmt := ethMsg.GetFee()
+				if txData.TxType() != ethtypes.LegacyTxType {
+					feeAmt = ethMsg.GetEffectiveFee(baseFee)
+				}
+
+				gasLimit := sdk.NewDecFromBigInt(new(big.Int).SetUint64(ethMsg.GetGas()))
+				requiredFee := minGasPrice.Mul(gasLimit)
+				fee := sdk.NewDecFromBigInt(feeAmt)
+
+				if fee.LT(requiredFee) {
+					return ctx, errorsmod.Wrapf(
+						errortypes.ErrInsufficientFee,
+						"provided fee < minimum fee for calls to contract %s (%s < %s). Please increase the gas price",
+						to.Hex(), fee.TruncateInt().String(), requiredFee.TruncateInt().String(),
+					)
+				}
+			}
+		}
+
+		if !checkSenderLimit {
+			continue
+		}
+
+		sender := ethMsg.GetFrom()
+		if sender.Empty() {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidAddress, "sender address is empty for tx %s", ethMsg.Hash)
+		}
+
+		if count := spd.feesKeeper.IncrementTransientSenderTxCount(ctx, sender); count > feeMarketParams.MaxTxsPerSenderPerBlock {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrMempoolIsFull,
+				"sender %s exceeded the max number of transactions per block (%d)",
+				ethMsg.From, feeMarketParams.MaxTxsPerSenderPerBlock,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,175 @@
+package evm_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmante "github.com/evmos/evmos/v15/app/ante/evm"
+	"github.com/evmos/evmos/v15/testutil"
+	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
+	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+)
+
+func (suite *AnteTestSuite) TestEthSpamProtectionDecorator() {
+	from, privKey := testutiltx.NewAddrKey()
+	contract := testutiltx.GenerateAddress()
+	other := testutiltx.GenerateAddress()
+	transferInput := common.FromHex("0xa9059cbb0000")
+
+	setParams := func(contractMinGasPrices []feemarkettypes.ContractMinGasPrice, maxTxs uint64) {
+		params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+		params.MinGasPrice = sdk.ZeroDec()
+		params.ContractMinGasPrices = contractMinGasPrices
+		params.MaxTxsPerSenderPerBlock = maxTxs
+		err := suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func() sdk.Tx
+		isCheckTx bool
+		expPass   bool
+		errMsg    string
+	}{
+		{
+			"pass - no spam protection configured",
+			func() sdk.Tx {
+				setParams(nil, 0)
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(0), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - call to a contract without min gas price",
+			func() sdk.Tx {
+				setParams([]feemarkettypes.ContractMinGasPrice{
+					{ContractAddress: contract.Hex(), MinGasPrice: sdk.NewDec(10)},
+				}, 0)
+				msg := suite.BuildTestEthTx(from, other, nil, transferInput, big.NewInt(0), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - gas price equal to the contract min gas price",
+			func() sdk.Tx {
+				setParams([]feemarkettypes.ContractMinGasPrice{
+					{ContractAddress: contract.Hex(), MinGasPrice: sdk.NewDec(10)},
+				}, 0)
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(10), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"fail - gas price lower than the contract min gas price",
+			func() sdk.Tx {
+				setParams([]feemarkettypes.ContractMinGasPrice{
+					{ContractAddress: contract.Hex(), MinGasPrice: sdk.NewDec(10)},
+				}, 0)
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(9), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			false,
+			"provided fee < minimum fee for calls to contract",
+		},
+		{
+			"pass - method min gas price takes precedence over the contract min gas price",
+			func() sdk.Tx {
+				setParams([]feemarkettypes.ContractMinGasPrice{
+					{ContractAddress: contract.Hex(), MinGasPrice: sdk.NewDec(10)},
+					{ContractAddress: contract.Hex(), MethodSelector: "0xa9059cbb", MinGasPrice: sdk.NewDec(5)},
+				}, 0)
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(5), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"fail - gas price lower than the method min gas price",
+			func() sdk.Tx {
+				setParams([]feemarkettypes.ContractMinGasPrice{
+					{ContractAddress: contract.Hex(), MethodSelector: "0xa9059cbb", MinGasPrice: sdk.NewDec(20)},
+				}, 0)
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(10), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			false,
+			"provided fee < minimum fee for calls to contract",
+		},
+		{
+			"pass - sender below the max txs per block",
+			func() sdk.Tx {
+				setParams(nil, 2)
+				suite.app.FeeMarketKeeper.IncrementTransientSenderTxCount(suite.ctx, from.Bytes())
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(0), nil, nil, nil)
+				tx := suite.CreateTestTx(msg, privKey, 1, false)
+				// the sender is set by the EthSigVerificationDecorator
+				msg.From = from.Hex()
+				return tx
+			},
+			true,
+			true,
+			"",
+		},
+		{
+			"fail - sender exceeded the max txs per block",
+			func() sdk.Tx {
+				setParams(nil, 2)
+				suite.app.FeeMarketKeeper.IncrementTransientSenderTxCount(suite.ctx, from.Bytes())
+				suite.app.FeeMarketKeeper.IncrementTransientSenderTxCount(suite.ctx, from.Bytes())
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(0), nil, nil, nil)
+				tx := suite.CreateTestTx(msg, privKey, 1, false)
+				// the sender is set by the EthSigVerificationDecorator
+				msg.From = from.Hex()
+				return tx
+			},
+			true,
+			false,
+			"exceeded the max number of transactions per block",
+		},
+		{
+			"pass - sender limit is not enforced on DeliverTx",
+			func() sdk.Tx {
+				setParams(nil, 1)
+				suite.app.FeeMarketKeeper.IncrementTransientSenderTxCount(suite.ctx, from.Bytes())
+				msg := suite.BuildTestEthTx(from, contract, nil, transferInput, big.NewInt(0), nil, nil, nil)
+				return suite.CreateTestTx(msg, privKey, 1, false)
+			},
+			false,
+			true,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tx := tc.malleate()
+
+			dec := evmante.NewEthSpamProtectionDecorator(suite.app.FeeMarketKeeper, suite.app.EvmKeeper)
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(tc.isCheckTx), tx, false, testutil.NextFn)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Contains(err.Error(), tc.errMsg, tc.name)
+			}
+		})
+	}
+}
@@ -3,11 +3,15 @@
 package evm
 
 import (
+	"fmt"
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	stakingprecompile "github.com/evmos/evmos/v15/precompiles/staking"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 	vestingtypes "github.com/evmos/evmos/v15/x/vesting/types"
 )
@@ -18,6 +22,10 @@ type EthVestingTransactionDecorator struct {
 	ak evmtypes.AccountKeeper
 	bk evmtypes.BankKeeper
 	ek EVMKeeper
+	sk vestingtypes.StakingKeeper
+
+	// stakingABI is used to decode delegations through the staking precompile
+	stakingABI abi.ABI
 }
 
 // ethVestingExpenseTracker tracks both the total transaction value to be sent across Ethereum
@@ -30,11 +38,24 @@ type ethVestingExpenseTracker struct {
 }
 
 // NewEthVestingTransactionDecorator returns a new EthVestingTransactionDecorator.
-func NewEthVestingTransactionDecorator(ak evmtypes.AccountKeeper, bk evmtypes.BankKeeper, ek EVMKeeper) EthVestingTransactionDecorator {
+func NewEthVestingTransactionDecorator(
+	ak evmtypes.AccountKeeper,
+	bk evmtypes.BankKeeper,
+	ek EVMKeeper,
+	sk vestingtypes.StakingKeeper,
+) EthVestingTransactionDecorator {
+	// NOTE: the ABI is embedded in the binary, so this should never fail
+	stakingABI, err := stakingprecompile.LoadABI()
+	if err != nil {
+		panic(fmt.Errorf("failed to load staking precompile ABI: %w", err))
+	}
+
 	return EthVestingTransactionDecorator{
-		ak: ak,
-		bk: bk,
-		ek: ek,
+		ak:         ak,
+		bk:         bk,
+		ek:         ek,
+		sk:         sk,
+		stakingABI: stakingABI,
 	}
 }
 
@@ -45,6 +66,8 @@ func NewEthVestingTransactionDecorator(ak evmtypes.AccountKeeper, bk evmtypes.Ba
 //   - the message is not a MsgEthereumTx
 //   - sender account cannot be found
 //   - tx values are in excess of any account's spendable balances
+//   - a delegation through the staking precompile is in excess of the account's
+//     vested coins
 func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	// Track the total value to be spent by each address across all messages and ensure
 	// that no account can exceed its spendable balance.
@@ -89,6 +112,10 @@ func (vtd EthVestingTransactionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 				"clawback vesting account has insufficient unlocked tokens to execute transaction: %s < %s", spendable.String(), total.String(),
 			)
 		}
+
+		if err := vtd.validateDelegation(ctx, clawbackAccount, msgEthTx); err != nil {
+			return ctx, err
+		}
 	}
 
 	return next(ctx, tx, simulate)
@@ -143,3 +170,52 @@ func (vtd EthVestingTransactionDecorator) updateAccountExpenses(
 
 	return expenses, nil
 }
+
+// validateDelegation checks that a clawback vesting account calling the staking
+// precompile only delegates vested coins. As in the Cosmos ante handler, vested
+// coins can be delegated even if they are still locked. Calls that cannot be
+// decoded are left for the precompile to reject during execution.
+func (vtd EthVestingTransactionDecorator) validateDelegation(
+	ctx sdk.Context,
+	account *vestingtypes.ClawbackVestingAccount,
+	msgEthTx *evmtypes.MsgEthereumTx,
+) error {
+	ethTx := msgEthTx.AsTransaction()
+	to := ethTx.To()
+	if to == nil || *to != common.HexToAddress(stakingprecompile.PrecompileAddress) {
+		return nil
+	}
+
+	data := ethTx.Data()
+	if len(data) < 4 {
+		return nil
+	}
+
+	method, err := vtd.stakingABI.MethodById(data[:4])
+	if err != nil || method.Name != stakingprecompile.DelegateMethod {
+		return nil
+	}
+
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil
+	}
+
+	bondDenom := vtd.sk.BondDenom(ctx)
+	msg, _, err := stakingprecompile.NewMsgDelegate(args, bondDenom)
+	if err != nil {
+		return nil
+	}
+
+	balance := vtd.bk.GetBalance(ctx, account.GetAddress(), bondDenom)
+	vested := account.GetDelegatableBalance(ctx.BlockTime(), balance)
+	if vested.IsLT(msg.Amount) {
+		return errorsmod.Wrapf(
+			vestingtypes.ErrInsufficientVestedCoins,
+			"cannot delegate unvested coins. coins vested < delegation amount (%s < %s)",
+			vested.Amount, msg.Amount.Amount,
+		)
+	}
+
+	return nil
+}
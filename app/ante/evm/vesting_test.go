@@ -7,8 +7,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	ethante "github.com/evmos/evmos/v15/app/ante/evm"
+	stakingprecompile "github.com/evmos/evmos/v15/precompiles/staking"
 	"github.com/evmos/evmos/v15/testutil"
 	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
@@ -115,7 +117,7 @@ func (suite *AnteTestSuite) TestEthVestingTransactionDecorator() {
 			suite.SetupTest()
 			tc.malleate()
 
-			dec := ethante.NewEthVestingTransactionDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.EvmKeeper)
+			dec := ethante.NewEthVestingTransactionDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper)
 			_, err := dec.AnteHandle(suite.ctx, tc.tx, false, testutil.NextFn)
 
 			if tc.expPass {
@@ -126,3 +128,86 @@ func (suite *AnteTestSuite) TestEthVestingTransactionDecorator() {
 		})
 	}
 }
+
+// TestEthVestingTransactionDecoratorDelegation tests that the EthVestingTransactionDecorator
+// allows clawback vesting accounts to delegate vested coins that are still locked through
+// the staking precompile, but not unvested coins.
+func (suite *AnteTestSuite) TestEthVestingTransactionDecoratorDelegation() {
+	addr := testutiltx.GenerateAddress()
+	valAddr := sdk.ValAddress(testutiltx.GenerateAddress().Bytes())
+	stakingPrecompile := common.HexToAddress(stakingprecompile.PrecompileAddress)
+
+	stakingABI, err := stakingprecompile.LoadABI()
+	suite.Require().NoError(err, "failed to load staking ABI")
+
+	testcases := []struct {
+		name        string
+		amount      int64
+		expPass     bool
+		errContains string
+	}{
+		{
+			"pass - delegate vested but locked coins",
+			500,
+			true,
+			"",
+		},
+		{
+			"fail - delegate unvested coins",
+			501,
+			false,
+			"cannot delegate unvested coins",
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			quarter := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 250))
+			total := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000))
+
+			// half of the coins are vested, but all of them are still locked
+			baseAcc := authtypes.NewBaseAccountWithAddress(addr.Bytes())
+			vestingAcc := vestingtypes.NewClawbackVestingAccount(
+				baseAcc, addr.Bytes(), total, suite.ctx.BlockTime().Add(-4500*time.Second),
+				sdkvesting.Periods{{Length: 10000, Amount: total}},
+				sdkvesting.Periods{
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+				},
+			)
+			acc := suite.app.AccountKeeper.NewAccount(suite.ctx, vestingAcc)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr.Bytes(), total)
+			suite.Require().NoError(err, "failed to fund account")
+
+			input, err := stakingABI.Pack(stakingprecompile.DelegateMethod, addr, valAddr.String(), big.NewInt(tc.amount))
+			suite.Require().NoError(err, "failed to pack delegate input")
+
+			tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+				ChainID:  suite.app.EvmKeeper.ChainID(),
+				Nonce:    1,
+				To:       &stakingPrecompile,
+				Amount:   big.NewInt(0),
+				GasLimit: 100000,
+				GasPrice: big.NewInt(1000000000),
+				Input:    input,
+			})
+			tx.From = addr.Hex()
+
+			dec := ethante.NewEthVestingTransactionDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper)
+			_, err = dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().ErrorContains(err, tc.errContains, tc.name)
+			}
+		})
+	}
+}
@@ -111,6 +111,9 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
@@ -167,6 +170,16 @@ import (
 	"github.com/evmos/evmos/v15/x/recovery"
 	recoverykeeper "github.com/evmos/evmos/v15/x/recovery/keeper"
 	recoverytypes "github.com/evmos/evmos/v15/x/recovery/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/callbacks"
+	callbackskeeper "github.com/evmos/evmos/v15/x/ibc/callbacks/keeper"
+	callbackstypes "github.com/evmos/evmos/v15/x/ibc/callbacks/types"
+	"github.com/evmos/evmos/v15/x/ibc/forward"
+	forwardkeeper "github.com/evmos/evmos/v15/x/ibc/forward/keeper"
+	forwardtypes "github.com/evmos/evmos/v15/x/ibc/forward/types"
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit"
+	ratelimitkeeper "github.com/evmos/evmos/v15/x/ibc/ratelimit/keeper"
+	ratelimittypes "github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
 	revenue "github.com/evmos/evmos/v15/x/revenue/v1"
 	revenuekeeper "github.com/evmos/evmos/v15/x/revenue/v1/keeper"
 	revenuetypes "github.com/evmos/evmos/v15/x/revenue/v1/types"
@@ -250,6 +263,9 @@ var (
 		claims.AppModuleBasic{},
 		recovery.AppModuleBasic{},
 		revenue.AppModuleBasic{},
+		forward.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		callbacks.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 	)
 
@@ -313,6 +329,7 @@ type Evmos struct {
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
@@ -334,6 +351,9 @@ type Evmos struct {
 	VestingKeeper    vestingkeeper.Keeper
 	RecoveryKeeper   *recoverykeeper.Keeper
 	RevenueKeeper    revenuekeeper.Keeper
+	ForwardKeeper    forwardkeeper.Keeper
+	RateLimitKeeper  ratelimitkeeper.Keeper
+	CallbacksKeeper  callbackskeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -345,6 +365,10 @@ type Evmos struct {
 	sm *module.SimulationManager
 
 	tpsCounter *tpsCounter
+
+	// parallelExecutor speculatively executes the EVM transactions of a block.
+	// It is nil when the parallel execution is disabled.
+	parallelExecutor *evmkeeper.ParallelExecutor
 }
 
 // SimulationManager implements runtime.AppI
@@ -375,12 +399,14 @@ func NewEvmos(
 	baseAppOptions = memiavlstore.SetupMemIAVL(logger, homePath, appOpts, false, false, baseAppOptions)
 
 	// Setup Mempool and Proposal Handlers
+	var processProposalHandler sdk.ProcessProposalHandler
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
 		mempool := mempool.NoOpMempool{}
 		app.SetMempool(mempool)
 		handler := baseapp.NewDefaultProposalHandler(mempool, app)
+		processProposalHandler = handler.ProcessProposalHandler()
 		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
+		app.SetProcessProposal(processProposalHandler)
 	})
 
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
@@ -424,6 +450,7 @@ func NewEvmos(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -543,14 +570,33 @@ func NewEvmos(
 		authtypes.FeeCollectorName,
 	)
 
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+		app.ClaimsKeeper, // ICS4 Wrapper: claims IBC middleware
+	)
+
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.ClaimsKeeper, // ICS4 Wrapper: claims IBC middleware
+		app.RateLimitKeeper, // ICS4 Wrapper: rate limit IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
 
+	// Create the app.ICAControllerKeeper
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, app.keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: core IBC
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+	)
+
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithPrecompiles(
 		evmkeeper.AvailablePrecompiles(
@@ -561,6 +607,8 @@ func NewEvmos(
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IncentivesKeeper,
+			app.ICAControllerKeeper,
 		),
 	)
 
@@ -598,6 +646,7 @@ func NewEvmos(
 		app.IBCKeeper.ChannelKeeper,
 		app.TransferKeeper,
 		app.ClaimsKeeper,
+		app.Erc20Keeper,
 	)
 
 	// NOTE: app.Erc20Keeper is already initialized elsewhere
@@ -606,6 +655,25 @@ func NewEvmos(
 	app.RecoveryKeeper.SetICS4Wrapper(app.IBCKeeper.ChannelKeeper)
 	app.ClaimsKeeper.SetICS4Wrapper(app.RecoveryKeeper)
 
+	app.ForwardKeeper = forwardkeeper.NewKeeper(
+		keys[forwardtypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.ClaimsKeeper,
+	)
+
+	app.CallbacksKeeper = callbackskeeper.NewKeeper(
+		keys[callbackstypes.StoreKey],
+		appCodec,
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.EvmKeeper,
+		app.ClaimsKeeper,
+	)
+
 	// Override the ICS20 app module
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 
@@ -624,34 +692,45 @@ func NewEvmos(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC module without an underlying application, so that
+	// the interchain accounts are controlled through the controller messages
+	icaControllerIBCModule := icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Packet Forward Middleware
+			- IBC Callbacks Middleware
 			- ERC-20 Middleware
 		 	- Recovery Middleware
 		 	- Airdrop Claims Middleware
+			- Rate Limit Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> ratelimit.SendPacket -> claim.SendPacket -> recovery.SendPacket -> erc20.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> forward.OnRecvPacket -> callbacks.OnRecvPacket -> erc20.OnRecvPacket -> recovery.OnRecvPacket -> claim.OnRecvPacket -> ratelimit.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
 	// create IBC module from top to bottom of stack
 	var transferStack porttypes.IBCModule
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	transferStack = claims.NewIBCMiddleware(*app.ClaimsKeeper, transferStack)
 	transferStack = recovery.NewIBCMiddleware(*app.RecoveryKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = callbacks.NewIBCMiddleware(app.CallbacksKeeper, transferStack)
+	transferStack = forward.NewIBCMiddleware(app.ForwardKeeper, transferStack)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -689,7 +768,7 @@ func NewEvmos(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
@@ -709,6 +788,9 @@ func NewEvmos(
 			app.GetSubspace(recoverytypes.ModuleName)),
 		revenue.NewAppModule(app.RevenueKeeper, app.AccountKeeper,
 			app.GetSubspace(revenuetypes.ModuleName)),
+		forward.NewAppModule(app.ForwardKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		callbacks.NewAppModule(app.CallbacksKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -746,6 +828,9 @@ func NewEvmos(
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		forwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		callbackstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -780,6 +865,9 @@ func NewEvmos(
 		incentivestypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		forwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		callbackstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -822,6 +910,9 @@ func NewEvmos(
 		epochstypes.ModuleName,
 		recoverytypes.ModuleName,
 		revenuetypes.ModuleName,
+		forwardtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		callbackstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 	)
 
@@ -878,8 +969,13 @@ func NewEvmos(
 
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
-	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
+	anteHandler := app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted)
 	app.setPostHandler()
+
+	if cast.ToBool(appOpts.Get(srvflags.EVMParallelExecution)) {
+		workers := cast.ToInt(appOpts.Get(srvflags.EVMParallelWorkers))
+		app.setParallelExecutor(anteHandler, encodingConfig.TxConfig.TxDecoder(), processProposalHandler, workers)
+	}
 	app.SetEndBlocker(app.EndBlocker)
 	app.setupUpgradeHandlers()
 
@@ -917,7 +1013,7 @@ func NewEvmos(
 // Name returns the name of the App
 func (app *Evmos) Name() string { return app.BaseApp.Name() }
 
-func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) {
+func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64) sdk.AnteHandler {
 	options := ante.HandlerOptions{
 		Cdc:                    app.appCodec,
 		AccountKeeper:          app.AccountKeeper,
@@ -939,7 +1035,26 @@ func (app *Evmos) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64)
 		panic(err)
 	}
 
-	app.SetAnteHandler(ante.NewAnteHandler(options))
+	anteHandler := ante.NewAnteHandler(options)
+	app.SetAnteHandler(anteHandler)
+	return anteHandler
+}
+
+// setParallelExecutor enables the optimistic parallel execution of the EVM
+// transactions, which are speculatively executed for the proposals accepted
+// by the node.
+func (app *Evmos) setParallelExecutor(
+	anteHandler sdk.AnteHandler,
+	txDecoder sdk.TxDecoder,
+	processProposalHandler sdk.ProcessProposalHandler,
+	workers int,
+) {
+	app.parallelExecutor = evmkeeper.NewParallelExecutor(app.EvmKeeper, anteHandler, txDecoder, workers)
+	app.EvmKeeper.SetParallelExecutor(app.parallelExecutor)
+	app.SetProcessProposal(app.parallelExecutor.ProcessProposalHandler(processProposalHandler))
+	// the block transactions are speculatively executed once the state changes
+	// of the BeginBlockers are applied
+	app.SetBeginBlocker(app.parallelExecutor.BeginBlocker(app.CommitMultiStore(), app.BeginBlocker))
 }
 
 func (app *Evmos) setPostHandler() {
@@ -964,6 +1079,12 @@ func (app *Evmos) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci
 
 // EndBlocker updates every end block
 func (app *Evmos) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	// All the transactions have been delivered, stop the speculative execution
+	// before the block state is modified and committed
+	if app.parallelExecutor != nil {
+		app.parallelExecutor.EndBlock(ctx)
+	}
+
 	return app.mm.EndBlock(ctx, req)
 }
 
@@ -1198,6 +1319,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint:staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
@@ -1326,6 +1448,10 @@ func (app *Evmos) setupUpgradeHandlers() {
 		v16.CreateUpgradeHandler(
 			app.mm, app.configurator,
 			app.EvmKeeper,
+			app.ICAControllerKeeper,
+			app.IncentivesKeeper,
+			app.EpochsKeeper,
+			app.RecoveryKeeper,
 		),
 	)
 
@@ -1388,6 +1514,17 @@ func (app *Evmos) setupUpgradeHandlers() {
 		storeUpgrades = &storetypes.StoreUpgrades{
 			Deleted: []string{crisistypes.ModuleName},
 		}
+	case v16.UpgradeName:
+		// add packet forward, IBC callbacks and rate limit middleware stores and
+		// the ica controller submodule store in v16
+		storeUpgrades = &storetypes.StoreUpgrades{
+			Added: []string{
+				forwardtypes.StoreKey,
+				callbackstypes.StoreKey,
+				icacontrollertypes.StoreKey,
+				ratelimittypes.StoreKey,
+			},
+		}
 	}
 
 	if storeUpgrades != nil {
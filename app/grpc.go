@@ -0,0 +1,96 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package app
+
+import (
+	"context"
+	"strconv"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// RegisterGRPCServer registers the gRPC query services of the app on the given
+// server. The BaseApp only wraps the unary queries with a query context, so the
+// server is wrapped to provide the query context to the streaming queries too.
+func (app *Evmos) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(&streamingQueryServer{Server: server, app: app})
+}
+
+// streamingQueryServer is a gRPC server that provides the query context of the
+// requested height to the streaming query handlers.
+type streamingQueryServer struct {
+	gogogrpc.Server
+	app *Evmos
+}
+
+// RegisterService implements the gogogrpc.Server interface.
+func (s *streamingQueryServer) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	if len(sd.Streams) == 0 {
+		s.Server.RegisterService(sd, handler)
+		return
+	}
+
+	desc := *sd
+	desc.Streams = make([]grpc.StreamDesc, len(sd.Streams))
+	for i, stream := range sd.Streams {
+		streamHandler := stream.Handler
+		stream.Handler = func(srv interface{}, serverStream grpc.ServerStream) error {
+			ctx, err := s.queryContext(serverStream)
+			if err != nil {
+				return err
+			}
+			return streamHandler(srv, &queryServerStream{ServerStream: serverStream, ctx: ctx})
+		}
+		desc.Streams[i] = stream
+	}
+
+	s.Server.RegisterService(&desc, handler)
+}
+
+// queryContext returns the stream context with the query context of the height
+// requested in the stream metadata, or the latest height.
+func (s *streamingQueryServer) queryContext(stream grpc.ServerStream) (context.Context, error) {
+	var height int64
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		if heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader); len(heightHeaders) == 1 {
+			var err error
+			height, err = strconv.ParseInt(heightHeaders[0], 10, 64)
+			if err != nil || height < 0 {
+				return nil, grpcstatus.Errorf(codes.InvalidArgument, "invalid height header %q", heightHeaders[0])
+			}
+		}
+	}
+
+	sdkCtx, err := s.app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if height == 0 {
+		height = sdkCtx.BlockHeight()
+	}
+	md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	if err := stream.SetHeader(md); err != nil {
+		s.app.Logger().Error("failed to set gRPC header", "err", err)
+	}
+
+	return context.WithValue(stream.Context(), sdk.SdkContextKey, sdkCtx), nil
+}
+
+// queryServerStream is a grpc.ServerStream with a query context.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements the grpc.ServerStream interface.
+func (s *queryServerStream) Context() context.Context {
+	return s.ctx
+}
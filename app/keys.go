@@ -19,6 +19,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -27,6 +28,9 @@ import (
 	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 	feemarkettypes "github.com/evmos/evmos/v15/x/feemarket/types"
+	callbackstypes "github.com/evmos/evmos/v15/x/ibc/callbacks/types"
+	forwardtypes "github.com/evmos/evmos/v15/x/ibc/forward/types"
+	ratelimittypes "github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
 	incentivestypes "github.com/evmos/evmos/v15/x/incentives/types"
 	inflationtypes "github.com/evmos/evmos/v15/x/inflation/v1/types"
 	recoverytypes "github.com/evmos/evmos/v15/x/recovery/types"
@@ -49,9 +53,10 @@ func StoreKeys() (
 		evidencetypes.StoreKey, capabilitytypes.StoreKey, consensusparamtypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, forwardtypes.StoreKey, callbackstypes.StoreKey,
+		ratelimittypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// evmos keys
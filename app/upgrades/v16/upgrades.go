@@ -6,9 +6,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/evmos/evmos/v15/precompiles/erc20registry"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+	"github.com/evmos/evmos/v15/precompiles/incentives"
 	"github.com/evmos/evmos/v15/precompiles/p256"
 	"github.com/evmos/evmos/v15/utils"
+	epochskeeper "github.com/evmos/evmos/v15/x/epochs/keeper"
 	evmkeeper "github.com/evmos/evmos/v15/x/evm/keeper"
+	incentiveskeeper "github.com/evmos/evmos/v15/x/incentives/keeper"
+	incentivestypes "github.com/evmos/evmos/v15/x/incentives/types"
+	recoverykeeper "github.com/evmos/evmos/v15/x/recovery/keeper"
+	recoverytypes "github.com/evmos/evmos/v15/x/recovery/types"
 )
 
 // CreateUpgradeHandler creates an SDK upgrade handler for v16.0.0
@@ -16,6 +26,10 @@ func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
 	ek *evmkeeper.Keeper,
+	ck icacontrollerkeeper.Keeper,
+	ik incentiveskeeper.Keeper,
+	epk epochskeeper.Keeper,
+	rk *recoverykeeper.Keeper,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
 		logger := ctx.Logger().With("upgrade", UpgradeName)
@@ -28,6 +42,47 @@ func CreateUpgradeHandler(
 			}
 		}
 
+		// the ICA controller submodule is added to the existing ICA module, so
+		// its genesis is not run and the params need to be set explicitly
+		ck.SetParams(ctx, icacontrollertypes.DefaultParams())
+
+		// set the incentive programs params, which are zero on the existing state
+		incentivesParams := ik.GetParams(ctx)
+		incentivesParams.IncentiveProgramCreationFee = incentivestypes.DefaultIncentiveProgramCreationFee
+		incentivesParams.MaxIncentiveProgramsPerContract = incentivestypes.DefaultMaxIncentiveProgramsPerContract
+		if err := ik.SetParams(ctx, incentivesParams); err != nil {
+			logger.Error("failed to set the incentive programs params", "error", err.Error())
+		}
+
+		// enable the funds recovery, which is false on the existing state
+		recoveryParams := rk.GetParams(ctx)
+		recoveryParams.EnableRecoverFunds = recoverytypes.DefaultEnableRecoverFunds
+		if err := rk.SetParams(ctx, recoveryParams); err != nil {
+			logger.Error("failed to set the recovery params", "error", err.Error())
+		}
+
+		// the end of the current epoch is reported with the next epoch number,
+		// so the current number is recorded as the last ended epoch for the
+		// migrated gas meters to be settled with the reward indexes of its end
+		if epochInfo, found := epk.GetEpochInfo(ctx, incentivesParams.IncentivesEpochIdentifier); found && epochInfo.EpochCountingStarted {
+			ik.SetLastEpochNumber(ctx, uint64(epochInfo.CurrentEpoch)) //#nosec G701 -- epoch numbers are positive
+		}
+
+		ics27Address := ics27.Precompile{}.Address()
+		if err := ek.EnablePrecompiles(ctx, ics27Address); err != nil {
+			logger.Error("failed to enable ICS27 precompile", "error", err.Error())
+		}
+
+		incentivesAddress := incentives.Precompile{}.Address()
+		if err := ek.EnablePrecompiles(ctx, incentivesAddress); err != nil {
+			logger.Error("failed to enable incentives precompile", "error", err.Error())
+		}
+
+		erc20RegistryAddress := erc20registry.Precompile{}.Address()
+		if err := ek.EnablePrecompiles(ctx, erc20RegistryAddress); err != nil {
+			logger.Error("failed to enable ERC20 registry precompile", "error", err.Error())
+		}
+
 		// Leave modules are as-is to avoid running InitGenesis.
 		logger.Debug("running module migrations ...")
 		return mm.RunMigrations(ctx, configurator, vm)
@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package app
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StoreMismatch is an entry that differs between the IAVL state and the
+// versiondb state of a store at a given height. A nil value means the entry is
+// missing from the corresponding state.
+type StoreMismatch struct {
+	Height         int64
+	Store          string
+	Key            []byte
+	IAVLValue      []byte
+	VersionDBValue []byte
+}
+
+// String implements the fmt.Stringer interface.
+func (m StoreMismatch) String() string {
+	return fmt.Sprintf(
+		"height %d, store %s, key %X: iavl %X, versiondb %X",
+		m.Height, m.Store, m.Key, m.IAVLValue, m.VersionDBValue,
+	)
+}
+
+// VerifyVersionDB compares the state of the given stores in the IAVL multistore
+// and the versiondb multistore at the given height. It returns the mismatching
+// entries, up to the given limit. A limit of zero returns all of them.
+func VerifyVersionDB(
+	iavl, versionDB storetypes.MultiStore,
+	storeKeys []storetypes.StoreKey,
+	height int64,
+	limit int,
+) ([]StoreMismatch, error) {
+	iavlStore, err := iavl.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load IAVL state at height %d: %w", height, err)
+	}
+	versionDBStore, err := versionDB.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load versiondb state at height %d: %w", height, err)
+	}
+
+	var mismatches []StoreMismatch
+	for _, key := range storeKeys {
+		remaining := 0
+		if limit > 0 {
+			remaining = limit - len(mismatches)
+			if remaining <= 0 {
+				break
+			}
+		}
+
+		storeMismatches := compareKVStores(
+			iavlStore.GetKVStore(key),
+			versionDBStore.GetKVStore(key),
+			remaining,
+		)
+		for _, m := range storeMismatches {
+			m.Height = height
+			m.Store = key.Name()
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	return mismatches, nil
+}
+
+// compareKVStores walks both stores in key order and returns the entries that
+// differ, up to the given limit, without the height and store name.
+func compareKVStores(iavl, versionDB storetypes.KVStore, limit int) []StoreMismatch {
+	iavlIt := iavl.Iterator(nil, nil)
+	defer iavlIt.Close()
+	versionDBIt := versionDB.Iterator(nil, nil)
+	defer versionDBIt.Close()
+
+	var mismatches []StoreMismatch
+	for iavlIt.Valid() || versionDBIt.Valid() {
+		if limit > 0 && len(mismatches) >= limit {
+			break
+		}
+
+		var cmp int
+		switch {
+		case !iavlIt.Valid():
+			cmp = 1
+		case !versionDBIt.Valid():
+			cmp = -1
+		default:
+			cmp = bytes.Compare(iavlIt.Key(), versionDBIt.Key())
+		}
+
+		switch {
+		case cmp < 0:
+			// the entry is missing from versiondb
+			mismatches = append(mismatches, StoreMismatch{Key: iavlIt.Key(), IAVLValue: iavlIt.Value()})
+			iavlIt.Next()
+		case cmp > 0:
+			// the entry is missing from IAVL
+			mismatches = append(mismatches, StoreMismatch{Key: versionDBIt.Key(), VersionDBValue: versionDBIt.Value()})
+			versionDBIt.Next()
+		default:
+			if !bytes.Equal(iavlIt.Value(), versionDBIt.Value()) {
+				mismatches = append(mismatches, StoreMismatch{
+					Key:            iavlIt.Key(),
+					IAVLValue:      iavlIt.Value(),
+					VersionDBValue: versionDBIt.Value(),
+				})
+			}
+			iavlIt.Next()
+			versionDBIt.Next()
+		}
+	}
+
+	return mismatches
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyVersionDB(t *testing.T) {
+	keyA := storetypes.NewKVStoreKey("a")
+	keyB := storetypes.NewKVStoreKey("b")
+	storeKeys := []storetypes.StoreKey{keyA, keyB}
+
+	newStore := func() storetypes.CommitMultiStore {
+		cms := store.NewCommitMultiStore(dbm.NewMemDB())
+		for _, key := range storeKeys {
+			cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, cms.LoadLatestVersion())
+		return cms
+	}
+
+	iavl := newStore()
+	versionDB := newStore()
+
+	// height 1: same state
+	for _, cms := range []storetypes.CommitMultiStore{iavl, versionDB} {
+		cms.GetKVStore(keyA).Set([]byte{1}, []byte("one"))
+		cms.GetKVStore(keyB).Set([]byte{2}, []byte("two"))
+		cms.Commit()
+	}
+
+	// height 2: a different value, a missing entry on each side
+	iavl.GetKVStore(keyA).Set([]byte{1}, []byte("uno"))
+	iavl.GetKVStore(keyB).Set([]byte{3}, []byte("three"))
+	iavl.Commit()
+	versionDB.GetKVStore(keyB).Set([]byte{4}, []byte("four"))
+	versionDB.Commit()
+
+	testCases := []struct {
+		name     string
+		height   int64
+		limit    int
+		expected []StoreMismatch
+		expError bool
+	}{
+		{
+			"same state",
+			1,
+			0,
+			nil,
+			false,
+		},
+		{
+			"different state",
+			2,
+			0,
+			[]StoreMismatch{
+				{Height: 2, Store: "a", Key: []byte{1}, IAVLValue: []byte("uno"), VersionDBValue: []byte("one")},
+				{Height: 2, Store: "b", Key: []byte{3}, IAVLValue: []byte("three")},
+				{Height: 2, Store: "b", Key: []byte{4}, VersionDBValue: []byte("four")},
+			},
+			false,
+		},
+		{
+			"different state - limit",
+			2,
+			2,
+			[]StoreMismatch{
+				{Height: 2, Store: "a", Key: []byte{1}, IAVLValue: []byte("uno"), VersionDBValue: []byte("one")},
+				{Height: 2, Store: "b", Key: []byte{3}, IAVLValue: []byte("three")},
+			},
+			false,
+		},
+		{
+			"fail - missing height",
+			3,
+			0,
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mismatches, err := VerifyVersionDB(iavl, versionDB, storeKeys, tc.height, tc.limit)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, mismatches)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/evmos/evmos/v15/ethereum/eip712"
 	evmos "github.com/evmos/evmos/v15/types"
 	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/spf13/cobra"
@@ -37,6 +38,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
 	cmd.AddCommand(LegacyEIP712Cmd())
+	cmd.AddCommand(EIP712SchemaCmd())
 
 	return cmd
 }
@@ -178,3 +180,54 @@ func LegacyEIP712Cmd() *cobra.Command {
 		},
 	}
 }
+
+// EIP712SchemaCmd outputs the EIP-712 schema registered for a message type
+func EIP712SchemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "eip712-schema [msg-type]",
+		Short: "Output the EIP-712 types registered for the given message type",
+		Long: `Output the EIP-712 types registered for the given message type, identified either by its
+Amino name or its type URL. Messages without a registered schema have their EIP-712 types derived
+from the message JSON.`,
+		Example: fmt.Sprintf(
+			`$ %s debug eip712-schema evmos/MsgConvertERC20
+$ %s debug eip712-schema /evmos.erc20.v1.MsgConvertERC20`,
+			version.AppName, version.AppName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			aminoName := args[0]
+			if strings.HasPrefix(aminoName, "/") {
+				msg, err := clientCtx.InterfaceRegistry.Resolve(aminoName)
+				if err != nil {
+					return errors.Wrap(err, "resolve message type URL")
+				}
+
+				bz, err := clientCtx.LegacyAmino.MarshalJSON(msg)
+				if err != nil {
+					return errors.Wrap(err, "message is not registered on the Amino codec")
+				}
+
+				aminoName = gjson.GetBytes(bz, "type").Str
+			}
+
+			schema, found := eip712.GetMsgSchema(aminoName)
+			if !found {
+				return fmt.Errorf(
+					"no EIP-712 schema registered for message %s, types are derived from the message JSON; registered schemas: %s",
+					aminoName, strings.Join(eip712.RegisteredMsgSchemas(), ", "),
+				)
+			}
+
+			bz, err := json.Marshal(schema.RootTypes())
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+}
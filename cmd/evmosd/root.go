@@ -136,6 +136,11 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 		rootCmd.AddCommand(changeSetCmd)
 	}
 
+	versionDBCmd := VersionDBCmd()
+	if versionDBCmd != nil {
+		rootCmd.AddCommand(versionDBCmd)
+	}
+
 	evmosserver.AddCommands(
 		rootCmd,
 		evmosserver.NewDefaultStartOptions(a.newApp, app.DefaultNodeHome),
@@ -7,16 +7,31 @@
 package main
 
 import (
+	"fmt"
+	"path/filepath"
 	"sort"
 
 	"github.com/linxGnu/grocksdb"
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/crypto-org-chain/cronos/versiondb"
 	versiondbclient "github.com/crypto-org-chain/cronos/versiondb/client"
+	"github.com/crypto-org-chain/cronos/versiondb/tsrocksdb"
 	"github.com/evmos/evmos/v15/app"
 	"github.com/evmos/evmos/v15/cmd/evmosd/opendb"
 )
 
+const (
+	flagHeights       = "heights"
+	flagSamples       = "samples"
+	flagStartHeight   = "start-height"
+	flagStores        = "stores"
+	flagMaxMismatches = "max-mismatches"
+)
+
 // ChangeSetCmd returns a Cobra command for interacting with change sets.
 // NOTE: this is only included in builds with rocksdb
 func ChangeSetCmd() *cobra.Command {
@@ -35,3 +50,155 @@ func ChangeSetCmd() *cobra.Command {
 		},
 	})
 }
+
+// VersionDBCmd returns a Cobra command for checking the versiondb state.
+// NOTE: this is only included in builds with rocksdb
+func VersionDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versiondb",
+		Short: "Commands for the versiondb historical state store",
+	}
+	cmd.AddCommand(VerifyVersionDBCmd())
+	return cmd
+}
+
+// VerifyVersionDBCmd returns a Cobra command comparing the versiondb state with
+// the IAVL state at sample heights. The node must be stopped.
+// NOTE: this is only included in builds with rocksdb
+func VerifyVersionDBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Compare the versiondb state with the IAVL state at sample heights",
+		Long: `Compare the versiondb state with the IAVL state at sample heights.
+The heights can be passed explicitly, otherwise they are evenly spread between the start height and the latest height of both stores.
+The heights pruned from the IAVL state are reported as failures. The node must be stopped.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			heights, err := cmd.Flags().GetInt64Slice(flagHeights)
+			if err != nil {
+				return err
+			}
+			samples, err := cmd.Flags().GetInt(flagSamples)
+			if err != nil {
+				return err
+			}
+			startHeight, err := cmd.Flags().GetInt64(flagStartHeight)
+			if err != nil {
+				return err
+			}
+			storeNames, err := cmd.Flags().GetStringSlice(flagStores)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetInt(flagMaxMismatches)
+			if err != nil {
+				return err
+			}
+
+			keys, _, _ := app.StoreKeys()
+			storeKeys := make([]storetypes.StoreKey, 0, len(keys))
+			if len(storeNames) == 0 {
+				for name := range keys {
+					storeNames = append(storeNames, name)
+				}
+				sort.Strings(storeNames)
+			}
+			for _, name := range storeNames {
+				key, found := keys[name]
+				if !found {
+					return fmt.Errorf("unknown store %s", name)
+				}
+				storeKeys = append(storeKeys, key)
+			}
+
+			db, err := opendb.OpenReadOnlyDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			cms := rootmulti.NewStore(db, serverCtx.Logger)
+			for _, key := range keys {
+				cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+			}
+			if err := cms.LoadLatestVersion(); err != nil {
+				return err
+			}
+
+			versionDBStore, err := tsrocksdb.NewStore(filepath.Join(home, "data", "versiondb"))
+			if err != nil {
+				return err
+			}
+			versionDBLatest, err := versionDBStore.GetLatestVersion()
+			if err != nil {
+				return err
+			}
+			verDB := versiondb.NewMultiStore(cms, versionDBStore, storeKeys)
+
+			if len(heights) == 0 {
+				latest := cms.LatestVersion()
+				if versionDBLatest < latest {
+					latest = versionDBLatest
+				}
+				heights = sampleHeights(startHeight, latest, samples)
+			}
+
+			failed := 0
+			for _, height := range heights {
+				mismatches, err := app.VerifyVersionDB(cms, verDB, storeKeys, height, limit)
+				switch {
+				case err != nil:
+					failed++
+					cmd.PrintErrf("height %d: %s\n", height, err)
+				case len(mismatches) > 0:
+					failed++
+					for _, m := range mismatches {
+						cmd.Println(m.String())
+					}
+				default:
+					cmd.Printf("height %d: ok\n", height)
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("versiondb verification failed at %d of %d heights", failed, len(heights))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagHeights, nil, "Heights to verify, overrides the sample heights")
+	cmd.Flags().Int(flagSamples, 5, "Number of sample heights to verify")
+	cmd.Flags().Int64(flagStartHeight, 1, "Lowest sample height, e.g. the earliest height kept by the IAVL pruning")
+	cmd.Flags().StringSlice(flagStores, nil, "Stores to verify, defaults to all of them")
+	cmd.Flags().Int(flagMaxMismatches, 10, "Maximum number of mismatches reported per height, 0 for no limit")
+	return cmd
+}
+
+// sampleHeights returns the given number of heights evenly spread between the
+// start and end heights, both included.
+func sampleHeights(start, end int64, samples int) []int64 {
+	if start < 1 {
+		start = 1
+	}
+	if end < start || samples <= 0 {
+		return nil
+	}
+	if samples == 1 || end == start {
+		return []int64{end}
+	}
+
+	step := (end - start) / int64(samples-1)
+	if step == 0 {
+		step = 1
+	}
+
+	heights := make([]int64, 0, samples)
+	for height := start; height < end && len(heights) < samples-1; height += step {
+		heights = append(heights, height)
+	}
+	return append(heights, end)
+}
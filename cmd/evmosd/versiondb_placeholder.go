@@ -16,3 +16,10 @@ import (
 func ChangeSetCmd() *cobra.Command {
 	return nil
 }
+
+// VersionDBCmd returns nil for builds without rocksdb
+// When building with rocksdb, VersionDBCmd returns a Cobra command
+// for checking the versiondb state (check the 'versiondb.go' file)
+func VersionDBCmd() *cobra.Command {
+	return nil
+}
@@ -228,6 +228,13 @@ func (pubKey PubKey) verifySignatureAsEIP712(msg, sig []byte) bool {
 		return true
 	}
 
+	// Try verifying the signature using the types derived from the message JSON,
+	// as signed by clients that are not aware of the registered message schemas
+	walkerEIP712Bytes, err := eip712.GetEIP712BytesForMsgWithoutSchemas(msg)
+	if err == nil && !bytes.Equal(walkerEIP712Bytes, eip712Bytes) && pubKey.verifySignatureECDSA(walkerEIP712Bytes, sig) {
+		return true
+	}
+
 	// Try verifying the signature using the legacy EIP-712 encoding
 	legacyEIP712Bytes, err := eip712.LegacyGetEIP712BytesForMsg(msg)
 	if err != nil {
@@ -11,6 +11,16 @@ import (
 func WrapTxToTypedData(
 	chainID uint64,
 	data []byte,
+) (apitypes.TypedData, error) {
+	return wrapTxToTypedData(chainID, data, true)
+}
+
+// wrapTxToTypedData wraps the SignDoc into a TypedData request, using the
+// registered message schemas only if useSchemas is set.
+func wrapTxToTypedData(
+	chainID uint64,
+	data []byte,
+	useSchemas bool,
 ) (apitypes.TypedData, error) {
 	messagePayload, err := createEIP712MessagePayload(data)
 	message := messagePayload.message
@@ -18,7 +28,7 @@ func WrapTxToTypedData(
 		return apitypes.TypedData{}, err
 	}
 
-	types, err := createEIP712Types(messagePayload)
+	types, err := createEIP712Types(messagePayload, useSchemas)
 	if err != nil {
 		return apitypes.TypedData{}, err
 	}
@@ -9,6 +9,7 @@ import (
 
 	chainparams "cosmossdk.io/simapp/params"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/evmos/evmos/v15/ethereum/eip712"
@@ -21,12 +22,14 @@ import (
 
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/evmos/evmos/v15/app"
 	"github.com/evmos/evmos/v15/cmd/config"
 	"github.com/evmos/evmos/v15/encoding"
 	"github.com/evmos/evmos/v15/utils"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
 
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -385,6 +388,44 @@ func (suite *EIP712TestSuite) TestEIP712() {
 	}
 }
 
+// TestSchemaSignatureCompatibility verifies that a message with a registered schema can still be
+// signed with the types derived from its JSON, as done by the clients unaware of the schemas.
+func (suite *EIP712TestSuite) TestSchemaSignatureCompatibility() {
+	suite.SetupTest()
+
+	privKey, pubKey := suite.createTestKeyPair()
+	msg := erc20types.NewMsgConvertERC20(
+		math.NewInt(100),
+		suite.createTestAddress(),
+		common.BytesToAddress(suite.createTestAddress()),
+		common.BytesToAddress(suite.createTestAddress()),
+	)
+
+	signBytes := legacytx.StdSignBytes(
+		utils.TestnetChainID+"-1",
+		25,
+		78,
+		0,
+		legacytx.NewStdFee(20000, suite.makeCoins(suite.denom, math.NewInt(2000))),
+		[]sdk.Msg{msg},
+		"",
+		nil,
+	)
+
+	schemaBytes, err := eip712.GetEIP712BytesForMsg(signBytes)
+	suite.Require().NoError(err)
+
+	walkerBytes, err := eip712.GetEIP712BytesForMsgWithoutSchemas(signBytes)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(schemaBytes, walkerBytes, "expected the schema to change the typed data")
+
+	for _, eip712Bytes := range [][]byte{schemaBytes, walkerBytes} {
+		sig, err := privKey.Sign(eip712Bytes)
+		suite.Require().NoError(err)
+		suite.Require().True(pubKey.VerifySignature(signBytes, sig))
+	}
+}
+
 // verifyEIP712SignatureVerification verifies that the payload passes signature verification if signed as its EIP-712 representation.
 func (suite *EIP712TestSuite) verifyEIP712SignatureVerification(expectedSuccess bool, privKey ethsecp256k1.PrivKey, pubKey ethsecp256k1.PubKey, signBytes []byte) {
 	eip712Bytes, err := eip712.GetEIP712BytesForMsg(signBytes)
@@ -35,7 +35,19 @@ func SetEncodingConfig(cfg params.EncodingConfig) {
 // GetEIP712BytesForMsg returns the EIP-712 object bytes for the given SignDoc bytes by decoding the bytes into
 // an EIP-712 object, then converting via WrapTxToTypedData. See https://eips.ethereum.org/EIPS/eip-712 for more.
 func GetEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
-	typedData, err := GetEIP712TypedDataForMsg(signDocBytes)
+	return getEIP712BytesForMsg(signDocBytes, true)
+}
+
+// GetEIP712BytesForMsgWithoutSchemas returns the EIP-712 object bytes for the given SignDoc bytes,
+// deriving the types of every message from its JSON even if the message has a registered schema.
+// It matches the typed data signed by clients that derive the message types themselves, so that
+// their signatures remain valid for the messages that were given a schema.
+func GetEIP712BytesForMsgWithoutSchemas(signDocBytes []byte) ([]byte, error) {
+	return getEIP712BytesForMsg(signDocBytes, false)
+}
+
+func getEIP712BytesForMsg(signDocBytes []byte, useSchemas bool) ([]byte, error) {
+	typedData, err := getEIP712TypedDataForMsg(signDocBytes, useSchemas)
 	if err != nil {
 		return nil, err
 	}
@@ -51,13 +63,17 @@ func GetEIP712BytesForMsg(signDocBytes []byte) ([]byte, error) {
 // GetEIP712TypedDataForMsg returns the EIP-712 TypedData representation for either
 // Amino or Protobuf encoded signature doc bytes.
 func GetEIP712TypedDataForMsg(signDocBytes []byte) (apitypes.TypedData, error) {
+	return getEIP712TypedDataForMsg(signDocBytes, true)
+}
+
+func getEIP712TypedDataForMsg(signDocBytes []byte, useSchemas bool) (apitypes.TypedData, error) {
 	// Attempt to decode as both Amino and Protobuf since the message format is unknown.
 	// If either decode works, we can move forward with the corresponding typed data.
-	typedDataAmino, errAmino := decodeAminoSignDoc(signDocBytes)
+	typedDataAmino, errAmino := decodeAminoSignDoc(signDocBytes, useSchemas)
 	if errAmino == nil && isValidEIP712Payload(typedDataAmino) {
 		return typedDataAmino, nil
 	}
-	typedDataProtobuf, errProtobuf := decodeProtobufSignDoc(signDocBytes)
+	typedDataProtobuf, errProtobuf := decodeProtobufSignDoc(signDocBytes, useSchemas)
 	if errProtobuf == nil && isValidEIP712Payload(typedDataProtobuf) {
 		return typedDataProtobuf, nil
 	}
@@ -73,7 +89,7 @@ func isValidEIP712Payload(typedData apitypes.TypedData) bool {
 
 // decodeAminoSignDoc attempts to decode the provided sign doc (bytes) as an Amino payload
 // and returns a signable EIP-712 TypedData object.
-func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+func decodeAminoSignDoc(signDocBytes []byte, useSchemas bool) (apitypes.TypedData, error) {
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
 		return apitypes.TypedData{}, err
@@ -108,9 +124,10 @@ func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		return apitypes.TypedData{}, errors.New("invalid chain ID passed as argument")
 	}
 
-	typedData, err := WrapTxToTypedData(
+	typedData, err := wrapTxToTypedData(
 		chainID.Uint64(),
 		signDocBytes,
+		useSchemas,
 	)
 	if err != nil {
 		return apitypes.TypedData{}, fmt.Errorf("could not convert to EIP712 representation: %w", err)
@@ -121,7 +138,7 @@ func decodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 
 // decodeProtobufSignDoc attempts to decode the provided sign doc (bytes) as a Protobuf payload
 // and returns a signable EIP-712 TypedData object.
-func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
+func decodeProtobufSignDoc(signDocBytes []byte, useSchemas bool) (apitypes.TypedData, error) {
 	// Ensure codecs have been initialized
 	if err := validateCodecInit(); err != nil {
 		return apitypes.TypedData{}, err
@@ -191,9 +208,10 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		tip,
 	)
 
-	typedData, err := WrapTxToTypedData(
+	typedData, err := wrapTxToTypedData(
 		chainID.Uint64(),
 		signBytes,
+		useSchemas,
 	)
 	if err != nil {
 		return apitypes.TypedData{}, err
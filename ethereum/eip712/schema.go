@@ -0,0 +1,257 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package eip712
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/tidwall/gjson"
+)
+
+// MsgSchema defines an explicit EIP-712 schema for a Cosmos message, to be used
+// instead of the types derived by walking the message JSON. The schema describes
+// the Amino JSON representation of the message: an object with a "type" field
+// holding the Amino message name and a "value" field holding the message fields.
+type MsgSchema struct {
+	// Name is the human-readable EIP-712 type name of the message, e.g. "MsgSend".
+	Name string
+	// Value is the EIP-712 type name of the message value. It must be defined in Types.
+	Value string
+	// Types defines the EIP-712 type of the message value and all of its nested types.
+	Types apitypes.Types
+}
+
+var (
+	schemasMu sync.RWMutex
+	// schemas holds the registered message schemas keyed by Amino message name.
+	schemas = make(map[string]MsgSchema)
+
+	// baseTypes are the types defined for every transaction, which can be reused
+	// but not redefined by the message schemas.
+	baseTypes = createBaseEIP712Types()
+
+	integerTypeRegex = regexp.MustCompile(`^u?int(8|16|24|32|40|48|56|64|72|80|88|96|104|112|120|128|136|144|152|160|168|176|184|192|200|208|216|224|232|240|248|256)?$`)
+)
+
+// RegisterMsgSchema registers the EIP-712 schema for the message with the given
+// Amino name (e.g. "cosmos-sdk/MsgSend"). It is meant to be called by the modules
+// on initialization, and panics if the schema is invalid, if the message already
+// has a schema, or if the schema redefines a type registered by another message.
+func RegisterMsgSchema(aminoName string, schema MsgSchema) {
+	schemasMu.Lock()
+	defer schemasMu.Unlock()
+
+	if _, found := schemas[aminoName]; found {
+		panic(fmt.Errorf("EIP-712 schema already registered for message %s", aminoName))
+	}
+
+	if err := schema.Validate(); err != nil {
+		panic(fmt.Errorf("invalid EIP-712 schema for message %s: %w", aminoName, err))
+	}
+
+	for typeName, fields := range schema.RootTypes() {
+		if existing, found := findRegisteredType(typeName); found && !typesAreEqual(existing, fields) {
+			panic(fmt.Errorf("EIP-712 schema for message %s redefines type %s", aminoName, typeName))
+		}
+	}
+
+	schemas[aminoName] = schema
+}
+
+// GetMsgSchema returns the EIP-712 schema registered for the message with the
+// given Amino name.
+func GetMsgSchema(aminoName string) (MsgSchema, bool) {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+
+	schema, found := schemas[aminoName]
+	return schema, found
+}
+
+// RegisteredMsgSchemas returns the Amino names of the messages with a registered
+// EIP-712 schema, in sorted order.
+func RegisteredMsgSchemas() []string {
+	schemasMu.RLock()
+	defer schemasMu.RUnlock()
+
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Validate performs a stateless validation of the schema. All referenced types
+// must be either EIP-712 primitive types supported in Amino JSON (string, bool
+// and integers), arrays, or struct types defined in the schema or in the base
+// transaction types.
+func (s MsgSchema) Validate() error {
+	if s.Name == "" || s.Value == "" {
+		return fmt.Errorf("schema name and value type cannot be empty")
+	}
+
+	for typeName := range s.Types {
+		if strings.HasPrefix(typeName, typePrefix) {
+			return fmt.Errorf("type %s cannot use the %q prefix, reserved for derived types", typeName, typePrefix)
+		}
+	}
+
+	if _, found := s.Types[s.Value]; !found {
+		return fmt.Errorf("value type %s is not defined", s.Value)
+	}
+
+	if _, found := s.Types[s.Name]; found {
+		return fmt.Errorf("message type %s cannot be defined in the schema types", s.Name)
+	}
+
+	for typeName, fields := range s.RootTypes() {
+		if baseFields, found := baseTypes[typeName]; found && !typesAreEqual(baseFields, fields) {
+			return fmt.Errorf("type %s redefines a base transaction type", typeName)
+		}
+
+		seen := make(map[string]bool, len(fields))
+		for _, field := range fields {
+			if field.Name == "" || seen[field.Name] {
+				return fmt.Errorf("type %s has an empty or duplicated field %q", typeName, field.Name)
+			}
+			seen[field.Name] = true
+
+			if !s.isKnownType(strings.TrimSuffix(field.Type, "[]")) {
+				return fmt.Errorf("type %s has field %s of unknown type %s", typeName, field.Name, field.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RootTypes returns the schema types including the message root type, i.e. the
+// EIP-712 types added to the transaction typed data for the message.
+func (s MsgSchema) RootTypes() apitypes.Types {
+	types := make(apitypes.Types, len(s.Types)+1)
+	for typeName, fields := range s.Types {
+		types[typeName] = fields
+	}
+
+	types[s.Name] = []apitypes.Type{
+		{Name: msgTypeField, Type: ethString},
+		{Name: msgValueField, Type: s.Value},
+	}
+
+	return types
+}
+
+// isKnownType returns true if the given (non-array) type is a supported primitive
+// type or a struct type defined in the schema or the base transaction types.
+func (s MsgSchema) isKnownType(typ string) bool {
+	if typ == ethString || typ == ethBool || integerTypeRegex.MatchString(typ) {
+		return true
+	}
+
+	if _, found := s.Types[typ]; found {
+		return true
+	}
+
+	_, found := baseTypes[typ]
+	return found
+}
+
+// matchesPayload returns true if the message JSON matches the schema exactly: every
+// object has exactly the fields defined by its type, with values of the expected
+// type. Only messages that match their schema are encoded with it, as otherwise
+// fields could be left out of the signed data.
+func (s MsgSchema) matchesPayload(msg gjson.Result) bool {
+	types := s.RootTypes()
+	for typeName, fields := range baseTypes {
+		if _, found := types[typeName]; !found {
+			types[typeName] = fields
+		}
+	}
+
+	return valueMatchesType(types, s.Name, msg)
+}
+
+// addToRoot adds the schema types to the transaction types and returns the message
+// type name.
+func (s MsgSchema) addToRoot(eip712Types apitypes.Types) string {
+	for typeName, fields := range s.RootTypes() {
+		eip712Types[typeName] = fields
+	}
+	return s.Name
+}
+
+// valueMatchesType returns true if the JSON value can be encoded as the given
+// EIP-712 type without leaving fields out.
+func valueMatchesType(types apitypes.Types, typ string, value gjson.Result) bool {
+	if strings.HasSuffix(typ, "[]") {
+		if !value.IsArray() {
+			return false
+		}
+
+		elemType := strings.TrimSuffix(typ, "[]")
+		for _, elem := range value.Array() {
+			if !valueMatchesType(types, elemType, elem) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	switch {
+	case typ == ethString:
+		return value.Type == gjson.String
+	case typ == ethBool:
+		return value.Type == gjson.True || value.Type == gjson.False
+	case integerTypeRegex.MatchString(typ):
+		if value.Type == gjson.String {
+			var integer math.HexOrDecimal256
+			return integer.UnmarshalText([]byte(value.Str)) == nil
+		}
+		return value.Type == gjson.Number
+	}
+
+	fields, found := types[typ]
+	if !found || !value.IsObject() {
+		return false
+	}
+
+	jsonFields := value.Map()
+	if len(jsonFields) != len(fields) {
+		return false
+	}
+
+	for _, field := range fields {
+		fieldValue, found := jsonFields[field.Name]
+		if !found || !valueMatchesType(types, field.Type, fieldValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findRegisteredType returns the fields of the given type if it is defined by a
+// registered schema or the base transaction types. It must be called with the
+// schemas lock held.
+func findRegisteredType(typeName string) ([]apitypes.Type, bool) {
+	if fields, found := baseTypes[typeName]; found {
+		return fields, true
+	}
+
+	for _, schema := range schemas {
+		if fields, found := schema.RootTypes()[typeName]; found {
+			return fields, true
+		}
+	}
+
+	return nil, false
+}
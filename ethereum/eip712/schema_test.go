@@ -0,0 +1,172 @@
+package eip712_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+	// register the x/erc20 message schemas
+	_ "github.com/evmos/evmos/v15/x/erc20/types"
+)
+
+func TestMsgSchemaValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		schema     eip712.MsgSchema
+		errContain string
+	}{
+		{
+			"pass - primitive, array and base types",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "MsgTestValue",
+				Types: apitypes.Types{
+					"MsgTestValue": {
+						{Name: "sender", Type: "string"},
+						{Name: "amount", Type: "Coin[]"},
+						{Name: "ids", Type: "uint64[]"},
+						{Name: "nested", Type: "Nested"},
+					},
+					"Nested": {{Name: "flag", Type: "bool"}},
+				},
+			},
+			"",
+		},
+		{
+			"fail - empty name",
+			eip712.MsgSchema{Value: "MsgTestValue", Types: apitypes.Types{"MsgTestValue": {}}},
+			"cannot be empty",
+		},
+		{
+			"fail - undefined value type",
+			eip712.MsgSchema{Name: "MsgTest", Value: "MsgTestValue", Types: apitypes.Types{}},
+			"is not defined",
+		},
+		{
+			"fail - reserved type prefix",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "TypeValue",
+				Types: apitypes.Types{"TypeValue": {{Name: "sender", Type: "string"}}},
+			},
+			"reserved for derived types",
+		},
+		{
+			"fail - message type defined in the types",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "MsgTestValue",
+				Types: apitypes.Types{
+					"MsgTest":      {{Name: "sender", Type: "string"}},
+					"MsgTestValue": {{Name: "sender", Type: "string"}},
+				},
+			},
+			"cannot be defined in the schema types",
+		},
+		{
+			"fail - base type redefined",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "MsgTestValue",
+				Types: apitypes.Types{
+					"MsgTestValue": {{Name: "amount", Type: "Coin"}},
+					"Coin":         {{Name: "amount", Type: "uint256"}},
+				},
+			},
+			"redefines a base transaction type",
+		},
+		{
+			"fail - duplicated field",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "MsgTestValue",
+				Types: apitypes.Types{
+					"MsgTestValue": {{Name: "sender", Type: "string"}, {Name: "sender", Type: "string"}},
+				},
+			},
+			"empty or duplicated field",
+		},
+		{
+			"fail - unknown field type",
+			eip712.MsgSchema{
+				Name:  "MsgTest",
+				Value: "MsgTestValue",
+				Types: apitypes.Types{
+					"MsgTestValue": {{Name: "sender", Type: "address"}},
+				},
+			},
+			"unknown type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.Validate()
+			if tc.errContain == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContain)
+			}
+		})
+	}
+}
+
+func TestMsgSchemaTypedData(t *testing.T) {
+	schema, found := eip712.GetMsgSchema("evmos/MsgConvertERC20")
+	require.True(t, found)
+	require.Contains(t, eip712.RegisteredMsgSchemas(), "evmos/MsgConvertERC20")
+
+	testCases := []struct {
+		name      string
+		msg       string
+		expSchema bool
+	}{
+		{
+			"schema - matching message",
+			`{"type":"evmos/MsgConvertERC20","value":{"amount":"100","contract_address":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd","receiver":"evmos1hnmrdr0jc2ve3ycxft0gcjjtrdkncpmmkeamf9","sender":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"}}`,
+			true,
+		},
+		{
+			"derived - missing field",
+			`{"type":"evmos/MsgConvertERC20","value":{"amount":"100","contract_address":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd","sender":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"}}`,
+			false,
+		},
+		{
+			"derived - unknown field",
+			`{"type":"evmos/MsgConvertERC20","value":{"amount":"100","contract_address":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd","extra":"field","receiver":"evmos1hnmrdr0jc2ve3ycxft0gcjjtrdkncpmmkeamf9","sender":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"}}`,
+			false,
+		},
+		{
+			"derived - invalid integer",
+			`{"type":"evmos/MsgConvertERC20","value":{"amount":"ten","contract_address":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd","receiver":"evmos1hnmrdr0jc2ve3ycxft0gcjjtrdkncpmmkeamf9","sender":"0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"}}`,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			payload := `{"account_number":"0","chain_id":"evmos_9000-1","fee":{"amount":[{"amount":"200","denom":"aevmos"}],"gas":"200000"},"memo":"","msgs":[` + tc.msg + `],"sequence":"0"}`
+
+			typedData, err := eip712.WrapTxToTypedData(9000, []byte(payload))
+			require.NoError(t, err)
+
+			msgType := typedData.Types["Tx"][len(typedData.Types["Tx"])-1]
+			require.Equal(t, "msg0", msgType.Name)
+
+			if tc.expSchema {
+				require.Equal(t, schema.Name, msgType.Type)
+				for typeName, fields := range schema.RootTypes() {
+					require.Equal(t, fields, typedData.Types[typeName])
+				}
+
+				_, _, err = apitypes.TypedDataAndHash(typedData)
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, "TypeMsgConvertERC200", msgType.Type)
+				require.Nil(t, typedData.Types[schema.Value])
+			}
+		})
+	}
+}
@@ -27,15 +27,34 @@ const (
 	ethInt64  = "int64"
 	ethString = "string"
 
-	msgTypeField = "type"
+	msgTypeField  = "type"
+	msgValueField = "value"
 
 	maxDuplicateTypeDefs = 1000
 )
 
 // getEIP712Types creates and returns the EIP-712 types
-// for the given message payload.
-func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, error) {
-	eip712Types := apitypes.Types{
+// for the given message payload. The registered message
+// schemas are only used if useSchemas is set.
+func createEIP712Types(messagePayload eip712MessagePayload, useSchemas bool) (apitypes.Types, error) {
+	eip712Types := createBaseEIP712Types()
+
+	for i := 0; i < messagePayload.numPayloadMsgs; i++ {
+		field := msgFieldForIndex(i)
+		msg := messagePayload.payload.Get(field)
+
+		if err := addMsgTypesToRoot(eip712Types, field, msg, useSchemas); err != nil {
+			return nil, err
+		}
+	}
+
+	return eip712Types, nil
+}
+
+// createBaseEIP712Types returns the EIP-712 types shared by
+// all transactions, before any message type is added.
+func createBaseEIP712Types() apitypes.Types {
+	return apitypes.Types{
 		"EIP712Domain": {
 			{
 				Name: "name",
@@ -75,28 +94,25 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 			{Name: "amount", Type: "string"},
 		},
 	}
-
-	for i := 0; i < messagePayload.numPayloadMsgs; i++ {
-		field := msgFieldForIndex(i)
-		msg := messagePayload.payload.Get(field)
-
-		if err := addMsgTypesToRoot(eip712Types, field, msg); err != nil {
-			return nil, err
-		}
-	}
-
-	return eip712Types, nil
 }
 
 // addMsgTypesToRoot adds all types for the given message
-// to eip712Types, recursively handling object sub-fields.
-func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result) (err error) {
+// to eip712Types. If useSchemas is set and the message has a
+// registered schema that matches its JSON, the schema types are
+// used. Otherwise, the types are derived recursively from the
+// object sub-fields.
+func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result, useSchemas bool) (err error) {
 	defer doRecover(&err)
 
 	if !msg.IsObject() {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "message is not valid JSON, cannot parse types")
 	}
 
+	if schema, found := GetMsgSchema(msg.Get(msgTypeField).Str); useSchemas && found && schema.matchesPayload(msg) {
+		addMsgTypeDefToTxSchema(eip712Types, msgField, schema.addToRoot(eip712Types))
+		return nil
+	}
+
 	msgRootType, err := msgRootType(msg)
 	if err != nil {
 		return err
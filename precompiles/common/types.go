@@ -35,6 +35,7 @@ var (
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqpgshrm7", // Distribution precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqzxrz44p", // ICS20 transfer precompile
 		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzqrm4kqgn", // Vesting precompile
+		"evmos1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqzq986495y", // Incentives precompile
 	}
 )
 
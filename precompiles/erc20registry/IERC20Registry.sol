@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IERC20Registry contract's address.
+address constant ERC20_REGISTRY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000807;
+
+/// @dev The IERC20Registry contract's instance.
+IERC20Registry constant ERC20_REGISTRY_CONTRACT = IERC20Registry(
+    ERC20_REGISTRY_PRECOMPILE_ADDRESS
+);
+
+/// @author Evmos Team
+/// @title ERC20 Registry Precompile Contract
+/// @dev The interface through which solidity contracts register their ERC20 tokens
+/// as token pairs without governance. The caller, which must be the token contract
+/// or the account that deployed it, locks the registration deposit.
+/// @custom:address 0x0000000000000000000000000000000000000807
+interface IERC20Registry {
+    /// @dev RegisterERC20 defines an Event emitted when a token pair is registered.
+    /// @param token The address of the ERC20 token contract
+    /// @param depositor The address of the account that locked the registration deposit
+    /// @param denom The Cosmos coin denomination of the token pair
+    event RegisterERC20(
+        address indexed token,
+        address indexed depositor,
+        string denom
+    );
+
+    /// @dev Registers the calling ERC20 token contract as a token pair.
+    /// @param holder The address of a token holder used to simulate a transfer
+    /// @return denom The Cosmos coin denomination of the token pair
+    function registerERC20(
+        address holder
+    ) external returns (string memory denom);
+
+    /// @dev Registers an ERC20 token contract deployed by the caller with CREATE.
+    /// @param token The address of the ERC20 token contract
+    /// @param holder The address of a token holder used to simulate a transfer
+    /// @param nonce The nonce of the caller used to deploy the token contract
+    /// @return denom The Cosmos coin denomination of the token pair
+    function registerDeployedERC20(
+        address token,
+        address holder,
+        uint64 nonce
+    ) external returns (string memory denom);
+
+    /// @dev Registers an ERC20 token contract deployed by the caller with CREATE2.
+    /// @param token The address of the ERC20 token contract
+    /// @param holder The address of a token holder used to simulate a transfer
+    /// @param salt The salt used to deploy the token contract
+    /// @param initCodeHash The keccak256 hash of the token contract init code
+    /// @return denom The Cosmos coin denomination of the token pair
+    function registerDeployedERC20Create2(
+        address token,
+        address holder,
+        bytes32 salt,
+        bytes32 initCodeHash
+    ) external returns (string memory denom);
+}
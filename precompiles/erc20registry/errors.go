@@ -0,0 +1,12 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package erc20registry
+
+const (
+	// ErrInvalidToken is raised when the token address is not valid.
+	ErrInvalidToken = "invalid token address: %v"
+	// ErrInvalidHolder is raised when the holder address is not valid.
+	ErrInvalidHolder = "invalid holder address: %v"
+	// ErrNotDeployer is raised when the token contract was not deployed by the caller.
+	ErrNotDeployer = "token contract %s was not deployed by the caller %s"
+)
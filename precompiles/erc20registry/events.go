@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20registry
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+const (
+	// EventTypeRegisterERC20 defines the event type for the ERC20 registry transactions.
+	EventTypeRegisterERC20 = "RegisterERC20"
+)
+
+// EmitRegisterERC20Event creates a new event emitted when a token pair is registered.
+func (p Precompile) EmitRegisterERC20Event(ctx sdk.Context, stateDB vm.StateDB, token, depositor common.Address, denom string) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeRegisterERC20]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(token)
+	if err != nil {
+		return err
+	}
+
+	topics[2], err = cmn.MakeTopic(depositor)
+	if err != nil {
+		return err
+	}
+
+	// Pack the arguments to be used as the Data field
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
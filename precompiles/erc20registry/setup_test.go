@@ -0,0 +1,102 @@
+package erc20registry_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/contracts"
+	"github.com/evmos/evmos/v15/precompiles/erc20registry"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/factory"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/grpc"
+	testkeyring "github.com/evmos/evmos/v15/testutil/integration/evmos/keyring"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/network"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var s *PrecompileTestSuite
+
+// PrecompileTestSuite is the implementation of the TestSuite interface for the
+// ERC20 registry precompile unit tests.
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	network     *network.UnitTestNetwork
+	factory     factory.TxFactory
+	grpcHandler grpc.Handler
+	keyring     testkeyring.Keyring
+
+	// token is an ERC20 contract deployed by the first keyring account, which
+	// holds some of its tokens
+	token common.Address
+	// nonce is the nonce used by the first keyring account to deploy the token
+	nonce uint64
+	// deposit is the registration deposit set on the ERC20 module params
+	deposit sdk.Coin
+
+	precompile *erc20registry.Precompile
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	s = new(PrecompileTestSuite)
+	suite.Run(t, s)
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	keyring := testkeyring.New(2)
+	integrationNetwork := network.NewUnitTestNetwork(
+		network.WithPreFundedAccounts(keyring.GetAllAccAddrs()...),
+	)
+	grpcHandler := grpc.NewIntegrationHandler(integrationNetwork)
+	txFactory := factory.New(integrationNetwork, grpcHandler)
+
+	precompile, err := erc20registry.NewPrecompile(
+		integrationNetwork.App.Erc20Keeper,
+		integrationNetwork.App.AuthzKeeper,
+	)
+	s.Require().NoError(err, "failed to create erc20 registry precompile")
+
+	// the default deposit is higher than the prefunded balances
+	ctx := integrationNetwork.GetContext()
+	params := integrationNetwork.App.Erc20Keeper.GetParams(ctx)
+	params.RegistrationDeposit = sdk.NewCoin(integrationNetwork.GetDenom(), sdk.NewInt(1e18))
+	err = integrationNetwork.App.Erc20Keeper.SetParams(ctx, params)
+	s.Require().NoError(err, "failed to set erc20 params")
+
+	deployer := keyring.GetKey(0)
+	account, err := grpcHandler.GetEvmAccount(deployer.Addr)
+	s.Require().NoError(err, "failed to get deployer account")
+
+	token, err := txFactory.DeployContract(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{},
+		factory.ContractDeploymentData{
+			Contract:        contracts.ERC20MinterBurnerDecimalsContract,
+			ConstructorArgs: []interface{}{"coin", "token", uint8(18)},
+		},
+	)
+	s.Require().NoError(err, "failed to deploy token contract")
+
+	_, err = txFactory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &token},
+		factory.CallArgs{
+			ContractABI: contracts.ERC20MinterBurnerDecimalsContract.ABI,
+			MethodName:  "mint",
+			Args:        []interface{}{deployer.Addr, big.NewInt(100)},
+		},
+	)
+	s.Require().NoError(err, "failed to mint tokens")
+	s.Require().NoError(integrationNetwork.NextBlock())
+
+	s.deposit = params.RegistrationDeposit
+	s.factory = txFactory
+	s.grpcHandler = grpcHandler
+	s.keyring = keyring
+	s.nonce = account.GetNonce()
+	s.precompile = precompile
+	s.network = integrationNetwork
+	s.token = token
+}
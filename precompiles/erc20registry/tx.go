@@ -0,0 +1,121 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20registry
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+const (
+	// RegisterERC20Method defines the ABI method name for the registration of
+	// the calling token contract.
+	RegisterERC20Method = "registerERC20"
+	// RegisterDeployedERC20Method defines the ABI method name for the
+	// registration of a token contract deployed by the caller with CREATE.
+	RegisterDeployedERC20Method = "registerDeployedERC20"
+	// RegisterDeployedERC20Create2Method defines the ABI method name for the
+	// registration of a token contract deployed by the caller with CREATE2.
+	RegisterDeployedERC20Create2Method = "registerDeployedERC20Create2"
+)
+
+// RegisterERC20 registers the calling ERC20 token contract as a token pair.
+// The token contract locks the registration deposit.
+func (p Precompile) RegisterERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	holder, err := parseRegisterERC20Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.registerERC20(ctx, contract, stateDB, method, contract.CallerAddress, holder)
+}
+
+// RegisterDeployedERC20 registers an ERC20 token contract deployed by the
+// caller as a token pair. The deployment is proven by deriving the token
+// address from the caller and its nonce (CREATE). The caller locks the
+// registration deposit.
+func (p Precompile) RegisterDeployedERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	token, holder, nonce, err := parseRegisterDeployedERC20Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if crypto.CreateAddress(contract.CallerAddress, nonce) != token {
+		return nil, fmt.Errorf(ErrNotDeployer, token, contract.CallerAddress)
+	}
+
+	return p.registerERC20(ctx, contract, stateDB, method, token, holder)
+}
+
+// RegisterDeployedERC20Create2 registers an ERC20 token contract deployed by
+// the caller as a token pair. The deployment is proven by deriving the token
+// address from the caller, the salt and the init code hash (CREATE2). The
+// caller locks the registration deposit.
+func (p Precompile) RegisterDeployedERC20Create2(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	token, holder, salt, initCodeHash, err := parseRegisterDeployedERC20Create2Args(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if crypto.CreateAddress2(contract.CallerAddress, salt, initCodeHash[:]) != token {
+		return nil, fmt.Errorf(ErrNotDeployer, token, contract.CallerAddress)
+	}
+
+	return p.registerERC20(ctx, contract, stateDB, method, token, holder)
+}
+
+// registerERC20 registers the token pair of a token contract that is either
+// the caller or was deployed by it, and locks the registration deposit from
+// the caller.
+func (p Precompile) registerERC20(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	token, holder common.Address,
+) ([]byte, error) {
+	depositor := contract.CallerAddress
+
+	pair, err := p.erc20Keeper.RegisterERC20WithDeposit(ctx, token, depositor.Bytes(), holder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterERC20Event(ctx, stateDB, token, depositor, pair.Denom); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	deposit, found := p.erc20Keeper.GetRegistrationDeposit(ctx, token)
+	if found && deposit.Amount.Denom == p.erc20Keeper.GetEVMDenom(ctx) {
+		stateDB.(*statedb.StateDB).SubBalance(depositor, deposit.Amount.Amount.BigInt())
+	}
+
+	return method.Outputs.Pack(pair.Denom)
+}
@@ -0,0 +1,353 @@
+package erc20registry_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/erc20registry"
+	"github.com/evmos/evmos/v15/precompiles/testutil"
+	"github.com/evmos/evmos/v15/testutil/integration/evmos/factory"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// requireRegistered is a helper function to check that the token pair of the
+// given token is registered and that the depositor locked the deposit.
+func (s *PrecompileTestSuite) requireRegistered(token, depositor common.Address, bz []byte) {
+	ctx := s.network.GetContext()
+	erc20Keeper := s.network.App.Erc20Keeper
+
+	s.Require().True(erc20Keeper.IsERC20Registered(ctx, token), "expected token pair to be registered")
+
+	id := erc20Keeper.GetERC20Map(ctx, token)
+	pair, found := erc20Keeper.GetTokenPair(ctx, id)
+	s.Require().True(found, "expected token pair")
+
+	if bz != nil {
+		out, err := s.precompile.Methods[erc20registry.RegisterERC20Method].Outputs.Unpack(bz)
+		s.Require().NoError(err, "failed to unpack output")
+		s.Require().Equal(pair.Denom, out[0], "expected different denom")
+	}
+
+	deposit, found := erc20Keeper.GetRegistrationDeposit(ctx, token)
+	s.Require().True(found, "expected registration deposit")
+	s.Require().Equal(sdk.AccAddress(depositor.Bytes()).String(), deposit.Depositor, "expected different depositor")
+	s.Require().Equal(s.deposit, deposit.Amount, "expected different deposit")
+}
+
+func (s *PrecompileTestSuite) TestRegisterERC20() {
+	method := s.precompile.Methods[erc20registry.RegisterERC20Method]
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() common.Address { return s.token },
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0), s.keyring.GetAddr(0)}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 1, 2),
+		},
+		{
+			"fail - invalid s.keyring.GetAddr(0) address",
+			func() common.Address { return s.token },
+			func() []interface{} {
+				return []interface{}{common.Address{}}
+			},
+			fmt.Sprintf(erc20registry.ErrInvalidHolder, common.Address{}),
+		},
+		{
+			"fail - caller is not a contract",
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			"is not a contract",
+		},
+		{
+			"fail - s.keyring.GetAddr(0) without tokens",
+			func() common.Address { return s.token },
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(1)}
+			},
+			"must hold tokens",
+		},
+		{
+			"fail - token cannot pay the deposit",
+			func() common.Address { return s.token },
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			"failed to lock registration deposit",
+		},
+		{
+			"pass - token registers itself",
+			func() common.Address {
+				s.fundAccount(s.token)
+				return s.token
+			},
+			func() []interface{} {
+				return []interface{}{s.keyring.GetAddr(0)}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			caller := tc.caller()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile, 0)
+
+			bz, err := s.precompile.RegisterERC20(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err, "expected registration to succeed")
+			s.requireRegistered(s.token, s.token, bz)
+
+			balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), s.token.Bytes(), s.deposit.Denom)
+			s.Require().True(balance.IsZero(), "expected deposit to be locked")
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRegisterDeployedERC20() {
+	method := s.precompile.Methods[erc20registry.RegisterDeployedERC20Method]
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0)}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 2),
+		},
+		{
+			"fail - invalid token address",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{common.Address{}, s.keyring.GetAddr(0), s.nonce}
+			},
+			fmt.Sprintf(erc20registry.ErrInvalidToken, common.Address{}),
+		},
+		{
+			"fail - invalid nonce",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0), "nonce"}
+			},
+			"invalid type for nonce",
+		},
+		{
+			"fail - wrong nonce",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0), s.nonce + 1}
+			},
+			"was not deployed by the caller",
+		},
+		{
+			"fail - caller is not the s.keyring.GetAddr(0)",
+			func() common.Address { return s.keyring.GetAddr(1) },
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0), s.nonce}
+			},
+			"was not deployed by the caller",
+		},
+		{
+			"fail - already registered",
+			func() common.Address {
+				_, err := s.network.App.Erc20Keeper.RegisterERC20(s.network.GetContext(), s.token)
+				s.Require().NoError(err)
+				return s.keyring.GetAddr(0)
+			},
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0), s.nonce}
+			},
+			erc20types.ErrTokenPairAlreadyExists.Error(),
+		},
+		{
+			"pass",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{s.token, s.keyring.GetAddr(0), s.nonce}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			stateDB := s.network.GetStateDB()
+
+			caller := tc.caller()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile, 0)
+
+			bz, err := s.precompile.RegisterDeployedERC20(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err, "expected registration to succeed")
+			s.requireRegistered(s.token, s.keyring.GetAddr(0), bz)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRegisterDeployedERC20Create2() {
+	method := s.precompile.Methods[erc20registry.RegisterDeployedERC20Create2Method]
+	tokenFactory := utiltx.GenerateAddress()
+	salt := [32]byte{1}
+
+	var (
+		token        common.Address
+		initCodeHash [32]byte
+	)
+
+	testcases := []struct {
+		name        string
+		caller      func() common.Address
+		malleate    func() []interface{}
+		errContains string
+	}{
+		{
+			"fail - invalid number of arguments",
+			func() common.Address { return tokenFactory },
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), salt}
+			},
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 3),
+		},
+		{
+			"fail - invalid salt",
+			func() common.Address { return tokenFactory },
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), salt[:], initCodeHash}
+			},
+			"invalid type for salt",
+		},
+		{
+			"fail - wrong salt",
+			func() common.Address { return tokenFactory },
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), [32]byte{2}, initCodeHash}
+			},
+			"was not deployed by the caller",
+		},
+		{
+			"fail - wrong init code hash",
+			func() common.Address { return tokenFactory },
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), salt, [32]byte{}}
+			},
+			"was not deployed by the caller",
+		},
+		{
+			"fail - caller is not the factory",
+			func() common.Address { return s.keyring.GetAddr(0) },
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), salt, initCodeHash}
+			},
+			"was not deployed by the caller",
+		},
+		{
+			"pass - factory registers the token",
+			func() common.Address {
+				s.fundAccount(tokenFactory)
+				return tokenFactory
+			},
+			func() []interface{} {
+				return []interface{}{token, s.keyring.GetAddr(0), salt, initCodeHash}
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			token, initCodeHash = s.deployCreate2Token(tokenFactory, salt)
+			stateDB := s.network.GetStateDB()
+
+			caller := tc.caller()
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.network.GetContext(), caller, s.precompile, 0)
+
+			bz, err := s.precompile.RegisterDeployedERC20Create2(ctx, contract, stateDB, &method, tc.malleate())
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err, "expected registration to succeed")
+			s.requireRegistered(token, tokenFactory, bz)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRun() {
+	deployer := s.keyring.GetKey(0)
+	precompileAddr := s.precompile.Address()
+
+	balance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), deployer.AccAddr, s.deposit.Denom)
+
+	// the value transfer changes the balance of the depositor in the EVM
+	// state, which is committed after the precompile locks the deposit
+	res, err := s.factory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &precompileAddr, Amount: big.NewInt(1)},
+		factory.CallArgs{
+			ContractABI: s.precompile.ABI,
+			MethodName:  erc20registry.RegisterDeployedERC20Method,
+			Args:        []interface{}{s.token, deployer.Addr, s.nonce},
+		},
+	)
+	s.Require().NoError(err, "expected registration tx to succeed")
+	s.Require().True(res.IsOK(), "expected registration tx to succeed", res.GetLog())
+	s.Require().NoError(s.network.NextBlock())
+
+	s.requireRegistered(s.token, deployer.Addr, nil)
+
+	// the deposit is not overwritten by the EVM state of the depositor
+	balanceAfter := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), deployer.AccAddr, s.deposit.Denom)
+	s.Require().True(balance.Sub(balanceAfter).IsGTE(s.deposit), "expected the deposit to be deducted")
+
+	moduleAddr := s.network.App.AccountKeeper.GetModuleAddress(erc20types.ModuleName)
+	moduleBalance := s.network.App.BankKeeper.GetBalance(s.network.GetContext(), moduleAddr, s.deposit.Denom)
+	s.Require().Equal(s.deposit, moduleBalance, "expected the deposit to be locked")
+
+	// a token contract can only be registered once
+	_, err = s.factory.ExecuteContractCall(
+		deployer.Priv,
+		evmtypes.EvmTxArgs{To: &precompileAddr},
+		factory.CallArgs{
+			ContractABI: s.precompile.ABI,
+			MethodName:  erc20registry.RegisterDeployedERC20Method,
+			Args:        []interface{}{s.token, deployer.Addr, s.nonce},
+		},
+	)
+	s.Require().Error(err, "expected second registration to fail")
+}
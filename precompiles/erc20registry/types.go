@@ -0,0 +1,86 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package erc20registry
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// parseRegisterERC20Args parses the arguments for the RegisterERC20 method.
+func parseRegisterERC20Args(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	holder, ok := args[0].(common.Address)
+	if !ok || holder == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidHolder, args[0])
+	}
+
+	return holder, nil
+}
+
+// parseRegisterDeployedERC20Args parses the arguments for the
+// RegisterDeployedERC20 method.
+func parseRegisterDeployedERC20Args(args []interface{}) (common.Address, common.Address, uint64, error) {
+	if len(args) != 3 {
+		return common.Address{}, common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	token, holder, err := parseTokenAndHolder(args)
+	if err != nil {
+		return common.Address{}, common.Address{}, 0, err
+	}
+
+	nonce, ok := args[2].(uint64)
+	if !ok {
+		return common.Address{}, common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidType, "nonce", uint64(0), args[2])
+	}
+
+	return token, holder, nonce, nil
+}
+
+// parseRegisterDeployedERC20Create2Args parses the arguments for the
+// RegisterDeployedERC20Create2 method.
+func parseRegisterDeployedERC20Create2Args(args []interface{}) (common.Address, common.Address, [32]byte, [32]byte, error) {
+	if len(args) != 4 {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	token, holder, err := parseTokenAndHolder(args)
+	if err != nil {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, err
+	}
+
+	salt, ok := args[2].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidType, "salt", [32]byte{}, args[2])
+	}
+
+	initCodeHash, ok := args[3].([32]byte)
+	if !ok {
+		return common.Address{}, common.Address{}, [32]byte{}, [32]byte{}, fmt.Errorf(cmn.ErrInvalidType, "initCodeHash", [32]byte{}, args[3])
+	}
+
+	return token, holder, salt, initCodeHash, nil
+}
+
+// parseTokenAndHolder parses the token and holder arguments that are common to
+// the methods registering a deployed token contract.
+func parseTokenAndHolder(args []interface{}) (common.Address, common.Address, error) {
+	token, ok := args[0].(common.Address)
+	if !ok || token == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidToken, args[0])
+	}
+
+	holder, ok := args[1].(common.Address)
+	if !ok || holder == (common.Address{}) {
+		return common.Address{}, common.Address{}, fmt.Errorf(ErrInvalidHolder, args[1])
+	}
+
+	return token, holder, nil
+}
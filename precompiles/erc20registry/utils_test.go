@@ -0,0 +1,49 @@
+package erc20registry_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/evmos/v15/contracts"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+)
+
+// fundAccount is a helper function to send the registration deposit to the
+// given address.
+func (s *PrecompileTestSuite) fundAccount(addr common.Address) {
+	ctx := s.network.GetContext()
+	coins := sdk.Coins{s.deposit}
+
+	err := s.network.App.BankKeeper.MintCoins(ctx, erc20types.ModuleName, coins)
+	s.Require().NoError(err, "failed to mint coins")
+	err = s.network.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, erc20types.ModuleName, addr.Bytes(), coins)
+	s.Require().NoError(err, "failed to send coins from module to account")
+}
+
+// deployCreate2Token is a helper function that places a copy of the token
+// contract, with its code and storage, at the address it would have been
+// deployed to by the given factory with CREATE2. It returns the address of the
+// copy and the init code hash.
+func (s *PrecompileTestSuite) deployCreate2Token(factory common.Address, salt [32]byte) (common.Address, [32]byte) {
+	ctx := s.network.GetContext()
+	evmKeeper := s.network.App.EvmKeeper
+
+	initCode := append([]byte{}, contracts.ERC20MinterBurnerDecimalsContract.Bin...)
+	initCodeHash := crypto.Keccak256Hash(initCode)
+	token := crypto.CreateAddress2(factory, salt, initCodeHash.Bytes())
+
+	account := evmKeeper.GetAccountWithoutBalance(ctx, s.token)
+	s.Require().NotNil(account, "expected token account")
+	account.Balance = big.NewInt(0)
+	err := evmKeeper.SetAccount(ctx, token, *account)
+	s.Require().NoError(err, "failed to set token account")
+
+	evmKeeper.ForEachStorage(ctx, s.token, func(key, value common.Hash) bool {
+		evmKeeper.SetState(ctx, token, key, value.Bytes())
+		return true
+	})
+
+	return token, initCodeHash
+}
@@ -66,6 +66,57 @@ interface ICS20I is IICS20Authorization {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferMulti defines a method for performing an IBC transfer of multiple coins.
+    /// One packet is sent per coin, and either all the packets are sent or none of them.
+    /// @param sourcePort the port on which the packets will be sent
+    /// @param sourceChannel the channel by which the packets will be sent
+    /// @param coins the coins to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutHeight the timeout height relative to the current block height. The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch. The timeout is disabled when set to 0
+    /// @param memo optional memo of each packet
+    /// @return nextSequences sequence numbers of the transfer packets sent, in the order of the coins
+    function transferMulti(
+        string memory sourcePort,
+        string memory sourceChannel,
+        Coin[] memory coins,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64[] memory nextSequences);
+
+    /// @dev WasmHookMemo defines a method for building the memo of a transfer that executes
+    /// a CosmWasm contract on the receiving chain, e.g. an Osmosis swap. The receiver of the
+    /// transfer must be the contract.
+    /// @param contract the bech32 address of the contract on the receiving chain
+    /// @param msg the JSON object of the message executed by the contract
+    /// @return memo the memo of the transfer
+    function wasmHookMemo(
+        string memory contract,
+        string memory msg
+    ) external view returns (string memory memo);
+
+    /// @dev ForwardMemo defines a method for building the memo of a transfer that is forwarded
+    /// to another chain by the packet forward middleware of the receiving chain.
+    /// @param receiver the address of the receiver on the next chain
+    /// @param port the port used to forward the packet
+    /// @param channel the channel used to forward the packet
+    /// @param timeout the timeout of the forwarded packet as a duration, e.g. "10m". The default timeout is used when empty
+    /// @param retries the number of times the forwarded packet is sent again after a timeout
+    /// @param next the optional memo of the forwarded packet, e.g. another forward memo
+    /// @return memo the memo of the transfer
+    function forwardMemo(
+        string memory receiver,
+        string memory port,
+        string memory channel,
+        string memory timeout,
+        uint8 retries,
+        string memory next
+    ) external view returns (string memory memo);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -24,4 +24,12 @@ const (
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
+	// ErrNoCoins is raised when no coins are given to a multi-denom transfer.
+	ErrNoCoins = "no coins to transfer"
+	// ErrInvalidContract is raised when the wasm hook contract is invalid.
+	ErrInvalidContract = "invalid contract: %v"
+	// ErrInvalidMemoJSON is raised when a JSON field of a memo is invalid.
+	ErrInvalidMemoJSON = "invalid %s JSON: %v"
+	// ErrInvalidForwardTimeout is raised when the timeout of a forward memo is invalid.
+	ErrInvalidForwardTimeout = "invalid forward timeout: %v"
 )
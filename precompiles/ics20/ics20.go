@@ -106,6 +106,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferMultiMethod:
+		bz, err = p.TransferMulti(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -115,6 +117,11 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DenomHash(ctx, contract, method, args)
 	case authorization.AllowanceMethod:
 		bz, err = p.Allowance(ctx, method, args)
+	// ICS20 memo builders
+	case WasmHookMemoMethod:
+		bz, err = p.WasmHookMemo(method, args)
+	case ForwardMemoMethod:
+		bz, err = p.ForwardMemo(method, args)
 	default:
 		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
 	}
@@ -136,6 +143,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferMulti
 //
 // Available authorization transactions are:
 //   - Approve
@@ -145,6 +153,7 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case TransferMethod,
+		TransferMultiMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
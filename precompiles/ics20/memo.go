@@ -0,0 +1,176 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package ics20
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	forwardtypes "github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+const (
+	// WasmHookMemoMethod defines the ABI method name for the ICS20 WasmHookMemo
+	// query.
+	WasmHookMemoMethod = "wasmHookMemo"
+	// ForwardMemoMethod defines the ABI method name for the ICS20 ForwardMemo
+	// query.
+	ForwardMemoMethod = "forwardMemo"
+)
+
+// wasmHookMetadata defines the memo of the ICS-20 packets executing a
+// CosmWasm contract on the receiving chain through the IBC hooks middleware:
+//
+//	{"wasm":{"contract":"osmo1...","msg":{...}}}
+type wasmHookMetadata struct {
+	Wasm wasmHook `json:"wasm"`
+}
+
+// wasmHook defines the contract executed by a wasm hook and its message
+type wasmHook struct {
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg"`
+}
+
+// WasmHookMemo returns the memo of an ICS-20 transfer that executes the given
+// message on a CosmWasm contract of the receiving chain. The receiver of the
+// transfer must be the contract.
+func (p Precompile) WasmHookMemo(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	contract, ok := args[0].(string)
+	if !ok || strings.TrimSpace(contract) == "" {
+		return nil, fmt.Errorf(ErrInvalidContract, args[0])
+	}
+
+	msg, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidMemoJSON, "msg", args[1])
+	}
+
+	rawMsg, err := compactJSONObject(msg)
+	if err != nil {
+		return nil, fmt.Errorf(ErrInvalidMemoJSON, "msg", err)
+	}
+
+	memo, err := json.Marshal(wasmHookMetadata{
+		Wasm: wasmHook{
+			Contract: contract,
+			Msg:      rawMsg,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(string(memo))
+}
+
+// ForwardMemo returns the memo of an ICS-20 transfer that is forwarded by the
+// packet forward middleware of the receiving chain to the next chain. The
+// next memo is the memo of the forwarded packet, which can be another
+// forward memo to route the tokens through multiple chains.
+func (p Precompile) ForwardMemo(
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 6 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	receiver, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidReceiver, args[0])
+	}
+
+	port, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourcePort)
+	}
+
+	channel, ok := args[2].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSourceChannel)
+	}
+
+	timeoutStr, ok := args[3].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidForwardTimeout, args[3])
+	}
+
+	var timeout time.Duration
+	if timeoutStr != "" {
+		var err error
+		if timeout, err = time.ParseDuration(timeoutStr); err != nil {
+			return nil, fmt.Errorf(ErrInvalidForwardTimeout, err)
+		}
+	}
+
+	retries, ok := args[4].(uint8)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "retries", uint8(0), args[4])
+	}
+
+	next, ok := args[5].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidMemoJSON, "next", args[5])
+	}
+
+	metadata := forwardtypes.ForwardMetadata{
+		Receiver: receiver,
+		Port:     port,
+		Channel:  channel,
+		Timeout:  forwardtypes.Duration(timeout),
+		Retries:  &retries,
+	}
+
+	// the next memo is nested as a JSON object if possible, as expected by
+	// the middlewares of the next chain
+	if next != "" {
+		rawNext, err := compactJSONObject(next)
+		if err != nil {
+			if rawNext, err = json.Marshal(next); err != nil {
+				return nil, err
+			}
+		}
+		metadata.Next = rawNext
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
+	memo, err := json.Marshal(forwardtypes.PacketMetadata{Forward: &metadata})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(string(memo))
+}
+
+// compactJSONObject returns the compacted JSON encoding of the given JSON object.
+func compactJSONObject(obj string) (json.RawMessage, error) {
+	obj = strings.TrimSpace(obj)
+	if !strings.HasPrefix(obj, "{") {
+		return nil, errors.New("expected a JSON object")
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(obj)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
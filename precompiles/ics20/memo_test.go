@@ -0,0 +1,162 @@
+package ics20_test
+
+import (
+	"fmt"
+
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/ics20"
+	forwardtypes "github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+func (s *PrecompileTestSuite) TestWasmHookMemo() {
+	method := s.precompile.Methods[ics20.WasmHookMemoMethod]
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expMemo     string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty args",
+			[]interface{}{},
+			"",
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - empty contract",
+			[]interface{}{"", `{"swap":{}}`},
+			"",
+			true,
+			"invalid contract",
+		},
+		{
+			"fail - msg is not a JSON object",
+			[]interface{}{"osmo1contract", `"swap"`},
+			"",
+			true,
+			"invalid msg JSON",
+		},
+		{
+			"fail - invalid msg JSON",
+			[]interface{}{"osmo1contract", `{"swap":`},
+			"",
+			true,
+			"invalid msg JSON",
+		},
+		{
+			"pass - msg is compacted",
+			[]interface{}{"osmo1contract", `{ "osmosis_swap": { "output_denom": "uosmo", "slippage": { "twap": { "slippage_percentage": "1", "window_seconds": 10 } } } }`},
+			`{"wasm":{"contract":"osmo1contract","msg":{"osmosis_swap":{"output_denom":"uosmo","slippage":{"twap":{"slippage_percentage":"1","window_seconds":10}}}}}}`,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.precompile.WasmHookMemo(&method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var memo string
+			err = s.precompile.UnpackIntoInterface(&memo, ics20.WasmHookMemoMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expMemo, memo)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestForwardMemo() {
+	method := s.precompile.Methods[ics20.ForwardMemoMethod]
+	testCases := []struct {
+		name        string
+		args        []interface{}
+		expMemo     string
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty args",
+			[]interface{}{},
+			"",
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - empty receiver",
+			[]interface{}{"", "transfer", "channel-0", "", uint8(0), ""},
+			"",
+			true,
+			"receiver cannot be empty",
+		},
+		{
+			"fail - invalid channel",
+			[]interface{}{"osmo1receiver", "transfer", "", "", uint8(0), ""},
+			"",
+			true,
+			"invalid channel",
+		},
+		{
+			"fail - invalid timeout",
+			[]interface{}{"osmo1receiver", "transfer", "channel-0", "10", uint8(0), ""},
+			"",
+			true,
+			"invalid forward timeout",
+		},
+		{
+			"fail - negative timeout",
+			[]interface{}{"osmo1receiver", "transfer", "channel-0", "-10m", uint8(0), ""},
+			"",
+			true,
+			"timeout cannot be negative",
+		},
+		{
+			"pass - without timeout and next memo",
+			[]interface{}{"osmo1receiver", "transfer", "channel-0", "", uint8(0), ""},
+			`{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-0","retries":0}}`,
+			false,
+			"",
+		},
+		{
+			"pass - with a string next memo",
+			[]interface{}{"osmo1receiver", "transfer", "channel-0", "10m", uint8(2), "memo"},
+			`{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-0","timeout":"10m0s","retries":2,"next":"memo"}}`,
+			false,
+			"",
+		},
+		{
+			"pass - with a nested forward memo",
+			[]interface{}{"osmo1receiver", "transfer", "channel-0", "1h", uint8(1), `{"forward": {"receiver": "cosmos1receiver", "port": "transfer", "channel": "channel-1"}}`},
+			`{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-0","timeout":"1h0m0s","retries":1,"next":{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1"}}}}`,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			bz, err := s.precompile.ForwardMemo(&method, tc.args)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			s.Require().NoError(err)
+			var memo string
+			err = s.precompile.UnpackIntoInterface(&memo, ics20.ForwardMemoMethod, bz)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expMemo, memo)
+
+			// the memo is parsed by the packet forward middleware
+			metadata, found, err := forwardtypes.ParseForwardMetadata(memo)
+			s.Require().NoError(err)
+			s.Require().True(found)
+			s.Require().Equal(tc.args[0], metadata.Receiver)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package ics20
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,9 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferMultiMethod defines the ABI method name for the ICS20 TransferMulti
+	// transaction.
+	TransferMultiMethod = "transferMulti"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -32,9 +36,60 @@ func (p Precompile) Transfer(
 		return nil, err
 	}
 
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, msg, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferMulti implements the ICS20 transfer of multiple coins, sending one
+// packet per coin. Either all the packets are sent or none of them.
+func (p Precompile) TransferMulti(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, sender, err := NewMsgTransfers(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// the packets are sent on a cached context, so that the state changes of
+	// the transfers are only committed if all of them succeed
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	sequences := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		sequences[i], err = p.transfer(cacheCtx, origin, contract, stateDB, msg, sender)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to transfer %s", msg.Token)
+		}
+	}
+
+	writeFn()
+
+	return method.Outputs.Pack(sequences)
+}
+
+// transfer sends the ICS20 transfer packet of the given message and returns
+// its sequence. The transfer authorization of the caller is used when the
+// caller is not the origin.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *transfertypes.MsgTransfer,
+	sender common.Address,
+) (uint64, error) {
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
-		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
 	}
 
 	// The provided sender address should always be equal to the origin address.
@@ -42,25 +97,25 @@ func (p Precompile) Transfer(
 	// update the sender address to be equal to the origin address.
 	// Otherwise, if the provided delegator address is different from the origin address,
 	// return an error because is a forbidden operation
-	sender, err = CheckOriginAndSender(contract, origin, sender)
+	sender, err := CheckOriginAndSender(contract, origin, sender)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
 	// and the sender is the origin
 	resp, expiration, err := CheckAndAcceptAuthorizationIfNeeded(ctx, contract, origin, p.AuthzKeeper, msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err := UpdateGrantIfNeeded(ctx, contract, p.AuthzKeeper, origin, expiration, resp); err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	if err = EmitIBCTransferEvent(
@@ -75,8 +130,8 @@ func (p Precompile) Transfer(
 		msg.Token,
 		msg.Memo,
 	); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
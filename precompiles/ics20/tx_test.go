@@ -357,3 +357,141 @@ func (s *PrecompileTestSuite) TestTransfer() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestTransferMulti() {
+	callingContractAddr := differentAddress
+	method := s.precompile.Methods[ics20.TransferMultiMethod]
+	testCases := []struct {
+		name        string
+		malleate    func(sender, receiver sdk.AccAddress) []interface{}
+		postCheck   func(sender, receiver sdk.AccAddress, data []byte)
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty args",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				return []interface{}{}
+			},
+			func(sender, receiver sdk.AccAddress, data []byte) {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 8, 0),
+		},
+		{
+			"fail - no coins",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					[]cmn.Coin{},
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(sender, receiver sdk.AccAddress, data []byte) {},
+			true,
+			ics20.ErrNoCoins,
+		},
+		{
+			"fail - no allowance left for the second coin and no packet is sent",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				err := s.NewTransferAuthorization(s.ctx, s.app, callingContractAddr, common.BytesToAddress(sender), path, defaultCoins, nil)
+				s.Require().NoError(err)
+				err = evmosutil.FundAccount(s.ctx, s.app.BankKeeper, sender, atomCoins)
+				s.Require().NoError(err)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					mutliCmnCoins,
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(sender, receiver sdk.AccAddress, data []byte) {
+				// the allowance spent by the first transfer is reverted
+				authz, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, callingContractAddr.Bytes(), sender, ics20.TransferMsgURL)
+				transferAuthz := authz.(*transfertypes.TransferAuthorization)
+				s.Require().Equal(defaultCoins, transferAuthz.Allocations[0].SpendLimit)
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, sender, utils.BaseDenom)
+				s.Require().Equal(sdk.NewInt(5e18), balance.Amount)
+			},
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"pass - transfer 1 Evmos and 1 Atom from chainA to chainB and spend the entire allowance",
+			func(sender, receiver sdk.AccAddress) []interface{} {
+				path := NewTransferPath(s.chainA, s.chainB)
+				s.coordinator.Setup(path)
+				err := s.NewTransferAuthorization(s.ctx, s.app, callingContractAddr, common.BytesToAddress(sender), path, mutliSpendLimit, nil)
+				s.Require().NoError(err)
+				err = evmosutil.FundAccount(s.ctx, s.app.BankKeeper, sender, atomCoins)
+				s.Require().NoError(err)
+				return []interface{}{
+					path.EndpointA.ChannelConfig.PortID,
+					path.EndpointA.ChannelID,
+					mutliCmnCoins,
+					common.BytesToAddress(sender.Bytes()),
+					receiver.String(),
+					s.chainB.GetTimeoutHeight(),
+					uint64(0),
+					"memo",
+				}
+			},
+			func(sender, receiver sdk.AccAddress, data []byte) {
+				var sequences []uint64
+				err := s.precompile.UnpackIntoInterface(&sequences, ics20.TransferMultiMethod, data)
+				s.Require().NoError(err)
+				s.Require().Equal([]uint64{1, 2}, sequences)
+
+				// Check allowance was deleted
+				authz, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, callingContractAddr.Bytes(), sender, ics20.TransferMsgURL)
+				s.Require().Nil(authz)
+
+				balance := s.app.BankKeeper.GetBalance(s.ctx, sender, utils.BaseDenom)
+				s.Require().Equal(sdk.NewInt(4e18), balance.Amount)
+				balance = s.app.BankKeeper.GetBalance(s.ctx, sender, "uatom")
+				s.Require().True(balance.IsZero())
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			sender := s.chainA.SenderAccount.GetAddress()
+			receiver := s.chainB.SenderAccount.GetAddress()
+
+			contract := vm.NewContract(vm.AccountRef(common.BytesToAddress(sender)), s.precompile, big.NewInt(0), 200000)
+			s.ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+			args := tc.malleate(sender, receiver)
+
+			// set the caller address to be another address (so we can test the authorization logic)
+			contract.CallerAddress = callingContractAddr
+			bz, err := s.precompile.TransferMulti(s.ctx, common.BytesToAddress(sender), contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+			}
+			tc.postCheck(sender, receiver, bz)
+		})
+	}
+}
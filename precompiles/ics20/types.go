@@ -414,3 +414,84 @@ func UpdateGrantIfNeeded(ctx sdk.Context, contract *vm.Contract, authzKeeper aut
 	}
 	return nil
 }
+
+// coins is a struct used to parse the Coins parameter
+// used as input in the transferMulti method
+type coins struct {
+	Coins []cmn.Coin
+}
+
+// NewMsgTransfers returns the transfer messages of the transferMulti method,
+// with one message per coin, from the given arguments.
+func NewMsgTransfers(method *abi.Method, args []interface{}) ([]*transfertypes.MsgTransfer, common.Address, error) {
+	if len(args) != 8 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 8, len(args))
+	}
+
+	sourcePort, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourcePort)
+	}
+
+	sourceChannel, ok := args[1].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSourceChannel)
+	}
+
+	var coinsInput coins
+	coinsArg := abi.Arguments{method.Inputs[2]}
+	if err := coinsArg.Copy(&coinsInput, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to Coins struct: %s", err)
+	}
+
+	if len(coinsInput.Coins) == 0 {
+		return nil, common.Address{}, errorsmod.Wrap(transfertypes.ErrInvalidAmount, ErrNoCoins)
+	}
+
+	sender, ok := args[3].(common.Address)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidSender, args[3])
+	}
+
+	receiver, ok := args[4].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidReceiver, args[4])
+	}
+
+	var input height
+	heightArg := abi.Arguments{method.Inputs[5]}
+	if err := heightArg.Copy(&input, []interface{}{args[5]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to TransferInput struct: %s", err)
+	}
+
+	timeoutTimestamp, ok := args[6].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidTimeoutTimestamp, args[6])
+	}
+
+	memo, ok := args[7].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidMemo, args[7])
+	}
+
+	msgs := make([]*transfertypes.MsgTransfer, len(coinsInput.Coins))
+	for i, coin := range coinsInput.Coins {
+		if coin.Amount == nil {
+			return nil, common.Address{}, errorsmod.Wrapf(transfertypes.ErrInvalidAmount, cmn.ErrInvalidAmount, coin.Amount)
+		}
+
+		// Use instance to prevent errors on denom or amount
+		token := sdk.Coin{
+			Denom:  coin.Denom,
+			Amount: sdk.NewIntFromBigInt(coin.Amount),
+		}
+
+		msg, err := CreateAndValidateMsgTransfer(sourcePort, sourceChannel, token, sdk.AccAddress(sender.Bytes()).String(), receiver, input.TimeoutHeight, timeoutTimestamp, memo)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, sender, nil
+}
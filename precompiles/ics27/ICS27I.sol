@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The ICS27I contract's address.
+address constant ICS27_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000806;
+
+/// @dev The ICS27I contract's instance.
+ICS27I constant ICS27_CONTRACT = ICS27I(ICS27_PRECOMPILE_ADDRESS);
+
+/// @dev CosmosMsg defines a Cosmos SDK message to be executed by an interchain account.
+/// @param typeUrl The type URL of the message, e.g. "/cosmos.staking.v1beta1.MsgDelegate"
+/// @param value The protobuf encoded message
+struct CosmosMsg {
+    string typeUrl;
+    bytes value;
+}
+
+/// @author Evmos Team
+/// @title ICS27 Interchain Accounts Precompile Contract
+/// @dev The interface through which solidity contracts will control interchain accounts
+/// on other chains through the ICS27 controller module.
+/// @custom:address 0x0000000000000000000000000000000000000806
+interface ICS27I {
+    /// @dev This event is emitted when the granter approves a grantee to control its
+    /// interchain accounts for the given method.
+    /// @param grantee The contract address that received an Authorization from the granter.
+    /// @param granter The account address that granted an Authorization.
+    /// @param method The message type URL of the method for which the approval is set.
+    event Approval(
+        address indexed grantee,
+        address indexed granter,
+        string method
+    );
+
+    /// @dev This event is emitted when the granter revokes the approvals of a grantee.
+    /// @param grantee The contract address that has its Authorization revoked.
+    /// @param granter The account address of the granter.
+    /// @param methods The message type URLs of the methods for which the approval is revoked.
+    event Revocation(
+        address indexed grantee,
+        address indexed granter,
+        string[] methods
+    );
+
+    /// @dev This event is emitted when the registration of an interchain account is initiated.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection to the host chain.
+    /// @param channelId The channel opened for the interchain account.
+    /// @param portId The controller port of the owner.
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string channelId,
+        string portId
+    );
+
+    /// @dev This event is emitted when messages are sent to an interchain account.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection to the host chain.
+    /// @param sequence The sequence of the sent packet.
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence
+    );
+
+    /// @dev Approves a grantee to control the interchain accounts of the origin.
+    /// @param grantee The contract address which will have an authorization to control the interchain accounts.
+    /// @param method The message type URL of the method to approve.
+    /// @return approved Boolean value to indicate if the approval was successful.
+    function approve(
+        address grantee,
+        string calldata method
+    ) external returns (bool approved);
+
+    /// @dev Revokes the approvals of a grantee to control the interchain accounts of the origin.
+    /// @param grantee The contract address which will have its authorizations revoked.
+    /// @param methods The message type URLs of the methods to revoke.
+    /// @return revoked Boolean value to indicate if the revocation was successful.
+    function revoke(
+        address grantee,
+        string[] calldata methods
+    ) external returns (bool revoked);
+
+    /// @dev Registers an interchain account for the owner on the host chain of the given connection.
+    /// The account is created once the channel handshake is completed by the relayers.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection to the host chain.
+    /// @param version The channel version. The default version is used if empty.
+    /// @return channelId The channel opened for the interchain account.
+    /// @return portId The controller port of the owner.
+    function registerInterchainAccount(
+        address owner,
+        string calldata connectionId,
+        string calldata version
+    ) external returns (string memory channelId, string memory portId);
+
+    /// @dev Sends messages to be executed by the interchain account of the owner.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection to the host chain.
+    /// @param msgs The messages to be executed on the host chain.
+    /// @param memo The memo of the packet.
+    /// @param relativeTimeout The packet timeout in nanoseconds, relative to the current block time.
+    /// @return sequence The sequence of the sent packet.
+    function sendTx(
+        address owner,
+        string calldata connectionId,
+        CosmosMsg[] calldata msgs,
+        string calldata memo,
+        uint64 relativeTimeout
+    ) external returns (uint64 sequence);
+
+    /// @dev Queries the address of the interchain account of the owner on the host chain.
+    /// @param owner The address of the owner of the interchain account.
+    /// @param connectionId The connection to the host chain.
+    /// @return accountAddress The address of the interchain account, or an empty string if not registered.
+    function interchainAccount(
+        address owner,
+        string calldata connectionId
+    ) external view returns (string memory accountAddress);
+}
@@ -0,0 +1,122 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+var (
+	// RegisterInterchainAccountMsgURL defines the ICS27 authorization type for MsgRegisterInterchainAccount
+	RegisterInterchainAccountMsgURL = sdk.MsgTypeURL(&icacontrollertypes.MsgRegisterInterchainAccount{})
+	// SendTxMsgURL defines the ICS27 authorization type for MsgSendTx
+	SendTxMsgURL = sdk.MsgTypeURL(&icacontrollertypes.MsgSendTx{})
+)
+
+// Approve is the precompile function for approving ICS27 transactions with a generic grant.
+func (p Precompile) Approve(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURL, err := checkApprovalArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typeURL {
+	case RegisterInterchainAccountMsgURL, SendTxMsgURL:
+		genericAuthorization := authz.GenericAuthorization{Msg: typeURL}
+		expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+		if err := p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), origin.Bytes(), &genericAuthorization, &expiration); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "ics27", typeURL)
+	}
+
+	if err := p.EmitApprovalEvent(ctx, stateDB, origin, grantee, typeURL); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Revoke removes the authorization grants given in the typeUrls for a given granter to a given grantee.
+// It only works if the origin matches the spender to avoid unauthorized revocations.
+// Works only for ICS27 messages.
+func (p Precompile) Revoke(
+	ctx sdk.Context,
+	origin common.Address,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	grantee, typeURLs, err := authorization.CheckRevokeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range typeURLs {
+		switch typeURL {
+		case RegisterInterchainAccountMsgURL, SendTxMsgURL:
+			if err = p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), typeURL); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "ics27", typeURL)
+		}
+	}
+
+	if err = authorization.EmitRevocationEvent(cmn.EmitEventArgs{
+		Ctx:            ctx,
+		StateDB:        stateDB,
+		ContractAddr:   p.Address(),
+		ContractEvents: p.ABI.Events,
+		EventData: authorization.EventRevocation{
+			Granter:  origin,
+			Grantee:  grantee,
+			TypeUrls: typeURLs,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkOwner checks that the caller is allowed to act on behalf of the owner
+// of the interchain account. Contracts control their own interchain accounts,
+// while controlling the interchain accounts of the tx origin requires a grant
+// from the origin when called from a contract.
+func (p Precompile) checkOwner(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	origin, owner common.Address,
+	msgURL string,
+) error {
+	if contract.CallerAddress == owner {
+		return nil
+	}
+
+	if origin != owner {
+		return fmt.Errorf(ErrDifferentOwnerOrigin, owner, contract.CallerAddress, origin)
+	}
+
+	if _, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, origin, msgURL); err != nil {
+		return fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, msgURL, contract.CallerAddress)
+	}
+
+	return nil
+}
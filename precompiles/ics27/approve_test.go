@@ -0,0 +1,174 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27_test
+
+import (
+	"fmt"
+
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+var differentAddress = testutiltx.GenerateAddress()
+
+func (s *PrecompileTestSuite) TestApprove() {
+	method := s.precompile.Methods[authorization.ApproveMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid grantee",
+			func() []interface{} {
+				return []interface{}{"", ics27.SendTxMsgURL}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(authorization.ErrInvalidGrantee, ""),
+		},
+		{
+			"fail - not an ICS27 message type",
+			func() []interface{} {
+				return []interface{}{differentAddress, "/cosmos.bank.v1beta1.MsgSend"}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidMsgType, "ics27", "/cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			"pass - approve the registration of interchain accounts",
+			func() []interface{} {
+				return []interface{}{differentAddress, ics27.RegisterInterchainAccountMsgURL}
+			},
+			func() {
+				authz, expiration := s.app.AuthzKeeper.GetAuthorization(s.ctx, differentAddress.Bytes(), s.address.Bytes(), ics27.RegisterInterchainAccountMsgURL)
+				s.Require().NotNil(authz)
+				s.Require().Equal(s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration).UTC(), *expiration)
+			},
+			false,
+			"",
+		},
+		{
+			"pass - approve sending txs through interchain accounts",
+			func() []interface{} {
+				return []interface{}{differentAddress, ics27.SendTxMsgURL}
+			},
+			func() {
+				authz, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, differentAddress.Bytes(), s.address.Bytes(), ics27.SendTxMsgURL)
+				s.Require().NotNil(authz)
+				authz, _ = s.app.AuthzKeeper.GetAuthorization(s.ctx, differentAddress.Bytes(), s.address.Bytes(), ics27.RegisterInterchainAccountMsgURL)
+				s.Require().Nil(authz)
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Approve(s.ctx, s.address, s.stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestRevoke() {
+	method := s.precompile.Methods[authorization.RevokeMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - not an ICS27 message type",
+			func() []interface{} {
+				return []interface{}{differentAddress, []string{"/cosmos.bank.v1beta1.MsgSend"}}
+			},
+			func() {},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidMsgType, "ics27", "/cosmos.bank.v1beta1.MsgSend"),
+		},
+		{
+			"fail - authorization does not exist",
+			func() []interface{} {
+				return []interface{}{differentAddress, []string{ics27.SendTxMsgURL}}
+			},
+			func() {},
+			true,
+			"not found",
+		},
+		{
+			"pass - revoke the grants of both methods",
+			func() []interface{} {
+				expiration := s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration)
+				s.grant(differentAddress, s.address, ics27.RegisterInterchainAccountMsgURL, expiration)
+				s.grant(differentAddress, s.address, ics27.SendTxMsgURL, expiration)
+				return []interface{}{differentAddress, []string{ics27.RegisterInterchainAccountMsgURL, ics27.SendTxMsgURL}}
+			},
+			func() {
+				authz, _ := s.app.AuthzKeeper.GetAuthorization(s.ctx, differentAddress.Bytes(), s.address.Bytes(), ics27.RegisterInterchainAccountMsgURL)
+				s.Require().Nil(authz)
+				authz, _ = s.app.AuthzKeeper.GetAuthorization(s.ctx, differentAddress.Bytes(), s.address.Bytes(), ics27.SendTxMsgURL)
+				s.Require().Nil(authz)
+				s.Require().Len(s.stateDB.Logs(), 1)
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			bz, err := s.precompile.Revoke(s.ctx, s.address, s.stateDB, &method, tc.malleate())
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cmn.TrueValue, bz)
+				tc.postCheck()
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27
+
+const (
+	// ErrInvalidOwner is raised when the owner address is not valid.
+	ErrInvalidOwner = "invalid owner address: %v"
+	// ErrInvalidConnectionID is raised when the connection ID is not valid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrDifferentOwnerOrigin is raised when the owner is neither the contract caller nor the tx origin.
+	ErrDifferentOwnerOrigin = "owner address %s is neither the caller %s nor the tx origin %s"
+	// ErrEmptyMsgs is raised when no messages are sent to the interchain account.
+	ErrEmptyMsgs = "no messages to execute on the interchain account"
+)
@@ -0,0 +1,137 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27_test
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+)
+
+func (s *PrecompileTestSuite) TestIsTransaction() {
+	s.Require().True(s.precompile.IsTransaction(authorization.ApproveMethod))
+	s.Require().True(s.precompile.IsTransaction(authorization.RevokeMethod))
+	s.Require().True(s.precompile.IsTransaction(ics27.RegisterInterchainAccountMethod))
+	s.Require().True(s.precompile.IsTransaction(ics27.SendTxMethod))
+	s.Require().False(s.precompile.IsTransaction(ics27.InterchainAccountMethod))
+	s.Require().False(s.precompile.IsTransaction("invalid"))
+}
+
+func (s *PrecompileTestSuite) TestRun() {
+	timeout := uint64(time.Hour.Nanoseconds())
+
+	testCases := []struct {
+		name        string
+		malleate    func() (common.Address, []byte)
+		readOnly    bool
+		expPass     bool
+		errContains string
+	}{
+		{
+			"pass - approve transaction",
+			func() (common.Address, []byte) {
+				input, err := s.precompile.Pack(authorization.ApproveMethod, callingContract, ics27.SendTxMsgURL)
+				s.Require().NoError(err)
+				return s.address, input
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - register interchain account transaction",
+			func() (common.Address, []byte) {
+				input, err := s.precompile.Pack(ics27.RegisterInterchainAccountMethod, s.address, s.path.EndpointA.ConnectionID, "")
+				s.Require().NoError(err)
+				return s.address, input
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - send tx transaction",
+			func() (common.Address, []byte) {
+				s.registerInterchainAccount(s.address)
+				input, err := s.precompile.Pack(ics27.SendTxMethod, s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout)
+				s.Require().NoError(err)
+				return s.address, input
+			},
+			false,
+			true,
+			"",
+		},
+		{
+			"pass - interchain account query",
+			func() (common.Address, []byte) {
+				s.registerInterchainAccount(s.address)
+				input, err := s.precompile.Pack(ics27.InterchainAccountMethod, s.address, s.path.EndpointA.ConnectionID)
+				s.Require().NoError(err)
+				return s.address, input
+			},
+			true,
+			true,
+			"",
+		},
+		{
+			"fail - register interchain account in a read-only call",
+			func() (common.Address, []byte) {
+				input, err := s.precompile.Pack(ics27.RegisterInterchainAccountMethod, s.address, s.path.EndpointA.ConnectionID, "")
+				s.Require().NoError(err)
+				return s.address, input
+			},
+			true,
+			false,
+			vm.ErrWriteProtection.Error(),
+		},
+		{
+			"fail - send tx from a contract without a grant of the origin",
+			func() (common.Address, []byte) {
+				s.registerInterchainAccount(s.address)
+				input, err := s.precompile.Pack(ics27.SendTxMethod, s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout)
+				s.Require().NoError(err)
+				return callingContract, input
+			},
+			false,
+			false,
+			"does not exist or is expired",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			caller, input := tc.malleate()
+
+			contract := vm.NewPrecompile(vm.AccountRef(caller), s.precompile, big.NewInt(0), uint64(1e6))
+			contract.Input = input
+
+			// Instantiate the EVM with the suite address as the tx origin
+			precompileAddr := s.precompile.Address()
+			msg := ethtypes.NewMessage(s.address, &precompileAddr, 0, big.NewInt(0), uint64(1e6), big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, true)
+			cfg, err := s.app.EvmKeeper.EVMConfig(s.ctx, sdk.ConsAddress(s.chainA.Vals.Proposer.Address), s.app.EvmKeeper.ChainID())
+			s.Require().NoError(err, "failed to instantiate EVM config")
+			evm := s.app.EvmKeeper.NewEVM(s.ctx, msg, cfg, nil, s.stateDB)
+
+			// Run precompiled contract
+			bz, err := s.precompile.Run(evm, contract, tc.readOnly)
+
+			// Check results
+			if tc.expPass {
+				s.Require().NoError(err, "expected no error when running the precompile")
+				s.Require().NotNil(bz, "expected returned bytes not to be nil")
+			} else {
+				s.Require().Error(err, "expected error to be returned when running the precompile")
+				s.Require().Nil(bz, "expected returned bytes to be nil")
+				s.Require().ErrorContains(err, tc.errContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// InterchainAccountMethod defines the ABI method name for the ICS27
+	// InterchainAccount query.
+	InterchainAccountMethod = "interchainAccount"
+)
+
+// InterchainAccount returns the address of the interchain account of the owner
+// on the host chain of the given connection. An empty address is returned if
+// the interchain account is not registered.
+func (p Precompile) InterchainAccount(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner, connectionID, err := parseInterchainAccountArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	return method.Outputs.Pack(address)
+}
@@ -0,0 +1,70 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/ethereum/go-ethereum/common"
+	evmosapp "github.com/evmos/evmos/v15/app"
+	evmosibc "github.com/evmos/evmos/v15/ibc/testing"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	inflationtypes "github.com/evmos/evmos/v15/x/inflation/v1/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	app     *evmosapp.Evmos
+	address common.Address
+
+	precompile *ics27.Precompile
+	stateDB    *statedb.StateDB
+
+	coordinator *ibcgotesting.Coordinator
+	// chainA is the Evmos controller chain and chainB the host chain
+	chainA *ibcgotesting.TestChain
+	chainB *ibcgotesting.TestChain
+	path   *evmosibc.Path
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (s *PrecompileTestSuite) SetupTest() {
+	s.coordinator = evmosibc.NewCoordinator(s.T(), 1, 1)
+	s.chainA = s.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	s.app = s.chainA.App.(*evmosapp.Evmos)
+
+	// Fund the sender address to pay the fees of the channel handshakes
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	s.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+	err := s.app.BankKeeper.MintCoins(s.chainA.GetContext(), inflationtypes.ModuleName, coins)
+	s.Require().NoError(err)
+	err = s.app.BankKeeper.SendCoinsFromModuleToAccount(s.chainA.GetContext(), inflationtypes.ModuleName, s.chainA.SenderAccount.GetAddress(), coins)
+	s.Require().NoError(err)
+
+	// The channels of the interchain accounts are opened on the path once
+	// registered, so only the connection is set up here.
+	s.path = evmosibc.NewPath(s.chainA, s.chainB)
+	s.path.SetChannelOrdered()
+	s.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+	evmosibc.SetupConnections(s.coordinator, s.path)
+
+	precompile, err := ics27.NewPrecompile(s.app.ICAControllerKeeper, s.app.AuthzKeeper)
+	s.Require().NoError(err)
+	s.precompile = precompile
+
+	s.address = common.BytesToAddress(s.chainA.SenderAccount.GetAddress().Bytes())
+	s.refreshContext()
+}
@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICS27
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICS27 SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount initiates the registration of an interchain
+// account for the owner on the host chain of the given connection.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgRegisterInterchainAccount(args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkOwner(ctx, contract, origin, owner, RegisterInterchainAccountMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ owner: %s, connection_id: %s, version: %s }", msg.Owner, msg.ConnectionId, msg.Version),
+	)
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper)
+	res, err := msgSrv.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.ChannelId, res.PortId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId, res.PortId)
+}
+
+// SendTx sends the given messages to be executed by the interchain account of
+// the owner on the host chain of the given connection.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, owner, err := NewMsgSendTx(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkOwner(ctx, contract, origin, owner, SendTxMsgURL); err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ owner: %s, connection_id: %s, relative_timeout: %d }", msg.Owner, msg.ConnectionId, msg.RelativeTimeout),
+	)
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper)
+	res, err := msgSrv.SendTx(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
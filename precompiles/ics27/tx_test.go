@@ -0,0 +1,277 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27_test
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+	testutiltx "github.com/evmos/evmos/v15/testutil/tx"
+)
+
+// callingContract is the contract calling the precompile on behalf of itself
+// or of the tx origin.
+var callingContract = testutiltx.GenerateAddress()
+
+func (s *PrecompileTestSuite) TestRegisterInterchainAccount() {
+	method := s.precompile.Methods[ics27.RegisterInterchainAccountMethod]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (common.Address, []interface{})
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - invalid connection ID",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{s.address, "", ""}
+			},
+			true,
+			fmt.Sprintf(ics27.ErrInvalidConnectionID, ""),
+		},
+		{
+			"fail - owner is neither the caller nor the origin",
+			func() (common.Address, []interface{}) {
+				return callingContract, []interface{}{differentAddress, s.path.EndpointA.ConnectionID, ""}
+			},
+			true,
+			"is neither the caller",
+		},
+		{
+			"fail - origin did not grant the calling contract",
+			func() (common.Address, []interface{}) {
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.RegisterInterchainAccountMsgURL, callingContract),
+		},
+		{
+			"fail - grant of the origin is expired",
+			func() (common.Address, []interface{}) {
+				s.grant(callingContract, s.address, ics27.RegisterInterchainAccountMsgURL, s.ctx.BlockTime().Add(time.Hour))
+				s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.RegisterInterchainAccountMsgURL, callingContract),
+		},
+		{
+			"fail - grant of the origin is revoked",
+			func() (common.Address, []interface{}) {
+				s.grant(callingContract, s.address, ics27.RegisterInterchainAccountMsgURL, s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration))
+				err := s.app.AuthzKeeper.DeleteGrant(s.ctx, callingContract.Bytes(), s.address.Bytes(), ics27.RegisterInterchainAccountMsgURL)
+				s.Require().NoError(err)
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.RegisterInterchainAccountMsgURL, callingContract),
+		},
+		{
+			"fail - grant of a different method",
+			func() (common.Address, []interface{}) {
+				s.grant(callingContract, s.address, ics27.SendTxMsgURL, s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration))
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.RegisterInterchainAccountMsgURL, callingContract),
+		},
+		{
+			"pass - origin registers its own interchain account",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - contract registers its own interchain account",
+			func() (common.Address, []interface{}) {
+				return callingContract, []interface{}{callingContract, s.path.EndpointA.ConnectionID, ""}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - contract registers the interchain account of the origin with a grant",
+			func() (common.Address, []interface{}) {
+				s.grant(callingContract, s.address, ics27.RegisterInterchainAccountMsgURL, s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration))
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, ""}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200000)
+
+			bz, err := s.precompile.RegisterInterchainAccount(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			channelID, portID := out[0].(string), out[1].(string)
+
+			owner := args[0].(common.Address)
+			expPortID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+			s.Require().NoError(err)
+			s.Require().Equal(expPortID, portID)
+
+			channel, found := s.app.IBCKeeper.ChannelKeeper.GetChannel(s.ctx, portID, channelID)
+			s.Require().True(found)
+			s.Require().Equal(channeltypes.INIT, channel.State)
+			s.Require().Len(s.stateDB.Logs(), 1)
+
+			// the interchain account is created once the handshake is completed
+			s.openChannel(portID, channelID)
+			address, found := s.app.ICAControllerKeeper.GetInterchainAccountAddress(s.ctx, s.path.EndpointA.ConnectionID, portID)
+			s.Require().True(found)
+			s.Require().NotEmpty(address)
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestSendTx() {
+	method := s.precompile.Methods[ics27.SendTxMethod]
+	timeout := uint64(time.Hour.Nanoseconds())
+
+	testCases := []struct {
+		name        string
+		malleate    func() (common.Address, []interface{})
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 5, 0),
+		},
+		{
+			"fail - no messages",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{s.address, s.path.EndpointA.ConnectionID, []ics27.CosmosMsg{}, "", timeout}
+			},
+			true,
+			ics27.ErrEmptyMsgs,
+		},
+		{
+			"fail - interchain account not registered",
+			func() (common.Address, []interface{}) {
+				return s.address, []interface{}{s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout}
+			},
+			true,
+			"failed to retrieve active channel",
+		},
+		{
+			"fail - origin did not grant the calling contract",
+			func() (common.Address, []interface{}) {
+				s.registerInterchainAccount(s.address)
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.SendTxMsgURL, callingContract),
+		},
+		{
+			"fail - grant of the origin is expired",
+			func() (common.Address, []interface{}) {
+				s.registerInterchainAccount(s.address)
+				s.grant(callingContract, s.address, ics27.SendTxMsgURL, s.ctx.BlockTime().Add(time.Hour))
+				s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout}
+			},
+			true,
+			fmt.Sprintf(authorization.ErrAuthzDoesNotExistOrExpired, ics27.SendTxMsgURL, callingContract),
+		},
+		{
+			"pass - origin sends a tx through its own interchain account",
+			func() (common.Address, []interface{}) {
+				s.registerInterchainAccount(s.address)
+				return s.address, []interface{}{s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "memo", timeout}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - contract sends a tx through its own interchain account",
+			func() (common.Address, []interface{}) {
+				s.registerInterchainAccount(callingContract)
+				return callingContract, []interface{}{callingContract, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout}
+			},
+			false,
+			"",
+		},
+		{
+			"pass - contract sends a tx through the interchain account of the origin with a grant",
+			func() (common.Address, []interface{}) {
+				s.registerInterchainAccount(s.address)
+				s.grant(callingContract, s.address, ics27.SendTxMsgURL, s.ctx.BlockTime().Add(cmn.DefaultExpirationDuration))
+				return callingContract, []interface{}{s.address, s.path.EndpointA.ConnectionID, s.cosmosMsgs(), "", timeout}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			caller, args := tc.malleate()
+			contract := vm.NewContract(vm.AccountRef(caller), s.precompile, big.NewInt(0), 200000)
+
+			bz, err := s.precompile.SendTx(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			out, err := method.Outputs.Unpack(bz)
+			s.Require().NoError(err)
+			sequence := out[0].(uint64)
+			s.Require().Equal(uint64(1), sequence)
+
+			// the packet is committed on the channel of the interchain account
+			commitment := s.app.IBCKeeper.ChannelKeeper.GetPacketCommitment(
+				s.ctx, s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, sequence,
+			)
+			s.Require().NotEmpty(commitment)
+			s.Require().Len(s.stateDB.Logs(), 1)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/authorization"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// CosmosMsg is a struct used to parse the messages executed by an interchain
+// account, which are given as protobuf encoded Cosmos SDK messages.
+type CosmosMsg struct {
+	TypeUrl string //nolint:revive,stylecheck // follows the protobuf Any field name
+	Value   []byte
+}
+
+// cosmosMsgs is a struct used to parse the Msgs parameter
+// used as input in the sendTx method
+type cosmosMsgs struct {
+	Msgs []CosmosMsg
+}
+
+// checkApprovalArgs checks the arguments passed to the approve function.
+func checkApprovalArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	grantee, ok := args[0].(common.Address)
+	if !ok || grantee == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(authorization.ErrInvalidGrantee, args[0])
+	}
+
+	typeURL, ok := args[1].(string)
+	if !ok || typeURL == "" {
+		return common.Address{}, "", fmt.Errorf(authorization.ErrInvalidMethod, args[1])
+	}
+
+	return grantee, typeURL, nil
+}
+
+// parseOwnerAndConnection parses the owner and connection ID arguments that are
+// common to all the ICS27 methods.
+func parseOwnerAndConnection(args []interface{}) (common.Address, string, error) {
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok || connectionID == "" {
+		return common.Address{}, "", fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	return owner, connectionID, nil
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance.
+func NewMsgRegisterInterchainAccount(args []interface{}) (*icacontrollertypes.MsgRegisterInterchainAccount, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	owner, connectionID, err := parseOwnerAndConnection(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	version, ok := args[2].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "version", "", args[2])
+	}
+
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, sdk.AccAddress(owner.Bytes()).String(), version)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance. The given messages are
+// wrapped into a Cosmos transaction executed by the interchain account.
+func NewMsgSendTx(method *abi.Method, args []interface{}) (*icacontrollertypes.MsgSendTx, common.Address, error) {
+	if len(args) != 5 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 5, len(args))
+	}
+
+	owner, connectionID, err := parseOwnerAndConnection(args)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	var input cosmosMsgs
+	msgsArg := abi.Arguments{method.Inputs[2]}
+	if err := msgsArg.Copy(&input, []interface{}{args[2]}); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CosmosMsgs struct: %s", err)
+	}
+
+	memo, ok := args[3].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "memo", "", args[3])
+	}
+
+	relativeTimeout, ok := args[4].(uint64)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "relativeTimeout", uint64(0), args[4])
+	}
+
+	data, err := NewCosmosTx(input.Msgs)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	msg := icacontrollertypes.NewMsgSendTx(sdk.AccAddress(owner.Bytes()).String(), connectionID, relativeTimeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, owner, nil
+}
+
+// NewCosmosTx encodes the given messages into the Cosmos transaction executed
+// by the interchain account on the host chain. The messages are not decoded,
+// since they don't need to be registered on this chain.
+func NewCosmosTx(msgs []CosmosMsg) ([]byte, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New(ErrEmptyMsgs)
+	}
+
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		if msg.TypeUrl == "" {
+			return nil, fmt.Errorf(cmn.ErrInvalidMsgType, "ics27", msg.TypeUrl)
+		}
+		anys[i] = &codectypes.Any{
+			TypeUrl: msg.TypeUrl,
+			Value:   msg.Value,
+		}
+	}
+
+	cosmosTx := &icatypes.CosmosTx{Messages: anys}
+	return cosmosTx.Marshal()
+}
+
+// parseInterchainAccountArgs parses the arguments for the InterchainAccount query.
+func parseInterchainAccountArgs(args []interface{}) (common.Address, string, error) {
+	if len(args) != 2 {
+		return common.Address{}, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	return parseOwnerAndConnection(args)
+}
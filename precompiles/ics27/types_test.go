@@ -0,0 +1,103 @@
+package ics27
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name    string
+		args    []interface{}
+		expPass bool
+	}{
+		{"pass", []interface{}{owner, "connection-0", ""}, true},
+		{"pass - with version", []interface{}{owner, "connection-0", icatypes.NewDefaultMetadataString("connection-0", "connection-1")}, true},
+		{"fail - invalid number of arguments", []interface{}{owner, "connection-0"}, false},
+		{"fail - zero owner", []interface{}{common.Address{}, "connection-0", ""}, false},
+		{"fail - empty connection", []interface{}{owner, "", ""}, false},
+		{"fail - invalid version", []interface{}{owner, "connection-0", 1}, false},
+	}
+
+	for _, tc := range testCases {
+		msg, addr, err := NewMsgRegisterInterchainAccount(tc.args)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, owner, addr, tc.name)
+		require.Equal(t, "connection-0", msg.ConnectionId, tc.name)
+		require.Equal(t, tc.args[2], msg.Version, tc.name)
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	precompile, err := NewPrecompile(icacontrollerkeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+	method := precompile.Methods[SendTxMethod]
+
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	msgs := []CosmosMsg{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{1, 2, 3}}}
+
+	testCases := []struct {
+		name    string
+		args    []interface{}
+		expPass bool
+	}{
+		{"pass", []interface{}{owner, "connection-0", msgs, "memo", uint64(60_000_000_000)}, true},
+		{"fail - invalid number of arguments", []interface{}{owner, "connection-0", msgs, "memo"}, false},
+		{"fail - zero owner", []interface{}{common.Address{}, "connection-0", msgs, "memo", uint64(1)}, false},
+		{"fail - empty connection", []interface{}{owner, "", msgs, "memo", uint64(1)}, false},
+		{"fail - invalid msgs", []interface{}{owner, "connection-0", "msgs", "memo", uint64(1)}, false},
+		{"fail - no msgs", []interface{}{owner, "connection-0", []CosmosMsg{}, "memo", uint64(1)}, false},
+		{"fail - empty msg type url", []interface{}{owner, "connection-0", []CosmosMsg{{Value: []byte{1}}}, "memo", uint64(1)}, false},
+		{"fail - invalid memo", []interface{}{owner, "connection-0", msgs, 1, uint64(1)}, false},
+		{"fail - zero timeout", []interface{}{owner, "connection-0", msgs, "memo", uint64(0)}, false},
+	}
+
+	for _, tc := range testCases {
+		msg, addr, err := NewMsgSendTx(&method, tc.args)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, owner, addr, tc.name)
+		require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type, tc.name)
+		require.Equal(t, "memo", msg.PacketData.Memo, tc.name)
+		require.Equal(t, uint64(60_000_000_000), msg.RelativeTimeout, tc.name)
+
+		var cosmosTx icatypes.CosmosTx
+		require.NoError(t, cosmosTx.Unmarshal(msg.PacketData.Data), tc.name)
+		require.Equal(t, []*codectypes.Any{{TypeUrl: msgs[0].TypeUrl, Value: msgs[0].Value}}, cosmosTx.Messages, tc.name)
+	}
+}
+
+func TestCheckApprovalArgs(t *testing.T) {
+	grantee := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	addr, typeURL, err := checkApprovalArgs([]interface{}{grantee, SendTxMsgURL})
+	require.NoError(t, err)
+	require.Equal(t, grantee, addr)
+	require.Equal(t, SendTxMsgURL, typeURL)
+
+	_, _, err = checkApprovalArgs([]interface{}{grantee})
+	require.Error(t, err)
+
+	_, _, err = checkApprovalArgs([]interface{}{common.Address{}, SendTxMsgURL})
+	require.Error(t, err)
+
+	_, _, err = checkApprovalArgs([]interface{}{grantee, ""})
+	require.Error(t, err)
+}
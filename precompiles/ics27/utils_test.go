@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package ics27_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/evmos/v15/precompiles/ics27"
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+)
+
+// refreshContext sets the context and the state DB of the suite to the
+// current block of the controller chain.
+func (s *PrecompileTestSuite) refreshContext() {
+	s.ctx = s.chainA.GetContext()
+	s.stateDB = statedb.New(s.ctx, s.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(s.ctx.HeaderHash().Bytes())))
+}
+
+// registerInterchainAccount registers an interchain account for the owner
+// and completes the channel handshake with the host chain. It returns the
+// controller port and channel of the interchain account.
+func (s *PrecompileTestSuite) registerInterchainAccount(owner common.Address) (string, string) {
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(&s.app.ICAControllerKeeper)
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(s.path.EndpointA.ConnectionID, sdk.AccAddress(owner.Bytes()).String(), "")
+	res, err := msgSrv.RegisterInterchainAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	s.openChannel(res.PortId, res.ChannelId)
+	return res.PortId, res.ChannelId
+}
+
+// openChannel completes the handshake of the interchain account channel
+// initialized on the controller chain.
+func (s *PrecompileTestSuite) openChannel(portID, channelID string) {
+	s.coordinator.CommitBlock(s.chainA)
+
+	s.path.EndpointA.ChannelID = channelID
+	s.path.EndpointA.ChannelConfig.PortID = portID
+	s.path.EndpointA.ChannelConfig.Version = s.path.EndpointA.GetChannel().Version
+	s.path.EndpointB.ChannelConfig.Version = s.path.EndpointA.ChannelConfig.Version
+
+	s.Require().NoError(s.path.EndpointB.ChanOpenTry())
+	s.Require().NoError(s.path.EndpointA.ChanOpenAck())
+	s.Require().NoError(s.path.EndpointB.ChanOpenConfirm())
+
+	s.refreshContext()
+}
+
+// grant saves a generic authorization from the granter to the grantee for
+// the given ICS27 message type URL.
+func (s *PrecompileTestSuite) grant(grantee, granter common.Address, msgURL string, expiration time.Time) {
+	err := s.app.AuthzKeeper.SaveGrant(s.ctx, grantee.Bytes(), granter.Bytes(), &authz.GenericAuthorization{Msg: msgURL}, &expiration)
+	s.Require().NoError(err)
+}
+
+// cosmosMsgs returns a bank send executed by the interchain account.
+func (s *PrecompileTestSuite) cosmosMsgs() []ics27.CosmosMsg {
+	msg := &banktypes.MsgSend{
+		FromAddress: "cosmos1interchainaccount",
+		ToAddress:   "cosmos1receiver",
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1)),
+	}
+	bz, err := msg.Marshal()
+	s.Require().NoError(err)
+
+	return []ics27.CosmosMsg{{TypeUrl: sdk.MsgTypeURL(msg), Value: bz}}
+}
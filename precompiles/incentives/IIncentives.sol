@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+import "../common/Types.sol";
+
+/// @dev The IIncentives contract's address.
+address constant INCENTIVES_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The IIncentives contract's instance.
+IIncentives constant INCENTIVES_CONTRACT = IIncentives(
+    INCENTIVES_PRECOMPILE_ADDRESS
+);
+
+/// @author Evmos Team
+/// @title Incentives Precompile Contract
+/// @dev The interface through which solidity contracts will interact with Incentives
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface IIncentives {
+    /// @dev ClaimRewards defines an Event emitted when incentive rewards are claimed
+    /// @param participant the address of the participant
+    /// @param amount the amount of the EVM denomination being claimed
+    event ClaimRewards(
+        address indexed participant,
+        uint256 amount
+    );
+
+    /// @dev Claims the rewards of a participant of incentivized contracts.
+    /// @param participant The address of the participant
+    /// @param contracts The addresses of the contracts to claim the rewards of.
+    /// The rewards of all the contracts are claimed when empty.
+    /// @return amount The amount of rewards claimed
+    function claimRewards(
+        address participant,
+        address[] calldata contracts
+    ) external returns (Coin[] calldata amount);
+
+    /// @dev Queries the rewards of a participant that can be claimed.
+    /// @param participant The address of the participant
+    /// @return total The total amount of rewards that can be claimed
+    function pendingRewards(
+        address participant
+    ) external view returns (Coin[] calldata total);
+}
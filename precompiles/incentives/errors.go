@@ -0,0 +1,8 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package incentives
+
+const (
+	// ErrInvalidParticipant is raised when the participant address is not valid.
+	ErrInvalidParticipant = "invalid participant address: %v"
+)
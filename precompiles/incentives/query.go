@@ -0,0 +1,35 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package incentives
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+const (
+	// PendingRewardsMethod defines the ABI method name for the incentives
+	// PendingRewards query.
+	PendingRewardsMethod = "pendingRewards"
+)
+
+// PendingRewards returns the total rewards of a participant that can be
+// claimed.
+func (p Precompile) PendingRewards(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	participant, err := parsePendingRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	_, total := p.incentivesKeeper.GetPendingRewards(ctx, participant)
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(total))
+}
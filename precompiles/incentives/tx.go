@@ -0,0 +1,68 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package incentives
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	incentivestypes "github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+const (
+	// ClaimRewardsMethod defines the ABI method name for the incentives
+	// ClaimIncentiveRewards transaction.
+	ClaimRewardsMethod = "claimRewards"
+)
+
+// ClaimRewards claims the rewards of a participant of the given incentivized
+// contracts, or of all of them if none is given.
+func (p Precompile) ClaimRewards(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	participant, contracts, err := parseClaimRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the contract is the participant, we don't need an origin check
+	// Otherwise check if the origin matches the participant address
+	isContractParticipant := contract.CallerAddress == participant
+	if !isContractParticipant && origin != participant {
+		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), participant.String())
+	}
+
+	msg := incentivestypes.NewMsgClaimIncentiveRewards(participant.Bytes(), contracts)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	res, err := p.incentivesKeeper.ClaimIncentiveRewards(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	evmDenomAmount := res.Amount.AmountOf(p.incentivesKeeper.GetEVMDenom(ctx))
+	if err = p.EmitClaimRewardsEvent(ctx, stateDB, participant, evmDenomAmount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if isContractParticipant && evmDenomAmount.IsPositive() {
+		stateDB.(*statedb.StateDB).AddBalance(contract.CallerAddress, evmDenomAmount.BigInt())
+	}
+
+	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
+}
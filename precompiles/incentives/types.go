@@ -0,0 +1,44 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package incentives
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	cmn "github.com/evmos/evmos/v15/precompiles/common"
+)
+
+// parseClaimRewardsArgs parses the arguments for the ClaimRewards method.
+func parseClaimRewardsArgs(args []interface{}) (common.Address, []common.Address, error) {
+	if len(args) != 2 {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	participant, ok := args[0].(common.Address)
+	if !ok || participant == (common.Address{}) {
+		return common.Address{}, nil, fmt.Errorf(ErrInvalidParticipant, args[0])
+	}
+
+	contracts, ok := args[1].([]common.Address)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf(cmn.ErrInvalidType, "contracts", []common.Address{}, args[1])
+	}
+
+	return participant, contracts, nil
+}
+
+// parsePendingRewardsArgs parses the arguments for the PendingRewards method.
+func parsePendingRewardsArgs(args []interface{}) (common.Address, error) {
+	if len(args) != 1 {
+		return common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	participant, ok := args[0].(common.Address)
+	if !ok || participant == (common.Address{}) {
+		return common.Address{}, fmt.Errorf(ErrInvalidParticipant, args[0])
+	}
+
+	return participant, nil
+}
@@ -0,0 +1,52 @@
+package incentives
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClaimRewardsArgs(t *testing.T) {
+	participant := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contracts := []common.Address{common.HexToAddress("0x2000000000000000000000000000000000000002")}
+
+	testCases := []struct {
+		name    string
+		args    []interface{}
+		expPass bool
+	}{
+		{"pass", []interface{}{participant, contracts}, true},
+		{"pass - all contracts", []interface{}{participant, []common.Address{}}, true},
+		{"fail - invalid number of arguments", []interface{}{participant}, false},
+		{"fail - zero participant", []interface{}{common.Address{}, contracts}, false},
+		{"fail - invalid participant", []interface{}{"participant", contracts}, false},
+		{"fail - invalid contracts", []interface{}{participant, "contracts"}, false},
+	}
+
+	for _, tc := range testCases {
+		addr, parsedContracts, err := parseClaimRewardsArgs(tc.args)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, participant, addr, tc.name)
+		require.Equal(t, tc.args[1], parsedContracts, tc.name)
+	}
+}
+
+func TestParsePendingRewardsArgs(t *testing.T) {
+	participant := common.HexToAddress("0x1000000000000000000000000000000000000001")
+
+	addr, err := parsePendingRewardsArgs([]interface{}{participant})
+	require.NoError(t, err)
+	require.Equal(t, participant, addr)
+
+	_, err = parsePendingRewardsArgs([]interface{}{})
+	require.Error(t, err)
+
+	_, err = parsePendingRewardsArgs([]interface{}{common.Address{}})
+	require.Error(t, err)
+}
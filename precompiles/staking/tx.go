@@ -100,6 +100,13 @@ func (p Precompile) Delegate(
 		}
 	}
 
+	// Clawback vesting accounts can delegate their vested coins, even if these are
+	// still locked, but not the unvested ones. The bank keeper then tracks the
+	// delegated vesting and delegated free coins on the account.
+	if err := p.vestingKeeper.ValidateVestedDelegation(ctx, delegatorHexAddr.Bytes(), msg.Amount); err != nil {
+		return nil, err
+	}
+
 	// Execute the transaction using the message server
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
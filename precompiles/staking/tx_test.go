@@ -3,8 +3,10 @@ package staking_test
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	geth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -12,6 +14,8 @@ import (
 	"github.com/evmos/evmos/v15/precompiles/staking"
 	"github.com/evmos/evmos/v15/precompiles/testutil"
 	evmosutiltx "github.com/evmos/evmos/v15/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v15/types"
+	vestingtypes "github.com/evmos/evmos/v15/x/vesting/types"
 )
 
 func (s *PrecompileTestSuite) TestDelegate() {
@@ -224,6 +228,73 @@ func (s *PrecompileTestSuite) TestDelegate() {
 	}
 }
 
+func (s *PrecompileTestSuite) TestDelegateClawbackVestingAccount() {
+	method := s.precompile.Methods[staking.DelegateMethod]
+
+	testCases := []struct {
+		name        string
+		amount      *big.Int
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - delegate unvested coins",
+			new(big.Int).Add(big.NewInt(3e18), big.NewInt(1)),
+			true,
+			"cannot delegate unvested coins",
+		},
+		{
+			"success - delegate vested but locked coins",
+			big.NewInt(3e18),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// convert the account into a clawback vesting account, where half of the
+			// vesting coins are vested but all of them are still locked
+			quarter := sdk.NewCoins(sdk.NewCoin(s.bondDenom, sdk.NewInt(1e18)))
+			total := sdk.NewCoins(sdk.NewCoin(s.bondDenom, sdk.NewInt(4e18)))
+			acc := s.app.AccountKeeper.GetAccount(s.ctx, s.address.Bytes())
+			vestingAcc := vestingtypes.NewClawbackVestingAccount(
+				acc.(*evmostypes.EthAccount).BaseAccount,
+				s.address.Bytes(),
+				total,
+				s.ctx.BlockTime().Add(-4500*time.Second),
+				sdkvesting.Periods{{Length: 10000, Amount: total}},
+				sdkvesting.Periods{
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+					{Length: 2000, Amount: quarter},
+				},
+			)
+			s.app.AccountKeeper.SetAccount(s.ctx, vestingAcc)
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, 200000)
+
+			args := []interface{}{s.address, s.validators[0].OperatorAddress, tc.amount}
+			bz, err := s.precompile.Delegate(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			va, err := s.app.VestingKeeper.GetClawbackVestingAccount(s.ctx, s.address.Bytes())
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewIntFromBigInt(tc.amount), va.DelegatedVesting.AmountOf(s.bondDenom), "expected delegation to be tracked on the vesting account")
+		})
+	}
+}
+
 func (s *PrecompileTestSuite) TestUndelegate() {
 	method := s.precompile.Methods[staking.UndelegateMethod]
 
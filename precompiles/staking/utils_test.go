@@ -192,7 +192,7 @@ func (s *PrecompileTestSuite) DoSetupTest() {
 
 	s.ethSigner = ethtypes.LatestSignerForChainID(s.app.EvmKeeper.ChainID())
 
-	precompile, err := staking.NewPrecompile(s.app.StakingKeeper, s.app.AuthzKeeper)
+	precompile, err := staking.NewPrecompile(s.app.StakingKeeper, s.app.VestingKeeper, s.app.AuthzKeeper)
 	s.Require().NoError(err)
 	s.precompile = precompile
 
@@ -3,14 +3,17 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/evmos/evmos/v15/rpc/backend"
+	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/bundler"
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/debug"
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/eth"
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/eth/filters"
@@ -19,6 +22,8 @@ import (
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/personal"
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/txpool"
 	"github.com/evmos/evmos/v15/rpc/namespaces/ethereum/web3"
+	"github.com/evmos/evmos/v15/rpc/namespaces/evmos"
+	"github.com/evmos/evmos/v15/server/config"
 	"github.com/evmos/evmos/v15/types"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
@@ -40,11 +45,22 @@ const (
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
 
+	// BundlerNamespace enables the ERC-4337 bundler methods, which are served
+	// under the eth namespace.
+	BundlerNamespace = "bundler"
+
+	// Evmos namespaces
+
+	EvmosNamespace = "evmos"
+
 	apiVersion = "1.0"
 )
 
-// APICreator creates the JSON-RPC API implementations.
+// APICreator creates the JSON-RPC API implementations. The given context is
+// canceled when the JSON-RPC server shuts down, which stops the background
+// routines of the APIs.
 type APICreator = func(
+	goCtx context.Context,
 	ctx *server.Context,
 	clientCtx client.Context,
 	tendermintWebsocketClient *rpcclient.WSClient,
@@ -57,7 +73,8 @@ var apiCreators map[string]APICreator
 
 func init() {
 	apiCreators = map[string]APICreator{
-		EthNamespace: func(ctx *server.Context,
+		EthNamespace: func(_ context.Context,
+			ctx *server.Context,
 			clientCtx client.Context,
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
@@ -79,7 +96,7 @@ func init() {
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(context.Context, *server.Context, client.Context, *rpcclient.WSClient, bool, types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -89,7 +106,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(_ context.Context, _ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -99,7 +116,8 @@ func init() {
 				},
 			}
 		},
-		PersonalNamespace: func(ctx *server.Context,
+		PersonalNamespace: func(_ context.Context,
+			ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
@@ -115,7 +133,7 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(_ context.Context, ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ types.EVMTxIndexer) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -125,7 +143,8 @@ func init() {
 				},
 			}
 		},
-		DebugNamespace: func(ctx *server.Context,
+		DebugNamespace: func(_ context.Context,
+			ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
@@ -141,7 +160,8 @@ func init() {
 				},
 			}
 		},
-		MinerNamespace: func(ctx *server.Context,
+		MinerNamespace: func(_ context.Context,
+			ctx *server.Context,
 			clientCtx client.Context,
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
@@ -157,11 +177,70 @@ func init() {
 				},
 			}
 		},
+		EvmosNamespace: func(_ context.Context,
+			ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EvmosNamespace,
+					Version:   apiVersion,
+					Service:   evmos.NewPublicAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		BundlerNamespace: func(goCtx context.Context,
+			ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer types.EVMTxIndexer,
+		) []rpc.API {
+			appConf, err := config.GetConfig(ctx.Viper)
+			if err != nil {
+				panic(err)
+			}
+
+			if appConf.JSONRPC.BundlerEntryPoint == "" || appConf.JSONRPC.BundlerAddress == "" {
+				ctx.Logger.Error("the bundler namespace requires the bundler entry point and address to be configured")
+				return nil
+			}
+
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			bundlerAPI, err := bundler.NewPublicAPI(
+				goCtx,
+				ctx.Logger,
+				evmBackend,
+				common.HexToAddress(appConf.JSONRPC.BundlerEntryPoint),
+				common.HexToAddress(appConf.JSONRPC.BundlerAddress),
+				appConf.JSONRPC.BundlerMaxOps,
+				appConf.JSONRPC.BundlerInterval,
+			)
+			if err != nil {
+				panic(err)
+			}
+
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   bundlerAPI,
+					Public:    true,
+				},
+			}
+		},
 	}
 }
 
-// GetRPCAPIs returns the list of all APIs
-func GetRPCAPIs(ctx *server.Context,
+// GetRPCAPIs returns the list of all APIs. The background routines of the APIs
+// are stopped once the given context is canceled.
+func GetRPCAPIs(goCtx context.Context,
+	ctx *server.Context,
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
@@ -172,7 +251,7 @@ func GetRPCAPIs(ctx *server.Context,
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(goCtx, ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -33,7 +33,7 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 
 	res, err := b.queryClient.Code(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(blockNum.Int64(), err)
 	}
 
 	return res.Code, nil
@@ -50,8 +50,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	_, err = b.TendermintBlockByNumber(blockNum)
 	if err != nil {
-		// the error message imitates geth behavior
-		return nil, errors.New("header not found")
+		return nil, errHeaderNotFound
 	}
 	ctx := rpctypes.ContextWithHeight(height)
 
@@ -95,7 +94,7 @@ func (b *Backend) GetProof(address common.Address, storageKeys []string, blockNr
 
 	res, err := b.queryClient.Account(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(height, err)
 	}
 
 	// query account proofs
@@ -135,7 +134,7 @@ func (b *Backend) GetStorageAt(address common.Address, key string, blockNrOrHash
 
 	res, err := b.queryClient.Storage(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(blockNum.Int64(), err)
 	}
 
 	value := common.HexToHash(res.Value)
@@ -155,12 +154,12 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 
 	_, err = b.TendermintBlockByNumber(blockNum)
 	if err != nil {
-		return nil, err
+		return nil, errHeaderNotFound
 	}
 
 	res, err := b.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(blockNum.Int64(), err)
 	}
 
 	val, ok := sdkmath.NewIntFromString(res.Balance)
@@ -206,7 +205,7 @@ func (b *Backend) GetTransactionCount(address common.Address, blockNum rpctypes.
 	includePending := blockNum == rpctypes.EthPendingBlockNumber
 	nonce, err := b.getAccountNonce(address, includePending, blockNum.Int64(), b.logger)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(blockNum.Int64(), err)
 	}
 
 	n = hexutil.Uint64(nonce)
@@ -117,6 +117,28 @@ func (suite *BackendTestSuite) TestGetProof() {
 			false,
 			&rpctypes.AccountResult{},
 		},
+		{
+			"fail - IAVL state of the height is pruned",
+			address1,
+			[]string{"0x0"},
+			rpctypes.BlockNumberOrHash{BlockNumber: &blockNr},
+			func(bn rpctypes.BlockNumber, addr common.Address) {
+				suite.backend.ctx = rpctypes.ContextWithHeight(bn.Int64())
+
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				_, err := RegisterBlock(client, bn.Int64(), nil)
+				suite.Require().NoError(err)
+				RegisterABCIQueryWithOptionsPruned(
+					client,
+					bn.Int64(),
+					"store/evm/key",
+					evmtypes.StateKey(address1, common.HexToHash("0x0").Bytes()),
+					tmrpcclient.ABCIQueryOptions{Height: bn.Int64(), Prove: true},
+				)
+			},
+			false,
+			&rpctypes.AccountResult{},
+		},
 		{
 			"pass",
 			address1,
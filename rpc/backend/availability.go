@@ -0,0 +1,119 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// stateNotAvailableMsg is the error message of the queries at a height whose
+// state isn't available on the node, e.g. pruned.
+const stateNotAvailableMsg = "failed to load state at height"
+
+// errHeaderNotFound is the error returned for the blocks that aren't available
+// on the node. The error message imitates geth behavior.
+var errHeaderNotFound = errors.New("header not found")
+
+// firstIndexedBlockGetter is implemented by the EVM transaction indexers that
+// keep track of the first block they indexed.
+type firstIndexedBlockGetter interface {
+	FirstIndexedBlock() (int64, error)
+}
+
+// NodeAvailability returns the earliest block, state and indexed transactions
+// available on the node, so that the historical requests can be routed to the
+// nodes able to serve them.
+func (b *Backend) NodeAvailability() (*rpctypes.NodeAvailability, error) {
+	status, err := b.clientCtx.Client.Status(b.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	earliestState := b.earliestStateHeight(int64(latest)) //#nosec G701 -- checked for int overflow already
+
+	res := &rpctypes.NodeAvailability{
+		EarliestBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+		EarliestState: hexutil.Uint64(earliestState),
+		LatestBlock:   latest,
+	}
+
+	if indexer, ok := b.indexer.(firstIndexedBlockGetter); ok {
+		first, err := indexer.FirstIndexedBlock()
+		if err != nil {
+			return nil, err
+		}
+		if first >= 0 {
+			earliestIndexed := hexutil.Uint64(first)
+			res.EarliestIndexedBlock = &earliestIndexed
+		}
+	}
+
+	return res, nil
+}
+
+// earliestStateHeight returns the earliest height with an available state, up
+// to the given latest height. As the pruning only removes the oldest heights, it
+// searches the heights above the last earliest height found.
+func (b *Backend) earliestStateHeight(latest int64) int64 {
+	low := atomic.LoadInt64(&b.earliestState)
+	if low < 1 {
+		low = 1
+	}
+	if low >= latest || b.stateAvailable(low) {
+		return low
+	}
+
+	// the state of the latest height is always available
+	high := latest
+	for low+1 < high {
+		mid := low + (high-low)/2
+		if b.stateAvailable(mid) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	atomic.StoreInt64(&b.earliestState, high)
+	return high
+}
+
+// stateAvailable returns true if the state of the given height can be queried.
+func (b *Backend) stateAvailable(height int64) bool {
+	_, err := b.queryClient.Params(rpctypes.ContextWithHeight(height), &evmtypes.QueryParamsRequest{})
+	return err == nil
+}
+
+// historicalStateError returns the error of a query at the given height. If the
+// query failed because the state of the height isn't available on the node, the
+// error is replaced by a geth compatible error, so that the clients can retry on
+// an archive node.
+func (b *Backend) historicalStateError(height int64, err error) error {
+	// the queries on the latest state don't depend on the pruning
+	if height <= 0 || !strings.Contains(err.Error(), stateNotAvailableMsg) {
+		return err
+	}
+
+	msg := fmt.Sprintf("missing trie node %x (path ) state at height %d is not available", common.Hash{}, height)
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return errors.New(msg)
+	}
+	earliest := b.earliestStateHeight(int64(latest)) //#nosec G701 -- checked for int overflow already
+	return fmt.Errorf("%s, the earliest available state is at height %d", msg, earliest)
+}
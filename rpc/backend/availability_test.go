@@ -0,0 +1,185 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/evmos/evmos/v15/rpc/backend/mocks"
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// registerLatestHeight registers the block number query of a node with the
+// given latest height.
+func (suite *BackendTestSuite) registerLatestHeight(latest int64) {
+	var header metadata.MD
+	suite.backend.ctx = rpctypes.ContextWithHeight(latest)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParams(queryClient, &header, latest)
+}
+
+// registerStateAvailability registers the state queries of a node with the
+// given latest height and earliest state height.
+func (suite *BackendTestSuite) registerStateAvailability(latest, earliestState int64) {
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+
+	for height := int64(1); height < latest; height++ {
+		call := queryClient.On("Params", rpctypes.ContextWithHeight(height), &evmtypes.QueryParamsRequest{})
+		if height < earliestState {
+			call.Return(nil, errors.New("failed to load state at height")).Maybe()
+		} else {
+			call.Return(&evmtypes.QueryParamsResponse{}, nil).Maybe()
+		}
+	}
+}
+
+func (suite *BackendTestSuite) TestNodeAvailability() {
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expPass      bool
+		expRes       *rpctypes.NodeAvailability
+	}{
+		{
+			"fail - status error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				RegisterStatusError(client)
+			},
+			false,
+			nil,
+		},
+		{
+			"pass - pruned node",
+			func() {
+				suite.registerLatestHeight(10)
+				suite.registerStateAvailability(10, 6)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				client.On("Status", suite.backend.ctx).
+					Return(&tmrpctypes.ResultStatus{SyncInfo: tmrpctypes.SyncInfo{EarliestBlockHeight: 3}}, nil)
+			},
+			true,
+			&rpctypes.NodeAvailability{
+				EarliestBlock: 3,
+				EarliestState: 6,
+				LatestBlock:   10,
+			},
+		},
+		{
+			"pass - archive node",
+			func() {
+				suite.registerLatestHeight(10)
+				suite.registerStateAvailability(10, 1)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				client.On("Status", suite.backend.ctx).
+					Return(&tmrpctypes.ResultStatus{SyncInfo: tmrpctypes.SyncInfo{EarliestBlockHeight: 1}}, nil)
+			},
+			true,
+			&rpctypes.NodeAvailability{
+				EarliestBlock: 1,
+				EarliestState: 1,
+				LatestBlock:   10,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			res, err := suite.backend.NodeAvailability()
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestEarliestStateHeightCache() {
+	suite.registerStateAvailability(10, 6)
+	suite.Require().Equal(int64(6), suite.backend.earliestStateHeight(10))
+	suite.Require().Equal(int64(6), suite.backend.earliestState)
+
+	// the next search starts from the cached height
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	queryClient.Calls = nil
+	suite.Require().Equal(int64(6), suite.backend.earliestStateHeight(10))
+	queryClient.AssertNumberOfCalls(suite.T(), "Params", 1)
+}
+
+func (suite *BackendTestSuite) TestHistoricalStateError() {
+	queryErr := errors.New("rpc error: code = Unknown desc = failed to load state at height 2; version does not exist (latest height: 10)")
+
+	testCases := []struct {
+		name         string
+		height       int64
+		err          error
+		registerMock func()
+		expErr       string
+	}{
+		{
+			"other error",
+			2,
+			errors.New("invalid request"),
+			func() {},
+			"invalid request",
+		},
+		{
+			"latest height",
+			0,
+			queryErr,
+			func() {},
+			queryErr.Error(),
+		},
+		{
+			"pruned height",
+			2,
+			queryErr,
+			func() {
+				suite.registerLatestHeight(10)
+				suite.registerStateAvailability(10, 6)
+			},
+			fmt.Sprintf(
+				"missing trie node %x (path ) state at height 2 is not available, the earliest available state is at height 6",
+				make([]byte, 32),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.registerMock()
+
+			err := suite.backend.historicalStateError(tc.height, tc.err)
+			suite.Require().EqualError(err, tc.expErr)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetBalancePrunedState() {
+	blockNr := rpctypes.NewBlockNumber(big.NewInt(2))
+	suite.registerLatestHeight(10)
+	suite.registerStateAvailability(10, 6)
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	client.On("Block", suite.backend.ctx, mock.AnythingOfType("*int64")).
+		Return(&tmrpctypes.ResultBlock{Block: tmtypes.MakeBlock(blockNr.Int64(), []tmtypes.Tx{}, nil, nil)}, nil)
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	queryClient.On("Balance", rpctypes.ContextWithHeight(blockNr.Int64()), &evmtypes.QueryBalanceRequest{Address: suite.from.String()}).
+		Return(nil, errors.New("failed to load state at height 2"))
+
+	_, err := suite.backend.GetBalance(suite.from, rpctypes.BlockNumberOrHash{BlockNumber: &blockNr})
+	suite.Require().ErrorContains(err, "missing trie node")
+}
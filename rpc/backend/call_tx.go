@@ -305,8 +305,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
-		// the error message imitates geth behavior
-		return 0, errors.New("header not found")
+		return 0, errHeaderNotFound
 	}
 
 	req := evmtypes.EthCallRequest{
@@ -321,7 +320,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// the latest block height for querying.
 	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
 	if err != nil {
-		return 0, err
+		return 0, b.historicalStateError(blockNr.Int64(), err)
 	}
 	return hexutil.Uint64(res.Gas), nil
 }
@@ -337,8 +336,7 @@ func (b *Backend) DoCall(
 	}
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
-		// the error message imitates geth behavior
-		return nil, errors.New("header not found")
+		return nil, errHeaderNotFound
 	}
 
 	req := evmtypes.EthCallRequest{
@@ -369,7 +367,7 @@ func (b *Backend) DoCall(
 
 	res, err := b.queryClient.EthCall(ctx, &req)
 	if err != nil {
-		return nil, err
+		return nil, b.historicalStateError(blockNr.Int64(), err)
 	}
 
 	if res.Failed() {
@@ -382,6 +380,163 @@ func (b *Backend) DoCall(
 	return res, nil
 }
 
+// CreateAccessList creates the EIP-2930 access list of the transaction, along with the
+// gas used when the access list is applied. The execution error, if any, is returned
+// on the result instead of failing the request, as in go-ethereum.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, errHeaderNotFound
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	// Make sure the context is canceled when the call has completed
+	// this makes sure resources are cleaned up.
+	defer cancel()
+
+	res, err := b.queryClient.CreateAccessList(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		// return an empty list instead of null
+		accessList = &ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 simulates the calls of the given blocks sequentially on top of the
+// requested block, returning the result of each call.
+func (b *Backend) SimulateV1(
+	opts evmtypes.SimOpts, blockNr rpctypes.BlockNumber,
+) ([]*rpctypes.SimBlockResult, error) {
+	bz, err := json.Marshal(&opts)
+	if err != nil {
+		return nil, err
+	}
+	header, err := b.TendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, errHeaderNotFound
+	}
+
+	req := evmtypes.SimulateV1Request{
+		Opts:            bz,
+		GasCap:          b.RPCGasCap(),
+		ProposerAddress: sdk.ConsAddress(header.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+
+	// From ContextWithHeight: if the provided height is 0,
+	// it will return an empty context and the gRPC query will use
+	// the latest block height for querying.
+	ctx := rpctypes.ContextWithHeight(blockNr.Int64())
+	timeout := b.RPCEVMTimeout()
+
+	// Setup context so it may be canceled the call has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+
+	// Make sure the context is canceled when the call has completed
+	// this makes sure resources are cleaned up.
+	defer cancel()
+
+	res, err := b.queryClient.SimulateV1(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*rpctypes.SimBlockResult, len(res.Blocks))
+	for i, block := range res.Blocks {
+		blocks[i] = &rpctypes.SimBlockResult{
+			Number:    hexutil.Uint64(block.Number),
+			Timestamp: hexutil.Uint64(block.Time),
+			Miner:     common.HexToAddress(block.FeeRecipient),
+			GasUsed:   hexutil.Uint64(block.GasUsed),
+			Calls:     make([]*rpctypes.SimCallResult, len(block.Calls)),
+		}
+		if block.BaseFee != nil {
+			blocks[i].BaseFeePerGas = (*hexutil.Big)(block.BaseFee.BigInt())
+		}
+
+		for j, call := range block.Calls {
+			blocks[i].Calls[j] = newSimCallResult(call)
+		}
+	}
+
+	return blocks, nil
+}
+
+// newSimCallResult formats the response of a simulated call.
+func newSimCallResult(res evmtypes.MsgEthereumTxResponse) *rpctypes.SimCallResult {
+	logs := evmtypes.LogsToEthereum(res.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := &rpctypes.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		// the error codes follow the eth_simulateV1 specification
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    hexutil.Encode(res.Ret),
+			}
+		} else {
+			result.Error = &rpctypes.SimCallError{Code: -32015, Message: res.VmError}
+		}
+	}
+
+	return result
+}
+
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
 func (b *Backend) GasPrice() (*hexutil.Big, error) {
 	var (
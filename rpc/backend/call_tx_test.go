@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/evmos/evmos/v15/rpc/backend/mocks"
 	rpctypes "github.com/evmos/evmos/v15/rpc/types"
@@ -460,6 +461,143 @@ func (suite *BackendTestSuite) TestDoCall() {
 	}
 }
 
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	callArgs := evmtypes.TransactionArgs{
+		To:      &toAddr,
+		ChainID: (*hexutil.Big)(suite.backend.chainID),
+	}
+	argsBz, err := json.Marshal(callArgs)
+	suite.Require().NoError(err)
+
+	storageKey := common.HexToHash("0x1")
+	request := &evmtypes.EthCallRequest{Args: argsBz, ChainId: suite.backend.chainID.Int64()}
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expResult    *rpctypes.AccessListResult
+		expPass      bool
+	}{
+		{
+			"fail - query error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessListError(queryClient, request)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - empty access list",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.CreateAccessListResponse{GasUsed: 21000})
+			},
+			&rpctypes.AccessListResult{AccessList: &ethtypes.AccessList{}, GasUsed: 21000},
+			true,
+		},
+		{
+			"pass - access list with vm error",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				_, err := RegisterBlock(client, 1, bz)
+				suite.Require().NoError(err)
+				RegisterCreateAccessList(queryClient, request, &evmtypes.CreateAccessListResponse{
+					AccessList: evmtypes.AccessList{{Address: toAddr.Hex(), StorageKeys: []string{storageKey.Hex()}}},
+					GasUsed:    30000,
+					VmError:    vm.ErrExecutionReverted.Error(),
+				})
+			},
+			&rpctypes.AccessListResult{
+				AccessList: &ethtypes.AccessList{{Address: toAddr, StorageKeys: []common.Hash{storageKey}}},
+				Error:      vm.ErrExecutionReverted.Error(),
+				GasUsed:    30000,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.CreateAccessList(callArgs, rpctypes.BlockNumber(1))
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResult, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	_, bz := suite.buildEthereumTx()
+	toAddr := utiltx.GenerateAddress()
+	opts := evmtypes.SimOpts{BlockStateCalls: []evmtypes.SimBlock{
+		{Calls: []evmtypes.TransactionArgs{{To: &toAddr}, {To: &toAddr}}},
+	}}
+	optsBz, err := json.Marshal(&opts)
+	suite.Require().NoError(err)
+
+	baseFee := sdk.NewInt(1)
+	log := &evmtypes.Log{Address: toAddr.Hex(), Topics: []string{}, Data: []byte{1}, BlockNumber: 2}
+
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	_, err = RegisterBlock(client, 1, bz)
+	suite.Require().NoError(err)
+	RegisterSimulateV1(queryClient, &evmtypes.SimulateV1Request{Opts: optsBz, ChainId: suite.backend.chainID.Int64()}, &evmtypes.SimulateV1Response{
+		Blocks: []evmtypes.SimulatedBlock{{
+			Number:       2,
+			Time:         10,
+			FeeRecipient: toAddr.Hex(),
+			BaseFee:      &baseFee,
+			GasUsed:      50000,
+			Calls: []evmtypes.MsgEthereumTxResponse{
+				{Ret: []byte{1}, Logs: []*evmtypes.Log{log}, GasUsed: 30000},
+				{Ret: []byte{}, GasUsed: 20000, VmError: vm.ErrExecutionReverted.Error()},
+			},
+		}},
+	})
+
+	res, err := suite.backend.SimulateV1(opts, rpctypes.BlockNumber(1))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*rpctypes.SimBlockResult{{
+		Number:        2,
+		Timestamp:     10,
+		Miner:         toAddr,
+		BaseFeePerGas: (*hexutil.Big)(big.NewInt(1)),
+		GasUsed:       50000,
+		Calls: []*rpctypes.SimCallResult{
+			{
+				ReturnData: []byte{1},
+				Logs:       []*ethtypes.Log{log.ToEthereum()},
+				GasUsed:    30000,
+				Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+			},
+			{
+				ReturnData: []byte{},
+				Logs:       []*ethtypes.Log{},
+				GasUsed:    20000,
+				Status:     hexutil.Uint64(ethtypes.ReceiptStatusFailed),
+				Error:      &rpctypes.SimCallError{Code: 3, Message: vm.ErrExecutionReverted.Error(), Data: "0x"},
+			},
+		},
+	}}, res)
+}
+
 func (suite *BackendTestSuite) TestGasPrice() {
 	defaultGasPrice := (*hexutil.Big)(big.NewInt(1))
 
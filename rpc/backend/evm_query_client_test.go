@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"testing"
 
@@ -64,6 +65,37 @@ func RegisterTraceBlockError(queryClient *mocks.EVMQueryClient) {
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// TraceBlockStream
+type traceBlockStreamClient struct {
+	grpc.ClientStream
+	responses []*evmtypes.QueryTraceBlockStreamResponse
+	err       error
+}
+
+func (c *traceBlockStreamClient) Recv() (*evmtypes.QueryTraceBlockStreamResponse, error) {
+	if len(c.responses) == 0 {
+		if c.err != nil {
+			return nil, c.err
+		}
+		return nil, io.EOF
+	}
+	res := c.responses[0]
+	c.responses = c.responses[1:]
+	return res, nil
+}
+
+func RegisterTraceBlockStream(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, data []byte) {
+	queryClient.On("TraceBlockStream", mock.Anything,
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: 9000, BlockMaxGas: -1}).
+		Return(&traceBlockStreamClient{responses: []*evmtypes.QueryTraceBlockStreamResponse{{TxIndex: 0, Data: data}}}, nil)
+}
+
+func RegisterTraceBlockStreamError(queryClient *mocks.EVMQueryClient, txs []*evmtypes.MsgEthereumTx, err error) {
+	queryClient.On("TraceBlockStream", mock.Anything,
+		&evmtypes.QueryTraceBlockRequest{Txs: txs, BlockNumber: 1, TraceConfig: &evmtypes.TraceConfig{}, ChainId: 9000, BlockMaxGas: -1}).
+		Return(&traceBlockStreamClient{err: err}, nil)
+}
+
 // Params
 func RegisterParams(queryClient *mocks.EVMQueryClient, header *metadata.MD, height int64) {
 	queryClient.On("Params", rpc.ContextWithHeight(height), &evmtypes.QueryParamsRequest{}, grpc.Header(header)).
@@ -151,6 +183,23 @@ func RegisterEthCallError(queryClient *mocks.EVMQueryClient, request *evmtypes.E
 		Return(nil, errortypes.ErrInvalidRequest)
 }
 
+// CreateAccessList
+func RegisterCreateAccessList(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest, res *evmtypes.CreateAccessListResponse) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(res, nil)
+}
+
+func RegisterCreateAccessListError(queryClient *mocks.EVMQueryClient, request *evmtypes.EthCallRequest) {
+	queryClient.On("CreateAccessList", mock.Anything, request).
+		Return(nil, errortypes.ErrInvalidRequest)
+}
+
+// SimulateV1
+func RegisterSimulateV1(queryClient *mocks.EVMQueryClient, request *evmtypes.SimulateV1Request, res *evmtypes.SimulateV1Response) {
+	queryClient.On("SimulateV1", mock.Anything, request).
+		Return(res, nil)
+}
+
 // Estimate Gas
 func RegisterEstimateGas(queryClient *mocks.EVMQueryClient, args evmtypes.TransactionArgs) {
 	bz, _ := json.Marshal(args)
@@ -3,8 +3,10 @@
 package backend
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -14,6 +16,8 @@ import (
 	rpctypes "github.com/evmos/evmos/v15/rpc/types"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // TraceTransaction returns the structured logs created during the execution of EVM
@@ -197,6 +201,14 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
 	}
 
+	if b.queryClient.Stream != nil {
+		results, err := b.traceBlockStream(ctxWithHeight, traceBlockRequest)
+		if status.Code(err) != codes.Unimplemented {
+			return results, err
+		}
+		b.logger.Debug("streaming block trace not supported by the node, falling back to TraceBlock", "error", err.Error())
+	}
+
 	res, err := b.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
 	if err != nil {
 		return nil, err
@@ -209,3 +221,39 @@ func (b *Backend) TraceBlock(height rpctypes.BlockNumber,
 
 	return decodedResults, nil
 }
+
+// traceBlockStream traces the transactions of a block using the streaming
+// TraceBlock query, which avoids building a single response for the whole
+// block on the node.
+func (b *Backend) traceBlockStream(ctx context.Context, req *evmtypes.QueryTraceBlockRequest) ([]*evmtypes.TxTraceResult, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := b.queryClient.Stream.TraceBlockStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*evmtypes.TxTraceResult, len(req.Txs))
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if res.TxIndex >= uint64(len(results)) {
+			return nil, fmt.Errorf("invalid transaction index %d in block trace", res.TxIndex)
+		}
+
+		var result evmtypes.TxTraceResult
+		if err := json.Unmarshal(res.Data, &result); err != nil {
+			return nil, err
+		}
+		results[res.TxIndex] = &result
+	}
+
+	return results, nil
+}
@@ -9,12 +9,15 @@ import (
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/crypto"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
 	"github.com/evmos/evmos/v15/indexer"
 	"github.com/evmos/evmos/v15/rpc/backend/mocks"
 	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (suite *BackendTestSuite) TestTraceTransaction() {
@@ -244,6 +247,50 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			&evmtypes.TraceConfig{},
 			false,
 		},
+		{
+			"pass - streamed transaction traces",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.queryClient.Stream = queryClient
+				RegisterTraceBlockStream(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, []byte(`{"result":"trace"}`))
+				RegisterConsensusParams(client, 1)
+			},
+			[]*evmtypes.TxTraceResult{{Result: "trace"}},
+			&resBlockFilled,
+			&evmtypes.TraceConfig{},
+			true,
+		},
+		{
+			"fail - streamed transaction traces error",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.queryClient.Stream = queryClient
+				RegisterTraceBlockStreamError(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, errortypes.ErrInvalidRequest)
+				RegisterConsensusParams(client, 1)
+			},
+			nil,
+			&resBlockFilled,
+			&evmtypes.TraceConfig{},
+			false,
+		},
+		{
+			"fail - streaming not supported, fallback to TraceBlock",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.queryClient.Stream = queryClient
+				RegisterTraceBlockStreamError(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx}, status.Error(codes.Unimplemented, "unknown method"))
+				// the TraceBlock response data can't be decoded
+				RegisterTraceBlock(queryClient, []*evmtypes.MsgEthereumTx{msgEthTx})
+				RegisterConsensusParams(client, 1)
+			},
+			nil,
+			&resBlockFilled,
+			&evmtypes.TraceConfig{},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -259,6 +306,7 @@ func (suite *BackendTestSuite) TestTraceBlock() {
 			} else {
 				suite.Require().Error(err)
 			}
+			suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient).AssertExpectations(suite.T())
 		})
 	}
 }
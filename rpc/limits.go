@@ -0,0 +1,375 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+
+	"github.com/evmos/evmos/v15/server/config"
+)
+
+const (
+	// errCodeInvalidRequest is the JSON-RPC error code of the batches exceeding
+	// the batch request limit
+	errCodeInvalidRequest = -32600
+	// errCodeResponseTooLarge is the JSON-RPC error code of the responses
+	// exceeding the max response size
+	errCodeResponseTooLarge = -32003
+	// errCodeLimitExceeded is the JSON-RPC error code of the rate limited requests
+	errCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the max size of the HTTP requests read by the
+	// limiter, same as the go-ethereum RPC server
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	// internalRequestHeader is the HTTP header of the requests forwarded by the
+	// websocket server, which are rate limited by the websocket server
+	internalRequestHeader = "X-Evmos-Internal-Request"
+
+	// bucketIdleTimeout is the time after which the token buckets of an idle
+	// client are removed
+	bucketIdleTimeout = 10 * time.Minute
+)
+
+var (
+	batchRejectedCounter    = metrics.NewRegisteredCounter("rpc/limits/rejected/batch", nil)
+	responseRejectedCounter = metrics.NewRegisteredCounter("rpc/limits/rejected/response", nil)
+	rateRejectedCounter     = metrics.NewRegisteredCounter("rpc/limits/rejected/rate", nil)
+)
+
+// limitError is a JSON-RPC error returned when a request exceeds a limit.
+type limitError struct {
+	code    int
+	message string
+}
+
+// Error implements the error interface.
+func (e *limitError) Error() string {
+	return e.message
+}
+
+// httpStatus returns the HTTP status code of the error response.
+func (e *limitError) httpStatus() int {
+	if e.code == errCodeLimitExceeded {
+		return http.StatusTooManyRequests
+	}
+	return http.StatusOK
+}
+
+// response returns the JSON encoded error response.
+func (e *limitError) response() []byte {
+	bz, _ := json.Marshal(&ErrorResponseJSON{ // #nosec G703 -- the error response is always valid JSON
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(int64(e.code)),
+			Message: e.message,
+		},
+	})
+	return bz
+}
+
+// rateLimit is the rate, in requests per second, and the burst of a token bucket.
+type rateLimit struct {
+	rate  float64
+	burst float64
+}
+
+// tokenBucket is a token bucket refilled at the rate of its limit.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accumulated since the last refill, up to the burst.
+func (b *tokenBucket) refill(limit rateLimit, now time.Time) {
+	b.tokens = math.Min(limit.burst, b.tokens+now.Sub(b.last).Seconds()*limit.rate)
+	b.last = now
+}
+
+// RequestLimiter enforces the batch size, response size and rate limits of the
+// JSON-RPC requests. The rate limits are token buckets per client, for all the
+// requests and for each method with a limit, where every request of a batch
+// consumes a token.
+type RequestLimiter struct {
+	batchLimit      int
+	maxResponseSize int
+	clientLimit     rateLimit
+	methodLimits    map[string]rateLimit
+	// internalToken authenticates the requests forwarded by the websocket server
+	internalToken string
+
+	mtx         sync.Mutex
+	buckets     map[string]*tokenBucket // by client and client-method
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+// NewRequestLimiter creates the request limiter of the given JSON-RPC
+// configuration.
+func NewRequestLimiter(cfg config.JSONRPCConfig) (*RequestLimiter, error) {
+	limits, err := config.ParseMethodRateLimits(cfg.MethodRateLimits)
+	if err != nil {
+		return nil, err
+	}
+	methodLimits := make(map[string]rateLimit, len(limits))
+	for _, limit := range limits {
+		methodLimits[limit.Method] = newRateLimit(limit.Rate, limit.Burst)
+	}
+
+	token := make([]byte, 16)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	return &RequestLimiter{
+		batchLimit:      cfg.BatchRequestLimit,
+		maxResponseSize: cfg.MaxResponseSize,
+		clientLimit:     newRateLimit(cfg.RateLimit, cfg.RateLimitBurst),
+		methodLimits:    methodLimits,
+		internalToken:   hex.EncodeToString(token),
+		buckets:         make(map[string]*tokenBucket),
+		now:             time.Now,
+	}, nil
+}
+
+// newRateLimit returns the rate limit of the given rate and burst. The burst
+// defaults to the rate, rounded up.
+func newRateLimit(rate float64, burst int) rateLimit {
+	if rate <= 0 {
+		return rateLimit{}
+	}
+	if burst <= 0 {
+		return rateLimit{rate: rate, burst: math.Ceil(rate)}
+	}
+	return rateLimit{rate: rate, burst: float64(burst)}
+}
+
+// checkRequest returns an error if the raw JSON-RPC request of the client
+// exceeds the batch limit or the rate limits.
+func (l *RequestLimiter) checkRequest(client string, body []byte, rateLimited bool) *limitError {
+	if l == nil {
+		return nil
+	}
+
+	methods, batch := requestMethods(body)
+
+	if batch && l.batchLimit > 0 && len(methods) > l.batchLimit {
+		batchRejectedCounter.Inc(1)
+		return &limitError{
+			code:    errCodeInvalidRequest,
+			message: fmt.Sprintf("batch too large: %d requests, max %d", len(methods), l.batchLimit),
+		}
+	}
+
+	if !rateLimited {
+		return nil
+	}
+	// the invalid requests consume a token too
+	if len(methods) == 0 {
+		methods = []string{""}
+	}
+
+	if method, ok := l.allow(client, methods); !ok {
+		rateRejectedCounter.Inc(1)
+		if method != "" {
+			metrics.GetOrRegisterCounter("rpc/limits/rejected/rate/"+method, nil).Inc(1)
+			return &limitError{code: errCodeLimitExceeded, message: fmt.Sprintf("rate limit exceeded for method %s", method)}
+		}
+		return &limitError{code: errCodeLimitExceeded, message: "rate limit exceeded"}
+	}
+
+	return nil
+}
+
+// allow consumes a token of the client bucket and a token of the method bucket
+// for each of the given methods. If a bucket doesn't have enough tokens, no
+// token is consumed and it returns false, along with the rate limited method if
+// any.
+func (l *RequestLimiter) allow(client string, methods []string) (string, bool) {
+	if l.clientLimit.rate <= 0 && len(l.methodLimits) == 0 {
+		return "", true
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.cleanup(now)
+
+	// count the tokens needed by each bucket
+	needed := make(map[string]float64)
+	for _, method := range methods {
+		if l.clientLimit.rate > 0 {
+			needed[""]++
+		}
+		if _, found := l.methodLimits[method]; found {
+			needed[method]++
+		}
+	}
+
+	buckets := make(map[string]*tokenBucket, len(needed))
+	for method, tokens := range needed {
+		limit := l.clientLimit
+		if method != "" {
+			limit = l.methodLimits[method]
+		}
+
+		key := client + "/" + method
+		bucket, found := l.buckets[key]
+		if !found {
+			bucket = &tokenBucket{tokens: limit.burst, last: now}
+			l.buckets[key] = bucket
+		}
+		bucket.refill(limit, now)
+
+		if bucket.tokens < tokens {
+			return method, false
+		}
+		buckets[method] = bucket
+	}
+
+	for method, bucket := range buckets {
+		bucket.tokens -= needed[method]
+	}
+	return "", true
+}
+
+// cleanup removes the buckets of the idle clients. It must be called with the
+// lock held.
+func (l *RequestLimiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < bucketIdleTimeout {
+		return
+	}
+	l.lastCleanup = now
+
+	for key, bucket := range l.buckets {
+		if now.Sub(bucket.last) > bucketIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Handler wraps the given JSON-RPC HTTP handler with the request limits.
+func (l *RequestLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		// the requests forwarded by the websocket server are rate limited by it
+		internal := r.Header.Get(internalRequestHeader) == l.internalToken
+		if limitErr := l.checkRequest(remoteHost(r.RemoteAddr), body, !internal); limitErr != nil {
+			writeLimitError(w, limitErr)
+			return
+		}
+
+		if l.maxResponseSize <= 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rw := &limitedResponseWriter{ResponseWriter: w, limit: l.maxResponseSize}
+		next.ServeHTTP(rw, r)
+		if rw.exceeded {
+			responseRejectedCounter.Inc(1)
+			writeLimitError(w, &limitError{
+				code:    errCodeResponseTooLarge,
+				message: fmt.Sprintf("response too large, max %d bytes", l.maxResponseSize),
+			})
+			return
+		}
+		rw.flush()
+	})
+}
+
+// writeLimitError writes the JSON-RPC error response of a limit error.
+func writeLimitError(w http.ResponseWriter, err *limitError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(err.httpStatus())
+	_, _ = w.Write(err.response()) // #nosec G104
+}
+
+// limitedResponseWriter buffers the response until it exceeds the limit.
+type limitedResponseWriter struct {
+	http.ResponseWriter
+	limit    int
+	status   int
+	buf      bytes.Buffer
+	exceeded bool
+}
+
+// WriteHeader implements the http.ResponseWriter interface.
+func (w *limitedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+// Write implements the http.ResponseWriter interface. The data exceeding the
+// limit is discarded.
+func (w *limitedResponseWriter) Write(p []byte) (int, error) {
+	if w.exceeded {
+		return len(p), nil
+	}
+	if w.buf.Len()+len(p) > w.limit {
+		w.exceeded = true
+		w.buf.Reset()
+		return len(p), nil
+	}
+	return w.buf.Write(p)
+}
+
+// flush writes the buffered response.
+func (w *limitedResponseWriter) flush() {
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	_, _ = w.ResponseWriter.Write(w.buf.Bytes()) // #nosec G104
+}
+
+// requestMethods returns the methods of a raw JSON-RPC request and whether it's
+// a batch. The invalid requests are left to the RPC server.
+func requestMethods(body []byte) ([]string, bool) {
+	type request struct {
+		Method string `json:"method"`
+	}
+
+	if !isBatch(body) {
+		var req request
+		_ = json.Unmarshal(body, &req) // #nosec G703
+		return []string{req.Method}, false
+	}
+
+	var reqs []request
+	if err := json.Unmarshal(body, &reqs); err != nil {
+		return nil, true
+	}
+	methods := make([]string, len(reqs))
+	for i, req := range reqs {
+		methods[i] = req.Method
+	}
+	return methods, true
+}
+
+// remoteHost returns the host of the remote address of a request.
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
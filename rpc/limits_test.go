@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/server/config"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	const response = `{"jsonrpc":"2.0","id":1,"result":"0x1"}`
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	})
+
+	single := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`
+	getLogs := `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[]}`
+	batch := func(reqs ...string) string {
+		return "[" + strings.Join(reqs, ",") + "]"
+	}
+
+	type request struct {
+		body     string
+		internal bool
+		elapsed  time.Duration
+		expCode  int
+		expBody  string
+	}
+
+	rateLimited := `{"jsonrpc":"2.0","error":{"code":-32005,"message":"rate limit exceeded"},"id":null}`
+
+	testCases := []struct {
+		name     string
+		malleate func(cfg *config.JSONRPCConfig)
+		requests []request
+	}{
+		{
+			"no limits",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.BatchRequestLimit = 0
+			},
+			[]request{
+				{body: batch(single, single, single), expCode: http.StatusOK, expBody: response},
+			},
+		},
+		{
+			"batch too large",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.BatchRequestLimit = 2
+			},
+			[]request{
+				{body: batch(single, single), expCode: http.StatusOK, expBody: response},
+				{
+					body:    batch(single, single, single),
+					expCode: http.StatusOK,
+					expBody: `{"jsonrpc":"2.0","error":{"code":-32600,"message":"batch too large: 3 requests, max 2"},"id":null}`,
+				},
+			},
+		},
+		{
+			"response too large",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.MaxResponseSize = len(response) - 1
+			},
+			[]request{
+				{
+					body:    single,
+					expCode: http.StatusOK,
+					expBody: `{"jsonrpc":"2.0","error":{"code":-32003,"message":"response too large, max 38 bytes"},"id":null}`,
+				},
+			},
+		},
+		{
+			"client rate limit",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimit = 2
+			},
+			[]request{
+				{body: single, expCode: http.StatusOK, expBody: response},
+				{body: single, expCode: http.StatusOK, expBody: response},
+				{body: single, expCode: http.StatusTooManyRequests, expBody: rateLimited},
+				// refilled
+				{body: single, elapsed: time.Second, expCode: http.StatusOK, expBody: response},
+				// a batch consumes a token per request
+				{body: batch(single, single), expCode: http.StatusTooManyRequests, expBody: rateLimited},
+				{body: single, expCode: http.StatusOK, expBody: response},
+			},
+		},
+		{
+			"client rate limit - burst",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimit = 1
+				cfg.RateLimitBurst = 3
+			},
+			[]request{
+				{body: batch(single, single, single), expCode: http.StatusOK, expBody: response},
+				{body: single, expCode: http.StatusTooManyRequests, expBody: rateLimited},
+			},
+		},
+		{
+			"method rate limit",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.MethodRateLimits = []string{"eth_getLogs:1"}
+			},
+			[]request{
+				{body: getLogs, expCode: http.StatusOK, expBody: response},
+				{
+					body:    getLogs,
+					expCode: http.StatusTooManyRequests,
+					expBody: `{"jsonrpc":"2.0","error":{"code":-32005,"message":"rate limit exceeded for method eth_getLogs"},"id":null}`,
+				},
+				{body: single, expCode: http.StatusOK, expBody: response},
+			},
+		},
+		{
+			"internal requests aren't rate limited",
+			func(cfg *config.JSONRPCConfig) {
+				cfg.RateLimit = 1
+			},
+			[]request{
+				{body: single, expCode: http.StatusOK, expBody: response},
+				{body: single, internal: true, expCode: http.StatusOK, expBody: response},
+				{body: single, expCode: http.StatusTooManyRequests, expBody: rateLimited},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultJSONRPCConfig()
+			tc.malleate(cfg)
+
+			limiter, err := NewRequestLimiter(*cfg)
+			require.NoError(t, err)
+
+			now := time.Now()
+			limiter.now = func() time.Time { return now }
+			handler := limiter.Handler(next)
+
+			for i, req := range tc.requests {
+				now = now.Add(req.elapsed)
+
+				httpReq := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(req.body))
+				if req.internal {
+					httpReq.Header.Set(internalRequestHeader, limiter.internalToken)
+				}
+				rec := httptest.NewRecorder()
+				handler.ServeHTTP(rec, httpReq)
+
+				require.Equal(t, req.expCode, rec.Code, "request %d", i)
+				require.Equal(t, req.expBody, rec.Body.String(), "request %d", i)
+			}
+		})
+	}
+}
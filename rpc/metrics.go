@@ -0,0 +1,292 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package rpc
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/evmos/evmos/v15/server/config"
+)
+
+const (
+	// methodMetricsPrefix is the prefix of the per-method metrics
+	methodMetricsPrefix = "rpc/methods"
+	// unknownMethod is the metrics name of the methods that aren't registered
+	// on the server, to bound the number of metrics created by the clients
+	unknownMethod = "unknown"
+
+	// maxRecordedResponseSize is the max size of the response prefix parsed for
+	// the error codes. The errors beyond the prefix of a large batch response
+	// aren't counted.
+	maxRecordedResponseSize = 1024 * 1024
+)
+
+// jsonRequest is the part of a JSON-RPC request recorded by the metrics.
+type jsonRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// jsonResponse is the part of a JSON-RPC response recorded by the metrics.
+type jsonResponse struct {
+	ID    json.RawMessage `json:"id"`
+	Error *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// RequestMetrics records the metrics of the JSON-RPC requests, for each
+// namespace and method: the duration, the requests in flight and the errors by
+// code. The requests lasting longer than the slow request threshold are logged
+// with the digest of their params.
+//
+// The metrics are recorded in the go-ethereum metrics registry, exported on the
+// metrics address when the metrics are enabled.
+type RequestMetrics struct {
+	logger        log.Logger
+	slowThreshold time.Duration
+	// methods are the methods registered on the server
+	methods  map[string]bool
+	registry metrics.Registry
+	now      func() time.Time
+}
+
+// NewRequestMetrics creates the request metrics of the JSON-RPC server serving
+// the given APIs.
+func NewRequestMetrics(cfg config.JSONRPCConfig, logger log.Logger, apis []rpc.API) *RequestMetrics {
+	return &RequestMetrics{
+		logger:        logger.With("module", "rpc-metrics"),
+		slowThreshold: cfg.SlowRequestThreshold,
+		methods:       apiMethods(apis),
+		registry:      metrics.DefaultRegistry,
+		now:           time.Now,
+	}
+}
+
+// apiMethods returns the JSON-RPC methods of the given APIs, named as by the
+// go-ethereum RPC server, along with the subscription methods served by the
+// websocket server.
+func apiMethods(apis []rpc.API) map[string]bool {
+	methods := map[string]bool{
+		"eth_subscribe":   true,
+		"eth_unsubscribe": true,
+	}
+	for _, api := range apis {
+		typ := reflect.TypeOf(api.Service)
+		if typ == nil {
+			continue
+		}
+		for i := 0; i < typ.NumMethod(); i++ {
+			name := []rune(typ.Method(i).Name)
+			name[0] = unicode.ToLower(name[0])
+			methods[api.Namespace+"_"+string(name)] = true
+		}
+	}
+	return methods
+}
+
+// metricName returns the name of the metric of a method, prefixed by the
+// namespace and the method.
+func (m *RequestMetrics) metricName(method, metric string) string {
+	if !m.methods[method] {
+		return fmt.Sprintf("%s/%s/%s", methodMetricsPrefix, unknownMethod, metric)
+	}
+	namespace, name, _ := strings.Cut(method, "_")
+	return fmt.Sprintf("%s/%s/%s/%s", methodMetricsPrefix, namespace, name, metric)
+}
+
+// begin records the given requests as in flight. The returned function must be
+// called once they are served.
+func (m *RequestMetrics) begin(reqs []jsonRequest) func() {
+	if m == nil || !metrics.Enabled {
+		return func() {}
+	}
+
+	inFlight := metrics.GetOrRegisterGauge(methodMetricsPrefix+"/inflight", m.registry)
+	gauges := make([]metrics.Gauge, len(reqs))
+	for i, req := range reqs {
+		gauges[i] = metrics.GetOrRegisterGauge(m.metricName(req.Method, "inflight"), m.registry)
+	}
+
+	inFlight.Inc(int64(len(reqs)))
+	for _, gauge := range gauges {
+		gauge.Inc(1)
+	}
+
+	return func() {
+		inFlight.Dec(int64(len(reqs)))
+		for _, gauge := range gauges {
+			gauge.Dec(1)
+		}
+	}
+}
+
+// observe records the given requests of a client, served in the given
+// duration, along with the error codes of their responses (0=success). The
+// duration is only recorded for the requests served individually, as the
+// requests of a batch are served together.
+func (m *RequestMetrics) observe(client string, reqs []jsonRequest, batch bool, codes []int, elapsed time.Duration) {
+	if m == nil {
+		return
+	}
+
+	if metrics.Enabled {
+		for i, req := range reqs {
+			metrics.GetOrRegisterCounter(m.metricName(req.Method, "requests"), m.registry).Inc(1)
+			if codes[i] != 0 {
+				// the codes are negative, which isn't allowed in the metric names
+				code := codes[i]
+				if code < 0 {
+					code = -code
+				}
+				metrics.GetOrRegisterCounter(m.metricName(req.Method, fmt.Sprintf("errors/%d", code)), m.registry).Inc(1)
+			}
+		}
+		if batch {
+			metrics.GetOrRegisterTimer(methodMetricsPrefix+"/batch/duration", m.registry).Update(elapsed)
+		} else if len(reqs) == 1 {
+			metrics.GetOrRegisterTimer(m.metricName(reqs[0].Method, "duration"), m.registry).Update(elapsed)
+		}
+	}
+
+	if m.slowThreshold > 0 && elapsed >= m.slowThreshold {
+		m.logSlowRequest(client, reqs, batch, elapsed)
+	}
+}
+
+// logSlowRequest logs the methods and the params digests of a slow request.
+func (m *RequestMetrics) logSlowRequest(client string, reqs []jsonRequest, batch bool, elapsed time.Duration) {
+	methods := make([]string, len(reqs))
+	digests := make([]string, len(reqs))
+	for i, req := range reqs {
+		methods[i] = req.Method
+		digests[i] = paramsDigest(req.Params)
+	}
+
+	m.logger.Info(
+		"slow JSON-RPC request",
+		"client", client,
+		"method", strings.Join(methods, ","),
+		"params", strings.Join(digests, ","),
+		"batch", batch,
+		"duration", elapsed.String(),
+	)
+}
+
+// paramsDigest returns a short digest of the params of a request, so that the
+// slow requests can be told apart without logging their params.
+func paramsDigest(params json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, params); err != nil {
+		buf.Reset()
+		buf.Write(params)
+	}
+	hash := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(hash[:8])
+}
+
+// Handler wraps the given JSON-RPC HTTP handler with the request metrics. The
+// requests forwarded by the websocket server are recorded by the handler.
+func (m *RequestMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		reqs, batch := parseRequests(body)
+		done := m.begin(reqs)
+		start := m.now()
+
+		rw := &recordingResponseWriter{ResponseWriter: w}
+		next.ServeHTTP(rw, r)
+
+		elapsed := m.now().Sub(start)
+		done()
+		m.observe(remoteHost(r.RemoteAddr), reqs, batch, responseErrorCodes(reqs, batch, rw.buf.Bytes()), elapsed)
+	})
+}
+
+// recordingResponseWriter records the prefix of the response.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	buf bytes.Buffer
+}
+
+// Write implements the http.ResponseWriter interface.
+func (w *recordingResponseWriter) Write(p []byte) (int, error) {
+	if remaining := maxRecordedResponseSize - w.buf.Len(); remaining > 0 {
+		if len(p) < remaining {
+			remaining = len(p)
+		}
+		w.buf.Write(p[:remaining])
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// parseRequests returns the requests of a raw JSON-RPC request and whether it's
+// a batch. An invalid request is returned as a request without method.
+func parseRequests(body []byte) ([]jsonRequest, bool) {
+	if !isBatch(body) {
+		var req jsonRequest
+		_ = json.Unmarshal(body, &req) // #nosec G703
+		return []jsonRequest{req}, false
+	}
+
+	var reqs []jsonRequest
+	if err := json.Unmarshal(body, &reqs); err != nil {
+		return []jsonRequest{{}}, true
+	}
+	return reqs, true
+}
+
+// responseErrorCodes returns the error code of the response of each request
+// (0=success). The responses of a batch are matched by request ID, and an error
+// response to the whole batch applies to all of its requests.
+func responseErrorCodes(reqs []jsonRequest, batch bool, body []byte) []int {
+	codes := make([]int, len(reqs))
+
+	var res jsonResponse
+	if !isBatch(body) {
+		if err := json.Unmarshal(body, &res); err != nil || res.Error == nil {
+			return codes
+		}
+		for i := range codes {
+			codes[i] = res.Error.Code
+		}
+		return codes
+	}
+
+	var responses []jsonResponse
+	if !batch || json.Unmarshal(body, &responses) != nil {
+		return codes
+	}
+
+	errs := make(map[string]int, len(responses))
+	for _, res := range responses {
+		if res.Error != nil {
+			errs[string(res.ID)] = res.Error.Code
+		}
+	}
+	for i, req := range reqs {
+		codes[i] = errs[string(req.ID)]
+	}
+	return codes
+}
@@ -0,0 +1,114 @@
+package rpc
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/server/config"
+)
+
+type testAPI struct{}
+
+func (testAPI) BlockNumber() uint64 { return 1 }
+
+func (testAPI) GetLogs() error { return nil }
+
+func TestRequestMetrics(t *testing.T) {
+	enabled := metrics.Enabled
+	metrics.Enabled = true
+	t.Cleanup(func() { metrics.Enabled = enabled })
+
+	var logs bytes.Buffer
+	cfg := config.DefaultJSONRPCConfig()
+	cfg.SlowRequestThreshold = time.Second
+	m := NewRequestMetrics(*cfg, log.NewTMLogger(&logs), []rpc.API{{Namespace: "eth", Service: &testAPI{}}})
+	m.registry = metrics.NewRegistry()
+
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	var (
+		response string
+		elapsed  time.Duration
+	)
+	handler := m.Handler(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		require.Equal(t, int64(1), metrics.GetOrRegisterGauge("rpc/methods/eth/getLogs/inflight", m.registry).Value())
+		now = now.Add(elapsed)
+		_, _ = w.Write([]byte(response))
+	}))
+
+	serve := func(body, res string, duration time.Duration) {
+		response, elapsed = res, duration
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+		require.Equal(t, res, rec.Body.String())
+	}
+
+	getLogs := `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x1"}]}`
+	serve(getLogs, `{"jsonrpc":"2.0","id":1,"result":[]}`, 10*time.Millisecond)
+	serve(getLogs, `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded"}}`, 10*time.Millisecond)
+	serve(
+		`[`+getLogs+`,{"jsonrpc":"2.0","id":2,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":3,"method":"eth_foo"}]`,
+		`[{"jsonrpc":"2.0","id":1,"result":[]},{"jsonrpc":"2.0","id":2,"result":"0x1"},{"jsonrpc":"2.0","id":3,"error":{"code":-32601,"message":"not found"}}]`,
+		500*time.Millisecond,
+	)
+	require.Empty(t, logs.String())
+
+	// the slow request is logged
+	serve(getLogs, `{"jsonrpc":"2.0","id":1,"result":[]}`, 3*time.Second)
+	require.Contains(t, logs.String(), "slow JSON-RPC request")
+	require.Contains(t, logs.String(), "method=eth_getLogs")
+	require.Contains(t, logs.String(), "params="+paramsDigest([]byte(`[{"fromBlock":"0x1"}]`)))
+	require.Contains(t, logs.String(), "duration=3s")
+
+	counter := func(name string) int64 {
+		return metrics.GetOrRegisterCounter(name, m.registry).Count()
+	}
+	require.Equal(t, int64(4), counter("rpc/methods/eth/getLogs/requests"))
+	require.Equal(t, int64(1), counter("rpc/methods/eth/getLogs/errors/32005"))
+	require.Equal(t, int64(1), counter("rpc/methods/eth/blockNumber/requests"))
+	require.Equal(t, int64(1), counter("rpc/methods/unknown/requests"))
+	require.Equal(t, int64(1), counter("rpc/methods/unknown/errors/32601"))
+
+	timer := metrics.GetOrRegisterTimer("rpc/methods/eth/getLogs/duration", m.registry)
+	require.Equal(t, int64(3), timer.Count())
+	require.Equal(t, int64(3*time.Second), timer.Max())
+	require.Equal(t, int64(1), metrics.GetOrRegisterTimer("rpc/methods/batch/duration", m.registry).Count())
+
+	require.Equal(t, int64(0), metrics.GetOrRegisterGauge("rpc/methods/inflight", m.registry).Value())
+	require.Equal(t, int64(0), metrics.GetOrRegisterGauge("rpc/methods/eth/getLogs/inflight", m.registry).Value())
+}
+
+func TestResponseErrorCodes(t *testing.T) {
+	single := []jsonRequest{{ID: []byte("1"), Method: "eth_call"}}
+	batch := []jsonRequest{{ID: []byte("1"), Method: "eth_call"}, {ID: []byte(`"a"`), Method: "eth_call"}}
+
+	testCases := []struct {
+		name     string
+		reqs     []jsonRequest
+		batch    bool
+		response string
+		expCodes []int
+	}{
+		{"success", single, false, `{"id":1,"result":"0x"}`, []int{0}},
+		{"error", single, false, `{"id":1,"error":{"code":3,"message":"execution reverted"}}`, []int{3}},
+		{"invalid response", single, false, `{"id":1,"error":`, []int{0}},
+		{"batch", batch, true, `[{"id":"a","error":{"code":-32000}},{"id":1,"result":"0x"}]`, []int{0, -32000}},
+		{"batch rejected", batch, true, `{"id":null,"error":{"code":-32600}}`, []int{-32600, -32600}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expCodes, responseErrorCodes(tc.reqs, tc.batch, []byte(tc.response)))
+		})
+	}
+}
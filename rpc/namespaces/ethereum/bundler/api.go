@@ -0,0 +1,401 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/evmos/v15/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+const (
+	// fixedBundleGas is the intrinsic gas of the bundle transaction, shared by its user operations.
+	fixedBundleGas = params.TxGas
+	// perUserOpGas is the EntryPoint overhead of each user operation on the bundle.
+	perUserOpGas = 18300
+	// perUserOpWordGas is the EntryPoint overhead of each word of a packed user operation.
+	perUserOpWordGas = 4
+	// verificationGasBuffer defines the percentage added to the estimated verification gas.
+	verificationGasBuffer = 10
+	// validUntilMargin is the min time a user operation has to remain valid to be accepted.
+	validUntilMargin = 30 * time.Second
+)
+
+// PublicAPI is the ERC-4337 bundler API. It exposes the eth_sendUserOperation,
+// eth_estimateUserOperationGas, eth_getUserOperationReceipt and eth_supportedEntryPoints
+// methods, and periodically bundles the pending user operations into EntryPoint
+// handleOps transactions signed by the configured bundler key.
+//
+// Bundle transactions are regular Ethereum transactions, so no changes to the ante
+// handler are needed: the user operations are validated by the EntryPoint contract,
+// and their validation is simulated against the latest state before being accepted.
+type PublicAPI struct {
+	logger        log.Logger
+	backend       backend.EVMBackend
+	entryPoint    common.Address
+	bundler       common.Address
+	maxOps        int
+	entryPointABI abi.ABI
+	mempool       *Mempool
+}
+
+// NewPublicAPI creates an instance of the bundler API and starts the bundling
+// loop, which runs until the given context is canceled.
+func NewPublicAPI(
+	ctx context.Context,
+	logger log.Logger,
+	backend backend.EVMBackend,
+	entryPoint, bundler common.Address,
+	maxOps int,
+	interval time.Duration,
+) (*PublicAPI, error) {
+	entryPointABI, err := LoadABI()
+	if err != nil {
+		return nil, err
+	}
+
+	api := &PublicAPI{
+		logger:        logger.With("api", "bundler"),
+		backend:       backend,
+		entryPoint:    entryPoint,
+		bundler:       bundler,
+		maxOps:        maxOps,
+		entryPointABI: entryPointABI,
+		mempool:       NewMempool(),
+	}
+
+	if interval > 0 {
+		go api.bundleLoop(ctx, interval)
+	}
+
+	return api, nil
+}
+
+// SupportedEntryPoints returns the EntryPoint contracts supported by the bundler.
+func (api *PublicAPI) SupportedEntryPoints() []common.Address {
+	api.logger.Debug("eth_supportedEntryPoints")
+	return []common.Address{api.entryPoint}
+}
+
+// SendUserOperation simulates the validation of a user operation and, if it
+// succeeds, adds it to the bundler mempool. It returns the user operation hash.
+func (api *PublicAPI) SendUserOperation(op UserOperation, entryPoint common.Address) (common.Hash, error) {
+	api.logger.Debug("eth_sendUserOperation", "sender", op.Sender, "entry-point", entryPoint)
+
+	if err := api.validateEntryPoint(entryPoint); err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := op.ValidateBasic(); err != nil {
+		return common.Hash{}, err
+	}
+
+	info, err := api.simulateValidation(op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if info.SigFailed {
+		return common.Hash{}, errors.New("invalid user operation signature")
+	}
+
+	if validUntil := info.ValidUntil; validUntil != nil && validUntil.Sign() > 0 &&
+		validUntil.Int64() < time.Now().Add(validUntilMargin).Unix() {
+		return common.Hash{}, fmt.Errorf("user operation expires too soon (valid until %d)", validUntil.Int64())
+	}
+
+	hash, err := api.userOpHash(op)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	if err := api.mempool.Add(hash, op); err != nil {
+		return common.Hash{}, err
+	}
+
+	return hash, nil
+}
+
+// EstimateUserOperationGas estimates the preVerificationGas, verificationGasLimit
+// and callGasLimit values of a user operation. The signature of the user operation
+// is not required to be valid.
+func (api *PublicAPI) EstimateUserOperationGas(op UserOperation, entryPoint common.Address) (*UserOperationGasEstimate, error) {
+	api.logger.Debug("eth_estimateUserOperationGas", "sender", op.Sender, "entry-point", entryPoint)
+
+	if err := api.validateEntryPoint(entryPoint); err != nil {
+		return nil, err
+	}
+
+	if op.Sender == (common.Address{}) {
+		return nil, errors.New("user operation sender cannot be empty")
+	}
+
+	preVerificationGas, err := api.preVerificationGas(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// simulate with zero fees so that no prefund is required, and with the max
+	// verification gas limit allowed on the node
+	simulationOp := op
+	simulationOp.PreVerificationGas = (*hexutil.Big)(new(big.Int).SetUint64(preVerificationGas))
+	simulationOp.VerificationGasLimit = (*hexutil.Big)(new(big.Int).SetUint64(api.backend.RPCGasCap()))
+	simulationOp.CallGasLimit = (*hexutil.Big)(new(big.Int))
+	simulationOp.MaxFeePerGas = (*hexutil.Big)(new(big.Int))
+	simulationOp.MaxPriorityFeePerGas = (*hexutil.Big)(new(big.Int))
+
+	info, err := api.simulateValidation(simulationOp)
+	if err != nil {
+		return nil, err
+	}
+
+	verificationGas := new(big.Int).Sub(info.PreOpGas, new(big.Int).SetUint64(preVerificationGas))
+	verificationGas.Mul(verificationGas, big.NewInt(100+verificationGasBuffer))
+	verificationGas.Div(verificationGas, big.NewInt(100))
+
+	callData := op.CallData
+	callGas, err := api.backend.EstimateGas(evmtypes.TransactionArgs{
+		From: &api.entryPoint,
+		To:   &op.Sender,
+		Data: &callData,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate user operation call gas: %w", err)
+	}
+
+	return &UserOperationGasEstimate{
+		PreVerificationGas:   hexutil.Uint64(preVerificationGas),
+		VerificationGasLimit: hexutil.Uint64(verificationGas.Uint64()),
+		CallGasLimit:         callGas,
+	}, nil
+}
+
+// GetUserOperationReceipt returns the receipt of a user operation submitted through
+// this node. It returns nil if the user operation is unknown or not yet included
+// in a block.
+func (api *PublicAPI) GetUserOperationReceipt(hash common.Hash) (*UserOperationReceipt, error) {
+	api.logger.Debug("eth_getUserOperationReceipt", "hash", hash)
+
+	_, txHash, found := api.mempool.Get(hash)
+	if !found || txHash == (common.Hash{}) {
+		return nil, nil
+	}
+
+	receipt, err := api.backend.GetTransactionReceipt(txHash)
+	if err != nil || receipt == nil {
+		// the bundle transaction is not yet included in a block
+		return nil, nil
+	}
+
+	return parseUserOperationReceipt(api.entryPointABI, api.entryPoint, hash, receipt)
+}
+
+// validateEntryPoint checks that the given EntryPoint is supported by the bundler.
+func (api *PublicAPI) validateEntryPoint(entryPoint common.Address) error {
+	if entryPoint != api.entryPoint {
+		return fmt.Errorf("unsupported entry point %s, expected %s", entryPoint, api.entryPoint)
+	}
+	return nil
+}
+
+// userOpHash returns the hash of the user operation for the supported EntryPoint.
+func (api *PublicAPI) userOpHash(op UserOperation) (common.Hash, error) {
+	chainID, err := api.backend.ChainID()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return op.Hash(api.entryPoint, chainID.ToInt()), nil
+}
+
+// simulateValidation calls the EntryPoint simulateValidation method through
+// eth_call against the latest state and decodes its result.
+func (api *PublicAPI) simulateValidation(op UserOperation) (*returnInfo, error) {
+	data, err := api.entryPointABI.Pack(SimulateValidationMethod, op.toEntryPoint())
+	if err != nil {
+		return nil, err
+	}
+
+	input := hexutil.Bytes(data)
+	_, err = api.backend.DoCall(evmtypes.TransactionArgs{
+		To:   &api.entryPoint,
+		Data: &input,
+	}, rpctypes.EthLatestBlockNumber)
+	if err == nil {
+		return nil, errors.New("simulateValidation did not revert")
+	}
+
+	data, err = revertData(err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate user operation validation: %w", err)
+	}
+
+	return unpackValidationResult(api.entryPointABI, data)
+}
+
+// simulateHandleOps calls the EntryPoint handleOps method with the bundle
+// through eth_call against the latest state. It returns the index of the user
+// operation that makes the bundle revert, or -1 if the bundle succeeds.
+func (api *PublicAPI) simulateHandleOps(args evmtypes.TransactionArgs, numOps int) (int, string, error) {
+	_, err := api.backend.DoCall(args, rpctypes.EthLatestBlockNumber)
+	if err == nil {
+		return -1, "", nil
+	}
+
+	data, err := revertData(err)
+	if err != nil {
+		return -1, "", err
+	}
+
+	opIndex, reason, ok := unpackFailedOp(api.entryPointABI, data)
+	if !ok || opIndex >= numOps {
+		return -1, "", fmt.Errorf("unexpected handleOps revert data: %s", hexutil.Encode(data))
+	}
+
+	return opIndex, reason, nil
+}
+
+// revertData returns the revert data of a failed eth_call.
+func revertData(err error) ([]byte, error) {
+	revertErr, ok := err.(interface{ ErrorData() interface{} })
+	if !ok {
+		return nil, err
+	}
+
+	revertHex, _ := revertErr.ErrorData().(string)
+	data, decodeErr := hexutil.Decode(revertHex)
+	if decodeErr != nil {
+		return nil, fmt.Errorf("invalid revert data: %w", decodeErr)
+	}
+
+	return data, nil
+}
+
+// preVerificationGas returns the gas overhead of including the user operation
+// on a bundle, which is not metered by the EntryPoint.
+func (api *PublicAPI) preVerificationGas(op UserOperation) (uint64, error) {
+	// use the max values for the gas fields so that the calldata cost is not underestimated
+	packingOp := op.toEntryPoint()
+	maxUint := new(big.Int).SetUint64(^uint64(0))
+	packingOp.PreVerificationGas = maxUint
+	packingOp.VerificationGasLimit = maxUint
+	packingOp.CallGasLimit = maxUint
+
+	data, err := api.entryPointABI.Methods[SimulateValidationMethod].Inputs.Pack(packingOp)
+	if err != nil {
+		return 0, err
+	}
+
+	gas := uint64(fixedBundleGas + perUserOpGas)
+	gas += uint64((len(data)+31)/32) * perUserOpWordGas
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return gas, nil
+}
+
+// bundleLoop bundles the pending user operations at the given interval until
+// the context is canceled.
+func (api *PublicAPI) bundleLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			api.logger.Debug("stopping the bundling loop")
+			return
+		case <-ticker.C:
+			api.bundle()
+		}
+	}
+}
+
+// bundle re-validates the pending user operations against the latest state and
+// submits the valid ones in a single EntryPoint handleOps transaction signed by
+// the bundler key. Operations that fail validation, or that make the simulation
+// of the bundle revert, are dropped from the mempool while the others are kept
+// in the bundle. If the bundle cannot be submitted for any other reason, its
+// operations are kept pending to be retried on the next bundle.
+func (api *PublicAPI) bundle() {
+	entries := api.mempool.Pending(api.maxOps)
+	if len(entries) == 0 {
+		return
+	}
+
+	var (
+		ops     = make([]entryPointUserOperation, 0, len(entries))
+		hashes  = make([]common.Hash, 0, len(entries))
+		invalid []common.Hash
+	)
+
+	for _, entry := range entries {
+		info, err := api.simulateValidation(entry.op)
+		if err != nil || info.SigFailed {
+			api.logger.Debug("dropping invalid user operation", "hash", entry.hash, "error", err)
+			invalid = append(invalid, entry.hash)
+			continue
+		}
+
+		ops = append(ops, entry.op.toEntryPoint())
+		hashes = append(hashes, entry.hash)
+	}
+
+	api.mempool.Remove(invalid)
+
+	for len(ops) > 0 {
+		data, err := api.entryPointABI.Pack(HandleOpsMethod, ops, api.bundler)
+		if err != nil {
+			api.logger.Error("failed to pack bundle", "error", err.Error())
+			api.mempool.Remove(hashes)
+			return
+		}
+
+		input := hexutil.Bytes(data)
+		args := evmtypes.TransactionArgs{
+			From: &api.bundler,
+			To:   &api.entryPoint,
+			Data: &input,
+		}
+
+		opIndex, reason, err := api.simulateHandleOps(args, len(ops))
+		if err != nil {
+			api.logger.Error("failed to simulate bundle", "ops", len(ops), "error", err.Error())
+			return
+		}
+
+		if opIndex >= 0 {
+			api.logger.Debug("dropping failed user operation", "hash", hashes[opIndex], "reason", reason)
+			api.mempool.Remove([]common.Hash{hashes[opIndex]})
+			ops = append(ops[:opIndex], ops[opIndex+1:]...)
+			hashes = append(hashes[:opIndex], hashes[opIndex+1:]...)
+			continue
+		}
+
+		txHash, err := api.backend.SendTransaction(args)
+		if err != nil {
+			api.logger.Error("failed to submit bundle", "ops", len(ops), "error", err.Error())
+			return
+		}
+
+		api.logger.Debug("submitted bundle", "tx-hash", txHash, "ops", len(ops))
+		api.mempool.MarkSubmitted(hashes, txHash)
+		return
+	}
+}
@@ -0,0 +1,232 @@
+package bundler
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// mockBackend simulates the EntryPoint calls of the bundler. The validation of
+// every user operation succeeds, while the bundles including a failing sender
+// revert with a FailedOp error.
+type mockBackend struct {
+	backend.EVMBackend
+
+	t             *testing.T
+	entryPointABI abi.ABI
+
+	mu      sync.Mutex
+	failing map[common.Address]bool
+	sendErr error
+	// bundles are the senders of the user operations of each submitted bundle
+	bundles [][]common.Address
+}
+
+func newMockBackend(t *testing.T, failing ...common.Address) *mockBackend {
+	entryPointABI, err := LoadABI()
+	require.NoError(t, err)
+
+	mock := &mockBackend{
+		t:             t,
+		entryPointABI: entryPointABI,
+		failing:       make(map[common.Address]bool),
+	}
+	for _, sender := range failing {
+		mock.failing[sender] = true
+	}
+	return mock
+}
+
+func (m *mockBackend) DoCall(args evmtypes.TransactionArgs, _ rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error) {
+	data := *args.Data
+	method, err := m.entryPointABI.MethodById(data[:4])
+	require.NoError(m.t, err)
+
+	if method.Name == SimulateValidationMethod {
+		emptyStake := struct {
+			Stake           *big.Int
+			UnstakeDelaySec *big.Int
+		}{big.NewInt(0), big.NewInt(0)}
+
+		validationResult := m.entryPointABI.Errors[ValidationResultError]
+		result, err := validationResult.Inputs.Pack(
+			returnInfo{
+				PreOpGas:         big.NewInt(60000),
+				Prefund:          big.NewInt(0),
+				ValidAfter:       big.NewInt(0),
+				ValidUntil:       big.NewInt(0),
+				PaymasterContext: []byte{},
+			},
+			emptyStake, emptyStake, emptyStake,
+		)
+		require.NoError(m.t, err)
+		return nil, evmtypes.NewExecErrorWithReason(append(validationResult.ID[:4], result...))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, sender := range m.bundleSenders(data) {
+		if m.failing[sender] {
+			failedOp := m.entryPointABI.Errors[FailedOpError]
+			result, err := failedOp.Inputs.Pack(big.NewInt(int64(i)), "AA23 reverted")
+			require.NoError(m.t, err)
+			return nil, evmtypes.NewExecErrorWithReason(append(failedOp.ID[:4], result...))
+		}
+	}
+
+	return &evmtypes.MsgEthereumTxResponse{}, nil
+}
+
+func (m *mockBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.sendErr != nil {
+		return common.Hash{}, m.sendErr
+	}
+
+	m.bundles = append(m.bundles, m.bundleSenders(*args.Data))
+	return crypto.Keccak256Hash(*args.Data), nil
+}
+
+// bundleSenders returns the senders of the user operations of a handleOps call.
+func (m *mockBackend) bundleSenders(data []byte) []common.Address {
+	values, err := m.entryPointABI.Methods[HandleOpsMethod].Inputs.Unpack(data[4:])
+	require.NoError(m.t, err)
+
+	ops := reflect.ValueOf(values[0])
+	senders := make([]common.Address, ops.Len())
+	for i := range senders {
+		senders[i] = ops.Index(i).FieldByName("Sender").Interface().(common.Address)
+	}
+	return senders
+}
+
+func (m *mockBackend) submitted() [][]common.Address {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.bundles
+}
+
+func TestBundle(t *testing.T) {
+	senders := []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2"), common.HexToAddress("0x3")}
+	hash := func(sender common.Address) common.Hash { return crypto.Keccak256Hash(sender.Bytes()) }
+
+	testCases := []struct {
+		name         string
+		failing      []common.Address
+		sendErr      error
+		expBundles   [][]common.Address
+		expSubmitted []common.Address
+		expPending   []common.Address
+		expDropped   []common.Address
+	}{
+		{
+			"pass - all the user operations are submitted",
+			nil,
+			nil,
+			[][]common.Address{{senders[2], senders[1], senders[0]}},
+			senders,
+			nil,
+			nil,
+		},
+		{
+			"pass - only the failing user operation is dropped",
+			[]common.Address{senders[1]},
+			nil,
+			[][]common.Address{{senders[2], senders[0]}},
+			[]common.Address{senders[0], senders[2]},
+			nil,
+			[]common.Address{senders[1]},
+		},
+		{
+			"pass - all the user operations fail",
+			senders,
+			nil,
+			nil,
+			nil,
+			nil,
+			senders,
+		},
+		{
+			"fail - the user operations are kept pending if the bundle is not submitted",
+			nil,
+			errors.New("failed to sign tx"),
+			nil,
+			nil,
+			senders,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := newMockBackend(t, tc.failing...)
+			mock.sendErr = tc.sendErr
+
+			api, err := NewPublicAPI(context.Background(), log.NewNopLogger(), mock, common.HexToAddress("0xe"), common.HexToAddress("0xb"), 10, 0)
+			require.NoError(t, err)
+
+			// the user operations are bundled by priority fee
+			for i, sender := range senders {
+				require.NoError(t, api.mempool.Add(hash(sender), newTestUserOp(sender, 0, int64(i+1))))
+			}
+
+			api.bundle()
+			require.Equal(t, tc.expBundles, mock.submitted())
+
+			for _, sender := range tc.expSubmitted {
+				_, txHash, found := api.mempool.Get(hash(sender))
+				require.True(t, found)
+				require.NotEqual(t, common.Hash{}, txHash)
+			}
+			for _, sender := range tc.expPending {
+				_, txHash, found := api.mempool.Get(hash(sender))
+				require.True(t, found)
+				require.Equal(t, common.Hash{}, txHash)
+			}
+			for _, sender := range tc.expDropped {
+				_, _, found := api.mempool.Get(hash(sender))
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestBundleLoopStops(t *testing.T) {
+	mock := newMockBackend(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api, err := NewPublicAPI(ctx, log.NewNopLogger(), mock, common.HexToAddress("0xe"), common.HexToAddress("0xb"), 10, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	sender := common.HexToAddress("0x1")
+	require.NoError(t, api.mempool.Add(common.BytesToHash(sender.Bytes()), newTestUserOp(sender, 0, 1)))
+	require.Eventually(t, func() bool { return len(mock.submitted()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// no bundles are submitted once the context is canceled
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+
+	sender = common.HexToAddress("0x2")
+	require.NoError(t, api.mempool.Add(common.BytesToHash(sender.Bytes()), newTestUserOp(sender, 0, 1)))
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, mock.submitted(), 1)
+	require.Equal(t, sender, api.mempool.Pending(0)[0].op.Sender)
+}
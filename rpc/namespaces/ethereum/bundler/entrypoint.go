@@ -0,0 +1,191 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+const (
+	// HandleOpsMethod defines the EntryPoint method used to execute a bundle.
+	HandleOpsMethod = "handleOps"
+	// SimulateValidationMethod defines the EntryPoint method used to simulate
+	// the validation of a user operation.
+	SimulateValidationMethod = "simulateValidation"
+
+	// FailedOpError defines the EntryPoint error returned when a user operation fails.
+	FailedOpError = "FailedOp"
+	// ValidationResultError defines the EntryPoint error returned when the simulation
+	// of a user operation succeeds.
+	ValidationResultError = "ValidationResult"
+
+	// UserOperationEvent defines the EntryPoint event emitted for each executed user operation.
+	UserOperationEvent = "UserOperationEvent"
+	// UserOperationRevertReasonEvent defines the EntryPoint event emitted when the
+	// execution of a user operation reverts.
+	UserOperationRevertReasonEvent = "UserOperationRevertReason"
+)
+
+// LoadABI loads the ERC-4337 EntryPoint ABI from the embedded abi.json file.
+func LoadABI() (abi.ABI, error) {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		return abi.ABI{}, fmt.Errorf("error loading the EntryPoint ABI %s", err)
+	}
+
+	return abi.JSON(bytes.NewReader(abiBz))
+}
+
+// unpackValidationResult decodes the revert data returned by the EntryPoint
+// simulateValidation method. The method always reverts: with a ValidationResult
+// error if the validation succeeds, or with a FailedOp error otherwise.
+func unpackValidationResult(entryPointABI abi.ABI, revertData []byte) (*returnInfo, error) {
+	if len(revertData) < 4 {
+		return nil, errors.New("invalid simulateValidation revert data")
+	}
+
+	selector := revertData[:4]
+
+	failedOp := entryPointABI.Errors[FailedOpError]
+	if bytes.Equal(selector, failedOp.ID[:4]) {
+		values, err := failedOp.Inputs.Unpack(revertData[4:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack %s error: %w", FailedOpError, err)
+		}
+		reason, _ := values[1].(string)
+		return nil, fmt.Errorf("user operation validation failed: %s", reason)
+	}
+
+	validationResult := entryPointABI.Errors[ValidationResultError]
+	if !bytes.Equal(selector, validationResult.ID[:4]) {
+		return nil, fmt.Errorf("unexpected simulateValidation revert data: %s", hexutil.Encode(revertData))
+	}
+
+	values, err := validationResult.Inputs.Unpack(revertData[4:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s error: %w", ValidationResultError, err)
+	}
+
+	info, ok := abi.ConvertType(values[0], returnInfo{}).(returnInfo)
+	if !ok {
+		return nil, fmt.Errorf("failed to decode %s return info", ValidationResultError)
+	}
+
+	return &info, nil
+}
+
+// unpackFailedOp decodes a FailedOp error returned by the EntryPoint into the
+// index of the failed user operation and the failure reason. It returns false
+// if the revert data is not a FailedOp error.
+func unpackFailedOp(entryPointABI abi.ABI, revertData []byte) (int, string, bool) {
+	failedOp := entryPointABI.Errors[FailedOpError]
+	if len(revertData) < 4 || !bytes.Equal(revertData[:4], failedOp.ID[:4]) {
+		return 0, "", false
+	}
+
+	values, err := failedOp.Inputs.Unpack(revertData[4:])
+	if err != nil {
+		return 0, "", false
+	}
+
+	opIndex, ok := values[0].(*big.Int)
+	if !ok || !opIndex.IsInt64() || opIndex.Sign() < 0 {
+		return 0, "", false
+	}
+
+	reason, _ := values[1].(string)
+	return int(opIndex.Int64()), reason, true
+}
+
+// parseUserOperationReceipt returns the user operation receipt from the logs of
+// the bundle transaction receipt. It returns nil if the transaction didn't
+// execute the user operation.
+func parseUserOperationReceipt(
+	entryPointABI abi.ABI,
+	entryPoint common.Address,
+	userOpHash common.Hash,
+	receipt map[string]interface{},
+) (*UserOperationReceipt, error) {
+	logs, _ := receipt["logs"].([]*ethtypes.Log)
+
+	userOpEvent := entryPointABI.Events[UserOperationEvent]
+	revertEvent := entryPointABI.Events[UserOperationRevertReasonEvent]
+
+	var (
+		opReceipt *UserOperationReceipt
+		opLogs    []*ethtypes.Log
+		reason    string
+	)
+
+	// the logs of a user operation are emitted before its UserOperationEvent and
+	// after the UserOperationEvent of the previous operation on the bundle
+	for _, log := range logs {
+		if log.Address != entryPoint || len(log.Topics) < 2 {
+			opLogs = append(opLogs, log)
+			continue
+		}
+
+		switch log.Topics[0] {
+		case revertEvent.ID:
+			if log.Topics[1] != userOpHash {
+				continue
+			}
+			values, err := revertEvent.Inputs.NonIndexed().Unpack(log.Data)
+			if err == nil {
+				revertReason, _ := values[1].([]byte)
+				reason = hexutil.Encode(revertReason)
+			}
+		case userOpEvent.ID:
+			if log.Topics[1] != userOpHash || len(log.Topics) < 4 {
+				opLogs = nil
+				continue
+			}
+
+			values, err := userOpEvent.Inputs.NonIndexed().Unpack(log.Data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unpack %s event: %w", UserOperationEvent, err)
+			}
+
+			nonce, _ := values[0].(*big.Int)
+			success, _ := values[1].(bool)
+			actualGasCost, _ := values[2].(*big.Int)
+			actualGasUsed, _ := values[3].(*big.Int)
+
+			opReceipt = &UserOperationReceipt{
+				UserOpHash:    userOpHash,
+				EntryPoint:    entryPoint,
+				Sender:        common.BytesToAddress(log.Topics[2].Bytes()),
+				Paymaster:     common.BytesToAddress(log.Topics[3].Bytes()),
+				Nonce:         (*hexutil.Big)(nonce),
+				ActualGasCost: (*hexutil.Big)(actualGasCost),
+				ActualGasUsed: (*hexutil.Big)(actualGasUsed),
+				Success:       success,
+				Reason:        reason,
+				Logs:          opLogs,
+				Receipt:       receipt,
+			}
+		default:
+			opLogs = append(opLogs, log)
+		}
+
+		if opReceipt != nil {
+			break
+		}
+	}
+
+	return opReceipt, nil
+}
@@ -0,0 +1,122 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnpackValidationResult(t *testing.T) {
+	entryPointABI, err := LoadABI()
+	require.NoError(t, err)
+
+	type stakeInfo struct {
+		Stake           *big.Int
+		UnstakeDelaySec *big.Int
+	}
+	emptyStake := stakeInfo{Stake: big.NewInt(0), UnstakeDelaySec: big.NewInt(0)}
+
+	testCases := []struct {
+		name       string
+		revertData func() []byte
+		expPass    bool
+		errContain string
+	}{
+		{
+			"pass - validation result",
+			func() []byte {
+				validationResult := entryPointABI.Errors[ValidationResultError]
+				data, err := validationResult.Inputs.Pack(
+					returnInfo{
+						PreOpGas:         big.NewInt(60000),
+						Prefund:          big.NewInt(1000),
+						ValidAfter:       big.NewInt(0),
+						ValidUntil:       big.NewInt(0),
+						PaymasterContext: []byte{},
+					},
+					emptyStake, emptyStake, emptyStake,
+				)
+				require.NoError(t, err)
+				return append(validationResult.ID[:4], data...)
+			},
+			true,
+			"",
+		},
+		{
+			"fail - failed op",
+			func() []byte {
+				failedOp := entryPointABI.Errors[FailedOpError]
+				data, err := failedOp.Inputs.Pack(big.NewInt(0), "AA21 didn't pay prefund")
+				require.NoError(t, err)
+				return append(failedOp.ID[:4], data...)
+			},
+			false,
+			"AA21 didn't pay prefund",
+		},
+		{
+			"fail - unknown revert data",
+			func() []byte {
+				return []byte{1, 2, 3, 4, 5}
+			},
+			false,
+			"unexpected simulateValidation revert data",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			info, err := unpackValidationResult(entryPointABI, tc.revertData())
+
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, big.NewInt(60000), info.PreOpGas)
+				require.False(t, info.SigFailed)
+			} else {
+				require.ErrorContains(t, err, tc.errContain)
+			}
+		})
+	}
+}
+
+func TestParseUserOperationReceipt(t *testing.T) {
+	entryPointABI, err := LoadABI()
+	require.NoError(t, err)
+
+	entryPoint := common.HexToAddress("0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789")
+	sender := common.HexToAddress("0x1")
+	opHash := common.HexToHash("0xaa")
+	otherHash := common.HexToHash("0xbb")
+
+	userOpEvent := entryPointABI.Events[UserOperationEvent]
+	newUserOpLog := func(hash common.Hash) *ethtypes.Log {
+		data, err := userOpEvent.Inputs.NonIndexed().Pack(big.NewInt(1), true, big.NewInt(2000), big.NewInt(200))
+		require.NoError(t, err)
+		return &ethtypes.Log{
+			Address: entryPoint,
+			Topics:  []common.Hash{userOpEvent.ID, hash, common.BytesToHash(sender.Bytes()), {}},
+			Data:    data,
+		}
+	}
+
+	otherOpLog := &ethtypes.Log{Address: common.HexToAddress("0x3"), Topics: []common.Hash{{1}}}
+	opLog := &ethtypes.Log{Address: common.HexToAddress("0x4"), Topics: []common.Hash{{2}}}
+
+	receipt := map[string]interface{}{
+		"logs": []*ethtypes.Log{otherOpLog, newUserOpLog(otherHash), opLog, newUserOpLog(opHash)},
+	}
+
+	opReceipt, err := parseUserOperationReceipt(entryPointABI, entryPoint, opHash, receipt)
+	require.NoError(t, err)
+	require.NotNil(t, opReceipt)
+	require.Equal(t, sender, opReceipt.Sender)
+	require.True(t, opReceipt.Success)
+	require.Equal(t, big.NewInt(2000), opReceipt.ActualGasCost.ToInt())
+	require.Equal(t, []*ethtypes.Log{opLog}, opReceipt.Logs)
+
+	opReceipt, err = parseUserOperationReceipt(entryPointABI, entryPoint, common.HexToHash("0xcc"), receipt)
+	require.NoError(t, err)
+	require.Nil(t, opReceipt)
+}
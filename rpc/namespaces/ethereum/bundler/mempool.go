@@ -0,0 +1,187 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// maxPendingOps defines the max number of pending user operations kept in the mempool.
+	maxPendingOps = 1000
+	// maxSubmittedOps defines the max number of submitted user operations tracked to
+	// serve their receipts.
+	maxSubmittedOps = 10000
+	// replacementFeeBump defines the min percentage by which the fees of a user
+	// operation must be increased to replace a pending one with the same nonce.
+	replacementFeeBump = 10
+)
+
+// mempoolEntry defines a user operation tracked by the mempool.
+type mempoolEntry struct {
+	op   UserOperation
+	hash common.Hash
+	// txHash is the hash of the bundle transaction that included the operation.
+	// It is empty while the operation is pending.
+	txHash common.Hash
+}
+
+// Mempool is the in-memory alternative mempool of the bundler. It holds at most
+// one pending user operation per sender, and keeps track of the bundle
+// transactions of the submitted operations.
+type Mempool struct {
+	mu sync.RWMutex
+
+	pending   map[common.Hash]*mempoolEntry
+	bySender  map[common.Address]common.Hash
+	submitted map[common.Hash]*mempoolEntry
+	// submittedOrder keeps the submission order to prune the oldest operations.
+	submittedOrder []common.Hash
+}
+
+// NewMempool creates a new empty Mempool.
+func NewMempool() *Mempool {
+	return &Mempool{
+		pending:   make(map[common.Hash]*mempoolEntry),
+		bySender:  make(map[common.Address]common.Hash),
+		submitted: make(map[common.Hash]*mempoolEntry),
+	}
+}
+
+// Add adds a user operation to the pending operations. A pending operation of the
+// same sender can only be replaced by an operation with the same nonce and higher fees.
+func (m *Mempool) Add(hash common.Hash, op UserOperation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, found := m.pending[hash]; found {
+		return fmt.Errorf("user operation %s already known", hash)
+	}
+
+	if _, found := m.submitted[hash]; found {
+		return fmt.Errorf("user operation %s already submitted", hash)
+	}
+
+	if prevHash, found := m.bySender[op.Sender]; found {
+		prev := m.pending[prevHash].op
+		if toBigInt(prev.Nonce).Cmp(toBigInt(op.Nonce)) != 0 {
+			return fmt.Errorf("sender %s already has a pending user operation", op.Sender)
+		}
+
+		if !isFeeBumped(toBigInt(prev.MaxFeePerGas), toBigInt(op.MaxFeePerGas)) ||
+			!isFeeBumped(toBigInt(prev.MaxPriorityFeePerGas), toBigInt(op.MaxPriorityFeePerGas)) {
+			return fmt.Errorf("replacement user operation must increase the fees by at least %d%%", replacementFeeBump)
+		}
+
+		delete(m.pending, prevHash)
+	} else if len(m.pending) >= maxPendingOps {
+		return errors.New("user operation mempool is full")
+	}
+
+	m.pending[hash] = &mempoolEntry{op: op, hash: hash}
+	m.bySender[op.Sender] = hash
+
+	return nil
+}
+
+// Pending returns up to limit pending user operations, sorted by priority fee.
+func (m *Mempool) Pending(limit int) []*mempoolEntry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]*mempoolEntry, 0, len(m.pending))
+	for _, entry := range m.pending {
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		cmp := toBigInt(entries[i].op.MaxPriorityFeePerGas).Cmp(toBigInt(entries[j].op.MaxPriorityFeePerGas))
+		if cmp != 0 {
+			return cmp > 0
+		}
+		// sort by hash for deterministic bundles
+		return entries[i].hash.Big().Cmp(entries[j].hash.Big()) < 0
+	})
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries
+}
+
+// MarkSubmitted moves the given pending user operations to the submitted ones,
+// recording the hash of the bundle transaction that included them.
+func (m *Mempool) MarkSubmitted(hashes []common.Hash, txHash common.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, hash := range hashes {
+		entry, found := m.pending[hash]
+		if !found {
+			continue
+		}
+
+		m.removePending(entry)
+		entry.txHash = txHash
+		m.submitted[hash] = entry
+		m.submittedOrder = append(m.submittedOrder, hash)
+	}
+
+	// prune the oldest submitted operations
+	for len(m.submittedOrder) > maxSubmittedOps {
+		delete(m.submitted, m.submittedOrder[0])
+		m.submittedOrder = m.submittedOrder[1:]
+	}
+}
+
+// Remove drops the given pending user operations.
+func (m *Mempool) Remove(hashes []common.Hash) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, hash := range hashes {
+		if entry, found := m.pending[hash]; found {
+			m.removePending(entry)
+		}
+	}
+}
+
+// Get returns the user operation with the given hash, and the hash of its bundle
+// transaction if it was already submitted.
+func (m *Mempool) Get(hash common.Hash) (UserOperation, common.Hash, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if entry, found := m.pending[hash]; found {
+		return entry.op, common.Hash{}, true
+	}
+
+	if entry, found := m.submitted[hash]; found {
+		return entry.op, entry.txHash, true
+	}
+
+	return UserOperation{}, common.Hash{}, false
+}
+
+// removePending removes a pending entry. It must be called with the lock held.
+func (m *Mempool) removePending(entry *mempoolEntry) {
+	delete(m.pending, entry.hash)
+	if m.bySender[entry.op.Sender] == entry.hash {
+		delete(m.bySender, entry.op.Sender)
+	}
+}
+
+// isFeeBumped returns true if the new fee is at least replacementFeeBump percent
+// higher than the previous one.
+func isFeeBumped(prev, fee *big.Int) bool {
+	minFee := new(big.Int).Mul(prev, big.NewInt(100+replacementFeeBump))
+	minFee.Div(minFee, big.NewInt(100))
+	return fee.Cmp(minFee) >= 0 && fee.Cmp(prev) > 0
+}
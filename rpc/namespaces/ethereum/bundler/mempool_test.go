@@ -0,0 +1,155 @@
+package bundler
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestUserOp(sender common.Address, nonce, fee int64) UserOperation {
+	return UserOperation{
+		Sender:               sender,
+		Nonce:                (*hexutil.Big)(big.NewInt(nonce)),
+		CallGasLimit:         (*hexutil.Big)(big.NewInt(100000)),
+		VerificationGasLimit: (*hexutil.Big)(big.NewInt(100000)),
+		PreVerificationGas:   (*hexutil.Big)(big.NewInt(50000)),
+		MaxFeePerGas:         (*hexutil.Big)(big.NewInt(fee)),
+		MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(fee)),
+	}
+}
+
+func TestMempool(t *testing.T) {
+	sender := common.HexToAddress("0x1")
+	other := common.HexToAddress("0x2")
+	hash := func(b byte) common.Hash { return common.BytesToHash([]byte{b}) }
+
+	testCases := []struct {
+		name       string
+		malleate   func(m *Mempool) error
+		expPass    bool
+		errContain string
+		expPending []common.Hash
+	}{
+		{
+			"pass - add user operations of different senders",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 10)); err != nil {
+					return err
+				}
+				return m.Add(hash(2), newTestUserOp(other, 0, 20))
+			},
+			true,
+			"",
+			[]common.Hash{hash(2), hash(1)},
+		},
+		{
+			"fail - duplicated user operation",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 10)); err != nil {
+					return err
+				}
+				return m.Add(hash(1), newTestUserOp(sender, 0, 10))
+			},
+			false,
+			"already known",
+			[]common.Hash{hash(1)},
+		},
+		{
+			"fail - sender with a pending user operation",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 10)); err != nil {
+					return err
+				}
+				return m.Add(hash(2), newTestUserOp(sender, 1, 10))
+			},
+			false,
+			"already has a pending user operation",
+			[]common.Hash{hash(1)},
+		},
+		{
+			"fail - replacement without fee bump",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 100)); err != nil {
+					return err
+				}
+				return m.Add(hash(2), newTestUserOp(sender, 0, 105))
+			},
+			false,
+			"must increase the fees",
+			[]common.Hash{hash(1)},
+		},
+		{
+			"pass - replacement with fee bump",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 100)); err != nil {
+					return err
+				}
+				return m.Add(hash(2), newTestUserOp(sender, 0, 110))
+			},
+			true,
+			"",
+			[]common.Hash{hash(2)},
+		},
+		{
+			"pass - submitted user operations are no longer pending",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 10)); err != nil {
+					return err
+				}
+				if err := m.Add(hash(2), newTestUserOp(other, 0, 10)); err != nil {
+					return err
+				}
+				m.MarkSubmitted([]common.Hash{hash(1)}, hash(100))
+
+				_, txHash, found := m.Get(hash(1))
+				require.True(t, found)
+				require.Equal(t, hash(100), txHash)
+
+				// the sender can submit a new user operation
+				return m.Add(hash(3), newTestUserOp(sender, 1, 10))
+			},
+			true,
+			"",
+			[]common.Hash{hash(2), hash(3)},
+		},
+		{
+			"pass - removed user operations",
+			func(m *Mempool) error {
+				if err := m.Add(hash(1), newTestUserOp(sender, 0, 10)); err != nil {
+					return err
+				}
+				m.Remove([]common.Hash{hash(1)})
+
+				_, _, found := m.Get(hash(1))
+				require.False(t, found)
+				return nil
+			},
+			true,
+			"",
+			[]common.Hash{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMempool()
+			err := tc.malleate(m)
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContain)
+			}
+
+			pending := m.Pending(0)
+			hashes := make([]common.Hash, len(pending))
+			for i, entry := range pending {
+				hashes[i] = entry.hash
+			}
+			require.Equal(t, tc.expPending, hashes)
+		})
+	}
+}
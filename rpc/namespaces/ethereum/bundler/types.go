@@ -0,0 +1,199 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package bundler
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// UserOperation defines an ERC-4337 user operation as submitted through the
+// JSON-RPC API.
+type UserOperation struct {
+	Sender               common.Address `json:"sender"`
+	Nonce                *hexutil.Big   `json:"nonce"`
+	InitCode             hexutil.Bytes  `json:"initCode"`
+	CallData             hexutil.Bytes  `json:"callData"`
+	CallGasLimit         *hexutil.Big   `json:"callGasLimit"`
+	VerificationGasLimit *hexutil.Big   `json:"verificationGasLimit"`
+	PreVerificationGas   *hexutil.Big   `json:"preVerificationGas"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+	PaymasterAndData     hexutil.Bytes  `json:"paymasterAndData"`
+	Signature            hexutil.Bytes  `json:"signature"`
+}
+
+// entryPointUserOperation is the UserOperation representation used to pack
+// the EntryPoint contract calls.
+type entryPointUserOperation struct {
+	Sender               common.Address
+	Nonce                *big.Int
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         *big.Int
+	VerificationGasLimit *big.Int
+	PreVerificationGas   *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	PaymasterAndData     []byte
+	Signature            []byte
+}
+
+// UserOperationGasEstimate defines the gas values estimated for a user operation.
+type UserOperationGasEstimate struct {
+	PreVerificationGas   hexutil.Uint64 `json:"preVerificationGas"`
+	VerificationGasLimit hexutil.Uint64 `json:"verificationGasLimit"`
+	CallGasLimit         hexutil.Uint64 `json:"callGasLimit"`
+}
+
+// UserOperationReceipt defines the receipt of a user operation included on a bundle.
+type UserOperationReceipt struct {
+	UserOpHash    common.Hash            `json:"userOpHash"`
+	EntryPoint    common.Address         `json:"entryPoint"`
+	Sender        common.Address         `json:"sender"`
+	Nonce         *hexutil.Big           `json:"nonce"`
+	Paymaster     common.Address         `json:"paymaster"`
+	ActualGasCost *hexutil.Big           `json:"actualGasCost"`
+	ActualGasUsed *hexutil.Big           `json:"actualGasUsed"`
+	Success       bool                   `json:"success"`
+	Reason        string                 `json:"reason"`
+	Logs          []*ethtypes.Log        `json:"logs"`
+	Receipt       map[string]interface{} `json:"receipt"`
+}
+
+// returnInfo defines the gas and validity values returned by the EntryPoint
+// simulateValidation method.
+type returnInfo struct {
+	PreOpGas         *big.Int
+	Prefund          *big.Int
+	SigFailed        bool
+	ValidAfter       *big.Int
+	ValidUntil       *big.Int
+	PaymasterContext []byte
+}
+
+// ValidateBasic performs a stateless validation of the user operation fields.
+func (op UserOperation) ValidateBasic() error {
+	if op.Sender == (common.Address{}) {
+		return errors.New("user operation sender cannot be empty")
+	}
+
+	for _, field := range []struct {
+		name  string
+		value *hexutil.Big
+	}{
+		{"nonce", op.Nonce},
+		{"callGasLimit", op.CallGasLimit},
+		{"verificationGasLimit", op.VerificationGasLimit},
+		{"preVerificationGas", op.PreVerificationGas},
+		{"maxFeePerGas", op.MaxFeePerGas},
+		{"maxPriorityFeePerGas", op.MaxPriorityFeePerGas},
+	} {
+		if field.value == nil {
+			return errors.New("user operation " + field.name + " cannot be empty")
+		}
+	}
+
+	if op.MaxPriorityFeePerGas.ToInt().Cmp(op.MaxFeePerGas.ToInt()) > 0 {
+		return errors.New("user operation maxPriorityFeePerGas cannot be higher than maxFeePerGas")
+	}
+
+	return nil
+}
+
+// toEntryPoint returns the EntryPoint contract representation of the user operation.
+func (op UserOperation) toEntryPoint() entryPointUserOperation {
+	return entryPointUserOperation{
+		Sender:               op.Sender,
+		Nonce:                toBigInt(op.Nonce),
+		InitCode:             op.InitCode,
+		CallData:             op.CallData,
+		CallGasLimit:         toBigInt(op.CallGasLimit),
+		VerificationGasLimit: toBigInt(op.VerificationGasLimit),
+		PreVerificationGas:   toBigInt(op.PreVerificationGas),
+		MaxFeePerGas:         toBigInt(op.MaxFeePerGas),
+		MaxPriorityFeePerGas: toBigInt(op.MaxPriorityFeePerGas),
+		PaymasterAndData:     op.PaymasterAndData,
+		Signature:            op.Signature,
+	}
+}
+
+// Hash returns the hash of the user operation for the given EntryPoint contract and
+// chain ID, as computed by the EntryPoint getUserOpHash method.
+func (op UserOperation) Hash(entryPoint common.Address, chainID *big.Int) common.Hash {
+	packed, err := userOpHashArguments.Pack(
+		op.Sender,
+		toBigInt(op.Nonce),
+		crypto.Keccak256Hash(op.InitCode),
+		crypto.Keccak256Hash(op.CallData),
+		toBigInt(op.CallGasLimit),
+		toBigInt(op.VerificationGasLimit),
+		toBigInt(op.PreVerificationGas),
+		toBigInt(op.MaxFeePerGas),
+		toBigInt(op.MaxPriorityFeePerGas),
+		crypto.Keccak256Hash(op.PaymasterAndData),
+	)
+	if err != nil {
+		// the arguments are statically typed, so packing cannot fail
+		panic(err)
+	}
+
+	encoded, err := hashArguments.Pack(crypto.Keccak256Hash(packed), entryPoint, chainID)
+	if err != nil {
+		panic(err)
+	}
+
+	return crypto.Keccak256Hash(encoded)
+}
+
+// Paymaster returns the paymaster address of the user operation, or the zero
+// address if the operation is not sponsored.
+func (op UserOperation) Paymaster() common.Address {
+	if len(op.PaymasterAndData) < common.AddressLength {
+		return common.Address{}
+	}
+	return common.BytesToAddress(op.PaymasterAndData[:common.AddressLength])
+}
+
+// toBigInt returns the big.Int value of the given hexutil.Big, or zero if nil.
+func toBigInt(value *hexutil.Big) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value.ToInt()
+}
+
+var (
+	userOpHashArguments = abi.Arguments{
+		{Type: mustNewType("address")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("bytes32")},
+		{Type: mustNewType("bytes32")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("uint256")},
+		{Type: mustNewType("bytes32")},
+	}
+
+	hashArguments = abi.Arguments{
+		{Type: mustNewType("bytes32")},
+		{Type: mustNewType("address")},
+		{Type: mustNewType("uint256")},
+	}
+)
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -72,6 +72,8 @@ type EthereumAPI interface {
 	// Allows developers to read data from the blockchain which includes executing
 	// smart contracts. However, no data is published to the Ethereum network.
 	Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error)
+	SimulateV1(opts evmtypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error)
 
 	// Chain Information
 	//
@@ -283,6 +285,46 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// CreateAccessList returns the EIP-2930 access list of the addresses and storage keys
+// the transaction accesses, along with the gas used when the access list is applied.
+// It defaults to the pending block if no block number or hash is provided.
+func (e *PublicAPI) CreateAccessList(args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
+// SimulateV1 executes a series of blocks of calls on top of the given block, each
+// call observing the state changes of the previous ones, and returns the result of
+// each call. It defaults to the latest block if no block number or hash is provided.
+func (e *PublicAPI) SimulateV1(opts evmtypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]*rpctypes.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
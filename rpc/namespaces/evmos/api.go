@@ -0,0 +1,33 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package evmos
+
+import (
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/evmos/evmos/v15/rpc/backend"
+	rpctypes "github.com/evmos/evmos/v15/rpc/types"
+)
+
+// PublicAPI is the evmos_ prefixed set of APIs, serving the Evmos specific node
+// information.
+type PublicAPI struct {
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewPublicAPI creates an instance of the public Evmos API.
+func NewPublicAPI(logger log.Logger, backend backend.EVMBackend) *PublicAPI {
+	return &PublicAPI{
+		logger:  logger.With("api", "evmos"),
+		backend: backend,
+	}
+}
+
+// NodeAvailability returns the earliest block, state and indexed transactions
+// available on the node, along with its latest block. The load balancers can use
+// it to route the historical requests to the nodes able to serve them.
+func (api *PublicAPI) NodeAvailability() (*rpctypes.NodeAvailability, error) {
+	api.logger.Debug("evmos_nodeAvailability")
+	return api.backend.NodeAvailability()
+}
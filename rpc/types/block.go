@@ -52,6 +52,8 @@ func NewBlockNumber(n *big.Int) BlockNumber {
 // ContextWithHeight wraps a context with the a gRPC block height header. If the provided height is
 // 0, it will return an empty context and the gRPC query will use the latest block height for querying.
 // Note that all metadata are processed and removed by tendermint layer, so it wont be accessible at gRPC server level.
+// The queries at a past height are served by the query multistore of the app, i.e. from versiondb when it's enabled,
+// so they don't require the IAVL state of the height.
 func ContextWithHeight(height int64) context.Context {
 	if height == 0 {
 		return context.Background()
@@ -4,6 +4,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/types/tx"
 
@@ -24,17 +25,29 @@ type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	// Stream is the EVM query client used for the streaming queries. It's nil
+	// when the client context has no gRPC client, as the ABCI queries don't
+	// support streaming.
+	Stream evmtypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
 func NewQueryClient(clientCtx client.Context) *QueryClient {
-	return &QueryClient{
+	queryClient := &QueryClient{
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
 	}
+	if clientCtx.GRPCClient != nil {
+		queryClient.Stream = evmtypes.NewQueryClient(clientCtx.GRPCClient)
+	}
+	return queryClient
 }
 
+// versionDoesNotExist is the log of the IAVL store queries at a height that
+// doesn't exist or was pruned.
+const versionDoesNotExist = "version does not exist"
+
 // GetProof performs an ABCI query with the given key and returns a merkle proof. The desired
 // tendermint height to perform the query should be set in the client context. The query will be
 // performed at one below this height (at the IAVL version) in order to obtain the correct merkle
@@ -61,5 +74,12 @@ func (QueryClient) GetProof(clientCtx client.Context, storeKey string, key []byt
 		return nil, nil, err
 	}
 
+	// The IAVL store doesn't fail the queries at a pruned height. The historical
+	// state served by versiondb to the other queries has no merkle proofs, so
+	// the proofs require the IAVL state of the height.
+	if strings.Contains(abciRes.Log, versionDoesNotExist) {
+		return nil, nil, fmt.Errorf("proof is not available at height %d: the state of this height is pruned", height)
+	}
+
 	return abciRes.Value, abciRes.ProofOps, nil
 }
@@ -78,6 +78,50 @@ type FeeHistoryResult struct {
 	GasUsedRatio []float64        `json:"gasUsedRatio"`
 }
 
+// AccessListResult returns an optional access list along with the gas used by the
+// transaction when the access list is applied, and the EVM execution error, if any.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// SimBlockResult is the result of a block simulated by eth_simulateV1.
+type SimBlockResult struct {
+	Number        hexutil.Uint64   `json:"number"`
+	Timestamp     hexutil.Uint64   `json:"timestamp"`
+	Miner         common.Address   `json:"miner"`
+	BaseFeePerGas *hexutil.Big     `json:"baseFeePerGas,omitempty"`
+	GasUsed       hexutil.Uint64   `json:"gasUsed"`
+	Calls         []*SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a call simulated by eth_simulateV1.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// SimCallError is the error of a failed call simulated by eth_simulateV1.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// NodeAvailability is the range of blocks, state and indexed transactions
+// served by the node, returned by evmos_nodeAvailability. The earliest indexed
+// block is omitted when the EVM transaction indexer is disabled.
+type NodeAvailability struct {
+	EarliestBlock        hexutil.Uint64  `json:"earliestBlock"`
+	EarliestState        hexutil.Uint64  `json:"earliestState"`
+	EarliestIndexedBlock *hexutil.Uint64 `json:"earliestIndexedBlock,omitempty"`
+	LatestBlock          hexutil.Uint64  `json:"latestBlock"`
+}
+
 // SignTransactionResult represents a RLP encoded signed transaction.
 type SignTransactionResult struct {
 	Raw hexutil.Bytes         `json:"raw"`
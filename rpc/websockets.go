@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -75,9 +76,18 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	limiter  *RequestLimiter
+	metrics  *RequestMetrics
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	cfg *config.Config,
+	limiter *RequestLimiter,
+	metrics *RequestMetrics,
+) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address) // #nosec G703
 
@@ -88,6 +98,8 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
 		logger:   logger,
+		limiter:  limiter,
+		metrics:  metrics,
 	}
 }
 
@@ -147,6 +159,26 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res) // #nosec G703
 }
 
+// observeRequest records the metrics of a request served by the websocket
+// server, with the error code of its response (0=success). The requests
+// forwarded to the HTTP server are recorded by it.
+func (s *websocketsServer) observeRequest(client string, mb []byte, code int, start time.Time) {
+	if s.metrics == nil {
+		return
+	}
+	reqs, batch := parseRequests(mb)
+	codes := make([]int, len(reqs))
+	for i := range codes {
+		codes[i] = code
+	}
+	s.metrics.observe(client, reqs, batch, codes, time.Since(start))
+}
+
+// sendLimitErrResponse sends the error response of a request exceeding a limit
+func (s *websocketsServer) sendLimitErrResponse(wsConn *wsConn, limitErr *limitError) {
+	_ = wsConn.WriteJSON(json.RawMessage(limitErr.response())) // #nosec G703
+}
+
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
@@ -191,6 +223,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		start := time.Now()
+		client := remoteHost(wsConn.conn.RemoteAddr().String())
+		if limitErr := s.limiter.checkRequest(client, mb, true); limitErr != nil {
+			s.sendLimitErrResponse(wsConn, limitErr)
+			s.observeRequest(client, mb, limitErr.code, start)
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -236,6 +276,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		case "eth_subscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.observeRequest(client, mb, errCodeInvalidRequest, start)
 				continue
 			}
 
@@ -243,9 +284,11 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			unsubFn, err := s.api.subscribe(wsConn, subID, params)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
+				s.observeRequest(client, mb, errCodeInvalidRequest, start)
 				continue
 			}
 			subscriptions[subID] = unsubFn
+			s.observeRequest(client, mb, 0, start)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -259,12 +302,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 		case "eth_unsubscribe":
 			params, ok := s.getParamsAndCheckValid(msg, wsConn)
 			if !ok {
+				s.observeRequest(client, mb, errCodeInvalidRequest, start)
 				continue
 			}
 
 			id, ok := params[0].(string)
 			if !ok {
 				s.sendErrResponse(wsConn, "invalid parameters")
+				s.observeRequest(client, mb, errCodeInvalidRequest, start)
 				continue
 			}
 
@@ -274,6 +319,7 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				delete(subscriptions, subID)
 				unsubFn()
 			}
+			s.observeRequest(client, mb, 0, start)
 
 			res := &SubscriptionResponseJSON{
 				Jsonrpc: "2.0",
@@ -318,6 +364,10 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if s.limiter != nil {
+		// the request is already rate limited
+		req.Header.Set(internalRequestHeader, s.limiter.internalToken)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	gostrings "strings"
 	"time"
 
 	"github.com/spf13/viper"
@@ -17,6 +19,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/crypto-org-chain/cronos/memiavl"
 	memiavlcfg "github.com/crypto-org-chain/cronos/store/config"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -59,6 +62,12 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultParallelExecution is the default value for the optimistic parallel execution of EVM transactions
+	DefaultParallelExecution = false
+
+	// DefaultParallelWorkers is the default number of parallel execution workers (0 = number of CPUs)
+	DefaultParallelWorkers = 0
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -95,6 +104,18 @@ const (
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
 
+	// DefaultBundlerMaxOps is the default max number of user operations included in a single bundle
+	DefaultBundlerMaxOps = 10
+
+	// DefaultBundlerInterval is the default interval at which pending user operations are bundled
+	DefaultBundlerInterval = 5 * time.Second
+
+	// DefaultBatchRequestLimit is the default max number of requests in a JSON-RPC batch
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultMaxResponseSize is the default max size in bytes of a JSON-RPC response (unlimited = 0)
+	DefaultMaxResponseSize = 0
+
 	// ============================
 	//           MemIAVL
 	// ============================
@@ -138,6 +159,10 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// ParallelExecution enables the optimistic parallel execution of the EVM transactions of a block.
+	ParallelExecution bool `mapstructure:"parallel-execution"`
+	// ParallelWorkers defines the number of workers used for the parallel execution. Default: number of CPUs.
+	ParallelWorkers int `mapstructure:"parallel-workers"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -180,6 +205,67 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// BundlerEntryPoint defines the address of the ERC-4337 EntryPoint contract supported by the bundler API.
+	BundlerEntryPoint string `mapstructure:"bundler-entry-point"`
+	// BundlerAddress defines the address of the node's keyring key used to sign the bundle transactions.
+	BundlerAddress string `mapstructure:"bundler-address"`
+	// BundlerMaxOps defines the max number of user operations included in a single bundle.
+	BundlerMaxOps int `mapstructure:"bundler-max-ops"`
+	// BundlerInterval defines the interval at which pending user operations are bundled.
+	BundlerInterval time.Duration `mapstructure:"bundler-interval"`
+	// BatchRequestLimit defines the max number of requests in a batch (0=unlimited).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// MaxResponseSize defines the max size in bytes of a response (0=unlimited).
+	MaxResponseSize int `mapstructure:"max-response-size"`
+	// RateLimit defines the max number of requests per second of each client (0=unlimited).
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst defines the max number of requests of each client in a burst. Default: the rate limit.
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// MethodRateLimits defines the max number of requests per second of each client for the
+	// given methods, formatted as 'method:rate' or 'method:rate:burst'.
+	MethodRateLimits []string `mapstructure:"method-rate-limits"`
+	// SlowRequestThreshold defines the duration above which a request is logged (0=disabled).
+	SlowRequestThreshold time.Duration `mapstructure:"slow-request-threshold"`
+}
+
+// MethodRateLimit is the rate limit of a JSON-RPC method for each client.
+type MethodRateLimit struct {
+	Method string
+	Rate   float64
+	Burst  int
+}
+
+// ParseMethodRateLimits parses the JSON-RPC method rate limits, formatted as
+// 'method:rate' or 'method:rate:burst'.
+func ParseMethodRateLimits(limits []string) ([]MethodRateLimit, error) {
+	res := make([]MethodRateLimit, 0, len(limits))
+	seen := make(map[string]bool, len(limits))
+	for _, limit := range limits {
+		parts := gostrings.Split(gostrings.TrimSpace(limit), ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid method rate limit '%s', expected 'method:rate[:burst]'", limit)
+		}
+		if seen[parts[0]] {
+			return nil, fmt.Errorf("repeated method rate limit '%s'", parts[0])
+		}
+		seen[parts[0]] = true
+
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate in method rate limit '%s'", limit)
+		}
+
+		var burst int
+		if len(parts) == 3 {
+			burst, err = strconv.Atoi(parts[2])
+			if err != nil || burst <= 0 {
+				return nil, fmt.Errorf("invalid burst in method rate limit '%s'", limit)
+			}
+		}
+
+		res = append(res, MethodRateLimit{Method: parts[0], Rate: rate, Burst: burst})
+	}
+	return res, nil
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -244,8 +330,10 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:            DefaultEVMTracer,
+		MaxTxGasWanted:    DefaultMaxTxGasWanted,
+		ParallelExecution: DefaultParallelExecution,
+		ParallelWorkers:   DefaultParallelWorkers,
 	}
 }
 
@@ -255,6 +343,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.ParallelWorkers < 0 {
+		return errors.New("parallel workers cannot be negative")
+	}
+
 	return nil
 }
 
@@ -265,7 +357,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "bundler", "evmos"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -289,6 +381,10 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		BundlerMaxOps:            DefaultBundlerMaxOps,
+		BundlerInterval:          DefaultBundlerInterval,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		MaxResponseSize:          DefaultMaxResponseSize,
 	}
 }
 
@@ -330,6 +426,46 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.BundlerEntryPoint != "" && !common.IsHexAddress(c.BundlerEntryPoint) {
+		return fmt.Errorf("invalid JSON-RPC bundler entry point address %s", c.BundlerEntryPoint)
+	}
+
+	if c.BundlerAddress != "" && !common.IsHexAddress(c.BundlerAddress) {
+		return fmt.Errorf("invalid JSON-RPC bundler address %s", c.BundlerAddress)
+	}
+
+	if c.BundlerMaxOps < 0 {
+		return errors.New("JSON-RPC bundler max ops cannot be negative")
+	}
+
+	if c.BundlerInterval < 0 {
+		return errors.New("JSON-RPC bundler interval duration cannot be negative")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.MaxResponseSize < 0 {
+		return errors.New("JSON-RPC max response size cannot be negative")
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimitBurst < 0 {
+		return errors.New("JSON-RPC rate limit burst cannot be negative")
+	}
+
+	if _, err := ParseMethodRateLimits(c.MethodRateLimits); err != nil {
+		return err
+	}
+
+	if c.SlowRequestThreshold < 0 {
+		return errors.New("JSON-RPC slow request threshold cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -54,6 +54,20 @@ func TestGetConfig(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"test unmarshal method rate limits",
+			func() *viper.Viper {
+				v := viper.New()
+				v.Set("json-rpc.method-rate-limits", "eth_getLogs:5,debug_traceBlockByNumber:1:2")
+				return v
+			},
+			func() Config {
+				cfg := DefaultConfig()
+				cfg.JSONRPC.MethodRateLimits = []string{"eth_getLogs:5", "debug_traceBlockByNumber:1:2"}
+				return *cfg
+			},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -68,3 +82,38 @@ func TestGetConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMethodRateLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		limits   []string
+		expected []MethodRateLimit
+		expError bool
+	}{
+		{"empty", nil, []MethodRateLimit{}, false},
+		{
+			"rate and burst",
+			[]string{"eth_getLogs:0.5", " eth_call:10:20"},
+			[]MethodRateLimit{{Method: "eth_getLogs", Rate: 0.5}, {Method: "eth_call", Rate: 10, Burst: 20}},
+			false,
+		},
+		{"missing rate", []string{"eth_getLogs"}, nil, true},
+		{"missing method", []string{":1"}, nil, true},
+		{"invalid rate", []string{"eth_getLogs:0"}, nil, true},
+		{"invalid burst", []string{"eth_getLogs:1:a"}, nil, true},
+		{"too many parts", []string{"eth_getLogs:1:2:3"}, nil, true},
+		{"repeated method", []string{"eth_getLogs:1", "eth_getLogs:2"}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			limits, err := ParseMethodRateLimits(tc.limits)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, limits)
+		})
+	}
+}
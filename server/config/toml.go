@@ -22,6 +22,14 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# ParallelExecution enables the optimistic parallel execution of the EVM transactions of a block.
+# Transactions are speculatively executed at the beginning of the block and their results are only
+# applied when the state they read has not been modified by the preceding transactions.
+parallel-execution = {{ .EVM.ParallelExecution }}
+
+# ParallelWorkers defines the number of workers used for the parallel execution (0 = number of CPUs).
+parallel-workers = {{ .EVM.ParallelWorkers }}
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -41,6 +49,10 @@ ws-address = "{{ .JSONRPC.WsAddress }}"
 # Example: "eth,txpool,personal,net,debug,web3"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
+# SlowRequestThreshold defines the duration above which a request is logged, along with its method
+# and a digest of its params (0=disabled).
+slow-request-threshold = "{{ .JSONRPC.SlowRequestThreshold }}"
+
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
 gas-cap = {{ .JSONRPC.GasCap }}
 
@@ -86,6 +98,38 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# BundlerEntryPoint defines the address of the ERC-4337 EntryPoint contract supported by the
+# 'bundler' API namespace.
+bundler-entry-point = "{{ .JSONRPC.BundlerEntryPoint }}"
+
+# BundlerAddress defines the hex address of the node's keyring key used to sign the bundle
+# (EntryPoint handleOps) transactions.
+bundler-address = "{{ .JSONRPC.BundlerAddress }}"
+
+# BundlerMaxOps defines the max number of user operations included in a single bundle.
+bundler-max-ops = {{ .JSONRPC.BundlerMaxOps }}
+
+# BundlerInterval defines the interval at which pending user operations are bundled.
+bundler-interval = "{{ .JSONRPC.BundlerInterval }}"
+
+# BatchRequestLimit defines the max number of requests in a batch (0=unlimited).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# MaxResponseSize defines the max size in bytes of a response (0=unlimited).
+max-response-size = {{ .JSONRPC.MaxResponseSize }}
+
+# RateLimit defines the max number of requests per second of each client IP address, for the HTTP
+# and WebSocket servers (0=unlimited). Each request of a batch counts as a request.
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst defines the max number of requests of each client in a burst (0=the rate limit).
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# MethodRateLimits defines the max number of requests per second of each client for the given
+# methods, formatted as 'method:rate' or 'method:rate:burst'.
+# Example: "eth_getLogs:5,debug_traceBlockByNumber:1:2"
+method-rate-limits = "{{range $index, $elmt := .JSONRPC.MethodRateLimits}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
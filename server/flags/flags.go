@@ -57,12 +57,22 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCBundlerEntryPoint        = "json-rpc.bundler-entry-point"
+	JSONRPCBundlerAddress           = "json-rpc.bundler-address"
+	JSONRPCBatchRequestLimit        = "json-rpc.batch-request-limit"
+	JSONRPCMaxResponseSize          = "json-rpc.max-response-size"
+	JSONRPCRateLimit                = "json-rpc.rate-limit"
+	JSONRPCRateLimitBurst           = "json-rpc.rate-limit-burst"
+	JSONRPCMethodRateLimits         = "json-rpc.method-rate-limits"
+	JSONRPCSlowRequestThreshold     = "json-rpc.slow-request-threshold"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer            = "evm.tracer"
+	EVMMaxTxGasWanted    = "evm.max-tx-gas-wanted"
+	EVMParallelExecution = "evm.parallel-execution"
+	EVMParallelWorkers   = "evm.parallel-workers"
 )
 
 // TLS flags
@@ -3,6 +3,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -48,7 +49,9 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	// the background routines of the APIs are stopped when the server shuts down
+	apisCtx, stopAPIs := context.WithCancel(context.Background())
+	apis := rpc.GetRPCAPIs(apisCtx, ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -57,12 +60,21 @@ func StartJSONRPC(ctx *server.Context,
 				"namespace", api.Namespace,
 				"service", api.Service,
 			)
+			stopAPIs()
 			return nil, nil, err
 		}
 	}
 
+	limiter, err := rpc.NewRequestLimiter(config.JSONRPC)
+	if err != nil {
+		stopAPIs()
+		return nil, nil, err
+	}
+
+	metrics := rpc.NewRequestMetrics(config.JSONRPC, ctx.Logger, apis)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", metrics.Handler(limiter.Handler(rpcServer))).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -77,10 +89,12 @@ func StartJSONRPC(ctx *server.Context,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
 		IdleTimeout:       config.JSONRPC.HTTPIdleTimeout,
 	}
+	httpSrv.RegisterOnShutdown(stopAPIs)
 	httpSrvDone := make(chan struct{}, 1)
 
 	ln, err := Listen(httpSrv.Addr, config)
 	if err != nil {
+		stopAPIs()
 		return nil, nil, err
 	}
 
@@ -101,6 +115,7 @@ func StartJSONRPC(ctx *server.Context,
 	select {
 	case err := <-errCh:
 		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
+		stopAPIs()
 		return nil, nil, err
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
@@ -109,7 +124,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, limiter, metrics)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
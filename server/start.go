@@ -199,9 +199,19 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().String(srvflags.JSONRPCBundlerEntryPoint, "", "Sets the ERC-4337 EntryPoint contract address supported by the bundler API")
+	cmd.Flags().String(srvflags.JSONRPCBundlerAddress, "", "Sets the address of the keyring key used to sign bundle transactions")
+	cmd.Flags().Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the max number of requests in a batch (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCMaxResponseSize, config.DefaultMaxResponseSize, "Sets the max size in bytes of a response (0=unlimited)")
+	cmd.Flags().Float64(srvflags.JSONRPCRateLimit, 0, "Sets the max number of requests per second of each client (0=unlimited)")
+	cmd.Flags().Int(srvflags.JSONRPCRateLimitBurst, 0, "Sets the max number of requests of each client in a burst (0=the rate limit)")
+	cmd.Flags().StringSlice(srvflags.JSONRPCMethodRateLimits, nil, "Sets the max number of requests per second of each client for the given methods, as 'method:rate[:burst]'") //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCSlowRequestThreshold, 0, "Sets the duration above which a request is logged (0=disabled)")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Bool(srvflags.EVMParallelExecution, config.DefaultParallelExecution, "Enable the optimistic parallel execution of the EVM transactions of a block")
+	cmd.Flags().Int(srvflags.EVMParallelWorkers, config.DefaultParallelWorkers, "Sets the number of parallel execution workers (0=number of CPUs)")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -104,6 +105,13 @@ func (k Keeper) OnRecvPacket(
 		)
 	}
 
+	// return original ACK if the recipient is a module account (e.g. the
+	// intermediate account of a forwarded packet), as claims records can only be
+	// merged or migrated to user accounts
+	if _, isModuleAccount := k.accountKeeper.GetAccount(ctx, recipient).(authtypes.ModuleAccountI); isModuleAccount {
+		return ack
+	}
+
 	senderClaimsRecord, senderRecordFound := k.GetClaimsRecord(ctx, sender)
 
 	if senderRecordFound && senderClaimsRecord.HasClaimedAction(types.ActionIBCTransfer) {
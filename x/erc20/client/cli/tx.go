@@ -23,6 +23,11 @@ import (
 	"github.com/evmos/evmos/v15/x/erc20/types"
 )
 
+// Transaction command flags
+const (
+	FlagNonce = "nonce"
+)
+
 // NewTxCmd returns a root CLI command handler for erc20 transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -36,6 +41,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewConvertCoinCmd(),
 		NewConvertERC20Cmd(),
+		NewRegisterERC20PermissionlessCmd(),
+		NewRefundRegistrationDepositCmd(),
 	)
 	return txCmd
 }
@@ -138,6 +145,89 @@ func NewConvertERC20Cmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterERC20PermissionlessCmd returns a CLI command handler for registering
+// a token pair for an ERC20 contract without a governance proposal
+func NewRegisterERC20PermissionlessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20-permissionless CONTRACT_ADDRESS",
+		Short: "Register a token pair for an ERC20 contract deployed by the sender, locking the registration deposit",
+		Long: `Register a token pair for an ERC20 contract deployed by the sender, locking the registration deposit.
+The deployment is proven with the nonce of the sender when the contract was created.`,
+		Example: fmt.Sprintf(
+			"$ %s tx %s register-erc20-permissionless 0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd --nonce 3 --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			nonce, err := cmd.Flags().GetUint64(FlagNonce)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterERC20Permissionless{
+				Sender:          cliCtx.GetFromAddress().String(),
+				ContractAddress: contract,
+				Nonce:           nonce,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagNonce, 0, "nonce of the sender when the contract was created")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRefundRegistrationDepositCmd returns a CLI command handler for refunding
+// the deposit locked for a permissionless token pair registration
+func NewRefundRegistrationDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-registration-deposit CONTRACT_ADDRESS",
+		Short: "Refund the deposit locked for the permissionless registration of an ERC20 contract once the deposit period has passed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := evmostypes.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRefundRegistrationDeposit{
+				Sender:          cliCtx.GetFromAddress().String(),
+				ContractAddress: contract,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
 func NewRegisterCoinProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
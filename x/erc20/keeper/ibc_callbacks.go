@@ -75,6 +75,13 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	// return acknowledgement without conversion if recipient is a module account
+	// (e.g. the intermediate account of a forwarded packet)
+	recipientAcc := k.accountKeeper.GetAccount(ctx, recipient)
+	if recipientAcc != nil && types.IsModuleAccount(recipientAcc) {
+		return ack
+	}
+
 	// parse the transferred denom
 	coin := ibc.GetReceivedCoin(
 		packet.SourcePort, packet.SourceChannel,
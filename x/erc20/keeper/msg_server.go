@@ -535,3 +535,106 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterERC20Permissionless implements the gRPC MsgServer interface. It
+// registers a token pair for an ERC20 contract deployed by the sender, after
+// checking that the contract transfers behave as expected by the token pair
+// conversions. The sender locks the registration deposit, which is refunded
+// after the deposit period unless governance forfeits it.
+func (k Keeper) RegisterERC20Permissionless(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20Permissionless,
+) (*types.MsgRegisterERC20PermissionlessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	if err := verifyDeployer(common.BytesToAddress(sender), contract, msg.Nonce); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.RegisterERC20WithDeposit(ctx, contract, sender, common.BytesToAddress(sender))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterERC20PermissionlessResponse{TokenPair: *pair}, nil
+}
+
+// RefundRegistrationDeposit implements the gRPC MsgServer interface. It
+// refunds the deposit locked for a permissionless token pair registration to
+// the depositor once the deposit period has passed.
+func (k Keeper) RefundRegistrationDeposit(
+	goCtx context.Context,
+	msg *types.MsgRefundRegistrationDeposit,
+) (*types.MsgRefundRegistrationDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	deposit, found := k.GetRegistrationDeposit(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrRegistrationDepositNotFound, "contract %s", contract,
+		)
+	}
+
+	if deposit.Depositor != msg.Sender {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "sender %s is not the depositor %s", msg.Sender, deposit.Depositor,
+		)
+	}
+
+	if ctx.BlockTime().Before(deposit.UnlockTime) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRegistrationDepositLocked, "deposit is locked until %s", deposit.UnlockTime,
+		)
+	}
+
+	depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, sdk.Coins{deposit.Amount}); err != nil {
+		return nil, err
+	}
+
+	k.DeleteRegistrationDeposit(ctx, contract)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundRegistrationDeposit,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+		),
+	)
+
+	return &types.MsgRefundRegistrationDepositResponse{}, nil
+}
+
+// ForfeitRegistrationDeposit implements the gRPC MsgServer interface. It burns
+// the deposit locked for a permissionless token pair registration, which
+// governance can do until the depositor refunds it.
+func (k Keeper) ForfeitRegistrationDeposit(
+	goCtx context.Context,
+	req *types.MsgForfeitRegistrationDeposit,
+) (*types.MsgForfeitRegistrationDepositResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	deposit, err := k.forfeitRegistrationDeposit(ctx, common.HexToAddress(req.ContractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForfeitRegistrationDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+		),
+	)
+
+	return &types.MsgForfeitRegistrationDepositResponse{}, nil
+}
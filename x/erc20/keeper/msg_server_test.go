@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,7 +12,10 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/utils"
 	"github.com/evmos/evmos/v15/x/erc20/keeper"
 	"github.com/evmos/evmos/v15/x/erc20/types"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
@@ -201,7 +205,6 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
@@ -379,7 +382,6 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
@@ -724,7 +726,6 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
@@ -941,7 +942,6 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestWrongPairOwnerERC20NativeCoin() {
@@ -1179,7 +1179,6 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
@@ -1358,7 +1357,6 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 			}
 		})
 	}
-	suite.mintFeeCollector = false
 }
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -1394,3 +1392,326 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// deployPermissionlessContract deploys an ERC20 contract and returns its
+// address and the nonce of the deployer used to create it.
+func (suite *KeeperTestSuite) deployPermissionlessContract(contractType int) (common.Address, uint64) {
+	var (
+		contract common.Address
+		err      error
+	)
+
+	switch contractType {
+	case contractDirectBalanceManipulation:
+		contract, err = suite.DeployContractDirectBalanceManipulation()
+	default:
+		contract, err = suite.DeployContract(erc20Name, erc20Symbol, erc20Decimals)
+	}
+	suite.Require().NoError(err)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address) - 1
+	suite.Require().Equal(crypto.CreateAddress(suite.address, nonce), contract)
+	return contract, nonce
+}
+
+func (suite *KeeperTestSuite) TestRegisterERC20Permissionless() {
+	var (
+		contract common.Address
+		msg      *types.MsgRegisterERC20Permissionless
+		sender   sdk.AccAddress
+	)
+	deposit := sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnablePermissionlessRegistration = false
+				err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			types.ErrPermissionlessRegistrationDisabled.Error(),
+		},
+		{
+			"fail - sender is not the deployer",
+			func() {
+				msg.Nonce++
+			},
+			types.ErrUnauthorizedRegistration.Error(),
+		},
+		{
+			"fail - account is not a contract",
+			func() {
+				msg.Nonce += 100
+				msg.ContractAddress = crypto.CreateAddress(suite.address, msg.Nonce).String()
+			},
+			"is not a contract",
+		},
+		{
+			"fail - sender holds no tokens",
+			func() {
+				var nonce uint64
+				contract, nonce = suite.deployPermissionlessContract(contractMinterBurner)
+				msg = types.NewMsgRegisterERC20Permissionless(sender, contract, nonce)
+			},
+			"must hold tokens",
+		},
+		{
+			"fail - fee on transfer",
+			func() {
+				var nonce uint64
+				contract, nonce = suite.deployPermissionlessContract(contractDirectBalanceManipulation)
+				msg = types.NewMsgRegisterERC20Permissionless(sender, contract, nonce)
+			},
+			"invalid receiver balance after transfer",
+		},
+		{
+			"fail - already registered",
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+				suite.Require().NoError(err)
+			},
+			types.ErrTokenPairAlreadyExists.Error(),
+		},
+		{
+			"pass",
+			func() {},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender = sdk.AccAddress(suite.address.Bytes())
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.RegistrationDeposit = deposit
+			err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			var nonce uint64
+			contract, nonce = suite.deployPermissionlessContract(contractMinterBurner)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+			msg = types.NewMsgRegisterERC20Permissionless(sender, contract, nonce)
+
+			tc.malleate()
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+
+			res, err := suite.app.Erc20Keeper.RegisterERC20Permissionless(suite.ctx, msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(res.TokenPair.Enabled)
+			suite.Require().Equal(contract.String(), res.TokenPair.Erc20Address)
+			suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contract))
+
+			// the simulated transfer is not committed
+			suite.Require().Equal(big.NewInt(100), suite.BalanceOf(contract, suite.address))
+
+			// the deposit is locked
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+			suite.Require().Equal(balance.Sub(deposit), balanceAfter)
+
+			lockedDeposit, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(sender.String(), lockedDeposit.Depositor)
+			suite.Require().Equal(deposit, lockedDeposit.Amount)
+			suite.Require().Equal(suite.ctx.BlockTime().Add(params.RegistrationDepositPeriod), lockedDeposit.UnlockTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundRegistrationDeposit() {
+	var (
+		contract common.Address
+		msg      *types.MsgRefundRegistrationDeposit
+		sender   sdk.AccAddress
+	)
+	deposit := sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - deposit not found",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().String()
+			},
+			types.ErrRegistrationDepositNotFound.Error(),
+		},
+		{
+			"fail - sender is not the depositor",
+			func() {
+				msg.Sender = sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+			},
+			"is not the depositor",
+		},
+		{
+			"fail - deposit is locked",
+			func() {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+			},
+			types.ErrRegistrationDepositLocked.Error(),
+		},
+		{
+			"fail - deposit forfeited by governance",
+			func() {
+				_, err := suite.app.Erc20Keeper.ForfeitRegistrationDeposit(suite.ctx, &types.MsgForfeitRegistrationDeposit{
+					Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					ContractAddress: contract.String(),
+				})
+				suite.Require().NoError(err)
+
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultRegistrationDepositPeriod))
+			},
+			types.ErrRegistrationDepositNotFound.Error(),
+		},
+		{
+			"pass - token pair disabled by governance",
+			func() {
+				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, contract.String())
+				suite.Require().NoError(err)
+
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultRegistrationDepositPeriod))
+			},
+			"",
+		},
+		{
+			"pass",
+			func() {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultRegistrationDepositPeriod))
+			},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender = sdk.AccAddress(suite.address.Bytes())
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.RegistrationDeposit = deposit
+			params.RegistrationDepositPeriod = types.DefaultRegistrationDepositPeriod
+			err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			var nonce uint64
+			contract, nonce = suite.deployPermissionlessContract(contractMinterBurner)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+			_, err = suite.app.Erc20Keeper.RegisterERC20Permissionless(suite.ctx, types.NewMsgRegisterERC20Permissionless(sender, contract, nonce))
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgRefundRegistrationDeposit(sender, contract)
+
+			tc.malleate()
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+
+			_, err = suite.app.Erc20Keeper.RefundRegistrationDeposit(suite.ctx, msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, sender, utils.BaseDenom)
+			suite.Require().Equal(balance.Add(deposit), balanceAfter)
+
+			_, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForfeitRegistrationDeposit() {
+	var (
+		contract common.Address
+		msg      *types.MsgForfeitRegistrationDeposit
+	)
+	deposit := sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		errContains string
+	}{
+		{
+			"fail - invalid authority",
+			func() {
+				msg.Authority = sdk.AccAddress(suite.address.Bytes()).String()
+			},
+			"invalid authority",
+		},
+		{
+			"fail - deposit not found",
+			func() {
+				msg.ContractAddress = utiltx.GenerateAddress().String()
+			},
+			types.ErrRegistrationDepositNotFound.Error(),
+		},
+		{
+			"pass",
+			func() {},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.RegistrationDeposit = deposit
+			err := suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			suite.Require().NoError(err)
+
+			var nonce uint64
+			contract, nonce = suite.deployPermissionlessContract(contractMinterBurner)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+			_, err = suite.app.Erc20Keeper.RegisterERC20Permissionless(suite.ctx, types.NewMsgRegisterERC20Permissionless(sender, contract, nonce))
+			suite.Require().NoError(err)
+
+			msg = &types.MsgForfeitRegistrationDeposit{
+				Authority:       authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				ContractAddress: contract.String(),
+			}
+
+			tc.malleate()
+
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, utils.BaseDenom)
+
+			_, err = suite.app.Erc20Keeper.ForfeitRegistrationDeposit(suite.ctx, msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			// the deposit is burned
+			supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, utils.BaseDenom)
+			suite.Require().Equal(supply.Sub(deposit), supplyAfter)
+
+			_, found := suite.app.Erc20Keeper.GetRegistrationDeposit(suite.ctx, contract)
+			suite.Require().False(found)
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/evmos/evmos/v15/x/erc20/types"
 )
@@ -14,8 +16,17 @@ var isTrue = []byte("0x01")
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	enableErc20 := k.IsERC20Enabled(ctx)
 	enableEvmHook := k.GetEnableEVMHook(ctx)
+	enablePermissionlessRegistration := k.IsPermissionlessRegistrationEnabled(ctx)
+	registrationDeposit := k.GetRegistrationDepositAmount(ctx)
+	registrationDepositPeriod := k.GetRegistrationDepositPeriod(ctx)
 
-	return types.NewParams(enableErc20, enableEvmHook)
+	return types.NewParams(
+		enableErc20,
+		enableEvmHook,
+		enablePermissionlessRegistration,
+		registrationDeposit,
+		registrationDepositPeriod,
+	)
 }
 
 // SetParams sets the erc20 parameters to the param space.
@@ -26,6 +37,9 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	k.setERC20Enabled(ctx, params.EnableErc20)
 	k.setEnableEVMHook(ctx, params.EnableEVMHook)
+	k.setPermissionlessRegistrationEnabled(ctx, params.EnablePermissionlessRegistration)
+	k.setRegistrationDeposit(ctx, params.RegistrationDeposit)
+	k.setRegistrationDepositPeriod(ctx, params.RegistrationDepositPeriod)
 
 	return nil
 }
@@ -42,6 +56,38 @@ func (k Keeper) GetEnableEVMHook(ctx sdk.Context) bool {
 	return store.Has(types.ParamStoreKeyEnableEVMHook)
 }
 
+// IsPermissionlessRegistrationEnabled returns true if token pairs can be
+// registered without a governance proposal
+func (k Keeper) IsPermissionlessRegistrationEnabled(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ParamStoreKeyEnablePermissionlessRegistration)
+}
+
+// GetRegistrationDepositAmount returns the deposit locked for a permissionless
+// token pair registration. An empty coin is returned if no deposit is required.
+func (k Keeper) GetRegistrationDepositAmount(ctx sdk.Context) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDeposit)
+	if len(bz) == 0 {
+		return sdk.Coin{}
+	}
+
+	var deposit sdk.Coin
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit
+}
+
+// GetRegistrationDepositPeriod returns the duration after which a registration
+// deposit can be refunded
+func (k Keeper) GetRegistrationDepositPeriod(ctx sdk.Context) time.Duration {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamStoreKeyRegistrationDepositPeriod)
+	if len(bz) == 0 {
+		return 0
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz))
+}
+
 // setERC20Enabled sets the EnableERC20 param in the store
 func (k Keeper) setERC20Enabled(ctx sdk.Context, enable bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -61,3 +107,29 @@ func (k Keeper) setEnableEVMHook(ctx sdk.Context, enable bool) {
 	}
 	store.Delete(types.ParamStoreKeyEnableEVMHook)
 }
+
+// setPermissionlessRegistrationEnabled sets the EnablePermissionlessRegistration param in the store
+func (k Keeper) setPermissionlessRegistrationEnabled(ctx sdk.Context, enable bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enable {
+		store.Set(types.ParamStoreKeyEnablePermissionlessRegistration, isTrue)
+		return
+	}
+	store.Delete(types.ParamStoreKeyEnablePermissionlessRegistration)
+}
+
+// setRegistrationDeposit sets the RegistrationDeposit param in the store
+func (k Keeper) setRegistrationDeposit(ctx sdk.Context, deposit sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	if deposit.Denom == "" && deposit.Amount.IsNil() {
+		store.Delete(types.ParamStoreKeyRegistrationDeposit)
+		return
+	}
+	store.Set(types.ParamStoreKeyRegistrationDeposit, k.cdc.MustMarshal(&deposit))
+}
+
+// setRegistrationDepositPeriod sets the RegistrationDepositPeriod param in the store
+func (k Keeper) setRegistrationDepositPeriod(ctx sdk.Context, period time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamStoreKeyRegistrationDepositPeriod, sdk.Uint64ToBigEndian(uint64(period)))
+}
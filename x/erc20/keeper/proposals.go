@@ -173,7 +173,6 @@ func (k Keeper) ToggleConversion(
 	}
 
 	pair.Enabled = !pair.Enabled
-
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
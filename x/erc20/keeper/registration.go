@@ -0,0 +1,269 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v15/contracts"
+	"github.com/evmos/evmos/v15/x/erc20/types"
+)
+
+// GetRegistrationDeposits gets all the deposits locked for permissionless
+// token pair registrations.
+func (k Keeper) GetRegistrationDeposits(ctx sdk.Context) []types.RegistrationDeposit {
+	deposits := []types.RegistrationDeposit{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.RegistrationDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// GetRegistrationDeposit gets the deposit locked for the registration of the
+// given ERC20 contract.
+func (k Keeper) GetRegistrationDeposit(ctx sdk.Context, contract common.Address) (types.RegistrationDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.RegistrationDeposit{}, false
+	}
+
+	var deposit types.RegistrationDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetRegistrationDeposit stores a registration deposit.
+func (k Keeper) SetRegistrationDeposit(ctx sdk.Context, deposit types.RegistrationDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(deposit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteRegistrationDeposit removes the registration deposit of the given
+// ERC20 contract.
+func (k Keeper) DeleteRegistrationDeposit(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRegistrationDeposit)
+	store.Delete(contract.Bytes())
+}
+
+// forfeitRegistrationDeposit burns the deposit locked for the registration of
+// the given ERC20 contract. It is only called by governance, so disabling a
+// token pair doesn't forfeit its deposit on its own.
+func (k Keeper) forfeitRegistrationDeposit(ctx sdk.Context, contract common.Address) (types.RegistrationDeposit, error) {
+	deposit, found := k.GetRegistrationDeposit(ctx, contract)
+	if !found {
+		return types.RegistrationDeposit{}, errorsmod.Wrapf(
+			types.ErrRegistrationDepositNotFound, "contract %s", contract,
+		)
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{deposit.Amount}); err != nil {
+		return types.RegistrationDeposit{}, errorsmod.Wrapf(err, "failed to burn registration deposit of %s", deposit.Erc20Address)
+	}
+
+	k.DeleteRegistrationDeposit(ctx, contract)
+	return deposit, nil
+}
+
+// RegisterERC20WithDeposit registers a token pair for the given ERC20 contract
+// without governance, after checking that the contract transfers behave as
+// expected by the token pair conversions. The transfers are simulated with the
+// balance of the holder. The depositor locks the registration deposit, which is
+// refunded after the deposit period unless governance forfeits it.
+// NOTE: the caller must check that the depositor is the contract or its deployer.
+func (k Keeper) RegisterERC20WithDeposit(
+	ctx sdk.Context,
+	contract common.Address,
+	depositor sdk.AccAddress,
+	holder common.Address,
+) (*types.TokenPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return nil, errorsmod.Wrap(
+			types.ErrERC20Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnablePermissionlessRegistration {
+		return nil, types.ErrPermissionlessRegistrationDisabled
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "account %s is not a contract", contract,
+		)
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "token ERC20 contract already registered: %s", contract,
+		)
+	}
+
+	if err := k.checkTransferBehavior(ctx, contract, holder); err != nil {
+		return nil, err
+	}
+
+	pair, err := k.RegisterERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := params.RegistrationDeposit
+	if !deposit.Amount.IsNil() && deposit.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.Coins{deposit}); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to lock registration deposit")
+		}
+
+		unlockTime := ctx.BlockTime().Add(params.RegistrationDepositPeriod)
+		k.SetRegistrationDeposit(ctx, types.NewRegistrationDeposit(contract, depositor, deposit, unlockTime))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20,
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		),
+	)
+
+	return pair, nil
+}
+
+// GetEVMDenom returns the denomination of the balances tracked by the EVM,
+// which the precompiles locking the registration deposit mirror to the EVM state
+func (k Keeper) GetEVMDenom(ctx sdk.Context) string {
+	return k.evmKeeper.GetParams(ctx).EvmDenom
+}
+
+// verifyDeployer checks that the sender is the account that deployed the given
+// contract. The deployment is proven by deriving the contract address from the
+// sender and its nonce (CREATE). The contracts themselves and their CREATE2
+// factories register through the ERC-20 registry precompile instead.
+func verifyDeployer(sender, contract common.Address, nonce uint64) error {
+	if crypto.CreateAddress(sender, nonce) != contract {
+		return errorsmod.Wrapf(
+			types.ErrUnauthorizedRegistration,
+			"contract %s was not deployed by %s", contract, sender,
+		)
+	}
+
+	return nil
+}
+
+// checkTransferBehavior simulates a transfer of the whole sender balance to
+// the module address, the same transfer performed when converting the tokens,
+// and checks that the exact amount is moved without changing the total supply.
+// This rejects tokens that charge fees on transfers or rebase the balances,
+// which cannot be escrowed by the module. The state changes of the simulated
+// transfer are discarded.
+func (k Keeper) checkTransferBehavior(ctx sdk.Context, contract, sender common.Address) error {
+	cacheCtx, _ := ctx.CacheContext()
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	amount := k.BalanceOf(cacheCtx, erc20, contract, sender)
+	if amount == nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+	if amount.Sign() == 0 {
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"sender %s must hold tokens to simulate a transfer", sender,
+		)
+	}
+
+	balanceModule := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	supply, err := k.totalSupply(cacheCtx, contract)
+	if balanceModule == nil || err != nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balances")
+	}
+
+	transferData, err := erc20.Pack("transfer", types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+
+	res, err := k.CallEVMWithData(cacheCtx, sender, &contract, transferData, true)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to simulate transfer")
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return err
+	}
+
+	if !unpackedRet.Value {
+		return errorsmod.Wrap(types.ErrUnsupportedERC20, "simulated transfer returned false")
+	}
+
+	balanceSenderAfter := k.BalanceOf(cacheCtx, erc20, contract, sender)
+	balanceModuleAfter := k.BalanceOf(cacheCtx, erc20, contract, types.ModuleAddress)
+	supplyAfter, err := k.totalSupply(cacheCtx, contract)
+	if balanceSenderAfter == nil || balanceModuleAfter == nil || err != nil {
+		return errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balances")
+	}
+
+	expModule := new(big.Int).Add(balanceModule, amount)
+
+	switch {
+	case balanceSenderAfter.Sign() != 0:
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"invalid sender balance after transfer - expected: 0, actual: %v", balanceSenderAfter,
+		)
+	case balanceModuleAfter.Cmp(expModule) != 0:
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"invalid receiver balance after transfer - expected: %v, actual: %v", expModule, balanceModuleAfter,
+		)
+	case supplyAfter.Cmp(supply) != 0:
+		return errorsmod.Wrapf(
+			types.ErrUnsupportedERC20,
+			"total supply changed on transfer - expected: %v, actual: %v", supply, supplyAfter,
+		)
+	}
+
+	return nil
+}
+
+// totalSupply queries the total supply of the given ERC20 contract
+func (k Keeper) totalSupply(ctx sdk.Context, contract common.Address) (*big.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, false, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+
+	unpacked, err := erc20.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack total supply")
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack total supply")
+	}
+
+	return supply, nil
+}
@@ -30,12 +30,17 @@ const (
 	convertERC20Name = "evmos/MsgConvertERC20"
 	convertCoinName  = "evmos/MsgConvertCoin"
 	updateParams     = "evmos/erc20/MsgUpdateParams"
+
+	registerERC20PermissionlessName = "evmos/erc20/MsgRegisterERC20Permissionless"
+	refundRegistrationDepositName   = "evmos/erc20/MsgRefundRegistrationDeposit"
+	forfeitRegistrationDepositName  = "evmos/erc20/MsgForfeitRegistrationDeposit"
 )
 
 // NOTE: This is required for the GetSignBytes function
 func init() {
 	RegisterLegacyAminoCodec(amino)
 	amino.Seal()
+	registerEIP712Schemas()
 }
 
 // RegisterInterfaces register implementations
@@ -45,6 +50,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
 		&MsgUpdateParams{},
+		&MsgRegisterERC20Permissionless{},
+		&MsgRefundRegistrationDeposit{},
+		&MsgForfeitRegistrationDeposit{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
@@ -63,4 +71,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParams, nil)
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20Permissionless{}, registerERC20PermissionlessName, nil)
+	cdc.RegisterConcrete(&MsgRefundRegistrationDeposit{}, refundRegistrationDepositName, nil)
+	cdc.RegisterConcrete(&MsgForfeitRegistrationDeposit{}, forfeitRegistrationDepositName, nil)
 }
@@ -0,0 +1,62 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+)
+
+// registerEIP712Schemas registers the EIP-712 schemas of the x/erc20 messages,
+// so that wallets display human-readable types when signing them.
+func registerEIP712Schemas() {
+	eip712.RegisterMsgSchema(convertCoinName, eip712.MsgSchema{
+		Name:  "MsgConvertCoin",
+		Value: "MsgConvertCoinValue",
+		Types: apitypes.Types{
+			"MsgConvertCoinValue": {
+				{Name: "coin", Type: "Coin"},
+				{Name: "receiver", Type: "string"},
+				{Name: "sender", Type: "string"},
+			},
+		},
+	})
+
+	eip712.RegisterMsgSchema(convertERC20Name, eip712.MsgSchema{
+		Name:  "MsgConvertERC20",
+		Value: "MsgConvertERC20Value",
+		Types: apitypes.Types{
+			"MsgConvertERC20Value": {
+				{Name: "contract_address", Type: "string"},
+				{Name: "amount", Type: "uint256"},
+				{Name: "receiver", Type: "string"},
+				{Name: "sender", Type: "string"},
+			},
+		},
+	})
+
+	eip712.RegisterMsgSchema(registerERC20PermissionlessName, eip712.MsgSchema{
+		Name:  "MsgRegisterERC20Permissionless",
+		Value: "MsgRegisterERC20PermissionlessValue",
+		Types: apitypes.Types{
+			"MsgRegisterERC20PermissionlessValue": {
+				{Name: "sender", Type: "string"},
+				{Name: "contract_address", Type: "string"},
+				{Name: "nonce", Type: "uint64"},
+			},
+		},
+	})
+
+	eip712.RegisterMsgSchema(refundRegistrationDepositName, eip712.MsgSchema{
+		Name:  "MsgRefundRegistrationDeposit",
+		Value: "MsgRefundRegistrationDepositValue",
+		Types: apitypes.Types{
+			"MsgRefundRegistrationDepositValue": {
+				{Name: "sender", Type: "string"},
+				{Name: "contract_address", Type: "string"},
+			},
+		},
+	})
+}
@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -130,6 +135,80 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+// RegistrationDeposit defines the deposit locked for a token pair registered
+// with a MsgRegisterERC20Permissionless.
+type RegistrationDeposit struct {
+	// erc20_address is the hex address of the registered ERC20 contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// depositor is the bech32 address of the account that locked the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount is the locked deposit
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// unlock_time is the time after which the deposit can be refunded
+	UnlockTime time.Time `protobuf:"bytes,4,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *RegistrationDeposit) Reset()         { *m = RegistrationDeposit{} }
+func (m *RegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*RegistrationDeposit) ProtoMessage()    {}
+func (*RegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+func (m *RegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationDeposit.Merge(m, src)
+}
+func (m *RegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationDeposit proto.InternalMessageInfo
+
+func (m *RegistrationDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *RegistrationDeposit) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *RegistrationDeposit) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 type RegisterCoinProposal struct {
@@ -138,14 +217,14 @@ type RegisterCoinProposal struct {
 	// description of the proposal
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{1}
+	return fileDescriptor_668d5dc537f45142, []int{2}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +267,7 @@ func (m *RegisterCoinProposal) GetDescription() string {
 	return ""
 }
 
-func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
+func (m *RegisterCoinProposal) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -210,7 +289,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{2}
+	return fileDescriptor_668d5dc537f45142, []int{3}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +355,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{3}
+	return fileDescriptor_668d5dc537f45142, []int{4}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,14 +409,14 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 // the RegisterCoinProposal content.
 type ProposalMetadata struct {
 	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+	Metadata []types1.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
 }
 
 func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_668d5dc537f45142, []int{4}
+	return fileDescriptor_668d5dc537f45142, []int{5}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +445,7 @@ func (m *ProposalMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
 
-func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+func (m *ProposalMetadata) GetMetadata() []types1.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -376,6 +455,7 @@ func (m *ProposalMetadata) GetMetadata() []types.Metadata {
 func init() {
 	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
 	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RegistrationDeposit)(nil), "evmos.erc20.v1.RegistrationDeposit")
 	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
 	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
 	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
@@ -385,39 +465,46 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
 
 var fileDescriptor_668d5dc537f45142 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xce, 0x74, 0xb7, 0xda, 0x9d, 0xb6, 0x21, 0x0e, 0xbb, 0x10, 0x16, 0x9a, 0x86, 0x15, 0x24,
-	0x78, 0x48, 0x9a, 0x15, 0x2f, 0x22, 0x48, 0x77, 0x1b, 0xa1, 0xd2, 0xee, 0x2e, 0xe9, 0x16, 0xc5,
-	0xcb, 0x32, 0x49, 0x86, 0x18, 0xba, 0x9b, 0x59, 0x66, 0xc6, 0xa8, 0x07, 0xef, 0x1e, 0xbd, 0x78,
-	0x17, 0xf4, 0x8f, 0xe9, 0xb1, 0x47, 0x4f, 0x22, 0xbb, 0x17, 0xff, 0x0c, 0xc9, 0x4c, 0x22, 0xd6,
-	0x63, 0x7b, 0x09, 0xef, 0xfb, 0xde, 0x8f, 0x7c, 0xf3, 0x3d, 0x1e, 0xec, 0x92, 0x62, 0x41, 0xb9,
-	0x47, 0x58, 0xdc, 0x3f, 0xf0, 0x0a, 0x5f, 0x05, 0xee, 0x92, 0x51, 0x41, 0x91, 0x2e, 0x73, 0xae,
-	0xa2, 0x0a, 0xbf, 0x6b, 0xc5, 0x94, 0x97, 0xc5, 0x11, 0xce, 0x2f, 0xbc, 0xc2, 0x8f, 0x88, 0xc0,
-	0xbe, 0x04, 0xaa, 0xbe, 0xdb, 0x4e, 0x69, 0x4a, 0x65, 0xe8, 0x95, 0x91, 0x62, 0x7b, 0xdf, 0x01,
-	0x6c, 0x4d, 0xe9, 0x05, 0xc9, 0x27, 0x38, 0x63, 0xe8, 0x3e, 0xdc, 0x95, 0xf3, 0x66, 0x38, 0x49,
-	0x18, 0xe1, 0xdc, 0x04, 0x36, 0x70, 0x5a, 0xe1, 0x8e, 0x24, 0x0f, 0x15, 0x87, 0xda, 0x70, 0x33,
-	0x21, 0x39, 0x5d, 0x98, 0x1b, 0x32, 0xa9, 0x00, 0x32, 0xe1, 0x5d, 0x92, 0xe3, 0x68, 0x4e, 0x12,
-	0xb3, 0x61, 0x03, 0x67, 0x2b, 0xac, 0x21, 0x7a, 0x0a, 0xf5, 0x98, 0xe6, 0x82, 0xe1, 0x58, 0xcc,
-	0xe8, 0xbb, 0x9c, 0x30, 0xb3, 0x69, 0x03, 0x47, 0xef, 0x77, 0xdc, 0xeb, 0x2f, 0x70, 0xc7, 0x65,
-	0x32, 0xdc, 0xad, 0x8b, 0x25, 0x7c, 0xd2, 0xfc, 0xfd, 0x75, 0x1f, 0xf4, 0xbe, 0x00, 0xd8, 0x0e,
-	0x49, 0x9a, 0x71, 0x41, 0xd8, 0x90, 0x66, 0xf9, 0x84, 0xd1, 0x25, 0xe5, 0x78, 0x5e, 0x8a, 0x11,
-	0x99, 0x98, 0x93, 0x4a, 0xa9, 0x02, 0xc8, 0x86, 0xdb, 0x09, 0xe1, 0x31, 0xcb, 0x96, 0x22, 0xa3,
-	0x79, 0x25, 0xf4, 0x5f, 0x0a, 0x3d, 0x83, 0x5b, 0x0b, 0x22, 0x70, 0x82, 0x05, 0x36, 0x1b, 0x76,
-	0xc3, 0xd9, 0xee, 0xef, 0xb9, 0xca, 0x40, 0x57, 0x7a, 0x56, 0x19, 0xe8, 0x9e, 0x56, 0x45, 0x83,
-	0xe6, 0xe5, 0xcf, 0x7d, 0x2d, 0xfc, 0xdb, 0x24, 0x75, 0x69, 0xbd, 0x8f, 0xb0, 0x53, 0xcb, 0x0a,
-	0xc2, 0x61, 0xff, 0xe0, 0xd6, 0xba, 0x1e, 0x40, 0x5d, 0xfa, 0x51, 0x2d, 0x80, 0x70, 0xa9, 0xae,
-	0x15, 0xfe, 0xc7, 0x56, 0xbf, 0xe7, 0x70, 0x6f, 0x4a, 0xd3, 0x74, 0x4e, 0xe4, 0x0a, 0x87, 0x34,
-	0x2f, 0x08, 0xe3, 0x19, 0xbd, 0xbd, 0x3d, 0x65, 0x5f, 0x39, 0x52, 0xee, 0xb2, 0xec, 0x2b, 0x41,
-	0xb5, 0x8b, 0x33, 0x68, 0xd4, 0xf3, 0x6b, 0x77, 0xae, 0xd9, 0x09, 0x6e, 0x60, 0xe7, 0xc3, 0x17,
-	0x70, 0x53, 0xee, 0x1b, 0x75, 0xe0, 0xbd, 0xf1, 0xcb, 0x51, 0x10, 0xce, 0xce, 0x47, 0x67, 0x93,
-	0x60, 0x78, 0xfc, 0xfc, 0x38, 0x38, 0x32, 0x34, 0x64, 0xc0, 0x1d, 0x45, 0x9f, 0x8e, 0x8f, 0xce,
-	0x4f, 0x02, 0x03, 0x20, 0x04, 0x75, 0xc5, 0x04, 0xaf, 0xa6, 0x41, 0x38, 0x3a, 0x3c, 0x31, 0x36,
-	0xba, 0xcd, 0x4f, 0xdf, 0x2c, 0x6d, 0x30, 0xb8, 0x5c, 0x59, 0xe0, 0x6a, 0x65, 0x81, 0x5f, 0x2b,
-	0x0b, 0x7c, 0x5e, 0x5b, 0xda, 0xd5, 0xda, 0xd2, 0x7e, 0xac, 0x2d, 0xed, 0xb5, 0x93, 0x66, 0xe2,
-	0xcd, 0xdb, 0xc8, 0x8d, 0xe9, 0xc2, 0xab, 0x4e, 0x4b, 0x7e, 0x0b, 0xff, 0xb1, 0xf7, 0xbe, 0x3a,
-	0x33, 0xf1, 0x61, 0x49, 0x78, 0x74, 0x47, 0x9e, 0xc7, 0xa3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x2a, 0x87, 0xd6, 0xd7, 0x82, 0x03, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x77, 0x9a, 0xb4, 0x36, 0x93, 0x36, 0xc4, 0xb1, 0x85, 0x35, 0xd8, 0x4d, 0x88, 0x20,
+	0xc1, 0xc3, 0x6e, 0x13, 0x11, 0x41, 0x04, 0x69, 0xd2, 0x15, 0x2a, 0xfd, 0xc5, 0x36, 0x45, 0xf1,
+	0x12, 0x26, 0xbb, 0xe3, 0xba, 0x24, 0x3b, 0x13, 0x76, 0x26, 0x51, 0x0f, 0xde, 0x3d, 0xf6, 0xe2,
+	0x5d, 0xd0, 0x3f, 0xa6, 0xc7, 0x1e, 0x7b, 0x52, 0x69, 0x2f, 0xfe, 0x19, 0x32, 0x3f, 0xb6, 0x3f,
+	0x3c, 0x89, 0xbd, 0x2c, 0xf3, 0xbe, 0xef, 0xbd, 0xdd, 0xf7, 0xfd, 0xec, 0x63, 0x60, 0x8d, 0xcc,
+	0x52, 0xc6, 0x3d, 0x92, 0x85, 0x9d, 0x75, 0x6f, 0xd6, 0xd6, 0x07, 0x77, 0x92, 0x31, 0xc1, 0x50,
+	0x45, 0xe5, 0x5c, 0x2d, 0xcd, 0xda, 0x35, 0x27, 0x64, 0x5c, 0x16, 0x0f, 0x31, 0x1d, 0x79, 0xb3,
+	0xf6, 0x90, 0x08, 0xdc, 0x56, 0x81, 0xae, 0xbf, 0x92, 0xe7, 0xe4, 0x22, 0x1f, 0xb2, 0x84, 0x9a,
+	0xfc, 0x4a, 0xcc, 0x62, 0xa6, 0x8e, 0x9e, 0x3c, 0x19, 0xb5, 0x1e, 0x33, 0x16, 0x8f, 0x89, 0xa7,
+	0xa2, 0xe1, 0xf4, 0xad, 0x27, 0x92, 0x94, 0x70, 0x81, 0xd3, 0x89, 0x2e, 0x68, 0x7e, 0x07, 0xb0,
+	0xd4, 0x67, 0x23, 0x42, 0xf7, 0x71, 0x92, 0xa1, 0xfb, 0x70, 0x59, 0x0d, 0x34, 0xc0, 0x51, 0x94,
+	0x11, 0xce, 0x6d, 0xd0, 0x00, 0xad, 0x52, 0xb0, 0xa4, 0xc4, 0x0d, 0xad, 0xa1, 0x15, 0x38, 0x1f,
+	0x11, 0xca, 0x52, 0x7b, 0x4e, 0x25, 0x75, 0x80, 0x6c, 0x78, 0x8b, 0x50, 0x3c, 0x1c, 0x93, 0xc8,
+	0x2e, 0x34, 0x40, 0x6b, 0x31, 0xc8, 0x43, 0xf4, 0x0c, 0x56, 0x42, 0x46, 0x45, 0x86, 0x43, 0x31,
+	0x60, 0xef, 0x29, 0xc9, 0xec, 0x62, 0x03, 0xb4, 0x2a, 0x9d, 0x55, 0xf7, 0x3a, 0x02, 0x77, 0x4f,
+	0x26, 0x83, 0xe5, 0xbc, 0x58, 0x85, 0x4f, 0x8b, 0xbf, 0xbf, 0xd6, 0x41, 0xf3, 0x14, 0xc0, 0x3b,
+	0x01, 0x89, 0x13, 0x2e, 0x32, 0x2c, 0x12, 0x46, 0x37, 0xc9, 0x84, 0xf1, 0x44, 0xfc, 0xdb, 0xc0,
+	0xf7, 0x60, 0x29, 0xd2, 0xf5, 0x2c, 0x33, 0x43, 0x5f, 0x0a, 0xe8, 0x09, 0x5c, 0xc0, 0x29, 0x9b,
+	0x52, 0xa1, 0xe6, 0x2e, 0x77, 0xee, 0xba, 0x9a, 0xb4, 0x2b, 0x49, 0xbb, 0x86, 0xb4, 0xdb, 0x63,
+	0x09, 0xed, 0x16, 0x8f, 0x7f, 0xd4, 0xad, 0xc0, 0x94, 0x23, 0x1f, 0x96, 0xa7, 0x74, 0xcc, 0xc2,
+	0xd1, 0x40, 0x42, 0x55, 0xa6, 0xca, 0x9d, 0x9a, 0xab, 0x89, 0xbb, 0x39, 0x71, 0xb7, 0x9f, 0x13,
+	0xef, 0x2e, 0xca, 0xf6, 0xa3, 0x9f, 0x75, 0x10, 0x40, 0xdd, 0x28, 0x53, 0xcd, 0x2f, 0x00, 0xae,
+	0x68, 0x6b, 0x24, 0x93, 0x5f, 0xd9, 0xcf, 0xd8, 0x84, 0x71, 0x3c, 0x96, 0x9c, 0x45, 0x22, 0xc6,
+	0xc4, 0x78, 0xd2, 0x01, 0x6a, 0xc0, 0x72, 0x44, 0x78, 0x98, 0x25, 0x13, 0xc9, 0xc1, 0xd8, 0xb9,
+	0x2a, 0xa1, 0xe7, 0x70, 0x31, 0x25, 0x02, 0x47, 0x58, 0x60, 0xbb, 0xd0, 0x28, 0xb4, 0xca, 0x9d,
+	0xb5, 0x4b, 0x4b, 0x74, 0x74, 0x61, 0x69, 0xc7, 0x14, 0x19, 0x5b, 0x17, 0x4d, 0x0a, 0xb9, 0xd5,
+	0xfc, 0x04, 0x57, 0xf3, 0xb1, 0xfc, 0xa0, 0xd7, 0x59, 0xbf, 0xf1, 0x5c, 0x0f, 0x60, 0x45, 0xfd,
+	0x16, 0xf3, 0xab, 0x08, 0x57, 0xd3, 0x95, 0x82, 0xbf, 0x54, 0xf3, 0x79, 0x0e, 0xd7, 0xfa, 0x2c,
+	0x8e, 0xc7, 0x44, 0x6d, 0x67, 0x8f, 0xd1, 0x19, 0xc9, 0x78, 0xc2, 0x6e, 0x8e, 0x47, 0xf6, 0xc9,
+	0x57, 0xda, 0x05, 0xd3, 0x27, 0x03, 0xb3, 0x66, 0x07, 0xb0, 0x9a, 0xbf, 0x3f, 0xa7, 0x73, 0x0d,
+	0x27, 0xf8, 0x0f, 0x9c, 0x0f, 0x5f, 0xc2, 0x79, 0xb5, 0xca, 0x68, 0x15, 0xde, 0xde, 0x7b, 0xb5,
+	0xeb, 0x07, 0x83, 0xc3, 0xdd, 0x83, 0x7d, 0xbf, 0xb7, 0xf5, 0x62, 0xcb, 0xdf, 0xac, 0x5a, 0xa8,
+	0x0a, 0x97, 0xb4, 0xbc, 0xb3, 0xb7, 0x79, 0xb8, 0xed, 0x57, 0x01, 0x42, 0xb0, 0xa2, 0x15, 0xff,
+	0x75, 0xdf, 0x0f, 0x76, 0x37, 0xb6, 0xab, 0x73, 0xb5, 0xe2, 0xe7, 0x6f, 0x8e, 0xd5, 0xed, 0x1e,
+	0x9f, 0x39, 0xe0, 0xe4, 0xcc, 0x01, 0xbf, 0xce, 0x1c, 0x70, 0x74, 0xee, 0x58, 0x27, 0xe7, 0x8e,
+	0x75, 0x7a, 0xee, 0x58, 0x6f, 0x5a, 0x71, 0x22, 0xde, 0x4d, 0x87, 0x6e, 0xc8, 0x52, 0xcf, 0x5c,
+	0x3b, 0xea, 0x39, 0x6b, 0x3f, 0xf6, 0x3e, 0x98, 0x2b, 0x48, 0x7c, 0x9c, 0x10, 0x3e, 0x5c, 0x50,
+	0xab, 0xf9, 0xe8, 0xcf, 0x00, 0x43, 0xc9, 0x15, 0x3f, 0x9e, 0x04, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -535,6 +622,61 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintErc20(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -747,6 +889,27 @@ func (m *TokenPair) Size() (n int) {
 	return n
 }
 
+func (m *RegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
 func (m *RegisterCoinProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -988,6 +1151,186 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1110,7 +1453,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1486,7 +1829,7 @@ func (m *ProposalMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
+			m.Metadata = append(m.Metadata, types1.Metadata{})
 			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
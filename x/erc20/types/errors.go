@@ -9,16 +9,21 @@ import (
 
 // errors
 var (
-	ErrERC20Disabled          = errorsmod.Register(ModuleName, 2, "erc20 module is disabled")
-	ErrInternalTokenPair      = errorsmod.Register(ModuleName, 3, "internal ethereum token mapping error")
-	ErrTokenPairNotFound      = errorsmod.Register(ModuleName, 4, "token pair not found")
-	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, 5, "token pair already exists")
-	ErrUndefinedOwner         = errorsmod.Register(ModuleName, 6, "undefined owner of contract pair")
-	ErrBalanceInvariance      = errorsmod.Register(ModuleName, 7, "post transfer balance invariant failed")
-	ErrUnexpectedEvent        = errorsmod.Register(ModuleName, 8, "unexpected event")
-	ErrABIPack                = errorsmod.Register(ModuleName, 9, "contract ABI pack failed")
-	ErrABIUnpack              = errorsmod.Register(ModuleName, 10, "contract ABI unpack failed")
-	ErrEVMDenom               = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
-	ErrEVMCall                = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
-	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrERC20Disabled                      = errorsmod.Register(ModuleName, 2, "erc20 module is disabled")
+	ErrInternalTokenPair                  = errorsmod.Register(ModuleName, 3, "internal ethereum token mapping error")
+	ErrTokenPairNotFound                  = errorsmod.Register(ModuleName, 4, "token pair not found")
+	ErrTokenPairAlreadyExists             = errorsmod.Register(ModuleName, 5, "token pair already exists")
+	ErrUndefinedOwner                     = errorsmod.Register(ModuleName, 6, "undefined owner of contract pair")
+	ErrBalanceInvariance                  = errorsmod.Register(ModuleName, 7, "post transfer balance invariant failed")
+	ErrUnexpectedEvent                    = errorsmod.Register(ModuleName, 8, "unexpected event")
+	ErrABIPack                            = errorsmod.Register(ModuleName, 9, "contract ABI pack failed")
+	ErrABIUnpack                          = errorsmod.Register(ModuleName, 10, "contract ABI unpack failed")
+	ErrEVMDenom                           = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
+	ErrEVMCall                            = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
+	ErrERC20TokenPairDisabled             = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrPermissionlessRegistrationDisabled = errorsmod.Register(ModuleName, 14, "permissionless token pair registration is disabled")
+	ErrUnauthorizedRegistration           = errorsmod.Register(ModuleName, 15, "sender is not the contract deployer")
+	ErrUnsupportedERC20                   = errorsmod.Register(ModuleName, 16, "unsupported ERC20 transfer behavior")
+	ErrRegistrationDepositNotFound        = errorsmod.Register(ModuleName, 17, "registration deposit not found")
+	ErrRegistrationDepositLocked          = errorsmod.Register(ModuleName, 18, "registration deposit is locked")
 )
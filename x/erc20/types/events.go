@@ -11,15 +11,17 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock             = "token_lock"
-	EventTypeTokenUnlock           = "token_unlock"
-	EventTypeMint                  = "mint"
-	EventTypeConvertCoin           = "convert_coin"
-	EventTypeConvertERC20          = "convert_erc20"
-	EventTypeBurn                  = "burn"
-	EventTypeRegisterCoin          = "register_coin"
-	EventTypeRegisterERC20         = "register_erc20"
-	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeTokenLock                  = "token_lock"
+	EventTypeTokenUnlock                = "token_unlock"
+	EventTypeMint                       = "mint"
+	EventTypeConvertCoin                = "convert_coin"
+	EventTypeConvertERC20               = "convert_erc20"
+	EventTypeBurn                       = "burn"
+	EventTypeRegisterCoin               = "register_coin"
+	EventTypeRegisterERC20              = "register_erc20"
+	EventTypeToggleTokenConversion      = "toggle_token_conversion" // #nosec
+	EventTypeRefundRegistrationDeposit  = "refund_registration_deposit"
+	EventTypeForfeitRegistrationDeposit = "forfeit_registration_deposit"
 
 	AttributeKeyCosmosCoin = "cosmos_coin"
 	AttributeKeyERC20Token = "erc20_token" // #nosec
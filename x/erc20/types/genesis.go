@@ -3,7 +3,11 @@
 
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
@@ -26,6 +30,7 @@ func DefaultGenesisState() *GenesisState {
 func (gs GenesisState) Validate() error {
 	seenErc20 := make(map[string]bool)
 	seenDenom := make(map[string]bool)
+	registered := make(map[common.Address]bool)
 
 	for _, b := range gs.TokenPairs {
 		if seenErc20[b.Erc20Address] {
@@ -41,6 +46,24 @@ func (gs GenesisState) Validate() error {
 
 		seenErc20[b.Erc20Address] = true
 		seenDenom[b.Denom] = true
+		registered[b.GetERC20Contract()] = true
+	}
+
+	seenDeposit := make(map[common.Address]bool)
+	for _, d := range gs.RegistrationDeposits {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		contract := d.GetERC20Contract()
+		if seenDeposit[contract] {
+			return fmt.Errorf("registration deposit duplicated on genesis: '%s'", d.Erc20Address)
+		}
+		if !registered[contract] {
+			return fmt.Errorf("registration deposit for an unregistered token pair: '%s'", d.Erc20Address)
+		}
+
+		seenDeposit[contract] = true
 	}
 
 	return gs.Params.Validate()
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +34,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// registration_deposits is a slice of the deposits locked for permissionless
+	// token pair registrations at genesis
+	RegistrationDeposits []RegistrationDeposit `protobuf:"bytes,3,rep,name=registration_deposits,json=registrationDeposits,proto3" json:"registration_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +86,13 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetRegistrationDeposits() []RegistrationDeposit {
+	if m != nil {
+		return m.RegistrationDeposits
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -85,6 +100,14 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// enable_permissionless_registration is the parameter to allow registering token pairs for
+	// ERC20 contracts with a MsgRegisterERC20Permissionless instead of a governance proposal.
+	EnablePermissionlessRegistration bool `protobuf:"varint,3,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// registration_deposit is the deposit locked by the sender of a MsgRegisterERC20Permissionless.
+	RegistrationDeposit types.Coin `protobuf:"bytes,4,opt,name=registration_deposit,json=registrationDeposit,proto3" json:"registration_deposit"`
+	// registration_deposit_period is the duration after which a registration deposit can be
+	// refunded, provided that governance has not disabled the token pair.
+	RegistrationDepositPeriod time.Duration `protobuf:"bytes,5,opt,name=registration_deposit_period,json=registrationDepositPeriod,proto3,stdduration" json:"registration_deposit_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,6 +157,27 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetEnablePermissionlessRegistration() bool {
+	if m != nil {
+		return m.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() types.Coin {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetRegistrationDepositPeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationDepositPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -142,26 +186,37 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x54, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x8e, 0x7d, 0x15, 0x9d, 0x01, 0x00, 0x00,
+	// 474 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xf6, 0xa8, 0x4e, 0xee, 0x1d, 0x88, 0x50, 0x50, 0x5a, 0x50, 0x5a, 0xca, 0xd2,
+	0xc9, 0x26, 0x05, 0x06, 0x36, 0x14, 0xee, 0x04, 0x03, 0x48, 0x55, 0x40, 0x0c, 0x0c, 0x44, 0x49,
+	0x6a, 0x72, 0x56, 0x9b, 0xbc, 0x91, 0xed, 0x46, 0xf0, 0x2d, 0x18, 0xf9, 0x48, 0x37, 0xde, 0xc8,
+	0x74, 0xa0, 0x56, 0x62, 0xe3, 0x3b, 0x20, 0xff, 0xa9, 0xd4, 0xf6, 0xba, 0x44, 0xf6, 0xfb, 0x3e,
+	0xef, 0xcf, 0x8f, 0x9d, 0x07, 0x3d, 0xa2, 0x75, 0x01, 0x82, 0x50, 0x9e, 0x4d, 0x9e, 0x92, 0x3a,
+	0x20, 0x39, 0x2d, 0xa9, 0x60, 0x02, 0x57, 0x1c, 0x24, 0xb8, 0xb7, 0x75, 0x17, 0xeb, 0x2e, 0xae,
+	0x83, 0xbe, 0x9f, 0x81, 0x50, 0xf2, 0x34, 0x11, 0x94, 0xd4, 0x41, 0x4a, 0x65, 0x12, 0x90, 0x0c,
+	0x58, 0x69, 0xf4, 0xfd, 0xfe, 0x1e, 0xcd, 0x0c, 0x9a, 0x5e, 0x37, 0x87, 0x1c, 0xf4, 0x92, 0xa8,
+	0x95, 0xad, 0xfa, 0x39, 0x40, 0xbe, 0xa0, 0x44, 0xef, 0xd2, 0xe5, 0x57, 0x32, 0x5b, 0xf2, 0x44,
+	0x32, 0xb0, 0xc4, 0xd1, 0x5f, 0x07, 0x9d, 0xbc, 0x31, 0x9e, 0x3e, 0xc8, 0x44, 0x52, 0xf7, 0x39,
+	0x6a, 0x57, 0x09, 0x4f, 0x0a, 0xe1, 0x39, 0x43, 0x67, 0xdc, 0x99, 0x3c, 0xc0, 0xbb, 0x1e, 0xf1,
+	0x54, 0x77, 0xc3, 0xa3, 0xcb, 0xeb, 0x41, 0x23, 0xb2, 0x5a, 0xf7, 0x15, 0xea, 0x48, 0x98, 0xd3,
+	0x32, 0xae, 0x12, 0xc6, 0x85, 0xd7, 0x1c, 0xb6, 0xc6, 0x9d, 0x49, 0x6f, 0x7f, 0xf4, 0xa3, 0x92,
+	0x4c, 0x13, 0xc6, 0xed, 0x34, 0x92, 0x9b, 0x82, 0x70, 0xbf, 0xa0, 0xfb, 0x9c, 0xe6, 0x4c, 0x48,
+	0x63, 0x2f, 0x9e, 0xd1, 0x0a, 0x04, 0x93, 0xc2, 0x6b, 0x69, 0xd6, 0x93, 0x7d, 0x56, 0xb4, 0x25,
+	0x3e, 0x33, 0x5a, 0x4b, 0xed, 0xf2, 0x9b, 0x2d, 0x31, 0xfa, 0xd7, 0x44, 0x6d, 0x63, 0xdd, 0x7d,
+	0x8c, 0x4e, 0x68, 0x99, 0xa4, 0x0b, 0x1a, 0x6b, 0x9a, 0xbe, 0xe8, 0x71, 0xd4, 0x31, 0xb5, 0x73,
+	0x55, 0x72, 0x5f, 0xa2, 0x3b, 0x1b, 0x49, 0x5d, 0xc4, 0x17, 0x00, 0x73, 0xaf, 0xa9, 0x54, 0xe1,
+	0xdd, 0xd5, 0xf5, 0xe0, 0xf4, 0xdc, 0x28, 0x3f, 0xbd, 0x7f, 0x0b, 0x30, 0x8f, 0x4e, 0xed, 0x60,
+	0x5d, 0xa8, 0xad, 0xfb, 0x0e, 0x8d, 0xec, 0x68, 0x45, 0x79, 0xc1, 0x84, 0x60, 0x50, 0x2e, 0xa8,
+	0x10, 0xf1, 0xb6, 0x2d, 0xaf, 0xa5, 0xcf, 0x1c, 0x1a, 0xe5, 0x74, 0x47, 0xb8, 0x7d, 0x33, 0x37,
+	0x42, 0xdd, 0x43, 0xcf, 0xe2, 0x1d, 0xe9, 0x9f, 0xd3, 0xc3, 0x26, 0x30, 0x58, 0x05, 0x06, 0xdb,
+	0xc0, 0xe0, 0xd7, 0xc0, 0x4a, 0xfb, 0x16, 0xf7, 0x0e, 0xbc, 0x85, 0x9b, 0xa1, 0x87, 0x87, 0x98,
+	0xca, 0x2f, 0x83, 0x99, 0x77, 0xcb, 0xa2, 0x4d, 0x72, 0xf0, 0x26, 0x39, 0xf8, 0xcc, 0x26, 0x27,
+	0x3c, 0x56, 0xe8, 0x9f, 0xbf, 0x07, 0x4e, 0xd4, 0x3b, 0x80, 0x9f, 0x6a, 0x4a, 0x18, 0x5e, 0xae,
+	0x7c, 0xe7, 0x6a, 0xe5, 0x3b, 0x7f, 0x56, 0xbe, 0xf3, 0x63, 0xed, 0x37, 0xae, 0xd6, 0x7e, 0xe3,
+	0xd7, 0xda, 0x6f, 0x7c, 0x1e, 0xe7, 0x4c, 0x5e, 0x2c, 0x53, 0x9c, 0x41, 0x41, 0x6c, 0x9e, 0xf5,
+	0xb7, 0x0e, 0x5e, 0x90, 0x6f, 0x36, 0xdb, 0xf2, 0x7b, 0x45, 0x45, 0xda, 0xd6, 0x67, 0x3f, 0xfb,
+	0x3f, 0x00, 0x75, 0xdd, 0x92, 0x15, 0x45, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,6 +239,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RegistrationDeposits) > 0 {
+		for iNdEx := len(m.RegistrationDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +300,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RegistrationDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationDepositPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RegistrationDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.EnablePermissionlessRegistration {
+		i--
+		if m.EnablePermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -279,6 +376,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegistrationDeposits) > 0 {
+		for _, e := range m.RegistrationDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -294,6 +397,13 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if m.EnablePermissionlessRegistration {
+		n += 2
+	}
+	l = m.RegistrationDeposit.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RegistrationDepositPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -399,6 +509,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposits = append(m.RegistrationDeposits, RegistrationDeposit{})
+			if err := m.RegistrationDeposits[len(m.RegistrationDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -489,6 +633,92 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePermissionlessRegistration = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RegistrationDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -115,6 +115,41 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with registration deposits",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TokenPairs: []types.TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+						Depositor:    "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v",
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - registration deposit for an unregistered token pair",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegistrationDeposits: []types.RegistrationDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v",
+						Amount:       types.DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid genesis - invalid token pair",
 			genState: &types.GenesisState{
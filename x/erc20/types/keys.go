@@ -32,11 +32,13 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixRegistrationDeposit
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPair           = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20    = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom    = []byte{prefixTokenPairByDenom}
+	KeyPrefixRegistrationDeposit = []byte{prefixRegistrationDeposit}
 )
@@ -17,11 +17,16 @@ var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterERC20Permissionless{}
+	_ sdk.Msg = &MsgRefundRegistrationDeposit{}
+	_ sdk.Msg = &MsgForfeitRegistrationDeposit{}
 )
 
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin                 = "convert_coin"
+	TypeMsgConvertERC20                = "convert_ERC20"
+	TypeMsgRegisterERC20Permissionless = "register_ERC20_permissionless"
+	TypeMsgRefundRegistrationDeposit   = "refund_registration_deposit"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -135,3 +140,100 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// NewMsgRegisterERC20Permissionless creates a new instance of MsgRegisterERC20Permissionless
+func NewMsgRegisterERC20Permissionless(sender sdk.AccAddress, contract common.Address, nonce uint64) *MsgRegisterERC20Permissionless { //nolint: interfacer
+	return &MsgRegisterERC20Permissionless{
+		Sender:          sender.String(),
+		ContractAddress: contract.String(),
+		Nonce:           nonce,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20Permissionless) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20Permissionless) Type() string {
+	return TypeMsgRegisterERC20Permissionless
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20Permissionless) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterERC20Permissionless) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20Permissionless) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRefundRegistrationDeposit creates a new instance of MsgRefundRegistrationDeposit
+func NewMsgRefundRegistrationDeposit(sender sdk.AccAddress, contract common.Address) *MsgRefundRegistrationDeposit { //nolint: interfacer
+	return &MsgRefundRegistrationDeposit{
+		Sender:          sender.String(),
+		ContractAddress: contract.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRefundRegistrationDeposit) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRefundRegistrationDeposit) Type() string { return TypeMsgRefundRegistrationDeposit }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRefundRegistrationDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRefundRegistrationDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRefundRegistrationDeposit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSigners returns the expected signers for a MsgForfeitRegistrationDeposit message.
+func (m *MsgForfeitRegistrationDeposit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgForfeitRegistrationDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	if !common.IsHexAddress(m.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", m.ContractAddress)
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgForfeitRegistrationDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -286,3 +286,59 @@ func (suite *MsgsTestSuite) TestMsgUpdateValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20PermissionlessValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	contract := utiltx.GenerateAddress().String()
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterERC20Permissionless
+		expPass bool
+	}{
+		{
+			"fail - invalid sender address",
+			&types.MsgRegisterERC20Permissionless{Sender: "invalid", ContractAddress: contract},
+			false,
+		},
+		{
+			"fail - invalid contract address",
+			&types.MsgRegisterERC20Permissionless{Sender: sender, ContractAddress: "0x"},
+			false,
+		},
+		{
+			"pass",
+			&types.MsgRegisterERC20Permissionless{Sender: sender, ContractAddress: contract, Nonce: 1},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRefundRegistrationDepositValidateBasic() {
+	sender := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	suite.Require().Error((&types.MsgRefundRegistrationDeposit{Sender: "invalid", ContractAddress: contract.String()}).ValidateBasic())
+	suite.Require().Error((&types.MsgRefundRegistrationDeposit{Sender: sender.String(), ContractAddress: "0x"}).ValidateBasic())
+	suite.Require().NoError(types.NewMsgRefundRegistrationDeposit(sender, contract).ValidateBasic())
+}
+
+func (suite *MsgsTestSuite) TestMsgForfeitRegistrationDepositValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := utiltx.GenerateAddress()
+
+	suite.Require().Error((&types.MsgForfeitRegistrationDeposit{Authority: "invalid", ContractAddress: contract.String()}).ValidateBasic())
+	suite.Require().Error((&types.MsgForfeitRegistrationDeposit{Authority: authority, ContractAddress: "0x"}).ValidateBasic())
+	suite.Require().NoError((&types.MsgForfeitRegistrationDeposit{Authority: authority, ContractAddress: contract.String()}).ValidateBasic())
+}
@@ -5,29 +5,56 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/utils"
 )
 
 // Parameter store key
 var (
-	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
-	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+	ParamStoreKeyEnableErc20                      = []byte("EnableErc20")
+	ParamStoreKeyEnableEVMHook                    = []byte("EnableEVMHook")
+	ParamStoreKeyEnablePermissionlessRegistration = []byte("EnablePermissionlessRegistration")
+	ParamStoreKeyRegistrationDeposit              = []byte("RegistrationDeposit")
+	ParamStoreKeyRegistrationDepositPeriod        = []byte("RegistrationDepositPeriod")
+)
+
+var (
+	// DefaultRegistrationDeposit is the default deposit of 100 EVMOS locked
+	// for a permissionless token pair registration
+	DefaultRegistrationDeposit = sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(100, 18))
+	// DefaultRegistrationDepositPeriod is the default duration of 30 days
+	// after which a registration deposit can be refunded
+	DefaultRegistrationDepositPeriod = 30 * 24 * time.Hour
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	enablePermissionlessRegistration bool,
+	registrationDeposit sdk.Coin,
+	registrationDepositPeriod time.Duration,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:                      enableErc20,
+		EnableEVMHook:                    enableEVMHook,
+		EnablePermissionlessRegistration: enablePermissionlessRegistration,
+		RegistrationDeposit:              registrationDeposit,
+		RegistrationDepositPeriod:        registrationDepositPeriod,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:                      true,
+		EnableEVMHook:                    true,
+		EnablePermissionlessRegistration: true,
+		RegistrationDeposit:              DefaultRegistrationDeposit,
+		RegistrationDepositPeriod:        DefaultRegistrationDepositPeriod,
 	}
 }
 
@@ -40,10 +67,55 @@ func ValidateBool(i interface{}) error {
 	return nil
 }
 
+// ValidateRegistrationDeposit validates the registration deposit. An empty
+// coin is valid and means that no deposit is required.
+func ValidateRegistrationDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if deposit.Denom == "" && deposit.Amount.IsNil() {
+		return nil
+	}
+
+	if err := deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateRegistrationDepositPeriod validates the registration deposit period.
+func ValidateRegistrationDepositPeriod(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if period < 0 {
+		return fmt.Errorf("registration deposit period cannot be negative: %s", period)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := ValidateBool(p.EnableEVMHook); err != nil {
 		return err
 	}
 
+	if err := ValidateBool(p.EnablePermissionlessRegistration); err != nil {
+		return err
+	}
+
+	if err := ValidateRegistrationDeposit(p.RegistrationDeposit); err != nil {
+		return err
+	}
+
+	if err := ValidateRegistrationDepositPeriod(p.RegistrationDepositPeriod); err != nil {
+		return err
+	}
+
 	return ValidateBool(p.EnableErc20)
 }
@@ -2,6 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/evmos/evmos/v15/x/erc20/types"
 	"github.com/stretchr/testify/suite"
@@ -24,7 +27,7 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", types.DefaultParams(), false},
 		{
 			"valid",
-			types.NewParams(true, true),
+			types.NewParams(true, true, true, types.DefaultRegistrationDeposit, types.DefaultRegistrationDepositPeriod),
 			false,
 		},
 		{
@@ -32,6 +35,16 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			types.Params{},
 			false,
 		},
+		{
+			"invalid registration deposit",
+			types.NewParams(true, true, true, sdk.Coin{Denom: "", Amount: sdk.NewInt(1)}, types.DefaultRegistrationDepositPeriod),
+			true,
+		},
+		{
+			"negative registration deposit period",
+			types.NewParams(true, true, true, types.DefaultRegistrationDeposit, -time.Hour),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,49 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v15/types"
+)
+
+// NewRegistrationDeposit returns an instance of RegistrationDeposit
+func NewRegistrationDeposit(erc20Address common.Address, depositor sdk.AccAddress, amount sdk.Coin, unlockTime time.Time) RegistrationDeposit {
+	return RegistrationDeposit{
+		Erc20Address: erc20Address.String(),
+		Depositor:    depositor.String(),
+		Amount:       amount,
+		UnlockTime:   unlockTime,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (rd RegistrationDeposit) GetERC20Contract() common.Address {
+	return common.HexToAddress(rd.Erc20Address)
+}
+
+// Validate performs a stateless validation of a RegistrationDeposit
+func (rd RegistrationDeposit) Validate() error {
+	if err := evmostypes.ValidateAddress(rd.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(rd.Depositor); err != nil {
+		return fmt.Errorf("invalid depositor address: %w", err)
+	}
+
+	if err := rd.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if !rd.Amount.IsPositive() {
+		return fmt.Errorf("registration deposit must be positive: %s", rd.Amount)
+	}
+
+	return nil
+}
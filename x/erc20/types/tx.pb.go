@@ -335,6 +335,306 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterERC20Permissionless defines a Msg to register a token pair for an
+// ERC20 contract without a governance proposal.
+type MsgRegisterERC20Permissionless struct {
+	// sender is the bech32 address of the contract deployer, which locks the
+	// registration deposit
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the hex address of the ERC20 contract to register
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// nonce is the nonce of the deployer when the contract was created with CREATE
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRegisterERC20Permissionless) Reset()         { *m = MsgRegisterERC20Permissionless{} }
+func (m *MsgRegisterERC20Permissionless) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20Permissionless) ProtoMessage()    {}
+func (*MsgRegisterERC20Permissionless) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{6}
+}
+func (m *MsgRegisterERC20Permissionless) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20Permissionless) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20Permissionless.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20Permissionless) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20Permissionless.Merge(m, src)
+}
+func (m *MsgRegisterERC20Permissionless) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20Permissionless) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20Permissionless.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20Permissionless proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20Permissionless) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20Permissionless) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterERC20Permissionless) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// MsgRegisterERC20PermissionlessResponse returns the registered token pair
+type MsgRegisterERC20PermissionlessResponse struct {
+	// token_pair is the registered token pair
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+}
+
+func (m *MsgRegisterERC20PermissionlessResponse) Reset() {
+	*m = MsgRegisterERC20PermissionlessResponse{}
+}
+func (m *MsgRegisterERC20PermissionlessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20PermissionlessResponse) ProtoMessage()    {}
+func (*MsgRegisterERC20PermissionlessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
+}
+func (m *MsgRegisterERC20PermissionlessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterERC20PermissionlessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20PermissionlessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20PermissionlessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20PermissionlessResponse.Merge(m, src)
+}
+func (m *MsgRegisterERC20PermissionlessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20PermissionlessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20PermissionlessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterERC20PermissionlessResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20PermissionlessResponse) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
+	}
+	return TokenPair{}
+}
+
+// MsgRefundRegistrationDeposit defines a Msg to refund the deposit locked for a
+// permissionless token pair registration.
+type MsgRefundRegistrationDeposit struct {
+	// sender is the bech32 address of the depositor
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract_address is the hex address of the registered ERC20 contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgRefundRegistrationDeposit) Reset()         { *m = MsgRefundRegistrationDeposit{} }
+func (m *MsgRefundRegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundRegistrationDeposit) ProtoMessage()    {}
+func (*MsgRefundRegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgRefundRegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundRegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundRegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundRegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundRegistrationDeposit.Merge(m, src)
+}
+func (m *MsgRefundRegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundRegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundRegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundRegistrationDeposit proto.InternalMessageInfo
+
+func (m *MsgRefundRegistrationDeposit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRefundRegistrationDeposit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgRefundRegistrationDepositResponse returns no fields
+type MsgRefundRegistrationDepositResponse struct {
+}
+
+func (m *MsgRefundRegistrationDepositResponse) Reset()         { *m = MsgRefundRegistrationDepositResponse{} }
+func (m *MsgRefundRegistrationDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundRegistrationDepositResponse) ProtoMessage()    {}
+func (*MsgRefundRegistrationDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{9}
+}
+func (m *MsgRefundRegistrationDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundRegistrationDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundRegistrationDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundRegistrationDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundRegistrationDepositResponse.Merge(m, src)
+}
+func (m *MsgRefundRegistrationDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundRegistrationDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundRegistrationDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundRegistrationDepositResponse proto.InternalMessageInfo
+
+// MsgForfeitRegistrationDeposit defines a governance Msg to burn the deposit
+// locked for a permissionless token pair registration.
+type MsgForfeitRegistrationDeposit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the hex address of the registered ERC20 contract
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgForfeitRegistrationDeposit) Reset()         { *m = MsgForfeitRegistrationDeposit{} }
+func (m *MsgForfeitRegistrationDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgForfeitRegistrationDeposit) ProtoMessage()    {}
+func (*MsgForfeitRegistrationDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{10}
+}
+func (m *MsgForfeitRegistrationDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForfeitRegistrationDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForfeitRegistrationDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForfeitRegistrationDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForfeitRegistrationDeposit.Merge(m, src)
+}
+func (m *MsgForfeitRegistrationDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForfeitRegistrationDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForfeitRegistrationDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForfeitRegistrationDeposit proto.InternalMessageInfo
+
+func (m *MsgForfeitRegistrationDeposit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgForfeitRegistrationDeposit) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgForfeitRegistrationDepositResponse returns no fields
+type MsgForfeitRegistrationDepositResponse struct {
+}
+
+func (m *MsgForfeitRegistrationDepositResponse) Reset()         { *m = MsgForfeitRegistrationDepositResponse{} }
+func (m *MsgForfeitRegistrationDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgForfeitRegistrationDepositResponse) ProtoMessage()    {}
+func (*MsgForfeitRegistrationDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{11}
+}
+func (m *MsgForfeitRegistrationDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgForfeitRegistrationDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgForfeitRegistrationDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgForfeitRegistrationDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgForfeitRegistrationDepositResponse.Merge(m, src)
+}
+func (m *MsgForfeitRegistrationDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgForfeitRegistrationDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgForfeitRegistrationDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgForfeitRegistrationDepositResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
 	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
@@ -342,48 +642,68 @@ func init() {
 	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.erc20.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.erc20.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterERC20Permissionless)(nil), "evmos.erc20.v1.MsgRegisterERC20Permissionless")
+	proto.RegisterType((*MsgRegisterERC20PermissionlessResponse)(nil), "evmos.erc20.v1.MsgRegisterERC20PermissionlessResponse")
+	proto.RegisterType((*MsgRefundRegistrationDeposit)(nil), "evmos.erc20.v1.MsgRefundRegistrationDeposit")
+	proto.RegisterType((*MsgRefundRegistrationDepositResponse)(nil), "evmos.erc20.v1.MsgRefundRegistrationDepositResponse")
+	proto.RegisterType((*MsgForfeitRegistrationDeposit)(nil), "evmos.erc20.v1.MsgForfeitRegistrationDeposit")
+	proto.RegisterType((*MsgForfeitRegistrationDepositResponse)(nil), "evmos.erc20.v1.MsgForfeitRegistrationDepositResponse")
 }
 
 func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
 
 var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x8b, 0x13, 0x3f,
-	0x1c, 0xc6, 0x3b, 0xdb, 0x52, 0x7e, 0x4d, 0x97, 0xee, 0x8f, 0xb0, 0xf4, 0x1f, 0x32, 0xad, 0x3d,
-	0xec, 0x56, 0x61, 0x27, 0xdb, 0xae, 0x7a, 0xf0, 0x66, 0x8b, 0x82, 0x87, 0x05, 0x19, 0x11, 0xc4,
-	0x4b, 0x49, 0xa7, 0x21, 0x3b, 0xe8, 0x24, 0x43, 0x92, 0x0e, 0xdb, 0x8b, 0x87, 0xbe, 0x01, 0x05,
-	0xdf, 0x88, 0x07, 0x0f, 0xbe, 0x84, 0x3d, 0x2e, 0x7a, 0x11, 0x0f, 0x8b, 0xb4, 0x0b, 0xbe, 0x0d,
-	0x99, 0x4c, 0xfa, 0x67, 0x6a, 0x77, 0xbd, 0xb4, 0x93, 0x3c, 0x4f, 0x92, 0xcf, 0xf3, 0xcd, 0x37,
-	0xa0, 0x42, 0xa2, 0x80, 0x4b, 0x44, 0x84, 0xd7, 0x3d, 0x46, 0x51, 0x07, 0xa9, 0x73, 0x27, 0x14,
-	0x5c, 0x71, 0x58, 0xd2, 0x82, 0xa3, 0x05, 0x27, 0xea, 0xd4, 0x6d, 0x8f, 0xcb, 0xd8, 0x39, 0xc4,
-	0x92, 0xa0, 0xa8, 0x33, 0x24, 0x0a, 0x77, 0x90, 0xc7, 0x7d, 0x96, 0xf8, 0xeb, 0x15, 0xa3, 0x07,
-	0x92, 0xc6, 0xfb, 0x04, 0x92, 0x1a, 0xa1, 0x96, 0x08, 0x03, 0x3d, 0x42, 0xc9, 0xc0, 0x48, 0x77,
-	0x36, 0x0e, 0xa7, 0x84, 0x11, 0xe9, 0x2f, 0xd4, 0x7d, 0xca, 0x29, 0x4f, 0x56, 0xc5, 0x5f, 0x8b,
-	0x35, 0x94, 0x73, 0xfa, 0x8e, 0x20, 0x1c, 0xfa, 0x08, 0x33, 0xc6, 0x15, 0x56, 0x3e, 0x67, 0x66,
-	0x4d, 0x6b, 0x02, 0x4a, 0xa7, 0x92, 0xf6, 0x39, 0x8b, 0x88, 0x50, 0x7d, 0xee, 0x33, 0x78, 0x02,
-	0x72, 0x31, 0x65, 0xd5, 0x6a, 0x5a, 0xed, 0x62, 0xb7, 0xe6, 0x18, 0x80, 0x38, 0x86, 0x63, 0x62,
-	0x38, 0xb1, 0xb1, 0x97, 0xbb, 0xb8, 0x6a, 0x64, 0x5c, 0x6d, 0x86, 0x75, 0xf0, 0x9f, 0x20, 0x1e,
-	0xf1, 0x23, 0x22, 0xaa, 0x3b, 0x4d, 0xab, 0x5d, 0x70, 0x97, 0x63, 0x58, 0x06, 0x79, 0x49, 0xd8,
-	0x88, 0x88, 0x6a, 0x56, 0x2b, 0x66, 0xd4, 0xaa, 0x82, 0x72, 0xfa, 0x68, 0x97, 0xc8, 0x90, 0x33,
-	0x49, 0x5a, 0x5f, 0x2d, 0xb0, 0xb7, 0x92, 0x9e, 0xba, 0xfd, 0xee, 0x31, 0xbc, 0x07, 0xfe, 0xf7,
-	0x38, 0x53, 0x02, 0x7b, 0x6a, 0x80, 0x47, 0x23, 0x41, 0xa4, 0xd4, 0x88, 0x05, 0x77, 0x6f, 0x31,
-	0xff, 0x24, 0x99, 0x86, 0xcf, 0x40, 0x1e, 0x07, 0x7c, 0xcc, 0x54, 0x82, 0xd2, 0x73, 0x62, 0xd0,
-	0x9f, 0x57, 0x8d, 0x03, 0xea, 0xab, 0xb3, 0xf1, 0xd0, 0xf1, 0x78, 0x60, 0xca, 0x6a, 0xfe, 0x8e,
-	0xe4, 0xe8, 0x2d, 0x52, 0x93, 0x90, 0x48, 0xe7, 0x39, 0x53, 0xae, 0x59, 0x9d, 0x0a, 0x95, 0xbd,
-	0x31, 0x54, 0x2e, 0x15, 0xaa, 0x06, 0x2a, 0x1b, 0xe4, 0xcb, 0x54, 0x1f, 0x92, 0x54, 0xaf, 0xc2,
-	0x11, 0x56, 0xe4, 0x05, 0x16, 0x38, 0x90, 0xf0, 0x11, 0x28, 0xe0, 0xb1, 0x3a, 0xe3, 0xc2, 0x57,
-	0x93, 0x24, 0x4e, 0xaf, 0xfa, 0xed, 0xcb, 0xd1, 0xbe, 0x29, 0xba, 0x49, 0xf4, 0x52, 0x09, 0x9f,
-	0x51, 0x77, 0x65, 0x85, 0x0f, 0x40, 0x3e, 0xd4, 0x3b, 0xe8, 0x88, 0xc5, 0x6e, 0xd9, 0x49, 0x77,
-	0x9f, 0x93, 0xec, 0x6f, 0xee, 0xc8, 0x78, 0x1f, 0x97, 0xa6, 0xbf, 0x3f, 0xdf, 0x5f, 0xed, 0x62,
-	0x60, 0xd7, 0x81, 0x16, 0xb0, 0xdd, 0xeb, 0x1d, 0x90, 0x3d, 0x95, 0x14, 0xbe, 0x07, 0xc5, 0xf5,
-	0xe6, 0xb0, 0x37, 0xcf, 0x49, 0xdf, 0x60, 0xfd, 0xe0, 0x76, 0x7d, 0x59, 0x8b, 0xc3, 0xe9, 0xf7,
-	0xeb, 0x4f, 0x3b, 0x77, 0x61, 0x03, 0xfd, 0xf5, 0x9c, 0x90, 0x97, 0xf8, 0x07, 0xba, 0xb1, 0xa6,
-	0x16, 0xd8, 0x4d, 0xf5, 0x41, 0xe3, 0xe6, 0x13, 0xb4, 0xa1, 0x7e, 0xf8, 0x0f, 0xc3, 0x92, 0xa1,
-	0xad, 0x19, 0x5a, 0xb0, 0x79, 0x0b, 0x83, 0x9e, 0x83, 0xaf, 0xc1, 0x6e, 0xea, 0xd6, 0xb6, 0x31,
-	0xac, 0x1b, 0xb6, 0x32, 0x6c, 0x2b, 0x73, 0xaf, 0x77, 0x31, 0xb3, 0xad, 0xcb, 0x99, 0x6d, 0xfd,
-	0x9a, 0xd9, 0xd6, 0xc7, 0xb9, 0x9d, 0xb9, 0x9c, 0xdb, 0x99, 0x1f, 0x73, 0x3b, 0xf3, 0xa6, 0xbd,
-	0xd6, 0xac, 0x86, 0x4f, 0xff, 0x46, 0x9d, 0x87, 0xe8, 0xdc, 0xb0, 0xea, 0x96, 0x1d, 0xe6, 0xf5,
-	0x4b, 0x3e, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xf3, 0xd2, 0x63, 0x9a, 0x04, 0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0xb7, 0x12, 0xc7, 0xac, 0xc7, 0x21, 0x59, 0x44, 0x48, 0x6c, 0x6d, 0x56, 0xce, 0x9a, 0x5d,
+	0xdb, 0xbb, 0x6c, 0xa4, 0xd8, 0xf9, 0x73, 0xc8, 0x61, 0x61, 0x9d, 0xdd, 0xc0, 0x1e, 0x0c, 0x41,
+	0xdb, 0x42, 0xe9, 0xc5, 0xc8, 0xf2, 0x44, 0x19, 0x12, 0xcf, 0x88, 0x99, 0xb1, 0x49, 0x2e, 0x2d,
+	0x18, 0x0a, 0xbd, 0xb5, 0xb4, 0x5f, 0xa0, 0x1f, 0xa1, 0x87, 0x1e, 0xfa, 0x11, 0x72, 0x0c, 0xed,
+	0xa5, 0xf4, 0x10, 0x4a, 0x52, 0xe8, 0xbd, 0x9f, 0xa0, 0x68, 0x34, 0x52, 0x2c, 0xd7, 0x56, 0x70,
+	0xa1, 0x97, 0xc4, 0x33, 0xbf, 0xdf, 0xbc, 0xf7, 0xfb, 0xbd, 0x79, 0xf3, 0x04, 0x56, 0x60, 0xbf,
+	0x4b, 0x98, 0x09, 0xa9, 0x53, 0xdf, 0x30, 0xfb, 0x35, 0x93, 0x9f, 0x1a, 0x1e, 0x25, 0x9c, 0xa8,
+	0x0b, 0x02, 0x30, 0x04, 0x60, 0xf4, 0x6b, 0x9a, 0xee, 0x10, 0xe6, 0x33, 0xdb, 0x36, 0x83, 0x66,
+	0xbf, 0xd6, 0x86, 0xdc, 0xae, 0x99, 0x0e, 0x41, 0x38, 0xe0, 0x6b, 0x2b, 0x12, 0xef, 0x32, 0xd7,
+	0x8f, 0xd3, 0x65, 0xae, 0x04, 0x0a, 0x01, 0xd0, 0x12, 0x2b, 0x33, 0x58, 0x48, 0x48, 0x1b, 0x49,
+	0x1e, 0x24, 0x0b, 0xb0, 0xd5, 0x11, 0xcc, 0x85, 0x18, 0x32, 0x14, 0x9e, 0x5c, 0x72, 0x89, 0x4b,
+	0x82, 0x88, 0xfe, 0xaf, 0xf0, 0x8c, 0x4b, 0x88, 0x7b, 0x02, 0x4d, 0xdb, 0x43, 0xa6, 0x8d, 0x31,
+	0xe1, 0x36, 0x47, 0x04, 0xcb, 0x33, 0xa5, 0x33, 0xb0, 0xd0, 0x64, 0xee, 0x1e, 0xc1, 0x7d, 0x48,
+	0xf9, 0x1e, 0x41, 0x58, 0xdd, 0x04, 0x69, 0xdf, 0x41, 0x5e, 0x59, 0x53, 0xaa, 0xb9, 0x7a, 0xc1,
+	0x90, 0xe2, 0x7c, 0x8b, 0x86, 0xb4, 0x68, 0xf8, 0xc4, 0x46, 0xfa, 0xfc, 0xb2, 0x98, 0xb2, 0x04,
+	0x59, 0xd5, 0xc0, 0x0f, 0x14, 0x3a, 0x10, 0xf5, 0x21, 0xcd, 0xcf, 0xac, 0x29, 0xd5, 0xac, 0x15,
+	0xad, 0xd5, 0x65, 0x90, 0x61, 0x10, 0x77, 0x20, 0xcd, 0xcf, 0x0a, 0x44, 0xae, 0x4a, 0x79, 0xb0,
+	0x1c, 0x4f, 0x6d, 0x41, 0xe6, 0x11, 0xcc, 0x60, 0xe9, 0xb5, 0x02, 0x16, 0x6f, 0xa0, 0x7f, 0xad,
+	0xbd, 0xfa, 0x86, 0xfa, 0x3b, 0xf8, 0xd1, 0x21, 0x98, 0x53, 0xdb, 0xe1, 0x2d, 0xbb, 0xd3, 0xa1,
+	0x90, 0x31, 0x21, 0x31, 0x6b, 0x2d, 0x86, 0xfb, 0x7f, 0x07, 0xdb, 0xea, 0x3e, 0xc8, 0xd8, 0x5d,
+	0xd2, 0xc3, 0x3c, 0x90, 0xd2, 0x30, 0x7c, 0xa1, 0xef, 0x2f, 0x8b, 0x65, 0x17, 0xf1, 0xa3, 0x5e,
+	0xdb, 0x70, 0x48, 0x57, 0x96, 0x5c, 0xfe, 0x5b, 0x67, 0x9d, 0x63, 0x93, 0x9f, 0x79, 0x90, 0x19,
+	0xff, 0x61, 0x6e, 0xc9, 0xd3, 0x31, 0x53, 0xb3, 0x13, 0x4d, 0xa5, 0x63, 0xa6, 0x0a, 0x60, 0x65,
+	0x44, 0x79, 0xe4, 0xea, 0x49, 0xe0, 0xea, 0xae, 0xd7, 0xb1, 0x39, 0x3c, 0xb0, 0xa9, 0xdd, 0x65,
+	0xea, 0x0e, 0xc8, 0xda, 0x3d, 0x7e, 0x44, 0x28, 0xe2, 0x67, 0x81, 0x9d, 0x46, 0xfe, 0xcd, 0xab,
+	0xf5, 0x25, 0x59, 0x74, 0xe9, 0xe8, 0x7f, 0x4e, 0x11, 0x76, 0xad, 0x1b, 0xaa, 0xba, 0x05, 0x32,
+	0x9e, 0x88, 0x20, 0x2c, 0xe6, 0xea, 0xcb, 0x46, 0xbc, 0x33, 0x8d, 0x20, 0xbe, 0xbc, 0x23, 0xc9,
+	0xdd, 0x5d, 0x18, 0x7c, 0x7a, 0xf9, 0xc7, 0x4d, 0x14, 0x29, 0x76, 0x58, 0x50, 0x24, 0xf6, 0x85,
+	0x02, 0xf4, 0x26, 0x73, 0x2d, 0xe8, 0x22, 0xc6, 0x21, 0x15, 0x4e, 0x0e, 0x20, 0xed, 0x22, 0xc6,
+	0x10, 0xc1, 0x27, 0x7e, 0x99, 0x37, 0xa2, 0x12, 0xdc, 0x26, 0x5c, 0xf2, 0xc6, 0xde, 0xe1, 0xcc,
+	0xf8, 0x3b, 0x5c, 0x02, 0x73, 0x98, 0x60, 0x07, 0x8a, 0xc2, 0xa7, 0xad, 0x60, 0xb1, 0x9b, 0xf3,
+	0x0d, 0x84, 0xa5, 0x3e, 0x02, 0xe5, 0x64, 0x85, 0xa1, 0x19, 0xf5, 0x2f, 0x00, 0x38, 0x39, 0x86,
+	0xb8, 0xe5, 0xd9, 0x88, 0x46, 0x8d, 0x3d, 0x52, 0xb1, 0x3b, 0x3e, 0xe3, 0xc0, 0x46, 0x54, 0x16,
+	0x2d, 0xcb, 0xc3, 0x8d, 0xd2, 0x63, 0x05, 0xac, 0x8a, 0x54, 0x87, 0x3d, 0xdc, 0x09, 0x12, 0x52,
+	0xf1, 0x8a, 0xfe, 0x81, 0x1e, 0x61, 0x88, 0x7f, 0xd7, 0x52, 0xc4, 0x4d, 0x97, 0xc1, 0xaf, 0x49,
+	0x4a, 0xa2, 0xfb, 0x7b, 0xa6, 0x80, 0x9f, 0x9b, 0xcc, 0xdd, 0x27, 0xf4, 0x10, 0x22, 0x3e, 0x86,
+	0xf9, 0xcd, 0xad, 0x37, 0x85, 0xf2, 0xd1, 0x7e, 0xab, 0x80, 0xdf, 0x12, 0x35, 0x85, 0xea, 0xeb,
+	0x9f, 0xe7, 0xc0, 0x6c, 0x93, 0xb9, 0xea, 0x03, 0x90, 0x1b, 0x1e, 0x4d, 0xfa, 0xe8, 0x9d, 0xc5,
+	0xe7, 0x87, 0x56, 0x4e, 0xc6, 0xa3, 0xe2, 0x54, 0x06, 0x6f, 0x3f, 0x3e, 0x9f, 0xf9, 0x45, 0x2d,
+	0x9a, 0x5f, 0x0d, 0x7a, 0xd3, 0x09, 0xf8, 0x2d, 0x31, 0xd6, 0x06, 0x0a, 0x98, 0x8f, 0x4d, 0xa1,
+	0xe2, 0xe4, 0x0c, 0x82, 0xa0, 0x55, 0x6e, 0x21, 0x44, 0x1a, 0xaa, 0x42, 0x43, 0x49, 0x5d, 0x4b,
+	0xd0, 0x20, 0xf6, 0xd4, 0x7b, 0x60, 0x3e, 0x36, 0x33, 0xc6, 0x69, 0x18, 0x26, 0x68, 0x95, 0x5b,
+	0x08, 0xd1, 0xbb, 0x78, 0xa4, 0x80, 0x9f, 0x92, 0x5e, 0xb8, 0x31, 0x26, 0x50, 0x02, 0x5f, 0xdb,
+	0x99, 0x8e, 0x1f, 0xe9, 0x78, 0x08, 0x0a, 0x93, 0xdf, 0xd6, 0x9f, 0x63, 0x83, 0x4e, 0x60, 0x6b,
+	0x5b, 0xd3, 0xb0, 0x23, 0x01, 0x03, 0x05, 0x68, 0x09, 0x4f, 0x65, 0x7d, 0x4c, 0xd0, 0xc9, 0x74,
+	0x6d, 0x7b, 0x2a, 0x7a, 0x28, 0xa2, 0xd1, 0x38, 0xbf, 0xd2, 0x95, 0x8b, 0x2b, 0x5d, 0xf9, 0x70,
+	0xa5, 0x2b, 0x4f, 0xaf, 0xf5, 0xd4, 0xc5, 0xb5, 0x9e, 0x7a, 0x77, 0xad, 0xa7, 0xee, 0x57, 0x87,
+	0x3e, 0x5c, 0xb2, 0x5b, 0xc4, 0xdf, 0x7e, 0x6d, 0xdb, 0x3c, 0x95, 0x9d, 0x23, 0x3e, 0x5f, 0xed,
+	0x8c, 0xf8, 0xaa, 0x6f, 0x7e, 0x19, 0x00, 0x15, 0x80, 0xdd, 0xef, 0xc2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -407,6 +727,17 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 contract
+	// without a governance proposal, by locking a refundable deposit. The sender
+	// must be the account that deployed the contract.
+	RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20Permissionless, opts ...grpc.CallOption) (*MsgRegisterERC20PermissionlessResponse, error)
+	// RefundRegistrationDeposit refunds the deposit locked for a permissionless
+	// token pair registration once the deposit period has passed.
+	RefundRegistrationDeposit(ctx context.Context, in *MsgRefundRegistrationDeposit, opts ...grpc.CallOption) (*MsgRefundRegistrationDepositResponse, error)
+	// ForfeitRegistrationDeposit defines a governance operation burning the
+	// deposit locked for a permissionless token pair registration.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ForfeitRegistrationDeposit(ctx context.Context, in *MsgForfeitRegistrationDeposit, opts ...grpc.CallOption) (*MsgForfeitRegistrationDepositResponse, error)
 }
 
 type msgClient struct {
@@ -444,6 +775,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterERC20Permissionless(ctx context.Context, in *MsgRegisterERC20Permissionless, opts ...grpc.CallOption) (*MsgRegisterERC20PermissionlessResponse, error) {
+	out := new(MsgRegisterERC20PermissionlessResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RegisterERC20Permissionless", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundRegistrationDeposit(ctx context.Context, in *MsgRefundRegistrationDeposit, opts ...grpc.CallOption) (*MsgRefundRegistrationDepositResponse, error) {
+	out := new(MsgRefundRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RefundRegistrationDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ForfeitRegistrationDeposit(ctx context.Context, in *MsgForfeitRegistrationDeposit, opts ...grpc.CallOption) (*MsgForfeitRegistrationDepositResponse, error) {
+	out := new(MsgForfeitRegistrationDepositResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ForfeitRegistrationDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
@@ -455,6 +813,17 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/erc20 module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterERC20Permissionless registers a token pair for an ERC20 contract
+	// without a governance proposal, by locking a refundable deposit. The sender
+	// must be the account that deployed the contract.
+	RegisterERC20Permissionless(context.Context, *MsgRegisterERC20Permissionless) (*MsgRegisterERC20PermissionlessResponse, error)
+	// RefundRegistrationDeposit refunds the deposit locked for a permissionless
+	// token pair registration once the deposit period has passed.
+	RefundRegistrationDeposit(context.Context, *MsgRefundRegistrationDeposit) (*MsgRefundRegistrationDepositResponse, error)
+	// ForfeitRegistrationDeposit defines a governance operation burning the
+	// deposit locked for a permissionless token pair registration.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ForfeitRegistrationDeposit(context.Context, *MsgForfeitRegistrationDeposit) (*MsgForfeitRegistrationDepositResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -470,6 +839,15 @@ func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvert
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterERC20Permissionless(ctx context.Context, req *MsgRegisterERC20Permissionless) (*MsgRegisterERC20PermissionlessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20Permissionless not implemented")
+}
+func (*UnimplementedMsgServer) RefundRegistrationDeposit(ctx context.Context, req *MsgRefundRegistrationDeposit) (*MsgRefundRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundRegistrationDeposit not implemented")
+}
+func (*UnimplementedMsgServer) ForfeitRegistrationDeposit(ctx context.Context, req *MsgForfeitRegistrationDeposit) (*MsgForfeitRegistrationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForfeitRegistrationDeposit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -529,6 +907,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterERC20Permissionless_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20Permissionless)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RegisterERC20Permissionless",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20Permissionless(ctx, req.(*MsgRegisterERC20Permissionless))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundRegistrationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RefundRegistrationDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundRegistrationDeposit(ctx, req.(*MsgRefundRegistrationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForfeitRegistrationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgForfeitRegistrationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForfeitRegistrationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ForfeitRegistrationDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForfeitRegistrationDeposit(ctx, req.(*MsgForfeitRegistrationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.erc20.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -545,6 +977,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterERC20Permissionless",
+			Handler:    _Msg_RegisterERC20Permissionless_Handler,
+		},
+		{
+			MethodName: "RefundRegistrationDeposit",
+			Handler:    _Msg_RefundRegistrationDeposit_Handler,
+		},
+		{
+			MethodName: "ForfeitRegistrationDeposit",
+			Handler:    _Msg_ForfeitRegistrationDeposit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/erc20/v1/tx.proto",
@@ -760,20 +1204,215 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterERC20Permissionless) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgRegisterERC20Permissionless) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20Permissionless) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20PermissionlessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20PermissionlessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20PermissionlessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundRegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundRegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundRegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundRegistrationDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundRegistrationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundRegistrationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForfeitRegistrationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForfeitRegistrationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForfeitRegistrationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgForfeitRegistrationDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgForfeitRegistrationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgForfeitRegistrationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -855,13 +1494,523 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterERC20Permissionless) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20PermissionlessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefundRegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundRegistrationDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgForfeitRegistrationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgForfeitRegistrationDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,48 +2033,15 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -953,13 +2069,13 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -969,23 +2085,24 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1008,7 +2125,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,10 +2148,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1058,7 +2175,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterERC20Permissionless) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,15 +2198,15 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterERC20Permissionless: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterERC20Permissionless: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1117,11 +2234,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1149,13 +2266,163 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20PermissionlessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20PermissionlessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20PermissionlessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundRegistrationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundRegistrationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundRegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,11 +2450,11 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1215,7 +2482,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1238,7 +2505,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundRegistrationDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,10 +2528,10 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundRegistrationDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundRegistrationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1288,7 +2555,7 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgForfeitRegistrationDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1311,10 +2578,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForfeitRegistrationDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForfeitRegistrationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1351,9 +2618,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1363,24 +2630,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1403,7 +2669,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForfeitRegistrationDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1426,10 +2692,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForfeitRegistrationDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForfeitRegistrationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -25,5 +25,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Valida
 	bloom := ethtypes.BytesToBloom(k.GetBlockBloomTransient(infCtx).Bytes())
 	k.EmitBlockBloomEvent(infCtx, bloom)
 
+	// the cached entries must not outlive the block state they were read from
+	k.stateCache.end()
+
 	return []abci.ValidatorUpdate{}
 }
@@ -27,10 +27,12 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	evmostypes "github.com/evmos/evmos/v15/types"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/tracers/native"
 	"github.com/evmos/evmos/v15/x/evm/types"
 )
 
@@ -415,6 +417,89 @@ func (k Keeper) EstimateGasInternal(c context.Context, req *types.EthCallRequest
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList. It executes the transaction
+// with an access list tracer, applying the access list of the previous execution,
+// until the access list is stable. The sender, recipient and precompiles are
+// excluded from the access list, since they are always warm.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the recipient of a contract creation is the address of the new contract
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	customPrecompiles := cfg.Params.GetActivePrecompilesAddrs()
+	precompiles := make([]common.Address, 0, len(vm.PrecompiledAddressesBerlin)+len(customPrecompiles))
+	precompiles = append(precompiles, vm.PrecompiledAddressesBerlin...)
+	precompiles = append(precompiles, customPrecompiles...)
+
+	var accessList ethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	prevTracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+	for {
+		// expand the access list of the previous execution
+		accessList = prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+		// execute each iteration on a cached context so that they all start from the same state,
+		// and pass false to not commit StateDB
+		tmpCtx, _ := ctx.CacheContext()
+		res, err := k.ApplyMessageWithConfig(tmpCtx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -520,8 +605,66 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
+	results := make([]*types.TxTraceResult, 0, len(req.Txs))
+	err := k.traceBlock(sdk.UnwrapSDKContext(c), req, func(_ int, _ common.Hash, result *types.TxTraceResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceBlockResponse{
+		Data: resultData,
+	}, nil
+}
+
+// TraceBlockStream traces the transactions of the queried block like TraceBlock,
+// but sends the result of each transaction as soon as it's traced instead of a
+// single response for the whole block.
+func (k Keeper) TraceBlockStream(req *types.QueryTraceBlockRequest, stream types.Query_TraceBlockStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx, ok := stream.Context().Value(sdk.SdkContextKey).(sdk.Context)
+	if !ok {
+		return status.Error(codes.Unimplemented, "query context not available for streaming queries")
+	}
+	ctx = ctx.WithContext(stream.Context())
+
+	return k.traceBlock(ctx, req, func(i int, txHash common.Hash, result *types.TxTraceResult) error {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		data, err := json.Marshal(result)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return stream.Send(&types.QueryTraceBlockStreamResponse{
+			TxIndex: uint64(i),
+			TxHash:  txHash.Hex(),
+			Data:    data,
+		})
+	})
+}
+
+// traceBlock traces the transactions of the requested block in order and calls
+// the given callback with the result of each transaction.
+func (k Keeper) traceBlock(
+	ctx sdk.Context,
+	req *types.QueryTraceBlockRequest,
+	cb func(i int, txHash common.Hash, result *types.TxTraceResult) error,
+) error {
 	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
-		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+		return status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
 	// get the context of block beginning
@@ -531,7 +674,6 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		contextHeight = 1
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
 	ctx = ctx.WithBlockHeight(contextHeight)
 	ctx = ctx.WithBlockTime(req.BlockTime)
 	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
@@ -543,12 +685,12 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 
 	chainID, err := getChainID(ctx, req.ChainId)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to load evm config")
+		return status.Error(codes.Internal, "failed to load evm config")
 	}
 
 	// compute and use base fee of height that is being traced
@@ -558,8 +700,12 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}
 
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
-	txsLength := len(req.Txs)
-	results := make([]*types.TxTraceResult, 0, txsLength)
+
+	var tracerConfig json.RawMessage
+	if req.TraceConfig != nil && req.TraceConfig.TracerJsonConfig != "" {
+		// ignore error. default to no traceConfig
+		_ = json.Unmarshal([]byte(req.TraceConfig.TracerJsonConfig), &tracerConfig)
+	}
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
@@ -568,24 +714,20 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, nil)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true, tracerConfig)
 		if err != nil {
 			result.Error = err.Error()
 		} else {
 			txConfig.LogIndex = logIndex
 			result.Result = traceResult
 		}
-		results = append(results, &result)
-	}
 
-	resultData, err := json.Marshal(results)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		if err := cb(i, ethTx.Hash(), &result); err != nil {
+			return err
+		}
 	}
 
-	return &types.QueryTraceBlockResponse{
-		Data: resultData,
-	}, nil
+	return nil
 }
 
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
@@ -638,7 +780,13 @@ func (k *Keeper) traceTx(
 	}
 
 	if traceConfig.Tracer != "" {
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig); err != nil {
+		// the native tracers take precedence over the ones registered on geth
+		var found bool
+		tracer, found, err = native.New(traceConfig.Tracer, tCtx, tracerJSONConfig)
+		if !found {
+			tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerJSONConfig)
+		}
+		if err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -14,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	ethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
 	ethparams "github.com/ethereum/go-ethereum/params"
+	"google.golang.org/grpc"
 
 	"github.com/evmos/evmos/v15/server/config"
 	utiltx "github.com/evmos/evmos/v15/testutil/tx"
@@ -25,10 +27,10 @@ import (
 const invalidAddress = "0x0000"
 
 // expGasConsumed is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee)
-const expGasConsumed = 7196
+const expGasConsumed = 7592
 
 // expGasConsumedWithFeeMkt is the gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) with enabled feemarket
-const expGasConsumedWithFeeMkt = 7190
+const expGasConsumedWithFeeMkt = 7586
 
 func (suite *KeeperTestSuite) TestQueryAccount() {
 	var (
@@ -937,7 +939,7 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			},
 			expPass:       true,
 			traceResponse: "{\"gas\":34828,\"failed\":false,\"returnValue\":\"0000000000000000000000000000000000000000000000000000000000000001\",\"structLogs\":[{\"pc\":0,\"op\":\"PUSH1\",\"gas\":",
-			expFinalGas:   23660, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
+			expFinalGas:   28412, // gas consumed in traceTx setup (GetProposerAddr + CalculateBaseFee) + gas consumed in malleate func
 		},
 		{
 			msg: "invalid chain id",
@@ -1184,6 +1186,196 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxNativeTracers() {
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+	transferTopic := types.ERC20Contract.ABI.Events["Transfer"].ID
+
+	type callFrame struct {
+		Type string `json:"type"`
+		Logs []struct {
+			Topics []common.Hash `json:"topics"`
+		} `json:"logs"`
+		Calls []callFrame `json:"calls"`
+	}
+
+	testCases := []struct {
+		name         string
+		tracer       string
+		tracerConfig string
+		validate     func(contractAddr common.Address, data []byte)
+	}{
+		{
+			"call tracer with only top call",
+			"callTracer",
+			`{"onlyTopCall":true}`,
+			func(_ common.Address, data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Equal("CALL", frame.Type)
+				suite.Require().Empty(frame.Calls)
+				suite.Require().Empty(frame.Logs)
+			},
+		},
+		{
+			"call tracer with logs",
+			"callTracer",
+			`{"withLog":true}`,
+			func(_ common.Address, data []byte) {
+				var frame callFrame
+				suite.Require().NoError(json.Unmarshal(data, &frame))
+				suite.Require().Len(frame.Logs, 1)
+				suite.Require().Equal(transferTopic, frame.Logs[0].Topics[0])
+			},
+		},
+		{
+			"prestate tracer",
+			"prestateTracer",
+			"",
+			func(contractAddr common.Address, data []byte) {
+				var prestate map[common.Address]json.RawMessage
+				suite.Require().NoError(json.Unmarshal(data, &prestate))
+				suite.Require().Contains(prestate, suite.address)
+				suite.Require().Contains(prestate, contractAddr)
+			},
+		},
+		{
+			"prestate tracer in diff mode",
+			"prestateTracer",
+			`{"diffMode":true}`,
+			func(contractAddr common.Address, data []byte) {
+				var diff struct {
+					Pre  map[common.Address]struct{ Storage map[common.Hash]common.Hash } `json:"pre"`
+					Post map[common.Address]struct{ Storage map[common.Hash]common.Hash } `json:"post"`
+				}
+				suite.Require().NoError(json.Unmarshal(data, &diff))
+				// the balances of the sender and the recipient are updated
+				suite.Require().Len(diff.Pre[contractAddr].Storage, 2)
+				suite.Require().Len(diff.Post[contractAddr].Storage, 2)
+				suite.Require().NotEqual(diff.Pre[contractAddr].Storage, diff.Post[contractAddr].Storage)
+			},
+		},
+		{
+			"4byte tracer",
+			"4byteTracer",
+			"",
+			func(_ common.Address, data []byte) {
+				suite.Require().JSONEq(`{"0xa9059cbb-64":1}`, string(data))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			txMsg := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt())
+			suite.Commit()
+
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg: txMsg,
+				TraceConfig: &types.TraceConfig{
+					Tracer:           tc.tracer,
+					TracerJsonConfig: tc.tracerConfig,
+				},
+			})
+			suite.Require().NoError(err)
+			tc.validate(contractAddr, res.Data)
+		})
+	}
+}
+
+// traceBlockStream is a mock of the TraceBlockStream server stream.
+type traceBlockStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*types.QueryTraceBlockStreamResponse
+}
+
+func (s *traceBlockStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *traceBlockStream) Send(res *types.QueryTraceBlockStreamResponse) error {
+	s.responses = append(s.responses, res)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestTraceBlockStream() {
+	testCases := []struct {
+		name        string
+		traceConfig *types.TraceConfig
+		expPass     bool
+	}{
+		{
+			"default tracer",
+			nil,
+			true,
+		},
+		{
+			"native call tracer",
+			&types.TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+			true,
+		},
+		{
+			"invalid trace config",
+			&types.TraceConfig{Limit: -1},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+			txs := []*types.MsgEthereumTx{
+				suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt()),
+				suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdkmath.NewIntWithDecimal(1, 18).BigInt()),
+			}
+			suite.Commit()
+
+			req := &types.QueryTraceBlockRequest{
+				Txs:         txs,
+				TraceConfig: tc.traceConfig,
+			}
+			// the traced transactions are committed, so each trace runs on its own branch
+			blockCtx, _ := suite.ctx.CacheContext()
+			expRes, expErr := suite.app.EvmKeeper.TraceBlock(sdk.WrapSDKContext(blockCtx), req)
+
+			streamCtx, _ := suite.ctx.CacheContext()
+			stream := &traceBlockStream{ctx: sdk.WrapSDKContext(streamCtx)}
+			err := suite.app.EvmKeeper.TraceBlockStream(req, stream)
+			if !tc.expPass {
+				suite.Require().Error(expErr)
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(expErr)
+			suite.Require().NoError(err)
+			suite.Require().Len(stream.responses, len(txs))
+
+			// the streamed results match the ones of the whole block
+			results := make([]json.RawMessage, len(stream.responses))
+			for i, res := range stream.responses {
+				suite.Require().Equal(uint64(i), res.TxIndex)
+				suite.Require().Equal(txs[i].AsTransaction().Hash().Hex(), res.TxHash)
+				results[i] = res.Data
+			}
+			data, err := json.Marshal(results)
+			suite.Require().NoError(err)
+			suite.Require().JSONEq(string(expRes.Data), string(data))
+		})
+	}
+
+	suite.Run("query context not available", func() {
+		err := suite.app.EvmKeeper.TraceBlockStream(&types.QueryTraceBlockRequest{}, &traceBlockStream{ctx: context.Background()})
+		suite.Require().Error(err)
+	})
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	address := utiltx.GenerateAddress()
 	suite.Require().Equal(uint64(0), suite.app.EvmKeeper.GetNonce(suite.ctx, address))
@@ -1368,6 +1560,309 @@ func (suite *KeeperTestSuite) TestEthCall() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var (
+		req          *types.EthCallRequest
+		contractAddr common.Address
+	)
+
+	balanceSlot := func(owner common.Address) common.Hash {
+		// the ERC20 balances mapping is at slot 0
+		return crypto.Keccak256Hash(common.LeftPadBytes(owner.Bytes(), 32), common.LeftPadBytes(nil, 32))
+	}
+
+	testCases := []struct {
+		name       string
+		malleate   func() []common.Hash
+		expPass    bool
+		expVMError string
+	}{
+		{
+			"fail - invalid args",
+			func() []common.Hash {
+				req = &types.EthCallRequest{Args: []byte("invalid args"), GasCap: config.DefaultGasCap}
+				return nil
+			},
+			false,
+			"",
+		},
+		{
+			"pass - contract call accessing storage",
+			func() []common.Hash {
+				transferData, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
+				suite.Require().NoError(err)
+
+				args, err := json.Marshal(&types.TransactionArgs{
+					From: &suite.address,
+					To:   &contractAddr,
+					Data: (*hexutil.Bytes)(&transferData),
+				})
+				suite.Require().NoError(err)
+				req = &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+
+				return []common.Hash{
+					balanceSlot(suite.address),
+					balanceSlot(common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")),
+				}
+			},
+			true,
+			"",
+		},
+		{
+			"pass - reverted contract call returns the vm error",
+			func() []common.Hash {
+				sender := utiltx.GenerateAddress()
+				transferData, err := types.ERC20Contract.ABI.Pack("transfer", suite.address, big.NewInt(1000))
+				suite.Require().NoError(err)
+
+				args, err := json.Marshal(&types.TransactionArgs{
+					From: &sender,
+					To:   &contractAddr,
+					Data: (*hexutil.Bytes)(&transferData),
+				})
+				suite.Require().NoError(err)
+				req = &types.EthCallRequest{Args: args, GasCap: config.DefaultGasCap}
+
+				return []common.Hash{balanceSlot(sender)}
+			},
+			true,
+			vm.ErrExecutionReverted.Error(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			expSlots := tc.malleate()
+
+			res, err := suite.queryClient.CreateAccessList(suite.ctx, req)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expVMError, res.VmError)
+			suite.Require().Greater(res.GasUsed, uint64(0))
+
+			// only the storage of the called contract is accessed
+			accessList := *res.AccessList.ToEthAccessList()
+			suite.Require().Len(accessList, 1)
+			suite.Require().Equal(contractAddr, accessList[0].Address)
+			suite.Require().ElementsMatch(expSlots, accessList[0].StorageKeys)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateV1() {
+	var (
+		opts         types.SimOpts
+		contractAddr common.Address
+	)
+
+	recipient := utiltx.GenerateAddress()
+	amount := big.NewInt(1000)
+	simGasLimit := hexutil.Uint64(50000)
+	simGasPrice := big.NewInt(1e12)
+
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", recipient, amount)
+	suite.Require().NoError(err)
+	balanceOfData, err := types.ERC20Contract.ABI.Pack("balanceOf", recipient)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expPass     bool
+		errContains string
+		check       func(res *types.SimulateV1Response)
+	}{
+		{
+			"fail - empty input",
+			func() {
+				opts = types.SimOpts{}
+			},
+			false,
+			"empty input",
+			nil,
+		},
+		{
+			"fail - block numbers are not increasing",
+			func() {
+				number := (*hexutil.Big)(big.NewInt(suite.ctx.BlockHeight()))
+				opts = types.SimOpts{BlockStateCalls: []types.SimBlock{
+					{BlockOverrides: &types.BlockOverrides{Number: number}},
+				}}
+			},
+			false,
+			"is not greater than the previous block",
+			nil,
+		},
+		{
+			"pass - sequential calls observe the previous state changes",
+			func() {
+				opts = types.SimOpts{BlockStateCalls: []types.SimBlock{
+					{Calls: []types.TransactionArgs{
+						{From: &suite.address, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+					}},
+					{Calls: []types.TransactionArgs{
+						{From: &suite.address, To: &contractAddr, Data: (*hexutil.Bytes)(&balanceOfData)},
+					}},
+				}}
+			},
+			true,
+			"",
+			func(res *types.SimulateV1Response) {
+				suite.Require().Len(res.Blocks, 2)
+
+				transferBlock := res.Blocks[0]
+				suite.Require().Equal(suite.ctx.BlockHeight()+1, transferBlock.Number)
+				suite.Require().Len(transferBlock.Calls, 1)
+				suite.Require().Empty(transferBlock.Calls[0].VmError)
+				suite.Require().Len(transferBlock.Calls[0].Logs, 1)
+				suite.Require().Equal(uint64(transferBlock.Number), transferBlock.Calls[0].Logs[0].BlockNumber)
+				suite.Require().Equal(transferBlock.Calls[0].GasUsed, transferBlock.GasUsed)
+
+				balanceBlock := res.Blocks[1]
+				suite.Require().Equal(suite.ctx.BlockHeight()+2, balanceBlock.Number)
+				suite.Require().Equal(common.LeftPadBytes(amount.Bytes(), 32), balanceBlock.Calls[0].Ret)
+
+				// the simulation is not persisted
+				balanceRes, err := suite.queryClient.EthCall(suite.ctx, &types.EthCallRequest{
+					Args:   suite.marshalCallArgs(types.TransactionArgs{To: &contractAddr, Data: (*hexutil.Bytes)(&balanceOfData)}),
+					GasCap: config.DefaultGasCap,
+				})
+				suite.Require().NoError(err)
+				suite.Require().Equal(common.LeftPadBytes(nil, 32), balanceRes.Ret)
+			},
+		},
+		{
+			"pass - reverted call and state overrides",
+			func() {
+				sender := utiltx.GenerateAddress()
+				balance := (*hexutil.Big)(big.NewInt(1e18))
+				opts = types.SimOpts{BlockStateCalls: []types.SimBlock{
+					{
+						StateOverrides: types.StateOverride{sender: {Balance: balance}},
+						Calls: []types.TransactionArgs{
+							// the sender has no tokens
+							{From: &sender, To: &contractAddr, Data: (*hexutil.Bytes)(&transferData)},
+							{From: &sender, To: &recipient, Value: (*hexutil.Big)(amount)},
+						},
+					},
+				}}
+			},
+			true,
+			"",
+			func(res *types.SimulateV1Response) {
+				suite.Require().Len(res.Blocks, 1)
+				suite.Require().Len(res.Blocks[0].Calls, 2)
+				suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.Blocks[0].Calls[0].VmError)
+				suite.Require().Empty(res.Blocks[0].Calls[1].VmError)
+			},
+		},
+		{
+			"fail - validation of a sender without balance",
+			func() {
+				sender := utiltx.GenerateAddress()
+				feeCap := (*hexutil.Big)(big.NewInt(1e12))
+				opts = types.SimOpts{
+					Validation: true,
+					BlockStateCalls: []types.SimBlock{
+						{Calls: []types.TransactionArgs{
+							{From: &sender, To: &recipient, Value: (*hexutil.Big)(amount), MaxFeePerGas: feeCap},
+						}},
+					},
+				}
+			},
+			false,
+			"insufficient funds",
+			nil,
+		},
+		{
+			"pass - validation refunds the leftover gas",
+			func() {
+				// the sender can only pay the full gas cost of the second call
+				// once the leftover gas of the first one is refunded
+				sender := utiltx.GenerateAddress()
+				balance := new(big.Int).Mul(new(big.Int).SetUint64(2*uint64(simGasLimit)), simGasPrice)
+				balance.Sub(balance, big.NewInt(1))
+				opts = types.SimOpts{
+					Validation: true,
+					BlockStateCalls: []types.SimBlock{
+						{
+							StateOverrides: types.StateOverride{sender: {Balance: (*hexutil.Big)(balance)}},
+							Calls: []types.TransactionArgs{
+								{From: &sender, To: &recipient, Gas: &simGasLimit, GasPrice: (*hexutil.Big)(simGasPrice)},
+								{From: &sender, To: &recipient, Gas: &simGasLimit, GasPrice: (*hexutil.Big)(simGasPrice)},
+							},
+						},
+					},
+				}
+			},
+			true,
+			"",
+			func(res *types.SimulateV1Response) {
+				suite.Require().Len(res.Blocks[0].Calls, 2)
+				suite.Require().Less(res.Blocks[0].Calls[0].GasUsed, uint64(simGasLimit))
+			},
+		},
+		{
+			"fail - validation charges the fees of the previous calls",
+			func() {
+				// the sender can't pay the full gas cost of the second call after
+				// paying the gas used by the first one, which is at least half of
+				// its gas limit
+				sender := utiltx.GenerateAddress()
+				balance := new(big.Int).Mul(new(big.Int).SetUint64(uint64(simGasLimit)+ethparams.TxGas), simGasPrice)
+				opts = types.SimOpts{
+					Validation: true,
+					BlockStateCalls: []types.SimBlock{
+						{
+							StateOverrides: types.StateOverride{sender: {Balance: (*hexutil.Big)(balance)}},
+							Calls: []types.TransactionArgs{
+								{From: &sender, To: &recipient, Gas: &simGasLimit, GasPrice: (*hexutil.Big)(simGasPrice)},
+								{From: &sender, To: &recipient, Gas: &simGasLimit, GasPrice: (*hexutil.Big)(simGasPrice)},
+							},
+						},
+					},
+				}
+			},
+			false,
+			"insufficient funds",
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdkmath.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			tc.malleate()
+
+			bz, err := json.Marshal(&opts)
+			suite.Require().NoError(err)
+
+			res, err := suite.queryClient.SimulateV1(suite.ctx, &types.SimulateV1Request{Opts: bz, GasCap: config.DefaultGasCap})
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+
+			suite.Require().NoError(err)
+			tc.check(res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) marshalCallArgs(args types.TransactionArgs) []byte {
+	bz, err := json.Marshal(&args)
+	suite.Require().NoError(err)
+	return bz
+}
+
 func (suite *KeeperTestSuite) TestEmptyRequest() {
 	k := suite.app.EvmKeeper
 
@@ -1423,6 +1918,18 @@ func (suite *KeeperTestSuite) TestEmptyRequest() {
 				return k.EstimateGas(suite.ctx, nil)
 			},
 		},
+		{
+			"CreateAccessList method",
+			func() (interface{}, error) {
+				return k.CreateAccessList(suite.ctx, nil)
+			},
+		},
+		{
+			"SimulateV1 method",
+			func() (interface{}, error) {
+				return k.SimulateV1(suite.ctx, nil)
+			},
+		},
 		{
 			"TraceTx method",
 			func() (interface{}, error) {
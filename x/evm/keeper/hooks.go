@@ -10,7 +10,10 @@ import (
 	"github.com/evmos/evmos/v15/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks         = MultiEvmHooks{}
+	_ types.EvmFailedTxHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -29,3 +32,18 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// PostFailedTxProcessing delegate the call to the underlying hooks that
+// implement the EvmFailedTxHooks interface
+func (mh MultiEvmHooks) PostFailedTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	for i := range mh {
+		h, ok := mh[i].(types.EvmFailedTxHooks)
+		if !ok {
+			continue
+		}
+		if err := h.PostFailedTxProcessing(ctx, msg, receipt); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
@@ -4,10 +4,12 @@ import (
 	"errors"
 	"math/big"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 
 	"github.com/evmos/evmos/v15/x/evm/keeper"
 	"github.com/evmos/evmos/v15/x/evm/statedb"
@@ -88,3 +90,85 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+// FailedTxRecordHook records the receipts of the failed txs
+type FailedTxRecordHook struct {
+	LogRecordHook
+	Receipts []*ethtypes.Receipt
+}
+
+func (dh *FailedTxRecordHook) PostFailedTxProcessing(_ sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	dh.Receipts = append(dh.Receipts, receipt)
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestEvmFailedTxHooks() {
+	suite.SetupTest()
+	suite.app.EvmKeeper = suite.app.EvmKeeper.CleanHooks()
+
+	// hooks without failed tx hook are skipped
+	k := suite.app.EvmKeeper
+	receipt := &ethtypes.Receipt{Status: ethtypes.ReceiptStatusFailed}
+	suite.Require().NoError(k.PostFailedTxProcessing(suite.ctx, ethtypes.Message{}, receipt))
+
+	hook := &FailedTxRecordHook{}
+	k.SetHooks(keeper.NewMultiEvmHooks(&LogRecordHook{}, hook))
+
+	err := k.PostFailedTxProcessing(suite.ctx, ethtypes.Message{}, receipt)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*ethtypes.Receipt{receipt}, hook.Receipts)
+}
+
+// FailedTxStateHook writes to the store on the failed txs and returns an error
+// if set
+type FailedTxStateHook struct {
+	LogRecordHook
+	storeKey storetypes.StoreKey
+	err      error
+}
+
+func (dh *FailedTxStateHook) PostFailedTxProcessing(ctx sdk.Context, _ core.Message, _ *ethtypes.Receipt) error {
+	ctx.KVStore(dh.storeKey).Set([]byte("failed"), []byte{1})
+	return dh.err
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionFailedTxHooks() {
+	testCases := []struct {
+		name      string
+		hookErr   error
+		expCommit bool
+	}{
+		{
+			"hook state committed",
+			nil,
+			true,
+		},
+		{
+			"hook error discards the hook state",
+			errors.New("failed tx post processing failed"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			storeKey := suite.app.GetKey(types.StoreKey)
+			suite.app.EvmKeeper = suite.app.EvmKeeper.CleanHooks()
+			suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(&FailedTxStateHook{storeKey: storeKey, err: tc.hookErr}))
+
+			// the transfer reverts as the amount is above the supply
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(5))
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			tx := suite.buildERC20TransferTx(contractAddr, common.BytesToAddress([]byte("recipient")), nonce)
+
+			res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx.AsTransaction())
+			suite.Require().NoError(err)
+			suite.Require().True(res.Failed())
+			// the hook errors don't replace the revert of the tx
+			suite.Require().Equal(vm.ErrExecutionReverted.Error(), res.VmError)
+
+			suite.Require().Equal(tc.expCommit, suite.ctx.KVStore(storeKey).Has([]byte("failed")))
+		})
+	}
+}
@@ -63,6 +63,14 @@ type Keeper struct {
 	// Some these precompiled contracts might not be active depending on the EVM
 	// parameters.
 	precompiles map[common.Address]vm.PrecompiledContract
+
+	// parallelExecutor speculatively executes the transactions of a block. It is
+	// nil when the parallel execution is disabled.
+	parallelExecutor *ParallelExecutor
+
+	// stateCache caches the contract code and storage read while delivering
+	// the current block.
+	stateCache *stateCache
 }
 
 // NewKeeper generates new evm module keeper
@@ -99,6 +107,7 @@ func NewKeeper(
 		transientKey:    transientKey,
 		tracer:          tracer,
 		ss:              ss,
+		stateCache:      newStateCache(),
 	}
 }
 
@@ -259,6 +268,27 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *et
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
 
+// PostFailedTxProcessing delegate the call to the hooks, if they implement the
+// EvmFailedTxHooks interface. Otherwise, this function returns with a `nil` error
+func (k *Keeper) PostFailedTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	hooks, ok := k.hooks.(types.EvmFailedTxHooks)
+	if !ok {
+		return nil
+	}
+	return hooks.PostFailedTxProcessing(ctx, msg, receipt)
+}
+
+// SetParallelExecutor sets the executor used to apply the speculative results of
+// the block transactions.
+func (k *Keeper) SetParallelExecutor(e *ParallelExecutor) *Keeper {
+	if k.parallelExecutor != nil {
+		panic("cannot set parallel executor twice")
+	}
+
+	k.parallelExecutor = e
+	return k
+}
+
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
@@ -267,6 +297,19 @@ func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainCo
 // GetAccountWithoutBalance load nonce and codehash without balance,
 // more efficient in cases where balance is not needed.
 func (k *Keeper) GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account {
+	if cached, found := k.stateCache.getAccount(ctx, addr); found {
+		ctx.GasMeter().ConsumeGas(cached.readGas, storetypes.GasReadCostFlatDesc)
+		return &cached.account
+	}
+
+	// the gas consumed is only read when the account can be cached, since reading
+	// it invalidates the speculative executions
+	cacheable := isCacheable(ctx)
+	var gasBefore storetypes.Gas
+	if cacheable {
+		gasBefore = ctx.GasMeter().GasConsumed()
+	}
+
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
 	if acct == nil {
@@ -279,10 +322,16 @@ func (k *Keeper) GetAccountWithoutBalance(ctx sdk.Context, addr common.Address)
 		codeHash = ethAcct.GetCodeHash().Bytes()
 	}
 
-	return &statedb.Account{
+	account := &statedb.Account{
 		Nonce:    acct.GetSequence(),
 		CodeHash: codeHash,
 	}
+
+	if cacheable {
+		k.stateCache.setAccount(ctx, addr, *account, ctx.GasMeter().GasConsumed()-gasBefore)
+	}
+
+	return account
 }
 
 // GetAccountOrEmpty returns empty account if not exist, returns error if it's not `EthAccount`
@@ -310,6 +359,13 @@ func (k *Keeper) GetNonce(ctx sdk.Context, addr common.Address) uint64 {
 	return acct.GetSequence()
 }
 
+// IsContract returns true if the account of the given address contains contract
+// code.
+func (k *Keeper) IsContract(ctx sdk.Context, addr common.Address) bool {
+	acct := k.GetAccountWithoutBalance(ctx, addr)
+	return acct != nil && acct.IsContract()
+}
+
 // GetBalance load account's balance of gas token
 func (k *Keeper) GetBalance(ctx sdk.Context, addr common.Address) *big.Int {
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
@@ -30,6 +30,12 @@ var _ types.MsgServer = &Keeper{}
 func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*types.MsgEthereumTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// The simulations run on the check state, so only the transactions
+	// delivered in the block read from the block state cache
+	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		ctx = WithStateCache(ctx)
+	}
+
 	sender := msg.From
 	tx := msg.AsTransaction()
 	txIndex := k.GetTxIndexTransient(ctx)
@@ -0,0 +1,579 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/armon/go-metrics"
+	abci "github.com/cometbft/cometbft/abci/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// errUnsafeSpeculation is returned when a speculative execution accessed state
+// that can't be validated once the transaction is delivered.
+var errUnsafeSpeculation = errors.New("speculative execution accessed untracked state")
+
+// ParallelStats holds the outcome of the speculative executions of a block.
+type ParallelStats struct {
+	// Hits is the number of transactions applied from their speculative result.
+	Hits uint64
+	// Conflicts is the number of speculative results discarded because the
+	// state they read was modified by a preceding transaction.
+	Conflicts uint64
+	// Misses is the number of transactions delivered without a speculative result.
+	Misses uint64
+}
+
+// ParallelExecutor optimistically executes the Ethereum transactions of a block
+// in parallel, in the spirit of Block-STM.
+//
+// The transactions of an accepted proposal are speculatively executed by a pool
+// of workers once BeginBlock has finished. Each execution runs the AnteHandler
+// and the EVM state transition on its own branch of a snapshot of the block
+// state taken at the end of BeginBlock, recording the keys read and written by
+// the state transition.
+//
+// Transactions are still delivered sequentially. When ApplyTransaction reaches
+// a transaction with a speculative result, the result is only used if every key
+// it read still holds the same value and the EVM configuration is unchanged. The
+// write set and events are then applied as-is. Otherwise the transaction is
+// re-executed against the current state. This guarantees that the results are
+// identical to a purely sequential execution.
+type ParallelExecutor struct {
+	keeper      *Keeper
+	anteHandler sdk.AnteHandler
+	txDecoder   sdk.TxDecoder
+	workers     int
+
+	mtx       sync.Mutex
+	proposals map[string]proposal
+	block     *speculativeBlock
+	stats     ParallelStats
+}
+
+// proposal is a block proposal accepted by ProcessProposal.
+type proposal struct {
+	height int64
+	txs    [][]byte
+}
+
+// NewParallelExecutor creates a new ParallelExecutor. The number of workers
+// defaults to the number of CPUs when it is not positive.
+func NewParallelExecutor(k *Keeper, anteHandler sdk.AnteHandler, txDecoder sdk.TxDecoder, workers int) *ParallelExecutor {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return &ParallelExecutor{
+		keeper:      k,
+		anteHandler: anteHandler,
+		txDecoder:   txDecoder,
+		workers:     workers,
+		proposals:   make(map[string]proposal),
+	}
+}
+
+// ProcessProposalHandler wraps the given handler to record the transactions of
+// the accepted proposals, so that they can be speculatively executed once the
+// block is delivered.
+func (e *ParallelExecutor) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestProcessProposal) abci.ResponseProcessProposal {
+		res := next(ctx, req)
+		if res.Status != abci.ResponseProcessProposal_ACCEPT {
+			return res
+		}
+
+		e.mtx.Lock()
+		defer e.mtx.Unlock()
+
+		for hash, p := range e.proposals {
+			if p.height < req.Height {
+				delete(e.proposals, hash)
+			}
+		}
+		e.proposals[string(req.Hash)] = proposal{height: req.Height, txs: req.Txs}
+		return res
+	}
+}
+
+// BeginBlocker wraps the given BeginBlocker to start the speculative execution
+// of the transactions of the block once it returns, if its proposal was
+// processed by this node.
+//
+// The speculative executions can't read the block state while the transactions
+// are delivered, so they run on an immutable snapshot of the state at the end
+// of BeginBlock: the last version committed to the given store along with the
+// writes of the BeginBlocker, which is run on a tracked branch of the block
+// state to record them.
+func (e *ParallelExecutor) BeginBlocker(cms storetypes.CommitMultiStore, next sdk.BeginBlocker) sdk.BeginBlocker {
+	return func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		txs := e.proposedTxs(ctx)
+		// NOTE: tracers write the execution traces as a side effect, so they must
+		// only run once and in order. The traced stores are not tracked either.
+		if len(txs) == 0 || e.keeper.tracer != "" || ctx.MultiStore().TracingEnabled() {
+			return next(ctx, req)
+		}
+
+		ms := newWriteTrackedMultiStore(ctx.MultiStore())
+		res := next(ctx.WithMultiStore(ms), req)
+
+		writeSet := ms.writeSet()
+		for _, access := range writeSet {
+			access.apply(ctx.MultiStore())
+		}
+
+		snapshot, err := newSnapshotMultiStore(cms, ctx.MultiStore(), writeSet)
+		if err != nil {
+			e.keeper.Logger(ctx).Debug("failed to snapshot the block state", "error", err)
+			return res
+		}
+
+		e.start(ctx.WithMultiStore(snapshot), txs)
+		return res
+	}
+}
+
+// proposedTxs returns the transactions of the current block, if its proposal
+// was processed by this node, and resets the statistics of the block.
+func (e *ParallelExecutor) proposedTxs(ctx sdk.Context) [][]byte {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	p := e.proposals[string(ctx.HeaderHash())]
+	for hash, p := range e.proposals {
+		if p.height <= ctx.BlockHeight() {
+			delete(e.proposals, hash)
+		}
+	}
+
+	e.stats = ParallelStats{}
+	return p.txs
+}
+
+// start starts the workers executing the given transactions on the snapshot
+// of the block state held by the context.
+func (e *ParallelExecutor) start(ctx sdk.Context, txs [][]byte) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	block := &speculativeBlock{
+		ctx:     ctx,
+		txs:     txs,
+		results: make(map[common.Hash]*speculativeTx, len(txs)),
+		claimed: make(map[common.Hash]bool, len(txs)),
+		done:    make(chan struct{}),
+	}
+	e.block = block
+
+	var wg sync.WaitGroup
+	for i := 0; i < e.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.run(block)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(block.done)
+	}()
+}
+
+// EndBlock stops the speculative execution of the current block and waits for
+// the workers to exit. It must be called before the block state is committed.
+func (e *ParallelExecutor) EndBlock(ctx sdk.Context) {
+	e.mtx.Lock()
+	block := e.block
+	e.block = nil
+	stats := e.stats
+	e.mtx.Unlock()
+
+	if block == nil {
+		return
+	}
+
+	block.stopped.Store(true)
+	<-block.done
+
+	e.keeper.Logger(ctx).Debug(
+		"parallel execution finished",
+		"height", ctx.BlockHeight(),
+		"hits", stats.Hits,
+		"conflicts", stats.Conflicts,
+		"misses", stats.Misses,
+	)
+}
+
+// Wait blocks until the speculative execution of the current block has
+// finished.
+func (e *ParallelExecutor) Wait() {
+	e.mtx.Lock()
+	block := e.block
+	e.mtx.Unlock()
+
+	if block != nil {
+		<-block.done
+	}
+}
+
+// Stats returns the outcome of the speculative executions of the current block.
+func (e *ParallelExecutor) Stats() ParallelStats {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	return e.stats
+}
+
+// run executes the transactions of the block until all of them have been
+// picked up or the block is stopped.
+func (e *ParallelExecutor) run(block *speculativeBlock) {
+	for !block.stopped.Load() {
+		i := int(block.next.Add(1)) - 1
+		if i >= len(block.txs) {
+			return
+		}
+		e.speculate(block, block.txs[i])
+	}
+}
+
+// speculate runs the AnteHandler and the EVM state transition of the given
+// transaction on a branch of the block state snapshot and stores its result.
+func (e *ParallelExecutor) speculate(block *speculativeBlock, txBytes []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.keeper.Logger(block.ctx).Debug("speculative execution panicked", "panic", r)
+		}
+	}()
+
+	tx, err := e.txDecoder(txBytes)
+	if err != nil {
+		return
+	}
+
+	// only transactions with a single MsgEthereumTx are executed in parallel,
+	// as the messages of a transaction depend on each other.
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return
+	}
+
+	msg, ok := msgs[0].(*types.MsgEthereumTx)
+	if !ok {
+		return
+	}
+
+	ethTx := msg.AsTransaction()
+	hash := ethTx.Hash()
+	if block.isClaimed(hash) {
+		return
+	}
+
+	// NOTE: the gas meters of the block context are used by the transactions
+	// being delivered, so the speculative execution uses its own.
+	blockGasMeter := sdk.NewInfiniteGasMeter()
+	if block.ctx.BlockGasMeter() != nil && block.ctx.BlockGasMeter().Limit() != 0 {
+		blockGasMeter = sdk.NewGasMeter(block.ctx.BlockGasMeter().Limit())
+	}
+
+	ctx := block.ctx.
+		WithMultiStore(block.ctx.MultiStore().CacheMultiStore()).
+		WithTxBytes(txBytes).
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+
+	ctx, err = e.anteHandler(ctx, tx, false)
+	if err != nil {
+		return
+	}
+
+	res, err := e.keeper.speculateTransaction(ctx, ethTx)
+	if err != nil {
+		return
+	}
+
+	block.store(hash, res)
+}
+
+// take returns the speculative result of the given transaction, if any. Once
+// taken, a transaction is never executed nor returned again.
+func (e *ParallelExecutor) take(hash common.Hash) *speculativeTx {
+	e.mtx.Lock()
+	block := e.block
+	e.mtx.Unlock()
+
+	if block == nil {
+		return nil
+	}
+
+	return block.take(hash)
+}
+
+// recordOutcome updates the statistics and metrics of the current block.
+func (e *ParallelExecutor) recordOutcome(outcome string) {
+	e.mtx.Lock()
+	switch outcome {
+	case "hit":
+		e.stats.Hits++
+	case "conflict":
+		e.stats.Conflicts++
+	default:
+		e.stats.Misses++
+	}
+	e.mtx.Unlock()
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "parallel", "tx", "total"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("outcome", outcome)},
+	)
+}
+
+// speculativeBlock holds the state of the speculative execution of a block.
+type speculativeBlock struct {
+	ctx     sdk.Context
+	txs     [][]byte
+	next    atomic.Int64
+	stopped atomic.Bool
+	done    chan struct{}
+
+	mtx     sync.Mutex
+	results map[common.Hash]*speculativeTx
+	// claimed holds the transactions that were already delivered.
+	claimed map[common.Hash]bool
+}
+
+func (b *speculativeBlock) isClaimed(hash common.Hash) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return b.claimed[hash]
+}
+
+func (b *speculativeBlock) store(hash common.Hash, res *speculativeTx) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if !b.claimed[hash] {
+		b.results[hash] = res
+	}
+}
+
+func (b *speculativeBlock) take(hash common.Hash) *speculativeTx {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	res := b.results[hash]
+	delete(b.results, hash)
+	b.claimed[hash] = true
+	return res
+}
+
+// speculativeTx is the result of the speculative execution of an Ethereum
+// transaction, together with the inputs required to validate it.
+type speculativeTx struct {
+	cfg      *statedb.EVMConfig
+	response *types.MsgEthereumTxResponse
+	events   sdk.Events
+	readSet  []storeAccess
+	writeSet []storeAccess
+	// gasObserved is set when the execution inspected the transaction gas meter,
+	// in which case the gas consumed prior to the execution is an input too.
+	gasObserved bool
+	gasBefore   uint64
+	gasLimit    uint64
+	gasUsed     uint64
+}
+
+// speculateTransaction applies the given transaction on a tracked branch of the
+// context and returns its result along with its read and write sets. The
+// context is not modified.
+func (k *Keeper) speculateTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*speculativeTx, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to return ethereum transaction as core message")
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	gasMeter := newObservedGasMeter(ctx.GasMeter())
+	blockGasMeter := newObservedGasMeter(ctx.BlockGasMeter())
+	ms := newTrackedMultiStore(ctx.MultiStore())
+
+	ctx = ctx.
+		WithMultiStore(ms).
+		WithGasMeter(gasMeter).
+		WithBlockGasMeter(blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+
+	// the transaction and log indexes are only known once the transaction is
+	// delivered, they are set on the logs by applySpeculativeTx.
+	txConfig := statedb.NewTxConfig(common.BytesToHash(ctx.HeaderHash()), tx.Hash(), 0, 0)
+
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if ms.tracker.iterated || blockGasMeter.observed {
+		return nil, errUnsafeSpeculation
+	}
+
+	return &speculativeTx{
+		cfg:         cfg,
+		response:    res,
+		events:      ctx.EventManager().Events(),
+		readSet:     ms.readSet(),
+		writeSet:    ms.writeSet(),
+		gasObserved: gasMeter.observed,
+		gasBefore:   gasBefore,
+		gasLimit:    gasMeter.GasMeter.Limit(),
+		gasUsed:     gasMeter.GasMeter.GasConsumed() - gasBefore,
+	}, nil
+}
+
+// applySpeculativeTx applies the speculative result of the given transaction,
+// if there is one and it is still valid on the given context. It returns false
+// when the transaction must be executed.
+func (k *Keeper) applySpeculativeTx(
+	ctx sdk.Context,
+	tx *ethtypes.Transaction,
+	cfg *statedb.EVMConfig,
+	txConfig statedb.TxConfig,
+) (*types.MsgEthereumTxResponse, bool) {
+	if k.parallelExecutor == nil {
+		return nil, false
+	}
+
+	spec := k.parallelExecutor.take(tx.Hash())
+	if spec == nil {
+		k.parallelExecutor.recordOutcome("miss")
+		return nil, false
+	}
+
+	if !k.isSpeculationValid(ctx, spec, cfg) {
+		k.parallelExecutor.recordOutcome("conflict")
+		return nil, false
+	}
+
+	for _, access := range spec.writeSet {
+		for _, entry := range access.entries {
+			k.stateCache.markKeyDirty(ctx, access.storeKey, entry.key)
+		}
+		access.apply(ctx.MultiStore())
+	}
+
+	ctx.GasMeter().ConsumeGas(spec.gasUsed, "speculative evm state transition")
+	ctx.EventManager().EmitEvents(spec.events)
+
+	res := *spec.response
+	if spec.response.Logs != nil {
+		res.Logs = make([]*types.Log, len(spec.response.Logs))
+		for i, log := range spec.response.Logs {
+			l := *log
+			l.TxIndex = uint64(txConfig.TxIndex)
+			l.Index = uint64(txConfig.LogIndex) + uint64(i)
+			res.Logs[i] = &l
+		}
+	}
+
+	k.parallelExecutor.recordOutcome("hit")
+	return &res, true
+}
+
+// isSpeculationValid returns true if all the inputs of the speculative execution
+// are unchanged on the given context.
+func (k *Keeper) isSpeculationValid(ctx sdk.Context, spec *speculativeTx, cfg *statedb.EVMConfig) bool {
+	if spec.cfg.CoinBase != cfg.CoinBase {
+		return false
+	}
+
+	if (spec.cfg.BaseFee == nil) != (cfg.BaseFee == nil) ||
+		(cfg.BaseFee != nil && spec.cfg.BaseFee.Cmp(cfg.BaseFee) != 0) {
+		return false
+	}
+
+	if !bytes.Equal(k.cdc.MustMarshal(&spec.cfg.Params), k.cdc.MustMarshal(&cfg.Params)) {
+		return false
+	}
+
+	if spec.gasObserved &&
+		(spec.gasBefore != ctx.GasMeter().GasConsumed() || spec.gasLimit != ctx.GasMeter().Limit()) {
+		return false
+	}
+
+	for _, access := range spec.readSet {
+		if !access.validate(ctx.MultiStore()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// observedGasMeter wraps a GasMeter and records whether the gas it consumed was
+// read. The limit is not tracked, as it is fixed for a block and transaction.
+type observedGasMeter struct {
+	sdk.GasMeter
+	observed bool
+}
+
+func newObservedGasMeter(gasMeter sdk.GasMeter) *observedGasMeter {
+	return &observedGasMeter{GasMeter: gasMeter}
+}
+
+// GasConsumed implements sdk.GasMeter.
+func (gm *observedGasMeter) GasConsumed() sdk.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasConsumed()
+}
+
+// GasConsumedToLimit implements sdk.GasMeter.
+func (gm *observedGasMeter) GasConsumedToLimit() sdk.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasConsumedToLimit()
+}
+
+// GasRemaining implements sdk.GasMeter.
+func (gm *observedGasMeter) GasRemaining() sdk.Gas {
+	gm.observed = true
+	return gm.GasMeter.GasRemaining()
+}
+
+// IsPastLimit implements sdk.GasMeter.
+func (gm *observedGasMeter) IsPastLimit() bool {
+	gm.observed = true
+	return gm.GasMeter.IsPastLimit()
+}
+
+// IsOutOfGas implements sdk.GasMeter.
+func (gm *observedGasMeter) IsOutOfGas() bool {
+	gm.observed = true
+	return gm.GasMeter.IsOutOfGas()
+}
+
+// String implements sdk.GasMeter.
+func (gm *observedGasMeter) String() string {
+	gm.observed = true
+	return gm.GasMeter.String()
+}
@@ -0,0 +1,522 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// storeEntry is a single key-value pair read from or written to a KVStore. A nil
+// value with exists set to false represents a missing (or deleted) key.
+type storeEntry struct {
+	key    []byte
+	value  []byte
+	exists bool
+}
+
+// storeAccess groups the entries accessed on a single KVStore.
+type storeAccess struct {
+	storeKey storetypes.StoreKey
+	entries  []storeEntry
+}
+
+// validate returns true if all the entries still hold the same value on the
+// given multistore.
+func (sa storeAccess) validate(ms storetypes.MultiStore) bool {
+	store := ms.GetKVStore(sa.storeKey)
+	for _, entry := range sa.entries {
+		value := store.Get(entry.key)
+		if (value != nil) != entry.exists || !bytes.Equal(value, entry.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// apply writes all the entries to the given multistore.
+func (sa storeAccess) apply(ms storetypes.MultiStore) {
+	store := ms.GetKVStore(sa.storeKey)
+	for _, entry := range sa.entries {
+		if entry.exists {
+			store.Set(entry.key, entry.value)
+		} else {
+			store.Delete(entry.key)
+		}
+	}
+}
+
+// accessTracker records the read and write sets of an execution performed
+// against a trackedMultiStore.
+type accessTracker struct {
+	// reads holds the first value observed for every key that was read before
+	// being written by the execution itself.
+	reads map[storetypes.StoreKey]map[string]storeEntry
+	// written holds the keys written by the execution.
+	written map[storetypes.StoreKey]map[string]struct{}
+	// iterated is set when the execution iterates over a store. Ranges can't be
+	// validated key by key, so the execution is then discarded.
+	iterated bool
+}
+
+func newAccessTracker() *accessTracker {
+	return &accessTracker{
+		reads:   make(map[storetypes.StoreKey]map[string]storeEntry),
+		written: make(map[storetypes.StoreKey]map[string]struct{}),
+	}
+}
+
+func (t *accessTracker) recordRead(storeKey storetypes.StoreKey, key, value []byte) {
+	// the reads are not recorded by the trackers of the write sets only
+	if t.reads == nil {
+		return
+	}
+
+	if _, ok := t.written[storeKey][string(key)]; ok {
+		return
+	}
+
+	reads, ok := t.reads[storeKey]
+	if !ok {
+		reads = make(map[string]storeEntry)
+		t.reads[storeKey] = reads
+	}
+
+	if _, ok := reads[string(key)]; ok {
+		return
+	}
+
+	reads[string(key)] = storeEntry{
+		key:    bytes.Clone(key),
+		value:  bytes.Clone(value),
+		exists: value != nil,
+	}
+}
+
+func (t *accessTracker) recordWrite(storeKey storetypes.StoreKey, key []byte) {
+	written, ok := t.written[storeKey]
+	if !ok {
+		written = make(map[string]struct{})
+		t.written[storeKey] = written
+	}
+	written[string(key)] = struct{}{}
+}
+
+// trackedMultiStore is a MultiStore that branches every KVStore of its parent
+// and records the keys read and written through them. The parent is never
+// modified.
+type trackedMultiStore struct {
+	parent  storetypes.MultiStore
+	tracker *accessTracker
+	stores  map[storetypes.StoreKey]*trackedStore
+}
+
+var _ storetypes.MultiStore = &trackedMultiStore{}
+
+func newTrackedMultiStore(parent storetypes.MultiStore) *trackedMultiStore {
+	return &trackedMultiStore{
+		parent:  parent,
+		tracker: newAccessTracker(),
+		stores:  make(map[storetypes.StoreKey]*trackedStore),
+	}
+}
+
+// newWriteTrackedMultiStore returns a trackedMultiStore that only records the
+// keys written through it.
+func newWriteTrackedMultiStore(parent storetypes.MultiStore) *trackedMultiStore {
+	ms := newTrackedMultiStore(parent)
+	ms.tracker.reads = nil
+	return ms
+}
+
+// readSet returns the values read by the execution, sorted by key.
+func (ms *trackedMultiStore) readSet() []storeAccess {
+	readSet := make([]storeAccess, 0, len(ms.tracker.reads))
+	for storeKey, reads := range ms.tracker.reads {
+		access := storeAccess{storeKey: storeKey, entries: make([]storeEntry, 0, len(reads))}
+		for _, entry := range reads {
+			access.entries = append(access.entries, entry)
+		}
+		readSet = append(readSet, access.sorted())
+	}
+	return sortStoreAccesses(readSet)
+}
+
+// writeSet returns the final values written by the execution, sorted by key.
+func (ms *trackedMultiStore) writeSet() []storeAccess {
+	writeSet := make([]storeAccess, 0, len(ms.tracker.written))
+	for storeKey, written := range ms.tracker.written {
+		store := ms.stores[storeKey]
+		access := storeAccess{storeKey: storeKey, entries: make([]storeEntry, 0, len(written))}
+		for key := range written {
+			value := store.cache.Get([]byte(key))
+			access.entries = append(access.entries, storeEntry{
+				key:    []byte(key),
+				value:  value,
+				exists: value != nil,
+			})
+		}
+		writeSet = append(writeSet, access.sorted())
+	}
+	return sortStoreAccesses(writeSet)
+}
+
+// GetStoreType implements storetypes.Store.
+func (ms *trackedMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (ms *trackedMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore().(storetypes.CacheWrap)
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper.
+func (ms *trackedMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements storetypes.MultiStore. The returned branch reads
+// through the tracked stores so that nested cache contexts are tracked too.
+func (ms *trackedMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore. Historical
+// versions are not accessible during a tracked execution.
+func (ms *trackedMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errortypes.ErrInvalidVersion
+}
+
+// GetStore implements storetypes.MultiStore.
+func (ms *trackedMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *trackedMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = &trackedStore{
+			cache:    cachekv.NewStore(ms.parent.GetKVStore(key)),
+			storeKey: key,
+			tracker:  ms.tracker,
+		}
+		ms.stores[key] = store
+	}
+	return store
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (ms *trackedMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements storetypes.MultiStore.
+func (ms *trackedMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements storetypes.MultiStore.
+func (ms *trackedMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (ms *trackedMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+// trackedStore is a branched KVStore that reports its accesses to an accessTracker.
+type trackedStore struct {
+	cache    storetypes.CacheKVStore
+	storeKey storetypes.StoreKey
+	tracker  *accessTracker
+}
+
+var _ storetypes.KVStore = &trackedStore{}
+
+// GetStoreType implements storetypes.Store.
+func (s *trackedStore) GetStoreType() storetypes.StoreType {
+	return s.cache.GetStoreType()
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (s *trackedStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper.
+func (s *trackedStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// Get implements storetypes.KVStore.
+func (s *trackedStore) Get(key []byte) []byte {
+	value := s.cache.Get(key)
+	s.tracker.recordRead(s.storeKey, key, value)
+	return value
+}
+
+// Has implements storetypes.KVStore.
+func (s *trackedStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements storetypes.KVStore.
+func (s *trackedStore) Set(key, value []byte) {
+	s.tracker.recordWrite(s.storeKey, key)
+	s.cache.Set(key, value)
+}
+
+// Delete implements storetypes.KVStore.
+func (s *trackedStore) Delete(key []byte) {
+	s.tracker.recordWrite(s.storeKey, key)
+	s.cache.Delete(key)
+}
+
+// Iterator implements storetypes.KVStore.
+func (s *trackedStore) Iterator(start, end []byte) storetypes.Iterator {
+	s.tracker.iterated = true
+	return s.cache.Iterator(start, end)
+}
+
+// ReverseIterator implements storetypes.KVStore.
+func (s *trackedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	s.tracker.iterated = true
+	return s.cache.ReverseIterator(start, end)
+}
+
+// branchMultiStore is a minimal CacheMultiStore that lazily branches the
+// KVStores of its parent and writes them back on Write.
+type branchMultiStore struct {
+	parent storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+	// keys preserves the order in which the stores were branched so that
+	// Write is deterministic.
+	keys []storetypes.StoreKey
+}
+
+var _ storetypes.CacheMultiStore = &branchMultiStore{}
+
+func newBranchMultiStore(parent storetypes.MultiStore) *branchMultiStore {
+	return &branchMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+// GetStoreType implements storetypes.Store.
+func (ms *branchMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (ms *branchMultiStore) CacheWrap() storetypes.CacheWrap {
+	return newBranchMultiStore(ms)
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper.
+func (ms *branchMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (ms *branchMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore.
+func (ms *branchMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errortypes.ErrInvalidVersion
+}
+
+// GetStore implements storetypes.MultiStore.
+func (ms *branchMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *branchMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = cachekv.NewStore(ms.parent.GetKVStore(key))
+		ms.stores[key] = store
+		ms.keys = append(ms.keys, key)
+	}
+	return store
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (ms *branchMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements storetypes.MultiStore.
+func (ms *branchMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements storetypes.MultiStore.
+func (ms *branchMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (ms *branchMultiStore) LatestVersion() int64 {
+	return ms.parent.LatestVersion()
+}
+
+// Write implements storetypes.CacheMultiStore.
+func (ms *branchMultiStore) Write() {
+	for _, key := range ms.keys {
+		ms.stores[key].Write()
+	}
+}
+
+// snapshotMultiStore is a read-only MultiStore holding the state of the block at
+// the end of BeginBlock. Its KVStores are built once, so that it can be branched
+// and read concurrently while the block state is modified.
+type snapshotMultiStore struct {
+	stores  map[storetypes.StoreKey]storetypes.CacheKVStore
+	version int64
+}
+
+var _ storetypes.MultiStore = &snapshotMultiStore{}
+
+// newSnapshotMultiStore snapshots the state of the given block store. The
+// persistent stores are read from the last version committed to the commit
+// store, which must be immutable, and the given writes of the block are
+// applied on top of them. The transient and memory stores are copied.
+func newSnapshotMultiStore(
+	cms storetypes.CommitMultiStore,
+	block storetypes.MultiStore,
+	writeSet []storeAccess,
+) (*snapshotMultiStore, error) {
+	keysByName, ok := cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil, fmt.Errorf("commit multistore %T does not list its store keys", cms)
+	}
+
+	ms := &snapshotMultiStore{
+		stores:  make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+		version: cms.LatestVersion(),
+	}
+
+	for _, key := range keysByName.StoreKeysByName() {
+		switch store := cms.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			immutable, err := store.GetImmutable(ms.version)
+			if err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to load version %d of store %s", ms.version, key.Name())
+			}
+			ms.stores[key] = cachekv.NewStore(immutable)
+		default:
+			ms.stores[key] = copyKVStore(block.GetKVStore(key))
+		}
+	}
+
+	for _, access := range writeSet {
+		access.apply(ms)
+	}
+
+	return ms, nil
+}
+
+// copyKVStore returns an in-memory copy of the given store.
+func copyKVStore(store storetypes.KVStore) storetypes.CacheKVStore {
+	cp := cachekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()})
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cp.Set(iterator.Key(), iterator.Value())
+	}
+	return cp
+}
+
+// GetStoreType implements storetypes.Store.
+func (ms *snapshotMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements storetypes.CacheWrapper.
+func (ms *snapshotMultiStore) CacheWrap() storetypes.CacheWrap {
+	return newBranchMultiStore(ms)
+}
+
+// CacheWrapWithTrace implements storetypes.CacheWrapper.
+func (ms *snapshotMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStore implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	return nil, errortypes.ErrInvalidVersion
+}
+
+// GetStore implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		panic(fmt.Sprintf("store %s is not part of the snapshot", key.Name()))
+	}
+	return store
+}
+
+// TracingEnabled implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// LatestVersion implements storetypes.MultiStore.
+func (ms *snapshotMultiStore) LatestVersion() int64 {
+	return ms.version
+}
+
+func (sa storeAccess) sorted() storeAccess {
+	sort.Slice(sa.entries, func(i, j int) bool {
+		return bytes.Compare(sa.entries[i].key, sa.entries[j].key) < 0
+	})
+	return sa
+}
+
+func sortStoreAccesses(accesses []storeAccess) []storeAccess {
+	sort.Slice(accesses, func(i, j int) bool {
+		return accesses[i].storeKey.Name() < accesses[j].storeKey.Name()
+	})
+	return accesses
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/evmos/v15/x/evm/keeper"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestParallelExecutor() {
+	var (
+		contractAddr common.Address
+		conflictTx   *evmtypes.MsgEthereumTx
+	)
+
+	// the AnteHandler is not run by ApplyTransaction, so it's skipped by the
+	// speculative execution too.
+	anteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	blockHash := tmhash.Sum([]byte("block"))
+
+	applyConflictTx := func(ctx sdk.Context) {
+		_, err := suite.app.EvmKeeper.ApplyTransaction(ctx, conflictTx.AsTransaction())
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name       string
+		propose    bool
+		beginBlock func(ctx sdk.Context)
+		malleate   func(ctx sdk.Context)
+		expStats   keeper.ParallelStats
+		expLogIdx  uint64
+	}{
+		{
+			"speculative result applied",
+			true,
+			func(sdk.Context) {},
+			func(sdk.Context) {},
+			keeper.ParallelStats{Hits: 1},
+			0,
+		},
+		{
+			"speculative result reads the state changes of the BeginBlocker",
+			true,
+			applyConflictTx,
+			func(sdk.Context) {},
+			keeper.ParallelStats{Hits: 1, Misses: 1},
+			1,
+		},
+		{
+			"speculative result conflicts with a preceding transaction",
+			true,
+			func(sdk.Context) {},
+			applyConflictTx,
+			keeper.ParallelStats{Conflicts: 1, Misses: 1},
+			1,
+		},
+		{
+			"proposal not processed by the node",
+			false,
+			func(sdk.Context) {},
+			func(sdk.Context) {},
+			keeper.ParallelStats{Misses: 1},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			txConfig := suite.clientCtx.TxConfig
+
+			// the speculative executions read the last committed state
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			suite.Commit()
+			suite.ctx = suite.ctx.WithHeaderHash(blockHash)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			tx := suite.buildERC20TransferTx(contractAddr, common.BytesToAddress([]byte("recipient")), nonce)
+			conflictTx = suite.buildERC20TransferTx(contractAddr, common.BytesToAddress([]byte("other")), nonce+1)
+
+			executor := keeper.NewParallelExecutor(suite.app.EvmKeeper, anteHandler, txConfig.TxDecoder(), 2)
+			suite.app.EvmKeeper.SetParallelExecutor(executor)
+
+			// sequential execution
+			seqCtx, _ := suite.ctx.CacheContext()
+			tc.beginBlock(seqCtx)
+			tc.malleate(seqCtx)
+			expRes, err := suite.app.EvmKeeper.ApplyTransaction(seqCtx, tx.AsTransaction())
+			suite.Require().NoError(err)
+
+			// parallel execution
+			ctx, _ := suite.ctx.CacheContext()
+			if tc.propose {
+				builtTx, err := tx.BuildTx(txConfig.NewTxBuilder(), suite.EvmDenom())
+				suite.Require().NoError(err)
+				bz, err := txConfig.TxEncoder()(builtTx)
+				suite.Require().NoError(err)
+
+				processProposal := executor.ProcessProposalHandler(baseapp.NoOpProcessProposal())
+				res := processProposal(ctx, abci.RequestProcessProposal{
+					Txs:    [][]byte{bz},
+					Hash:   blockHash,
+					Height: ctx.BlockHeight(),
+				})
+				suite.Require().Equal(abci.ResponseProcessProposal_ACCEPT, res.Status)
+			}
+
+			beginBlocker := executor.BeginBlocker(suite.app.CommitMultiStore(), func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+				tc.beginBlock(ctx)
+				return abci.ResponseBeginBlock{}
+			})
+			beginBlocker(ctx, abci.RequestBeginBlock{})
+			executor.Wait()
+
+			tc.malleate(ctx)
+			res, err := suite.app.EvmKeeper.ApplyTransaction(ctx, tx.AsTransaction())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expStats, executor.Stats())
+			executor.EndBlock(ctx)
+
+			suite.Require().Equal(expRes, res)
+			suite.Require().Len(res.Logs, 1)
+			suite.Require().Equal(tc.expLogIdx, res.Logs[0].Index)
+			suite.Require().Equal(seqCtx.EventManager().Events(), ctx.EventManager().Events())
+			for _, key := range []string{evmtypes.StoreKey, banktypes.StoreKey, authtypes.StoreKey} {
+				suite.Require().Equal(suite.storeContent(seqCtx, key), suite.storeContent(ctx, key), key)
+			}
+		})
+	}
+}
+
+// TestParallelExecutorConcurrentDelivery delivers the transactions of a block
+// while they are speculatively executed. It must be run with the race detector.
+func (suite *KeeperTestSuite) TestParallelExecutorConcurrentDelivery() {
+	anteHandler := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+	blockHash := tmhash.Sum([]byte("block"))
+
+	suite.SetupTest()
+	txConfig := suite.clientCtx.TxConfig
+
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+	suite.Commit()
+	suite.ctx = suite.ctx.WithHeaderHash(blockHash)
+
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	txs := make([]*evmtypes.MsgEthereumTx, 16)
+	txsBytes := make([][]byte, len(txs))
+	for i := range txs {
+		txs[i] = suite.buildERC20TransferTx(contractAddr, common.BigToAddress(big.NewInt(int64(i+1))), nonce+uint64(i))
+		builtTx, err := txs[i].BuildTx(txConfig.NewTxBuilder(), suite.EvmDenom())
+		suite.Require().NoError(err)
+		txsBytes[i], err = txConfig.TxEncoder()(builtTx)
+		suite.Require().NoError(err)
+	}
+
+	executor := keeper.NewParallelExecutor(suite.app.EvmKeeper, anteHandler, txConfig.TxDecoder(), 4)
+	suite.app.EvmKeeper.SetParallelExecutor(executor)
+
+	// sequential execution
+	seqCtx, _ := suite.ctx.CacheContext()
+	expRes := make([]*evmtypes.MsgEthereumTxResponse, len(txs))
+	for i, tx := range txs {
+		res, err := suite.app.EvmKeeper.ApplyTransaction(seqCtx, tx.AsTransaction())
+		suite.Require().NoError(err)
+		expRes[i] = res
+	}
+
+	// parallel execution, the transactions are delivered without waiting for
+	// the speculative executions
+	ctx, _ := suite.ctx.CacheContext()
+	processProposal := executor.ProcessProposalHandler(baseapp.NoOpProcessProposal())
+	res := processProposal(ctx, abci.RequestProcessProposal{
+		Txs:    txsBytes,
+		Hash:   blockHash,
+		Height: ctx.BlockHeight(),
+	})
+	suite.Require().Equal(abci.ResponseProcessProposal_ACCEPT, res.Status)
+
+	beginBlocker := executor.BeginBlocker(suite.app.CommitMultiStore(), func(sdk.Context, abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		return abci.ResponseBeginBlock{}
+	})
+	beginBlocker(ctx, abci.RequestBeginBlock{})
+
+	for i, tx := range txs {
+		res, err := suite.app.EvmKeeper.ApplyTransaction(ctx, tx.AsTransaction())
+		suite.Require().NoError(err)
+		suite.Require().Equal(expRes[i], res)
+	}
+
+	stats := executor.Stats()
+	executor.EndBlock(ctx)
+
+	suite.Require().Equal(uint64(len(txs)), stats.Hits+stats.Conflicts+stats.Misses)
+	suite.Require().Equal(seqCtx.EventManager().Events(), ctx.EventManager().Events())
+	for _, key := range []string{evmtypes.StoreKey, banktypes.StoreKey, authtypes.StoreKey} {
+		suite.Require().Equal(suite.storeContent(seqCtx, key), suite.storeContent(ctx, key), key)
+	}
+}
+
+func (suite *KeeperTestSuite) buildERC20TransferTx(contractAddr, to common.Address, nonce uint64) *evmtypes.MsgEthereumTx {
+	transferData, err := evmtypes.ERC20Contract.ABI.Pack("transfer", to, big.NewInt(10))
+	suite.Require().NoError(err)
+
+	tx := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:  suite.app.EvmKeeper.ChainID(),
+		Nonce:    nonce,
+		To:       &contractAddr,
+		GasLimit: 100_000,
+		Input:    transferData,
+	})
+	tx.From = suite.address.Hex()
+	err = tx.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), suite.signer)
+	suite.Require().NoError(err)
+	return tx
+}
+
+func (suite *KeeperTestSuite) storeContent(ctx sdk.Context, storeKey string) map[string][]byte {
+	content := make(map[string][]byte)
+	iterator := ctx.KVStore(suite.app.GetKey(storeKey)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		content[string(iterator.Key())] = iterator.Value()
+	}
+	return content
+}
@@ -14,16 +14,21 @@ import (
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 	distprecompile "github.com/evmos/evmos/v15/precompiles/distribution"
+	erc20registryprecompile "github.com/evmos/evmos/v15/precompiles/erc20registry"
 	ics20precompile "github.com/evmos/evmos/v15/precompiles/ics20"
+	ics27precompile "github.com/evmos/evmos/v15/precompiles/ics27"
+	incentivesprecompile "github.com/evmos/evmos/v15/precompiles/incentives"
 	strideoutpost "github.com/evmos/evmos/v15/precompiles/outposts/stride"
 	"github.com/evmos/evmos/v15/precompiles/p256"
 	stakingprecompile "github.com/evmos/evmos/v15/precompiles/staking"
 	vestingprecompile "github.com/evmos/evmos/v15/precompiles/vesting"
 	erc20Keeper "github.com/evmos/evmos/v15/x/erc20/keeper"
 	transferkeeper "github.com/evmos/evmos/v15/x/ibc/transfer/keeper"
+	incentiveskeeper "github.com/evmos/evmos/v15/x/incentives/keeper"
 	vestingkeeper "github.com/evmos/evmos/v15/x/vesting/keeper"
 )
 
@@ -37,6 +42,8 @@ func AvailablePrecompiles(
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	incentivesKeeper incentiveskeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -44,7 +51,7 @@ func AvailablePrecompiles(
 	// secp256r1 precompile as per EIP-7212
 	p256Precompile := &p256.Precompile{}
 
-	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, authzKeeper)
+	stakingPrecompile, err := stakingprecompile.NewPrecompile(stakingKeeper, vestingKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to load staking precompile: %w", err))
 	}
@@ -69,12 +76,30 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load stride outpost: %w", err))
 	}
 
+	incentivesPrecompile, err := incentivesprecompile.NewPrecompile(incentivesKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load incentives precompile: %w", err))
+	}
+
+	icaPrecompile, err := ics27precompile.NewPrecompile(icaControllerKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load ICS27 precompile: %w", err))
+	}
+
+	erc20RegistryPrecompile, err := erc20registryprecompile.NewPrecompile(erc20Keeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load ERC20 registry precompile: %w", err))
+	}
+
 	precompiles[p256Precompile.Address()] = p256Precompile
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
 	precompiles[strideOutpost.Address()] = strideOutpost
+	precompiles[incentivesPrecompile.Address()] = incentivesPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[erc20RegistryPrecompile.Address()] = erc20RegistryPrecompile
 	return precompiles
 }
 
@@ -0,0 +1,271 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	ethparams "github.com/ethereum/go-ethereum/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+// SimulateV1 implements eth_simulateV1. It executes the calls of the given blocks
+// sequentially on top of the requested state, so that each call observes the state
+// changes of the previous ones. No state changes are persisted.
+func (k Keeper) SimulateV1(c context.Context, req *types.SimulateV1Request) (*types.SimulateV1Response, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var opts types.SimOpts
+	if err := json.Unmarshal(req.Opts, &opts); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chainID, err := getChainID(ctx, req.ChainId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cfg, err := k.EVMConfig(ctx, GetProposerAddress(ctx, req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// all the blocks are executed on a cached context that is never written. The
+	// context is isolated from the block state cache, as the simulated blocks
+	// override the block height and state.
+	simCtx, _ := ctx.CacheContext()
+	simCtx = WithoutStateCache(simCtx)
+
+	var (
+		number       = ctx.BlockHeight()
+		timestamp    = uint64(ctx.BlockTime().Unix())
+		gasRemaining = req.GasCap
+		blocks       = make([]types.SimulatedBlock, 0, len(opts.BlockStateCalls))
+	)
+
+	for _, block := range opts.BlockStateCalls {
+		// by default, each block is built on top of the previous one
+		number++
+		timestamp++
+
+		blockCfg := *cfg
+		if overrides := block.BlockOverrides; overrides != nil {
+			if overrides.Number != nil {
+				if overrides.Number.ToInt().Int64() < number {
+					return nil, status.Errorf(codes.InvalidArgument, "block number %s is not greater than the previous block", overrides.Number)
+				}
+				number = overrides.Number.ToInt().Int64()
+			}
+			if overrides.Time != nil {
+				if uint64(*overrides.Time) < timestamp {
+					return nil, status.Errorf(codes.InvalidArgument, "block timestamp %d is not greater than the previous block", uint64(*overrides.Time))
+				}
+				timestamp = uint64(*overrides.Time)
+			}
+			if overrides.FeeRecipient != nil {
+				blockCfg.CoinBase = *overrides.FeeRecipient
+			}
+			if overrides.BaseFeePerGas != nil {
+				blockCfg.BaseFee = overrides.BaseFeePerGas.ToInt()
+			}
+		}
+
+		blockCtx := simCtx.
+			WithBlockHeight(number).
+			WithBlockTime(time.Unix(int64(timestamp), 0).UTC()) //#nosec G701 -- timestamp is a valid unix time
+
+		if err := k.applyStateOverrides(blockCtx, block.StateOverrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		result := types.SimulatedBlock{
+			Number:       number,
+			Time:         timestamp,
+			FeeRecipient: blockCfg.CoinBase.Hex(),
+			Calls:        make([]types.MsgEthereumTxResponse, 0, len(block.Calls)),
+		}
+		if blockCfg.BaseFee != nil {
+			baseFee := sdkmath.NewIntFromBigInt(blockCfg.BaseFee)
+			result.BaseFee = &baseFee
+		}
+
+		var logIndex uint
+		for i, args := range block.Calls {
+			if req.GasCap != 0 && gasRemaining < ethparams.TxGas {
+				return nil, status.Errorf(codes.InvalidArgument, "gas cap (%d) exceeded by the simulated calls", req.GasCap)
+			}
+
+			res, err := k.simulateCall(blockCtx, args, &blockCfg, opts.Validation, uint(i), logIndex, gasRemaining)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "call %d of block %d: %s", i, number, err)
+			}
+
+			if req.GasCap != 0 {
+				gasRemaining -= res.GasUsed
+			}
+
+			for _, log := range res.Logs {
+				log.BlockNumber = uint64(number)
+			}
+
+			logIndex += uint(len(res.Logs))
+			result.GasUsed += res.GasUsed
+			result.Calls = append(result.Calls, *res)
+		}
+
+		blocks = append(blocks, result)
+	}
+
+	return &types.SimulateV1Response{Blocks: blocks}, nil
+}
+
+// simulateCall executes a simulated call and commits its state changes to the given
+// context, incrementing the sender nonce as done by the ante handler for the
+// transactions. If validation is enabled, the nonce, base fee and balance of the
+// sender are checked and the fees are charged as in the transaction execution:
+// the full gas cost is deducted before the call and the leftover gas is refunded
+// after it.
+func (k *Keeper) simulateCall(
+	ctx sdk.Context,
+	args types.TransactionArgs,
+	cfg *statedb.EVMConfig,
+	validation bool,
+	txIndex, logIndex uint,
+	gasCap uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+
+	if validation && args.Nonce != nil && uint64(*args.Nonce) != nonce {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidSequence, "invalid nonce for %s; expected %d, got %d", from, nonce, uint64(*args.Nonce))
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	if validation {
+		if cfg.BaseFee != nil && msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+			return nil, fmt.Errorf("%w: address %s, maxFeePerGas: %s, baseFee: %s", core.ErrFeeCapTooLow, from, msg.GasFeeCap(), cfg.BaseFee)
+		}
+
+		cost := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasFeeCap())
+		cost.Add(cost, msg.Value())
+		if balance := k.GetBalance(ctx, from); balance.Cmp(cost) < 0 {
+			return nil, fmt.Errorf("%w: address %s have %s want %s", core.ErrInsufficientFunds, from, balance, cost)
+		}
+
+		// deduct the full gas cost at the effective gas price, as done by the ante handler
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(msg.Gas()), msg.GasPrice())
+		fees := sdk.NewCoins(sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(fee)))
+		if !fees.IsZero() {
+			if err := k.DeductTxCostsFromUserBalance(ctx, fees, from); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	txHash := args.ToTransaction().AsTransaction().Hash()
+	txConfig := statedb.NewTxConfig(common.Hash{}, txHash, txIndex, logIndex)
+
+	// pass true to commit the StateDB, so that the next calls observe the state changes
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if validation {
+		if err := k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+			return nil, err
+		}
+	}
+
+	// the EVM only increments the sender nonce on contract creations
+	if msg.To() != nil {
+		account := k.GetAccountOrEmpty(ctx, from)
+		account.Nonce = nonce + 1
+		if err := k.SetAccount(ctx, from, account); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// applyStateOverrides applies the account overrides to the given context.
+func (k *Keeper) applyStateOverrides(ctx sdk.Context, overrides types.StateOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	stateDB := statedb.New(ctx, k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+
+	for addr, account := range overrides {
+		if account.Nonce != nil {
+			stateDB.SetNonce(addr, uint64(*account.Nonce))
+		}
+
+		if account.Code != nil {
+			stateDB.SetCode(addr, *account.Code)
+		}
+
+		if account.Balance != nil {
+			diff := new(big.Int).Sub(account.Balance.ToInt(), stateDB.GetBalance(addr))
+			if diff.Sign() > 0 {
+				stateDB.AddBalance(addr, diff)
+			} else {
+				stateDB.SubBalance(addr, diff.Neg(diff))
+			}
+		}
+
+		if account.State != nil {
+			// replace the account storage
+			var keys []common.Hash
+			if err := stateDB.ForEachStorage(addr, func(key, _ common.Hash) bool {
+				keys = append(keys, key)
+				return true
+			}); err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				stateDB.SetState(addr, key, common.Hash{})
+			}
+
+			for key, value := range *account.State {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				stateDB.SetState(addr, key, value)
+			}
+		}
+	}
+
+	return stateDB.Commit()
+}
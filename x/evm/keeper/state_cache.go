@@ -0,0 +1,360 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package keeper
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/armon/go-metrics"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+const (
+	// accountKeyLen is the length of the full x/auth store key of an account
+	accountKeyLen = 1 + common.AddressLength
+	// storageKeyLen is the length of the full store key of a storage slot
+	storageKeyLen = 1 + common.AddressLength + common.HashLength
+	// codeKeyLen is the length of the full store key of a contract code
+	codeKeyLen = 1 + common.HashLength
+)
+
+// stateCacheContextKey is the context key of the flag enabling the state cache.
+type stateCacheContextKey struct{}
+
+// WithStateCache returns a context whose reads of accounts, code and storage go
+// through the block state cache. It must only be set on the contexts delivering
+// the block transactions, as the cache holds the state of the block being
+// delivered.
+func WithStateCache(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(stateCacheContextKey{}, true)
+}
+
+// WithoutStateCache returns a context isolated from the block state cache: its
+// reads bypass the cache and its writes don't evict the cached entries. It is
+// used for the executions on a state that is never committed, such as the
+// simulations.
+func WithoutStateCache(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(stateCacheContextKey{}, false)
+}
+
+// stateCacheFlag returns the value of the state cache flag of the context, and
+// whether it is set.
+func stateCacheFlag(ctx sdk.Context) (enabled, set bool) {
+	enabled, set = ctx.Value(stateCacheContextKey{}).(bool)
+	return enabled, set
+}
+
+// storageSlot identifies a contract storage slot.
+type storageSlot struct {
+	address common.Address
+	key     common.Hash
+}
+
+// cachedAccount is a contract account cached without its balance, along with
+// the gas consumed to read it from the store.
+type cachedAccount struct {
+	account statedb.Account
+	readGas storetypes.Gas
+}
+
+// stateCache is a block-scoped read-through cache of the contract accounts,
+// code and storage slots read by the Ethereum transactions of the block. Only
+// the contexts flagged with WithStateCache read from the cache, which is
+// reset when a flagged context of a new block reads from it and at the end of
+// the block.
+//
+// The writes of a transaction can be discarded (e.g. when it fails), so instead
+// of being updated a written entry is marked as dirty and bypasses the cache
+// for the rest of the block. A clean entry thus holds the value it had at the
+// beginning of the block on every branch of the block state. Every write of
+// the block state through the keeper evicts the entry, including the writes
+// of contexts that don't read from the cache (e.g. the Cosmos transactions
+// calling the EVM), except for the check state, the queries and the contexts
+// flagged with WithoutStateCache.
+//
+// Only the nonce and code hash of contract accounts are cached, since they are
+// only written by the keeper: the sender of a transaction, whose nonce is
+// updated by the ante handler, cannot be a contract. The balances are always
+// read from the bank module, as they can be modified by any module.
+type stateCache struct {
+	mtx    sync.Mutex
+	height int64
+
+	accounts map[common.Address]cachedAccount
+	code     map[common.Hash][]byte
+	storage  map[storageSlot][]byte
+
+	dirtyAccounts map[common.Address]struct{}
+	dirtyCode     map[common.Hash]struct{}
+	dirtyStorage  map[storageSlot]struct{}
+
+	hits   uint64
+	misses uint64
+}
+
+func newStateCache() *stateCache {
+	c := &stateCache{}
+	c.reset(0)
+	return c
+}
+
+// reset clears the cache for the given block height and reports the hit ratio
+// of the previous block.
+func (c *stateCache) reset(height int64) {
+	if total := c.hits + c.misses; total > 0 {
+		telemetry.SetGauge(float32(c.hits)/float32(total), types.ModuleName, "state_cache", "hit_ratio")
+	}
+
+	c.height = height
+	c.accounts = make(map[common.Address]cachedAccount)
+	c.code = make(map[common.Hash][]byte)
+	c.storage = make(map[storageSlot][]byte)
+	c.dirtyAccounts = make(map[common.Address]struct{})
+	c.dirtyCode = make(map[common.Hash]struct{})
+	c.dirtyStorage = make(map[storageSlot]struct{})
+	c.hits = 0
+	c.misses = 0
+}
+
+// end resets the cache at the end of the block, so that no entry outlives the
+// block state it was read from.
+func (c *stateCache) end() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.reset(0)
+}
+
+// sync resets the cache if the flagged context is at another block height.
+func (c *stateCache) sync(ctx sdk.Context) {
+	if ctx.BlockHeight() != c.height {
+		c.reset(ctx.BlockHeight())
+	}
+}
+
+// isCacheable returns true if the cache can be read on the given context. Only
+// the contexts explicitly flagged with WithStateCache read from the cache.
+// Speculative executions must read from their tracked store to record their
+// read set.
+func isCacheable(ctx sdk.Context) bool {
+	if enabled, _ := stateCacheFlag(ctx); !enabled || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return false
+	}
+
+	switch ctx.MultiStore().(type) {
+	case *trackedMultiStore, *branchMultiStore:
+		return false
+	default:
+		return true
+	}
+}
+
+// writesBlockState returns true if the writes of the given context can reach
+// the block state, so that they must evict the cached entries. The check state
+// and the queries have their own state, and the contexts flagged with
+// WithoutStateCache are never committed.
+func writesBlockState(ctx sdk.Context) bool {
+	if enabled, set := stateCacheFlag(ctx); set && !enabled {
+		return false
+	}
+
+	return !ctx.IsCheckTx() && !ctx.IsReCheckTx()
+}
+
+// getAccount returns the cached contract account.
+func (c *stateCache) getAccount(ctx sdk.Context, addr common.Address) (cachedAccount, bool) {
+	if !isCacheable(ctx) {
+		return cachedAccount{}, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	cached, found := c.accounts[addr]
+	c.record("account", found)
+	if found {
+		cached.account.CodeHash = bytes.Clone(cached.account.CodeHash)
+	}
+	return cached, found
+}
+
+// setAccount caches an account read from the store if it's a contract.
+func (c *stateCache) setAccount(ctx sdk.Context, addr common.Address, account statedb.Account, readGas storetypes.Gas) {
+	if !isCacheable(ctx) || !account.IsContract() {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	if _, dirty := c.dirtyAccounts[addr]; !dirty {
+		account.CodeHash = bytes.Clone(account.CodeHash)
+		c.accounts[addr] = cachedAccount{account: account, readGas: readGas}
+	}
+}
+
+// getStorage returns the cached value of a storage slot.
+func (c *stateCache) getStorage(ctx sdk.Context, slot storageSlot) ([]byte, bool) {
+	if !isCacheable(ctx) {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	value, found := c.storage[slot]
+	c.record("storage", found)
+	return value, found
+}
+
+// setStorage caches the value of a storage slot read from the store.
+func (c *stateCache) setStorage(ctx sdk.Context, slot storageSlot, value []byte) {
+	if !isCacheable(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	if _, dirty := c.dirtyStorage[slot]; !dirty {
+		c.storage[slot] = bytes.Clone(value)
+	}
+}
+
+// getCode returns the cached code for the given code hash.
+func (c *stateCache) getCode(ctx sdk.Context, codeHash common.Hash) ([]byte, bool) {
+	if !isCacheable(ctx) {
+		return nil, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	code, found := c.code[codeHash]
+	c.record("code", found)
+	return code, found
+}
+
+// setCode caches the code read from the store.
+func (c *stateCache) setCode(ctx sdk.Context, codeHash common.Hash, code []byte) {
+	if !isCacheable(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.sync(ctx)
+
+	if _, dirty := c.dirtyCode[codeHash]; !dirty {
+		c.code[codeHash] = bytes.Clone(code)
+	}
+}
+
+// markAccountDirty evicts an account for the rest of the block. It must be
+// called before the account is written.
+func (c *stateCache) markAccountDirty(ctx sdk.Context, addr common.Address) {
+	if !writesBlockState(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.accounts, addr)
+	c.dirtyAccounts[addr] = struct{}{}
+}
+
+// markStorageDirty evicts a storage slot for the rest of the block. It must be
+// called before the slot is written.
+func (c *stateCache) markStorageDirty(ctx sdk.Context, slot storageSlot) {
+	if !writesBlockState(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.storage, slot)
+	c.dirtyStorage[slot] = struct{}{}
+}
+
+// markCodeDirty evicts a code for the rest of the block. It must be called
+// before the code is written.
+func (c *stateCache) markCodeDirty(ctx sdk.Context, codeHash common.Hash) {
+	if !writesBlockState(ctx) {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.code, codeHash)
+	c.dirtyCode[codeHash] = struct{}{}
+}
+
+// markKeyDirty evicts the entry of a raw x/evm or x/auth store key, if any. It's
+// used when writing to the store without going through the keeper.
+func (c *stateCache) markKeyDirty(ctx sdk.Context, storeKey storetypes.StoreKey, key []byte) {
+	switch storeKey.Name() {
+	case authtypes.StoreKey:
+		if len(key) == accountKeyLen && bytes.HasPrefix(key, authtypes.AddressStoreKeyPrefix) {
+			c.markAccountDirty(ctx, common.BytesToAddress(key[1:]))
+		}
+	case types.StoreKey:
+		switch {
+		case len(key) == storageKeyLen && bytes.HasPrefix(key, types.KeyPrefixStorage):
+			c.markStorageDirty(ctx, storageSlot{
+				address: common.BytesToAddress(key[1 : 1+common.AddressLength]),
+				key:     common.BytesToHash(key[1+common.AddressLength:]),
+			})
+		case len(key) == codeKeyLen && bytes.HasPrefix(key, types.KeyPrefixCode):
+			c.markCodeDirty(ctx, common.BytesToHash(key[1:]))
+		}
+	}
+}
+
+// record updates the hit and miss counters.
+func (c *stateCache) record(kind string, hit bool) {
+	outcome := "miss"
+	if hit {
+		outcome = "hit"
+		c.hits++
+	} else {
+		c.misses++
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "state_cache", "total"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("type", kind), telemetry.NewLabel("outcome", outcome)},
+	)
+}
+
+// consumeCachedReadGas consumes the gas of a KVStore read of the given key
+// length and value on the context gas meter, so that cache hits are charged as
+// if the value was read from the store.
+func consumeCachedReadGas(ctx sdk.Context, keyLen int, value []byte) {
+	gasConfig := ctx.KVGasConfig()
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostFlat, storetypes.GasReadCostFlatDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(keyLen), storetypes.GasReadPerByteDesc)
+	ctx.GasMeter().ConsumeGas(gasConfig.ReadCostPerByte*storetypes.Gas(len(value)), storetypes.GasReadPerByteDesc)
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v15/server/config"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	evmostypes "github.com/evmos/evmos/v15/types"
+	"github.com/evmos/evmos/v15/x/evm/keeper"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	"github.com/evmos/evmos/v15/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestStateCache() {
+	addr := utiltx.GenerateAddress()
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+	code := []byte("code")
+	codeHash := crypto.Keccak256Hash(code)
+
+	readGas := func(ctx sdk.Context, read func(ctx sdk.Context)) uint64 {
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		read(ctx)
+		return ctx.GasMeter().GasConsumed()
+	}
+	getState := func(ctx sdk.Context) { suite.app.EvmKeeper.GetState(ctx, addr, key) }
+	getCode := func(ctx sdk.Context) { suite.app.EvmKeeper.GetCode(ctx, codeHash) }
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		expValue common.Hash
+		expCode  []byte
+	}{
+		{
+			"cached values",
+			func(sdk.Context) {},
+			value,
+			code,
+		},
+		{
+			"written values",
+			func(ctx sdk.Context) {
+				suite.app.EvmKeeper.SetState(ctx, addr, key, common.BytesToHash([]byte("new")).Bytes())
+				suite.app.EvmKeeper.SetCode(ctx, codeHash.Bytes(), nil)
+			},
+			common.BytesToHash([]byte("new")),
+			nil,
+		},
+		{
+			"values written on a discarded branch",
+			func(ctx sdk.Context) {
+				branchCtx, _ := ctx.CacheContext()
+				suite.app.EvmKeeper.SetState(branchCtx, addr, key, common.BytesToHash([]byte("new")).Bytes())
+				suite.app.EvmKeeper.SetCode(branchCtx, codeHash.Bytes(), nil)
+				suite.Require().Equal(common.BytesToHash([]byte("new")), suite.app.EvmKeeper.GetState(branchCtx, addr, key))
+				suite.Require().Nil(suite.app.EvmKeeper.GetCode(branchCtx, codeHash))
+			},
+			value,
+			code,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetState(suite.ctx, addr, key, value.Bytes())
+			suite.app.EvmKeeper.SetCode(suite.ctx, codeHash.Bytes(), code)
+			suite.Commit()
+
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = keeper.WithStateCache(ctx)
+			missStateGas := readGas(ctx, getState)
+			missCodeGas := readGas(ctx, getCode)
+
+			// cache hits are charged the same gas as the store reads
+			suite.Require().Equal(missStateGas, readGas(ctx, getState))
+			suite.Require().Equal(missCodeGas, readGas(ctx, getCode))
+
+			tc.malleate(ctx)
+
+			suite.Require().Equal(tc.expValue, suite.app.EvmKeeper.GetState(ctx, addr, key))
+			suite.Require().Equal(tc.expCode, suite.app.EvmKeeper.GetCode(ctx, codeHash))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStateCacheContexts() {
+	addr := utiltx.GenerateAddress()
+	key := common.BytesToHash([]byte("key"))
+	value := common.BytesToHash([]byte("value"))
+	newValue := common.BytesToHash([]byte("new"))
+
+	// writeStore writes the slot without going through the keeper, so that the
+	// reads from the cache return the previous value
+	writeStore := func(ctx sdk.Context) {
+		store := prefix.NewStore(ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.AddressStoragePrefix(addr))
+		store.Set(key.Bytes(), newValue.Bytes())
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		expValue common.Hash
+	}{
+		{
+			"cache hit",
+			func(ctx sdk.Context) {
+				writeStore(ctx)
+			},
+			value,
+		},
+		{
+			"context without the flag does not read from the cache",
+			func(ctx sdk.Context) {
+				writeStore(ctx)
+				suite.Require().Equal(newValue, suite.app.EvmKeeper.GetState(ctx, addr, key))
+			},
+			value,
+		},
+		{
+			"check context does not read from the cache",
+			func(ctx sdk.Context) {
+				writeStore(ctx)
+				suite.Require().Equal(newValue, suite.app.EvmKeeper.GetState(ctx.WithIsCheckTx(true), addr, key))
+			},
+			value,
+		},
+		{
+			"write of a deliver context without the flag evicts the slot",
+			func(ctx sdk.Context) {
+				suite.app.EvmKeeper.SetState(ctx, addr, key, newValue.Bytes())
+			},
+			newValue,
+		},
+		{
+			"isolated context at a later height neither evicts nor resets the cache",
+			func(ctx sdk.Context) {
+				isolatedCtx, _ := ctx.CacheContext()
+				isolatedCtx = keeper.WithoutStateCache(isolatedCtx).WithBlockHeight(ctx.BlockHeight() + 10)
+				suite.app.EvmKeeper.SetState(isolatedCtx, addr, key, newValue.Bytes())
+				suite.Require().Equal(newValue, suite.app.EvmKeeper.GetState(isolatedCtx, addr, key))
+				writeStore(ctx)
+			},
+			value,
+		},
+		{
+			"simulated blocks neither evict nor reset the cache",
+			func(ctx sdk.Context) {
+				number := (*hexutil.Big)(big.NewInt(ctx.BlockHeight() + 10))
+				stateDiff := map[common.Hash]common.Hash{key: newValue}
+				bz, err := json.Marshal(&types.SimOpts{BlockStateCalls: []types.SimBlock{
+					{
+						BlockOverrides: &types.BlockOverrides{Number: number},
+						StateOverrides: types.StateOverride{addr: {StateDiff: &stateDiff}},
+					},
+				}})
+				suite.Require().NoError(err)
+
+				_, err = suite.app.EvmKeeper.SimulateV1(ctx, &types.SimulateV1Request{Opts: bz, GasCap: config.DefaultGasCap})
+				suite.Require().NoError(err)
+				writeStore(ctx)
+			},
+			value,
+		},
+		{
+			"end of the block resets the cache",
+			func(ctx sdk.Context) {
+				writeStore(ctx)
+				suite.app.EvmKeeper.EndBlock(ctx, abci.RequestEndBlock{})
+			},
+			newValue,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetState(suite.ctx, addr, key, value.Bytes())
+			suite.Commit()
+
+			ctx, _ := suite.ctx.CacheContext()
+			deliverCtx := keeper.WithStateCache(ctx)
+			suite.Require().Equal(value, suite.app.EvmKeeper.GetState(deliverCtx, addr, key))
+
+			tc.malleate(ctx)
+
+			suite.Require().Equal(tc.expValue, suite.app.EvmKeeper.GetState(deliverCtx, addr, key))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStateCacheAccounts() {
+	contract := utiltx.GenerateAddress()
+	eoa := utiltx.GenerateAddress()
+	codeHash := crypto.Keccak256Hash([]byte("code"))
+
+	// setNonce updates the nonce through the account keeper, without going
+	// through the EVM keeper
+	setNonce := func(ctx sdk.Context, addr common.Address, nonce uint64) {
+		acc := suite.app.AccountKeeper.GetAccount(ctx, addr.Bytes())
+		suite.Require().NoError(acc.SetSequence(nonce))
+		suite.app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{
+		Nonce:    1,
+		Balance:  common.Big0,
+		CodeHash: codeHash.Bytes(),
+	}))
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, eoa, *statedb.NewEmptyAccount()))
+	suite.Commit()
+
+	ctx, _ := suite.ctx.CacheContext()
+	ctx = keeper.WithStateCache(ctx)
+
+	readGas := func(addr common.Address) uint64 {
+		gasCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		suite.app.EvmKeeper.GetAccountWithoutBalance(gasCtx, addr)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	// cache hits are charged the same gas as the store reads
+	missGas := readGas(contract)
+	suite.Require().Equal(missGas, readGas(contract))
+
+	// only the contract accounts are cached
+	readGas(eoa)
+	setNonce(ctx, contract, 5)
+	setNonce(ctx, eoa, 5)
+	suite.Require().Equal(uint64(1), suite.app.EvmKeeper.GetAccountWithoutBalance(ctx, contract).Nonce)
+	suite.Require().Equal(uint64(5), suite.app.EvmKeeper.GetAccountWithoutBalance(ctx, eoa).Nonce)
+
+	// the writes through the keeper evict the account
+	account := suite.app.EvmKeeper.GetAccountOrEmpty(ctx, contract)
+	account.Nonce = 2
+	suite.Require().NoError(suite.app.EvmKeeper.SetAccount(ctx, contract, account))
+	suite.Require().Equal(uint64(2), suite.app.EvmKeeper.GetAccountWithoutBalance(ctx, contract).Nonce)
+
+	acc := suite.app.AccountKeeper.GetAccount(ctx, contract.Bytes())
+	suite.Require().Equal(codeHash, acc.(evmostypes.EthAccountI).GetCodeHash())
+}
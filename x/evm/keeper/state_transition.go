@@ -164,8 +164,12 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		tmpCtx, commit = ctx.CacheContext()
 	}
 
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	// use the speculative result of the transaction when it's still valid,
+	// otherwise pass true to commit the StateDB
+	res, ok := k.applySpeculativeTx(tmpCtx, tx, cfg, txConfig)
+	if !ok {
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+	}
 	if err != nil {
 		// when a transaction contains multiple msg, as long as one of the msg fails
 		// all gas will be deducted. so is not msg.Gas()
@@ -227,6 +231,16 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 			res.Logs = types.NewLogsFromEth(receipt.Logs)
 			ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
 		}
+	} else {
+		receipt.Status = ethtypes.ReceiptStatusFailed
+		// The failed tx hooks can't revert the tx, their state changes are
+		// only committed if they succeed.
+		failedCtx, commitFailed := ctx.CacheContext()
+		if err = k.PostFailedTxProcessing(failedCtx, msg, receipt); err != nil {
+			k.Logger(ctx).Error("failed tx post processing failed", "error", err)
+		} else {
+			commitFailed()
+		}
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
@@ -263,6 +277,42 @@ func (k *Keeper) ApplyMessage(ctx sdk.Context, msg core.Message, tracer vm.EVMLo
 	return k.ApplyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig)
 }
 
+// CallContract calls a contract from a native module with the given calldata
+// and gas limit, and commits the state changes if the call succeeds. The
+// response is returned along with an error if the execution failed, so that the
+// caller can account for the gas used.
+func (k *Keeper) CallContract(
+	ctx sdk.Context,
+	from, contract common.Address,
+	data []byte,
+	gasLimit uint64,
+) (*types.MsgEthereumTxResponse, error) {
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.GetNonce(ctx, from),
+		big.NewInt(0), // amount
+		gasLimit,      // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.ApplyMessage(ctx, msg, types.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Failed() {
+		return res, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	return res, nil
+}
+
 // ApplyMessageWithConfig computes the new state by applying the given message against the existing state.
 // If the message fails, the VM execution error with the reason will be returned to the client
 // and the transaction won't be committed to the store.
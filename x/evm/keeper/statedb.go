@@ -36,9 +36,16 @@ func (k *Keeper) GetAccount(ctx sdk.Context, addr common.Address) *statedb.Accou
 
 // GetState loads contract state from database, implements `statedb.Keeper` interface.
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+	slot := storageSlot{address: addr, key: key}
+	value, found := k.stateCache.getStorage(ctx, slot)
+	if found {
+		consumeCachedReadGas(ctx, storageKeyLen, value)
+	} else {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+		value = store.Get(key.Bytes())
+		k.stateCache.setStorage(ctx, slot, value)
+	}
 
-	value := store.Get(key.Bytes())
 	if len(value) == 0 {
 		return common.Hash{}
 	}
@@ -48,8 +55,15 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, key common.Hash)
 
 // GetCode loads contract code from database, implements `statedb.Keeper` interface.
 func (k *Keeper) GetCode(ctx sdk.Context, codeHash common.Hash) []byte {
+	if code, found := k.stateCache.getCode(ctx, codeHash); found {
+		consumeCachedReadGas(ctx, codeKeyLen, code)
+		return code
+	}
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
-	return store.Get(codeHash.Bytes())
+	code := store.Get(codeHash.Bytes())
+	k.stateCache.setCode(ctx, codeHash, code)
+	return code
 }
 
 // ForEachStorage iterate contract storage, callback return false to break early
@@ -106,6 +120,8 @@ func (k *Keeper) SetBalance(ctx sdk.Context, addr common.Address, amount *big.In
 
 // SetAccount updates nonce/balance/codeHash together.
 func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error {
+	k.stateCache.markAccountDirty(ctx, addr)
+
 	// update account
 	cosmosAddr := sdk.AccAddress(addr.Bytes())
 	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
@@ -143,6 +159,8 @@ func (k *Keeper) SetAccount(ctx sdk.Context, addr common.Address, account stated
 
 // SetState update contract storage, delete if value is empty.
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	k.stateCache.markStorageDirty(ctx, storageSlot{address: addr, key: key})
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
 	action := "updated"
 	if len(value) == 0 {
@@ -160,6 +178,8 @@ func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash,
 
 // SetCode set contract code, delete if code is empty.
 func (k *Keeper) SetCode(ctx sdk.Context, codeHash, code []byte) {
+	k.stateCache.markCodeDirty(ctx, common.BytesToHash(codeHash))
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
 
 	// store or delete code
@@ -206,6 +226,7 @@ func (k *Keeper) DeleteAccount(ctx sdk.Context, addr common.Address) error {
 	})
 
 	// remove auth account
+	k.stateCache.markAccountDirty(ctx, addr)
 	k.accountKeeper.RemoveAccount(ctx, acct)
 
 	k.Logger(ctx).Debug(
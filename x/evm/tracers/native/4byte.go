@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// fourByteTracer collects the 4-byte method identifiers called by a transaction,
+// along with the size of their call data, so that they can be matched against
+// known method signatures.
+//
+// Example result:
+//
+//	{
+//	  "0x27dc297e-128": 1,
+//	  "0x38cc4831-0": 2
+//	}
+type fourByteTracer struct {
+	env       *vm.EVM
+	ids       map[string]int // number of calls per identifier and call data size
+	interrupt uint32         // atomic flag to signal execution interruption
+	reason    error          // reason of the interruption
+}
+
+var _ tracers.Tracer = &fourByteTracer{}
+
+func newFourByteTracer(*tracers.Context, json.RawMessage) (tracers.Tracer, error) {
+	return &fourByteTracer{ids: make(map[string]int)}, nil
+}
+
+// store records a call of the given identifier with the given call data size.
+func (t *fourByteTracer) store(id []byte, size int) {
+	t.ids[bytesToHex(id)+"-"+strconv.Itoa(size)]++
+}
+
+// CaptureTxStart implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureTxEnd(uint64) {}
+
+// CaptureStart implements the vm.EVMLogger interface. It records the call data
+// of the transaction.
+func (t *fourByteTracer) CaptureStart(env *vm.EVM, _ common.Address, _ common.Address, _ bool, input []byte, _ uint64, _ *big.Int) {
+	t.env = env
+	if len(input) >= 4 {
+		t.store(input[:4], len(input)-4)
+	}
+}
+
+// CaptureEnd implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureEnd([]byte, uint64, time.Duration, error) {}
+
+// CaptureState implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureState(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, []byte, int, error) {
+}
+
+// CaptureFault implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// CaptureEnter implements the vm.EVMLogger interface. It records the call data
+// of the contract calls.
+func (t *fourByteTracer) CaptureEnter(op vm.OpCode, _ common.Address, to common.Address, input []byte, _ uint64, _ *big.Int) {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+	if len(input) < 4 {
+		return
+	}
+	// skip the contract creations and self destructs
+	if op != vm.DELEGATECALL && op != vm.STATICCALL && op != vm.CALL && op != vm.CALLCODE {
+		return
+	}
+	// skip the precompile calls, including the stateful Evmos precompiles
+	if _, isPrecompile := t.env.Precompile(to); isPrecompile {
+		return
+	}
+	t.store(input[:4], len(input)-4)
+}
+
+// CaptureExit implements the vm.EVMLogger interface.
+func (*fourByteTracer) CaptureExit([]byte, uint64, error) {}
+
+// GetResult returns the JSON encoded identifiers and the interruption reason,
+// if any.
+func (t *fourByteTracer) GetResult() (json.RawMessage, error) {
+	res, err := json.Marshal(t.ids)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates the execution of the tracer at the first opportune moment.
+func (t *fourByteTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
@@ -0,0 +1,237 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// callLog is a log emitted within a call frame.
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+// callFrame is a call performed during the transaction execution.
+type callFrame struct {
+	Type    string      `json:"type"`
+	From    string      `json:"from"`
+	To      string      `json:"to,omitempty"`
+	Value   string      `json:"value,omitempty"`
+	Gas     string      `json:"gas"`
+	GasUsed string      `json:"gasUsed"`
+	Input   string      `json:"input"`
+	Output  string      `json:"output,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Logs    []callLog   `json:"logs,omitempty"`
+	Calls   []callFrame `json:"calls,omitempty"`
+}
+
+// failed returns true if the call frame reverted or failed.
+func (f callFrame) failed() bool {
+	return f.Error != ""
+}
+
+// clearFailedLogs removes the logs of the failed call frames, as they are not
+// part of the transaction logs.
+func (f *callFrame) clearFailedLogs(parentFailed bool) {
+	failed := f.failed() || parentFailed
+	if failed {
+		f.Logs = nil
+	}
+	for i := range f.Calls {
+		f.Calls[i].clearFailedLogs(failed)
+	}
+}
+
+// callTracerConfig is the configuration of the call tracer.
+type callTracerConfig struct {
+	// OnlyTopCall skips the sub calls of the transaction
+	OnlyTopCall bool `json:"onlyTopCall"`
+	// WithLog includes the logs emitted by each call frame
+	WithLog bool `json:"withLog"`
+}
+
+// callTracer tracks the call frames of a transaction.
+type callTracer struct {
+	env       *vm.EVM
+	callstack []callFrame
+	config    callTracerConfig
+	interrupt uint32 // atomic flag to signal execution interruption
+	reason    error  // reason of the interruption
+}
+
+var _ tracers.Tracer = &callTracer{}
+
+func newCallTracer(_ *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config callTracerConfig
+	if err := unmarshalConfig(cfg, &config); err != nil {
+		return nil, err
+	}
+
+	// the first call frame is populated on start and end
+	return &callTracer{callstack: make([]callFrame, 1), config: config}, nil
+}
+
+// CaptureTxStart implements the vm.EVMLogger interface.
+func (*callTracer) CaptureTxStart(uint64) {}
+
+// CaptureTxEnd implements the vm.EVMLogger interface.
+func (*callTracer) CaptureTxEnd(uint64) {}
+
+// CaptureStart implements the vm.EVMLogger interface to initialize the top call frame.
+func (t *callTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.env = env
+	t.callstack[0] = callFrame{
+		Type:  vm.CALL.String(),
+		From:  addrToHex(from),
+		To:    addrToHex(to),
+		Input: bytesToHex(input),
+		Gas:   uintToHex(gas),
+		Value: bigToHex(value),
+	}
+	if create {
+		t.callstack[0].Type = vm.CREATE.String()
+	}
+}
+
+// CaptureEnd implements the vm.EVMLogger interface to finalize the top call frame.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	t.callstack[0].GasUsed = uintToHex(gasUsed)
+	if err == nil {
+		t.callstack[0].Output = bytesToHex(output)
+		return
+	}
+
+	t.callstack[0].Error = err.Error()
+	if errors.Is(err, vm.ErrExecutionReverted) && len(output) > 0 {
+		t.callstack[0].Output = bytesToHex(output)
+	}
+}
+
+// CaptureState implements the vm.EVMLogger interface. It records the logs
+// emitted by the current call frame if enabled.
+func (t *callTracer) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, scope *vm.ScopeContext, _ []byte, depth int, err error) {
+	if err != nil || !t.config.WithLog {
+		return
+	}
+	// the depth of the top call is 1
+	if t.config.OnlyTopCall && depth > 1 {
+		return
+	}
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+
+	switch op {
+	case vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4:
+		stack := scope.Stack.Data
+		size := int(op - vm.LOG0)
+		if len(stack) < size+2 {
+			return
+		}
+
+		mStart := stack[len(stack)-1]
+		mSize := stack[len(stack)-2]
+		topics := make([]common.Hash, size)
+		for i := 0; i < size; i++ {
+			topics[i] = stack[len(stack)-3-i].Bytes32()
+		}
+
+		data, err := memoryCopy(scope.Memory, int64(mStart.Uint64()), int64(mSize.Uint64()))
+		if err != nil {
+			return
+		}
+
+		frame := &t.callstack[len(t.callstack)-1]
+		frame.Logs = append(frame.Logs, callLog{
+			Address: scope.Contract.Address(),
+			Topics:  topics,
+			Data:    data,
+		})
+	}
+}
+
+// CaptureFault implements the vm.EVMLogger interface.
+func (*callTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {}
+
+// CaptureEnter implements the vm.EVMLogger interface to push a new call frame.
+func (t *callTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		t.env.Cancel()
+		return
+	}
+
+	t.callstack = append(t.callstack, callFrame{
+		Type:  typ.String(),
+		From:  addrToHex(from),
+		To:    addrToHex(to),
+		Input: bytesToHex(input),
+		Gas:   uintToHex(gas),
+		Value: bigToHex(value),
+	})
+}
+
+// CaptureExit implements the vm.EVMLogger interface to pop the current call
+// frame into its parent.
+func (t *callTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	if t.config.OnlyTopCall {
+		return
+	}
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	size--
+
+	call.GasUsed = uintToHex(gasUsed)
+	if err == nil {
+		call.Output = bytesToHex(output)
+	} else {
+		call.Error = err.Error()
+		if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+			call.To = ""
+		}
+	}
+	t.callstack[size-1].Calls = append(t.callstack[size-1].Calls, call)
+}
+
+// GetResult returns the JSON encoded top call frame and the interruption
+// reason, if any.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	if t.config.WithLog {
+		t.callstack[0].clearFailedLogs(false)
+	}
+
+	res, err := json.Marshal(t.callstack[0])
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates the execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
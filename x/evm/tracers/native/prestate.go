@@ -0,0 +1,291 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package native
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+// account is the state of an account touched by the transaction.
+type account struct {
+	Balance *big.Int
+	Code    []byte
+	Nonce   uint64
+	Storage map[common.Hash]common.Hash
+}
+
+// exists returns true if the account isn't empty.
+func (a *account) exists() bool {
+	return a.Nonce > 0 || len(a.Code) > 0 || len(a.Storage) > 0 || (a.Balance != nil && a.Balance.Sign() != 0)
+}
+
+// MarshalJSON implements json.Marshaler, omitting the empty fields.
+func (a *account) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Balance *hexutil.Big                `json:"balance,omitempty"`
+		Code    hexutil.Bytes               `json:"code,omitempty"`
+		Nonce   uint64                      `json:"nonce,omitempty"`
+		Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+	}{
+		Balance: (*hexutil.Big)(a.Balance),
+		Code:    a.Code,
+		Nonce:   a.Nonce,
+		Storage: a.Storage,
+	})
+}
+
+type state = map[common.Address]*account
+
+// prestateTracerConfig is the configuration of the prestate tracer.
+type prestateTracerConfig struct {
+	// DiffMode returns the state modified by the transaction, before and after
+	// its execution, instead of the state it accessed.
+	DiffMode bool `json:"diffMode"`
+}
+
+// prestateTracer collects the state accessed by a transaction before its
+// execution and, in diff mode, the state it modified.
+type prestateTracer struct {
+	env       *vm.EVM
+	pre       state
+	post      state
+	create    bool
+	to        common.Address
+	config    prestateTracerConfig
+	interrupt uint32 // atomic flag to signal execution interruption
+	reason    error  // reason of the interruption
+	created   map[common.Address]bool
+	deleted   map[common.Address]bool
+}
+
+var _ tracers.Tracer = &prestateTracer{}
+
+func newPrestateTracer(_ *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if err := unmarshalConfig(cfg, &config); err != nil {
+		return nil, err
+	}
+
+	return &prestateTracer{
+		pre:     state{},
+		post:    state{},
+		config:  config,
+		created: make(map[common.Address]bool),
+		deleted: make(map[common.Address]bool),
+	}, nil
+}
+
+// CaptureTxStart implements the vm.EVMLogger interface.
+func (*prestateTracer) CaptureTxStart(uint64) {}
+
+// CaptureStart implements the vm.EVMLogger interface. It records the sender,
+// recipient and coinbase accounts.
+func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, _ []byte, _ uint64, value *big.Int) {
+	t.env = env
+	t.create = create
+	t.to = to
+
+	t.lookupAccount(from)
+	t.lookupAccount(to)
+	t.lookupAccount(env.Context.Coinbase)
+
+	// the value is already transferred when the top call starts
+	t.pre[to].Balance = new(big.Int).Sub(t.pre[to].Balance, value)
+	t.pre[from].Balance = new(big.Int).Add(t.pre[from].Balance, value)
+
+	// the sender nonce is only increased by the EVM on contract creations, as
+	// the AnteHandler handles it on calls.
+	if create {
+		t.pre[from].Nonce--
+		if t.config.DiffMode {
+			t.created[to] = true
+		}
+	}
+}
+
+// CaptureEnd implements the vm.EVMLogger interface.
+func (t *prestateTracer) CaptureEnd([]byte, uint64, time.Duration, error) {
+	if t.config.DiffMode || !t.create {
+		return
+	}
+
+	// keep an existing account prior to the contract creation at that address
+	if s := t.pre[t.to]; s != nil && !s.exists() {
+		delete(t.pre, t.to)
+	}
+}
+
+// CaptureState implements the vm.EVMLogger interface. It records the accounts
+// and storage slots accessed by the opcode.
+func (t *prestateTracer) CaptureState(_ uint64, op vm.OpCode, _, _ uint64, scope *vm.ScopeContext, _ []byte, _ int, err error) {
+	if err != nil {
+		return
+	}
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
+
+	stack := scope.Stack.Data
+	stackLen := len(stack)
+	caller := scope.Contract.Address()
+
+	switch {
+	case stackLen >= 1 && (op == vm.SLOAD || op == vm.SSTORE):
+		t.lookupStorage(caller, stack[stackLen-1].Bytes32())
+	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
+		t.lookupAccount(stack[stackLen-1].Bytes20())
+		if op == vm.SELFDESTRUCT {
+			t.deleted[caller] = true
+		}
+	case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
+		t.lookupAccount(stack[stackLen-2].Bytes20())
+	case op == vm.CREATE:
+		addr := crypto.CreateAddress(caller, t.env.StateDB.GetNonce(caller))
+		t.lookupAccount(addr)
+		t.created[addr] = true
+	case stackLen >= 4 && op == vm.CREATE2:
+		offset := stack[stackLen-2]
+		size := stack[stackLen-3]
+		initCode, err := memoryCopy(scope.Memory, int64(offset.Uint64()), int64(size.Uint64()))
+		if err != nil {
+			return
+		}
+		salt := stack[stackLen-4]
+		addr := crypto.CreateAddress2(caller, salt.Bytes32(), crypto.Keccak256(initCode))
+		t.lookupAccount(addr)
+		t.created[addr] = true
+	}
+}
+
+// CaptureFault implements the vm.EVMLogger interface.
+func (*prestateTracer) CaptureFault(uint64, vm.OpCode, uint64, uint64, *vm.ScopeContext, int, error) {
+}
+
+// CaptureEnter implements the vm.EVMLogger interface.
+func (*prestateTracer) CaptureEnter(vm.OpCode, common.Address, common.Address, []byte, uint64, *big.Int) {
+}
+
+// CaptureExit implements the vm.EVMLogger interface.
+func (*prestateTracer) CaptureExit([]byte, uint64, error) {}
+
+// CaptureTxEnd implements the vm.EVMLogger interface. In diff mode, it compares
+// the recorded accounts with their state after the transaction execution.
+func (t *prestateTracer) CaptureTxEnd(uint64) {
+	if !t.config.DiffMode || t.env == nil {
+		return
+	}
+
+	for addr, pre := range t.pre {
+		// the state of a deleted account is kept in pre and pruned from post
+		if t.deleted[addr] {
+			continue
+		}
+
+		modified := false
+		post := &account{Storage: make(map[common.Hash]common.Hash)}
+
+		if balance := t.env.StateDB.GetBalance(addr); balance.Cmp(pre.Balance) != 0 {
+			modified = true
+			post.Balance = balance
+		}
+		if nonce := t.env.StateDB.GetNonce(addr); nonce != pre.Nonce {
+			modified = true
+			post.Nonce = nonce
+		}
+		if code := t.env.StateDB.GetCode(addr); !bytes.Equal(code, pre.Code) {
+			modified = true
+			post.Code = code
+		}
+
+		for key, value := range pre.Storage {
+			// omit the empty slots from the pre state
+			if value == (common.Hash{}) {
+				delete(pre.Storage, key)
+			}
+
+			newValue := t.env.StateDB.GetState(addr, key)
+			if value == newValue {
+				delete(pre.Storage, key)
+				continue
+			}
+
+			modified = true
+			if newValue != (common.Hash{}) {
+				post.Storage[key] = newValue
+			}
+		}
+
+		if modified {
+			t.post[addr] = post
+		} else {
+			delete(t.pre, addr)
+		}
+	}
+
+	// the state of the created contracts was empty before the transaction
+	for addr := range t.created {
+		if s := t.pre[addr]; s != nil && !s.exists() {
+			delete(t.pre, addr)
+		}
+	}
+}
+
+// GetResult returns the JSON encoded state and the interruption reason, if any.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	var (
+		res []byte
+		err error
+	)
+
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post state `json:"post"`
+			Pre  state `json:"pre"`
+		}{t.post, t.pre})
+	} else {
+		res, err = json.Marshal(t.pre)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+// Stop terminates the execution of the tracer at the first opportune moment.
+func (t *prestateTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// lookupAccount records the state of an account if it's not recorded yet.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, found := t.pre[addr]; found {
+		return
+	}
+
+	t.pre[addr] = &account{
+		Balance: t.env.StateDB.GetBalance(addr),
+		Nonce:   t.env.StateDB.GetNonce(addr),
+		Code:    t.env.StateDB.GetCode(addr),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+}
+
+// lookupStorage records the value of a storage slot if it's not recorded yet.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	if _, found := t.pre[addr].Storage[key]; found {
+		return
+	}
+	t.pre[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+}
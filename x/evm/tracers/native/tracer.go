@@ -0,0 +1,111 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+// Package native implements the built-in Go tracers of the EVM module. They are
+// always available to the trace queries and take precedence over the tracers
+// registered on the go-ethereum tracers package.
+//
+// The tracers are adapted to the Evmos state transition: the EVM fees and the
+// sender nonce of a call are handled by the AnteHandler, which is not run when
+// tracing a transaction.
+package native
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// CallTracer is the name of the native call tracer
+	CallTracer = "callTracer"
+	// PrestateTracer is the name of the native prestate tracer
+	PrestateTracer = "prestateTracer"
+	// FourByteTracer is the name of the native 4byte tracer
+	FourByteTracer = "4byteTracer"
+)
+
+// memoryPaddingLimit is the maximum number of bytes a memory read can be
+// padded with.
+const memoryPaddingLimit = 1024 * 1024
+
+type ctorFn func(*tracers.Context, json.RawMessage) (tracers.Tracer, error)
+
+var ctors = map[string]ctorFn{
+	CallTracer:     newCallTracer,
+	PrestateTracer: newPrestateTracer,
+	FourByteTracer: newFourByteTracer,
+}
+
+// New returns the native tracer with the given name, configured with the given
+// JSON configuration. The returned boolean is false if there's no native tracer
+// with this name.
+func New(name string, ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, bool, error) {
+	ctor, found := ctors[name]
+	if !found {
+		return nil, false, nil
+	}
+
+	tracer, err := ctor(ctx, cfg)
+	return tracer, true, err
+}
+
+// unmarshalConfig decodes the tracer configuration, if any.
+func unmarshalConfig(cfg json.RawMessage, config interface{}) error {
+	if len(cfg) == 0 || string(cfg) == "null" {
+		return nil
+	}
+	return json.Unmarshal(cfg, config)
+}
+
+// memoryCopy returns a copy of the memory in the given range, padded with zeros
+// if it exceeds the memory size.
+func memoryCopy(m *vm.Memory, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, fmt.Errorf("invalid memory range: offset %d, size %d", offset, size)
+	}
+	if size == 0 {
+		return []byte{}, nil
+	}
+
+	memLen := int64(m.Len())
+	if offset+size <= memLen {
+		return m.GetCopy(offset, size), nil
+	}
+
+	paddingNeeded := offset + size - memLen
+	if paddingNeeded > memoryPaddingLimit {
+		return nil, fmt.Errorf("reached limit for padding memory slice: %d", paddingNeeded)
+	}
+
+	cpy := make([]byte, size)
+	if offset < memLen {
+		copy(cpy, m.Data()[offset:memLen])
+	}
+	return cpy, nil
+}
+
+func bytesToHex(s []byte) string {
+	return "0x" + common.Bytes2Hex(s)
+}
+
+func bigToHex(n *big.Int) string {
+	if n == nil {
+		return ""
+	}
+	return "0x" + n.Text(16)
+}
+
+func uintToHex(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func addrToHex(a common.Address) string {
+	return strings.ToLower(a.Hex())
+}
@@ -61,6 +61,13 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmFailedTxHooks defines the optional hooks called for the evm txs that are
+// reverted. They are implemented by the EvmHooks that need to observe them.
+type EvmFailedTxHooks interface {
+	// Called after tx is reverted, if return an error, the hook state changes are discarded and the error is logged.
+	PostFailedTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -31,6 +31,9 @@ var (
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
 		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
+		"0x0000000000000000000000000000000000000805", // Incentives precompile
+		"0x0000000000000000000000000000000000000806", // ICS27 interchain accounts precompile
+		"0x0000000000000000000000000000000000000807", // ERC20 registry precompile
 		"0x0000000000000000000000000000000000000900", // Stride outpost
 	}
 	// DefaultExtraEIPs defines the default extra EIPs to be included
@@ -70,6 +70,9 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
 	require.ErrorContains(t, validateEIPs([]int64{1884, 1884, 1885, 1886}), "duplicate EIP: 1884")
+	// the transient storage and MCOPY opcodes are not supported by the go-ethereum fork
+	require.Error(t, validateEIPs([]int64{1153}))
+	require.Error(t, validateEIPs([]int64{5656}))
 }
 
 func TestValidateChainConfig(t *testing.T) {
@@ -905,6 +905,274 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// access_list is the EIP-2930 access list of the addresses and storage keys
+	// accessed by the transaction, excluding the sender, recipient and precompiles
+	AccessList AccessList `protobuf:"bytes,1,rep,name=access_list,json=accessList,proto3,castrepeated=AccessList" json:"access_list"`
+	// gas_used is the gas used by the transaction with the access list applied
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm_error is the error returned by the EVM execution, if any
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{18}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() AccessList {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+// SimulateV1Request defines SimulateV1 request
+type SimulateV1Request struct {
+	// opts uses the same json format as the eth_simulateV1 json rpc api.
+	Opts []byte `protobuf:"bytes,1,opt,name=opts,proto3" json:"opts,omitempty"`
+	// gas_cap defines the max gas that can be used by all the simulated calls
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// proposer_address of the requested block in hex format
+	ProposerAddress github_com_cosmos_cosmos_sdk_types.ConsAddress `protobuf:"bytes,3,opt,name=proposer_address,json=proposerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ConsAddress" json:"proposer_address,omitempty"`
+	// chain_id is the eip155 chain id parsed from the requested block header
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *SimulateV1Request) Reset()         { *m = SimulateV1Request{} }
+func (m *SimulateV1Request) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Request) ProtoMessage()    {}
+func (*SimulateV1Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{19}
+}
+func (m *SimulateV1Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Request.Merge(m, src)
+}
+func (m *SimulateV1Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Request proto.InternalMessageInfo
+
+func (m *SimulateV1Request) GetOpts() []byte {
+	if m != nil {
+		return m.Opts
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+func (m *SimulateV1Request) GetProposerAddress() github_com_cosmos_cosmos_sdk_types.ConsAddress {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *SimulateV1Request) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+// SimulateV1Response defines SimulateV1 response
+type SimulateV1Response struct {
+	// blocks are the results of the simulated blocks
+	Blocks []SimulatedBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (m *SimulateV1Response) Reset()         { *m = SimulateV1Response{} }
+func (m *SimulateV1Response) String() string { return proto.CompactTextString(m) }
+func (*SimulateV1Response) ProtoMessage()    {}
+func (*SimulateV1Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{20}
+}
+func (m *SimulateV1Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateV1Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateV1Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateV1Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateV1Response.Merge(m, src)
+}
+func (m *SimulateV1Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateV1Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateV1Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateV1Response proto.InternalMessageInfo
+
+func (m *SimulateV1Response) GetBlocks() []SimulatedBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+// SimulatedBlock defines the result of a block simulated by SimulateV1
+type SimulatedBlock struct {
+	// number is the height of the simulated block
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// time is the unix timestamp of the simulated block
+	Time uint64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	// fee_recipient is the hex address of the block coinbase
+	FeeRecipient string `protobuf:"bytes,3,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	// base_fee is the EIP1559 base fee of the simulated block
+	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+	// gas_used is the gas used by all the calls of the block
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// calls are the results of the simulated calls
+	Calls []MsgEthereumTxResponse `protobuf:"bytes,6,rep,name=calls,proto3" json:"calls"`
+}
+
+func (m *SimulatedBlock) Reset()         { *m = SimulatedBlock{} }
+func (m *SimulatedBlock) String() string { return proto.CompactTextString(m) }
+func (*SimulatedBlock) ProtoMessage()    {}
+func (*SimulatedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{21}
+}
+func (m *SimulatedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulatedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedBlock.Merge(m, src)
+}
+func (m *SimulatedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedBlock proto.InternalMessageInfo
+
+func (m *SimulatedBlock) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *SimulatedBlock) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *SimulatedBlock) GetCalls() []MsgEthereumTxResponse {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msg is the MsgEthereumTx for the requested transaction
@@ -932,7 +1200,7 @@ func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{18}
+	return fileDescriptor_e15a877459347994, []int{22}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1302,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{19}
+	return fileDescriptor_e15a877459347994, []int{23}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,7 +1362,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{20}
+	return fileDescriptor_e15a877459347994, []int{24}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1457,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{21}
+	return fileDescriptor_e15a877459347994, []int{25}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,6 +1493,71 @@ func (m *QueryTraceBlockResponse) GetData() []byte {
 	return nil
 }
 
+// QueryTraceBlockStreamResponse defines the TraceBlockStream response for a
+// single transaction of the block
+type QueryTraceBlockStreamResponse struct {
+	// tx_index is the index of the transaction in the traced transactions
+	TxIndex uint64 `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// tx_hash (hex) is the hash of the transaction
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// data is the JSON encoded trace result of the transaction
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryTraceBlockStreamResponse) Reset()         { *m = QueryTraceBlockStreamResponse{} }
+func (m *QueryTraceBlockStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTraceBlockStreamResponse) ProtoMessage()    {}
+func (*QueryTraceBlockStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *QueryTraceBlockStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTraceBlockStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTraceBlockStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTraceBlockStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTraceBlockStreamResponse.Merge(m, src)
+}
+func (m *QueryTraceBlockStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTraceBlockStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTraceBlockStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTraceBlockStreamResponse proto.InternalMessageInfo
+
+func (m *QueryTraceBlockStreamResponse) GetTxIndex() uint64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *QueryTraceBlockStreamResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *QueryTraceBlockStreamResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // QueryBaseFeeRequest defines the request type for querying the EIP1559 base
 // fee.
 type QueryBaseFeeRequest struct {
@@ -1234,7 +1567,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{22}
+	return fileDescriptor_e15a877459347994, []int{27}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1606,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e15a877459347994, []int{23}
+	return fileDescriptor_e15a877459347994, []int{28}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,10 +1654,15 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "ethermint.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "ethermint.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "ethermint.evm.v1.CreateAccessListResponse")
+	proto.RegisterType((*SimulateV1Request)(nil), "ethermint.evm.v1.SimulateV1Request")
+	proto.RegisterType((*SimulateV1Response)(nil), "ethermint.evm.v1.SimulateV1Response")
+	proto.RegisterType((*SimulatedBlock)(nil), "ethermint.evm.v1.SimulatedBlock")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "ethermint.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "ethermint.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "ethermint.evm.v1.QueryTraceBlockRequest")
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
+	proto.RegisterType((*QueryTraceBlockStreamResponse)(nil), "ethermint.evm.v1.QueryTraceBlockStreamResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
 }
@@ -1332,99 +1670,118 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6f, 0x13, 0x47,
-	0x14, 0xce, 0xc6, 0x4e, 0xec, 0x3c, 0x27, 0x90, 0x0e, 0x01, 0x9c, 0x25, 0x89, 0xc3, 0xb6, 0x89,
-	0x0d, 0x85, 0x5d, 0x92, 0xaa, 0x48, 0xed, 0xa5, 0x60, 0x2b, 0x50, 0x0a, 0x54, 0xd4, 0x8d, 0x7a,
-	0xa8, 0x84, 0xac, 0xf1, 0x7a, 0x58, 0x5b, 0xb1, 0x77, 0xcc, 0xce, 0xd8, 0x72, 0x40, 0x1c, 0x8a,
-	0x50, 0x7f, 0xa8, 0x17, 0xa4, 0xde, 0x7a, 0xe2, 0xde, 0x5b, 0x2f, 0x3d, 0xf6, 0xca, 0x11, 0xa9,
-	0x97, 0xaa, 0x07, 0x5a, 0x41, 0x0f, 0xfd, 0x1b, 0x7a, 0xaa, 0x66, 0x76, 0xd6, 0xde, 0xb5, 0xbd,
-	0x71, 0xa8, 0xe8, 0xad, 0xa7, 0xdd, 0x99, 0x79, 0xf3, 0xbe, 0x6f, 0xde, 0x7b, 0x33, 0xef, 0x83,
-	0x15, 0xc2, 0xeb, 0xc4, 0x6b, 0x35, 0x5c, 0x6e, 0x91, 0x6e, 0xcb, 0xea, 0x6e, 0x59, 0x77, 0x3b,
-	0xc4, 0xdb, 0x37, 0xdb, 0x1e, 0xe5, 0x14, 0x2d, 0xf6, 0x57, 0x4d, 0xd2, 0x6d, 0x99, 0xdd, 0x2d,
-	0xfd, 0xac, 0x4d, 0x59, 0x8b, 0x32, 0xab, 0x8a, 0x19, 0xf1, 0x4d, 0xad, 0xee, 0x56, 0x95, 0x70,
-	0xbc, 0x65, 0xb5, 0xb1, 0xd3, 0x70, 0x31, 0x6f, 0x50, 0xd7, 0xdf, 0xad, 0xeb, 0x23, 0xbe, 0x85,
-	0x13, 0x7f, 0x6d, 0x79, 0x64, 0x8d, 0xf7, 0xd4, 0xd2, 0x92, 0x43, 0x1d, 0x2a, 0x7f, 0x2d, 0xf1,
-	0xa7, 0x66, 0x57, 0x1c, 0x4a, 0x9d, 0x26, 0xb1, 0x70, 0xbb, 0x61, 0x61, 0xd7, 0xa5, 0x5c, 0x22,
-	0x31, 0xb5, 0x9a, 0x53, 0xab, 0x72, 0x54, 0xed, 0xdc, 0xb1, 0x78, 0xa3, 0x45, 0x18, 0xc7, 0xad,
-	0xb6, 0x6f, 0x60, 0xbc, 0x07, 0xc7, 0x3e, 0x11, 0x6c, 0x2f, 0xdb, 0x36, 0xed, 0xb8, 0xbc, 0x4c,
-	0xee, 0x76, 0x08, 0xe3, 0x28, 0x0b, 0x29, 0x5c, 0xab, 0x79, 0x84, 0xb1, 0xac, 0xb6, 0xae, 0x15,
-	0xe6, 0xca, 0xc1, 0xf0, 0xfd, 0xf4, 0xd7, 0x4f, 0x72, 0x53, 0x7f, 0x3d, 0xc9, 0x4d, 0x19, 0x36,
-	0x2c, 0x45, 0xb7, 0xb2, 0x36, 0x75, 0x19, 0x11, 0x7b, 0xab, 0xb8, 0x89, 0x5d, 0x9b, 0x04, 0x7b,
-	0xd5, 0x10, 0x9d, 0x82, 0x39, 0x9b, 0xd6, 0x48, 0xa5, 0x8e, 0x59, 0x3d, 0x3b, 0x2d, 0xd7, 0xd2,
-	0x62, 0xe2, 0x43, 0xcc, 0xea, 0x68, 0x09, 0x66, 0x5c, 0x2a, 0x36, 0x25, 0xd6, 0xb5, 0x42, 0xb2,
-	0xec, 0x0f, 0x8c, 0x0f, 0x60, 0x59, 0x82, 0x94, 0x64, 0x78, 0xff, 0x05, 0xcb, 0x2f, 0x35, 0xd0,
-	0xc7, 0x79, 0x50, 0x64, 0x37, 0xe0, 0x88, 0x9f, 0xb9, 0x4a, 0xd4, 0xd3, 0x82, 0x3f, 0x7b, 0xd9,
-	0x9f, 0x44, 0x3a, 0xa4, 0x99, 0x00, 0x15, 0xfc, 0xa6, 0x25, 0xbf, 0xfe, 0x58, 0xb8, 0xc0, 0xbe,
-	0xd7, 0x8a, 0xdb, 0x69, 0x55, 0x89, 0xa7, 0x4e, 0xb0, 0xa0, 0x66, 0x3f, 0x96, 0x93, 0xc6, 0x75,
-	0x58, 0x91, 0x3c, 0x3e, 0xc3, 0xcd, 0x46, 0x0d, 0x73, 0xea, 0x0d, 0x1d, 0xe6, 0x34, 0xcc, 0xdb,
-	0xd4, 0x1d, 0xe6, 0x91, 0x11, 0x73, 0x97, 0x47, 0x4e, 0xf5, 0xad, 0x06, 0xab, 0x31, 0xde, 0xd4,
-	0xc1, 0xf2, 0x70, 0x34, 0x60, 0x15, 0xf5, 0x18, 0x90, 0x7d, 0x8d, 0x47, 0x0b, 0x8a, 0xa8, 0xe8,
-	0xe7, 0xf9, 0x55, 0xd2, 0x73, 0x41, 0x15, 0x51, 0x7f, 0xeb, 0xa4, 0x22, 0x32, 0xae, 0x2b, 0xb0,
-	0x4f, 0x39, 0xf5, 0xb0, 0x33, 0x19, 0x0c, 0x2d, 0x42, 0x62, 0x8f, 0xec, 0xab, 0x7a, 0x13, 0xbf,
-	0x21, 0xf8, 0x73, 0x0a, 0xbe, 0xef, 0x4c, 0xc1, 0x2f, 0xc1, 0x4c, 0x17, 0x37, 0x3b, 0x01, 0xb8,
-	0x3f, 0x30, 0x2e, 0xc2, 0xa2, 0x2a, 0xa5, 0xda, 0x2b, 0x1d, 0x32, 0x0f, 0x6f, 0x84, 0xf6, 0x29,
-	0x08, 0x04, 0x49, 0x51, 0xfb, 0x72, 0xd7, 0x7c, 0x59, 0xfe, 0x1b, 0xf7, 0x00, 0x49, 0xc3, 0xdd,
-	0xde, 0x0d, 0xea, 0xb0, 0x00, 0x02, 0x41, 0x52, 0xde, 0x18, 0xdf, 0xbf, 0xfc, 0x47, 0x57, 0x00,
-	0x06, 0xef, 0x8a, 0x3c, 0x5b, 0x66, 0x7b, 0xd3, 0xf4, 0x8b, 0xd6, 0x14, 0x8f, 0x90, 0xe9, 0xbf,
-	0x57, 0xea, 0x11, 0x32, 0x6f, 0x0d, 0x42, 0x55, 0x0e, 0xed, 0x0c, 0x91, 0xfc, 0x46, 0x53, 0x81,
-	0x0d, 0xc0, 0x15, 0xcf, 0x33, 0x90, 0x6c, 0x52, 0x47, 0x9c, 0x2e, 0x51, 0xc8, 0x6c, 0x1f, 0x37,
-	0x87, 0x9f, 0x3e, 0xf3, 0x06, 0x75, 0xca, 0xd2, 0x04, 0x5d, 0x1d, 0x43, 0x2a, 0x3f, 0x91, 0x94,
-	0x8f, 0x13, 0x66, 0x65, 0x2c, 0xa9, 0x38, 0xdc, 0xc2, 0x1e, 0x6e, 0x05, 0x71, 0x30, 0x6e, 0x2a,
-	0x82, 0xc1, 0xac, 0x22, 0x78, 0x11, 0x66, 0xdb, 0x72, 0x46, 0x06, 0x28, 0xb3, 0x9d, 0x1d, 0xa5,
-	0xe8, 0xef, 0x28, 0x26, 0x9f, 0x3e, 0xcf, 0x4d, 0x95, 0x95, 0xb5, 0xf1, 0x93, 0x06, 0x47, 0x76,
-	0x78, 0xbd, 0x84, 0x9b, 0xcd, 0x50, 0xa4, 0xb1, 0xe7, 0xb0, 0x20, 0x27, 0xe2, 0x1f, 0x9d, 0x84,
-	0x94, 0x83, 0x59, 0xc5, 0xc6, 0x6d, 0x75, 0x3d, 0x66, 0x1d, 0xcc, 0x4a, 0xb8, 0x8d, 0x6e, 0xc3,
-	0x62, 0xdb, 0xa3, 0x6d, 0xca, 0x88, 0xd7, 0xbf, 0x62, 0xe2, 0x7a, 0xcc, 0x17, 0xb7, 0xff, 0x7e,
-	0x9e, 0x33, 0x9d, 0x06, 0xaf, 0x77, 0xaa, 0xa6, 0x4d, 0x5b, 0x96, 0xea, 0x0d, 0xfe, 0xe7, 0x3c,
-	0xab, 0xed, 0x59, 0x7c, 0xbf, 0x4d, 0x98, 0x59, 0x1a, 0xdc, 0xed, 0xf2, 0xd1, 0xc0, 0x57, 0x70,
-	0x2f, 0x97, 0x21, 0x6d, 0xd7, 0x71, 0xc3, 0xad, 0x34, 0x6a, 0xd9, 0xe4, 0xba, 0x56, 0x48, 0x94,
-	0x53, 0x72, 0x7c, 0xad, 0x66, 0xe4, 0xe1, 0xd8, 0x0e, 0xe3, 0x8d, 0x16, 0xe6, 0xe4, 0x2a, 0x1e,
-	0x04, 0x62, 0x11, 0x12, 0x0e, 0xf6, 0xc9, 0x27, 0xcb, 0xe2, 0xd7, 0x78, 0x94, 0x0c, 0x72, 0xea,
-	0x61, 0x9b, 0xec, 0xf6, 0x82, 0x73, 0x6e, 0x41, 0xa2, 0xc5, 0x1c, 0x15, 0xaf, 0xdc, 0x68, 0xbc,
-	0x6e, 0x32, 0x67, 0x47, 0xcc, 0x91, 0x4e, 0x6b, 0xb7, 0x57, 0x16, 0xb6, 0xe8, 0x12, 0xcc, 0x73,
-	0xe1, 0xa4, 0x62, 0x53, 0xf7, 0x4e, 0xc3, 0x91, 0x27, 0xcd, 0x6c, 0xaf, 0x8e, 0xee, 0x95, 0x50,
-	0x25, 0x69, 0x54, 0xce, 0xf0, 0xc1, 0x00, 0x95, 0x60, 0xbe, 0xed, 0x91, 0x1a, 0xb1, 0x09, 0x63,
-	0xd4, 0x63, 0xd9, 0xa4, 0x2c, 0xa8, 0x89, 0xe8, 0x91, 0x4d, 0xe2, 0x95, 0xac, 0x36, 0xa9, 0xbd,
-	0x17, 0xbc, 0x47, 0x33, 0x32, 0x32, 0x19, 0x39, 0xe7, 0xbf, 0x46, 0x68, 0x15, 0xc0, 0x37, 0x91,
-	0x97, 0x66, 0x56, 0x5e, 0x9a, 0x39, 0x39, 0x23, 0xfb, 0x4c, 0x29, 0x58, 0x16, 0xad, 0x30, 0x9b,
-	0x92, 0xc7, 0xd0, 0x4d, 0xbf, 0x4f, 0x9a, 0x41, 0x9f, 0x34, 0x77, 0x83, 0x3e, 0x59, 0x4c, 0x8b,
-	0xa2, 0x79, 0xfc, 0x7b, 0x4e, 0x53, 0x4e, 0xc4, 0xca, 0xd8, 0xdc, 0xa7, 0xff, 0x9b, 0xdc, 0xcf,
-	0x45, 0x72, 0x8f, 0x0c, 0x58, 0xf0, 0xe9, 0xb7, 0x70, 0xaf, 0x22, 0xd2, 0x0d, 0xa1, 0x08, 0xdc,
-	0xc4, 0xbd, 0xab, 0x98, 0x7d, 0x94, 0x4c, 0x4f, 0x2f, 0x26, 0xca, 0x69, 0xde, 0xab, 0x34, 0xdc,
-	0x1a, 0xe9, 0x19, 0x67, 0xd5, 0x2b, 0xd7, 0xaf, 0x82, 0xc1, 0x13, 0x54, 0xc3, 0x1c, 0x07, 0xe5,
-	0x2e, 0xfe, 0x8d, 0x1f, 0x13, 0x70, 0x62, 0x60, 0x5c, 0x14, 0x5e, 0x43, 0x55, 0xc3, 0x7b, 0xc1,
-	0x43, 0x30, 0xb9, 0x6a, 0x78, 0x8f, 0xbd, 0x86, 0xaa, 0xf9, 0x3f, 0xe1, 0x93, 0x13, 0x6e, 0x9c,
-	0x87, 0x93, 0x23, 0x39, 0x3b, 0x20, 0xc7, 0xc7, 0xfb, 0xfd, 0x9a, 0x91, 0x2b, 0x24, 0xe8, 0x0b,
-	0xc6, 0xed, 0x7e, 0x2f, 0x56, 0xd3, 0xca, 0xc5, 0x0e, 0xa4, 0xc5, 0xe3, 0x5d, 0xb9, 0x43, 0x54,
-	0x3f, 0x2c, 0x9e, 0xfd, 0xed, 0x79, 0x6e, 0xf3, 0x10, 0x67, 0xbe, 0xe6, 0x72, 0xd1, 0xb8, 0xa5,
-	0xbb, 0xed, 0x9f, 0xe7, 0x61, 0x46, 0xfa, 0x47, 0x5f, 0x68, 0x90, 0x52, 0x7a, 0x05, 0x6d, 0x8c,
-	0xd6, 0xc2, 0x18, 0x41, 0xaa, 0x6f, 0x4e, 0x32, 0xf3, 0xb9, 0x1a, 0xf9, 0x87, 0xbf, 0xfc, 0xf9,
-	0xdd, 0xf4, 0x69, 0x94, 0x13, 0xf2, 0x99, 0xb2, 0x40, 0x44, 0x2b, 0xbd, 0x62, 0xdd, 0x57, 0xb9,
-	0x7b, 0x80, 0xbe, 0xd7, 0x60, 0x21, 0x22, 0x09, 0xd1, 0xdb, 0x31, 0x10, 0xe3, 0xa4, 0xa7, 0x7e,
-	0xee, 0x70, 0xc6, 0x8a, 0x95, 0x29, 0x59, 0x15, 0xd0, 0x66, 0x94, 0x55, 0xa0, 0x3c, 0x47, 0xc8,
-	0xfd, 0xa0, 0xc1, 0xe2, 0xb0, 0xb2, 0x43, 0x66, 0x0c, 0x64, 0x8c, 0xa0, 0xd4, 0xad, 0x43, 0xdb,
-	0x2b, 0x96, 0x17, 0x25, 0xcb, 0x0b, 0xc8, 0x8c, 0xb2, 0xec, 0x06, 0xf6, 0x03, 0xa2, 0x61, 0xa1,
-	0xfa, 0x00, 0x3d, 0xd4, 0x20, 0xa5, 0xf4, 0x5b, 0x6c, 0x3a, 0xa3, 0xd2, 0x30, 0x36, 0x9d, 0x43,
-	0x32, 0xd0, 0x28, 0x48, 0x4a, 0x06, 0x5a, 0x8f, 0x52, 0x52, 0x5a, 0x90, 0x85, 0x42, 0xf6, 0x95,
-	0x06, 0x29, 0xa5, 0xe2, 0x62, 0x49, 0x44, 0x25, 0x63, 0x2c, 0x89, 0x21, 0x31, 0x68, 0x9c, 0x97,
-	0x24, 0xf2, 0x68, 0x23, 0x4a, 0x82, 0xf9, 0x66, 0x03, 0x0e, 0xd6, 0xfd, 0x3d, 0xb2, 0xff, 0x00,
-	0x75, 0x21, 0x29, 0x84, 0x1e, 0x32, 0x62, 0x4b, 0xa4, 0xaf, 0x1e, 0xf5, 0x37, 0x0f, 0xb4, 0x51,
-	0xf8, 0x1b, 0x12, 0x3f, 0x87, 0x56, 0x87, 0xab, 0xa7, 0x16, 0x89, 0x00, 0x83, 0x59, 0x5f, 0xe7,
-	0xa0, 0xb7, 0x62, 0xbc, 0x46, 0xe4, 0x94, 0xbe, 0x31, 0xc1, 0x4a, 0xa1, 0xaf, 0x48, 0xf4, 0x13,
-	0x68, 0x29, 0x8a, 0xee, 0x8b, 0x28, 0xc4, 0x21, 0xa5, 0x34, 0x14, 0x5a, 0x1f, 0xf5, 0x17, 0x95,
-	0x57, 0x7a, 0x7e, 0x52, 0xcf, 0x08, 0x30, 0xd7, 0x24, 0x66, 0x16, 0x9d, 0x88, 0x62, 0x12, 0x5e,
-	0xaf, 0xd8, 0x02, 0xea, 0x1e, 0x64, 0x42, 0x02, 0xe8, 0x10, 0xc8, 0x63, 0xce, 0x3a, 0x46, 0x41,
-	0x19, 0x86, 0xc4, 0x5d, 0x41, 0xfa, 0x10, 0xae, 0x32, 0x15, 0xcf, 0x2f, 0xea, 0x41, 0x4a, 0xf5,
-	0xd1, 0xd8, 0x3a, 0x8b, 0xaa, 0xad, 0xd8, 0x3a, 0x1b, 0x6a, 0xc7, 0x71, 0xa7, 0xf6, 0x1b, 0x28,
-	0xef, 0xa1, 0x47, 0x1a, 0xc0, 0xe0, 0x85, 0x47, 0x85, 0x83, 0xdc, 0x86, 0x1b, 0xb7, 0x7e, 0xe6,
-	0x10, 0x96, 0x8a, 0xc3, 0x69, 0xc9, 0xe1, 0x14, 0x5a, 0x1e, 0xc7, 0x41, 0xb6, 0x1c, 0x11, 0x00,
-	0xd5, 0x21, 0x0e, 0xb8, 0xed, 0xe1, 0xc6, 0x72, 0xc0, 0x6d, 0x8f, 0x34, 0x9a, 0xb8, 0x00, 0x04,
-	0xcd, 0xa7, 0x78, 0xe9, 0xe9, 0x8b, 0x35, 0xed, 0xd9, 0x8b, 0x35, 0xed, 0x8f, 0x17, 0x6b, 0xda,
-	0xe3, 0x97, 0x6b, 0x53, 0xcf, 0x5e, 0xae, 0x4d, 0xfd, 0xfa, 0x72, 0x6d, 0xea, 0xf3, 0x70, 0x33,
-	0xea, 0xef, 0xa5, 0xcc, 0xea, 0x6e, 0xbd, 0x6b, 0xf5, 0xa4, 0x1f, 0xd9, 0x90, 0xaa, 0xb3, 0xb2,
-	0xdf, 0xbf, 0xf3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9f, 0xb4, 0x4f, 0x77, 0xdf, 0x11, 0x00,
-	0x00,
+	// 1770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0x14, 0x49, 0x3d, 0x49, 0x36, 0x33, 0x66, 0x1c, 0x6a, 0x23, 0x89, 0xf2, 0x3a,
+	0xfa, 0x88, 0x1b, 0xef, 0x9a, 0x2a, 0x6a, 0xa0, 0x3d, 0xb4, 0x31, 0x09, 0xc5, 0x75, 0x63, 0x17,
+	0x29, 0xad, 0xe6, 0x50, 0x20, 0x20, 0x86, 0xcb, 0xd1, 0x72, 0x61, 0xee, 0x2e, 0xbd, 0x33, 0x24,
+	0x56, 0x0e, 0x7c, 0x68, 0x10, 0xb4, 0x69, 0x7b, 0x09, 0xd0, 0x5b, 0x4f, 0x39, 0xf4, 0xd4, 0xde,
+	0x7a, 0x29, 0xd0, 0xfe, 0x03, 0x39, 0x06, 0xe8, 0xa5, 0xe8, 0xc1, 0x29, 0xec, 0x1e, 0xfa, 0x37,
+	0xf4, 0x54, 0xcc, 0xc7, 0x92, 0xbb, 0xfc, 0x76, 0x90, 0x02, 0x3d, 0xe4, 0xb4, 0x33, 0xb3, 0xef,
+	0xe3, 0x37, 0xef, 0xbd, 0x79, 0x1f, 0xb0, 0x43, 0x58, 0x87, 0x84, 0x9e, 0xeb, 0x33, 0x8b, 0x0c,
+	0x3c, 0x6b, 0x50, 0xb5, 0x1e, 0xf7, 0x49, 0x78, 0x61, 0xf6, 0xc2, 0x80, 0x05, 0xa8, 0x38, 0xfc,
+	0x6b, 0x92, 0x81, 0x67, 0x0e, 0xaa, 0xfa, 0x0d, 0x3b, 0xa0, 0x5e, 0x40, 0xad, 0x16, 0xa6, 0x44,
+	0x92, 0x5a, 0x83, 0x6a, 0x8b, 0x30, 0x5c, 0xb5, 0x7a, 0xd8, 0x71, 0x7d, 0xcc, 0xdc, 0xc0, 0x97,
+	0xdc, 0xba, 0x3e, 0x21, 0x9b, 0x0b, 0x91, 0xff, 0xb6, 0x27, 0xfe, 0xb1, 0x48, 0xfd, 0x2a, 0x39,
+	0x81, 0x13, 0x88, 0xa5, 0xc5, 0x57, 0xea, 0x74, 0xc7, 0x09, 0x02, 0xa7, 0x4b, 0x2c, 0xdc, 0x73,
+	0x2d, 0xec, 0xfb, 0x01, 0x13, 0x9a, 0xa8, 0xfa, 0x5b, 0x51, 0x7f, 0xc5, 0xae, 0xd5, 0x3f, 0xb7,
+	0x98, 0xeb, 0x11, 0xca, 0xb0, 0xd7, 0x93, 0x04, 0xc6, 0x77, 0xe1, 0xca, 0x4f, 0x38, 0xda, 0x3b,
+	0xb6, 0x1d, 0xf4, 0x7d, 0xd6, 0x20, 0x8f, 0xfb, 0x84, 0x32, 0x54, 0x86, 0x3c, 0x6e, 0xb7, 0x43,
+	0x42, 0x69, 0x59, 0xdb, 0xd7, 0x8e, 0xd7, 0x1b, 0xf1, 0xf6, 0x7b, 0x85, 0x4f, 0x3e, 0xab, 0xac,
+	0xfc, 0xfb, 0xb3, 0xca, 0x8a, 0x61, 0x43, 0x29, 0xcd, 0x4a, 0x7b, 0x81, 0x4f, 0x09, 0xe7, 0x6d,
+	0xe1, 0x2e, 0xf6, 0x6d, 0x12, 0xf3, 0xaa, 0x2d, 0x7a, 0x1d, 0xd6, 0xed, 0xa0, 0x4d, 0x9a, 0x1d,
+	0x4c, 0x3b, 0xe5, 0x55, 0xf1, 0xaf, 0xc0, 0x0f, 0x7e, 0x88, 0x69, 0x07, 0x95, 0x60, 0xcd, 0x0f,
+	0x38, 0x53, 0x66, 0x5f, 0x3b, 0xce, 0x36, 0xe4, 0xc6, 0xf8, 0x01, 0x6c, 0x0b, 0x25, 0x75, 0x61,
+	0xde, 0xaf, 0x80, 0xf2, 0x17, 0x1a, 0xe8, 0xd3, 0x24, 0x28, 0xb0, 0x07, 0x70, 0x49, 0x7a, 0xae,
+	0x99, 0x96, 0xb4, 0x25, 0x4f, 0xef, 0xc8, 0x43, 0xa4, 0x43, 0x81, 0x72, 0xa5, 0x1c, 0xdf, 0xaa,
+	0xc0, 0x37, 0xdc, 0x73, 0x11, 0x58, 0x4a, 0x6d, 0xfa, 0x7d, 0xaf, 0x45, 0x42, 0x75, 0x83, 0x2d,
+	0x75, 0xfa, 0x63, 0x71, 0x68, 0xbc, 0x0b, 0x3b, 0x02, 0xc7, 0xfb, 0xb8, 0xeb, 0xb6, 0x31, 0x0b,
+	0xc2, 0xb1, 0xcb, 0x5c, 0x83, 0x4d, 0x3b, 0xf0, 0xc7, 0x71, 0x6c, 0xf0, 0xb3, 0x3b, 0x13, 0xb7,
+	0xfa, 0x8d, 0x06, 0xbb, 0x33, 0xa4, 0xa9, 0x8b, 0x1d, 0xc1, 0xe5, 0x18, 0x55, 0x5a, 0x62, 0x0c,
+	0xf6, 0x6b, 0xbc, 0x5a, 0x1c, 0x44, 0x35, 0xe9, 0xe7, 0x97, 0x71, 0xcf, 0x2d, 0x28, 0xa5, 0x59,
+	0x17, 0x05, 0x91, 0xf1, 0xae, 0x52, 0xf6, 0x90, 0x05, 0x21, 0x76, 0x16, 0x2b, 0x43, 0x45, 0xc8,
+	0x3c, 0x22, 0x17, 0x2a, 0xde, 0xf8, 0x32, 0xa1, 0xfe, 0x2d, 0x28, 0xa5, 0x85, 0x29, 0xf5, 0x25,
+	0x58, 0x1b, 0xe0, 0x6e, 0x3f, 0x56, 0x2e, 0x37, 0xc6, 0x6d, 0x28, 0xaa, 0x50, 0x6a, 0xbf, 0xd4,
+	0x25, 0x8f, 0xe0, 0x95, 0x04, 0x9f, 0x52, 0x81, 0x20, 0xcb, 0x63, 0x5f, 0x70, 0x6d, 0x36, 0xc4,
+	0xda, 0x78, 0x02, 0x48, 0x10, 0x9e, 0x45, 0xf7, 0x03, 0x87, 0xc6, 0x2a, 0x10, 0x64, 0xc5, 0x8b,
+	0x91, 0xf2, 0xc5, 0x1a, 0xbd, 0x03, 0x30, 0xca, 0x2b, 0xe2, 0x6e, 0x1b, 0x27, 0x87, 0xa6, 0x0c,
+	0x5a, 0x93, 0x27, 0x21, 0x53, 0xe6, 0x2b, 0x95, 0x84, 0xcc, 0xf7, 0x46, 0xa6, 0x6a, 0x24, 0x38,
+	0x13, 0x20, 0x7f, 0xa5, 0xc1, 0x95, 0x94, 0x72, 0x85, 0xf3, 0x4d, 0xc8, 0x76, 0x03, 0x87, 0xdf,
+	0x2e, 0x73, 0xbc, 0x71, 0xf2, 0xaa, 0x39, 0x9e, 0xfa, 0xcc, 0xfb, 0x81, 0xd3, 0x10, 0x24, 0xe8,
+	0xee, 0x14, 0x50, 0x47, 0x0b, 0x41, 0x49, 0x3d, 0x49, 0x54, 0x46, 0x49, 0xd9, 0xe1, 0x3d, 0x1c,
+	0x62, 0x2f, 0xb6, 0x83, 0xf1, 0x00, 0xae, 0xa4, 0x4e, 0x15, 0xc0, 0xdb, 0x90, 0xeb, 0x89, 0x13,
+	0x61, 0xa0, 0x8d, 0x93, 0xf2, 0x24, 0x44, 0xc9, 0x51, 0xcb, 0x7e, 0xfe, 0xac, 0xb2, 0xd2, 0x50,
+	0xd4, 0xc6, 0x9f, 0x35, 0xb8, 0x74, 0xca, 0x3a, 0x75, 0xdc, 0xed, 0x26, 0x2c, 0x8d, 0x43, 0x87,
+	0xc6, 0x3e, 0xe1, 0x6b, 0xf4, 0x1a, 0xe4, 0x1d, 0x4c, 0x9b, 0x36, 0xee, 0xa9, 0xe7, 0x91, 0x73,
+	0x30, 0xad, 0xe3, 0x1e, 0xfa, 0x00, 0x8a, 0xbd, 0x30, 0xe8, 0x05, 0x94, 0x84, 0xc3, 0x27, 0xc6,
+	0x9f, 0xc7, 0x66, 0xed, 0xe4, 0x3f, 0xcf, 0x2a, 0xa6, 0xe3, 0xb2, 0x4e, 0xbf, 0x65, 0xda, 0x81,
+	0x67, 0xa9, 0xda, 0x20, 0x3f, 0x37, 0x69, 0xfb, 0x91, 0xc5, 0x2e, 0x7a, 0x84, 0x9a, 0xf5, 0xd1,
+	0xdb, 0x6e, 0x5c, 0x8e, 0x65, 0xc5, 0xef, 0x72, 0x1b, 0x0a, 0x76, 0x07, 0xbb, 0x7e, 0xd3, 0x6d,
+	0x97, 0xb3, 0xfb, 0xda, 0x71, 0xa6, 0x91, 0x17, 0xfb, 0x7b, 0x6d, 0xe3, 0x08, 0xae, 0x9c, 0x52,
+	0xe6, 0x7a, 0x98, 0x91, 0xbb, 0x78, 0x64, 0x88, 0x22, 0x64, 0x1c, 0x2c, 0xc1, 0x67, 0x1b, 0x7c,
+	0x69, 0xfc, 0x5e, 0x83, 0x72, 0x3d, 0x24, 0x98, 0x91, 0x3b, 0xb6, 0x4d, 0x28, 0xbd, 0xef, 0xd2,
+	0x51, 0x86, 0x68, 0xc0, 0x06, 0x16, 0xa7, 0xcd, 0xae, 0x4b, 0x99, 0xf2, 0xef, 0xee, 0xa4, 0xf1,
+	0x24, 0xeb, 0x59, 0xbf, 0xd7, 0x25, 0x35, 0xc4, 0x2d, 0xf8, 0x87, 0x2f, 0x2b, 0x90, 0x90, 0x07,
+	0x78, 0xb8, 0xe6, 0xa0, 0xb9, 0xb1, 0xfa, 0x94, 0xb4, 0x95, 0xb5, 0xb8, 0xf1, 0x7e, 0x4a, 0x49,
+	0x9b, 0xff, 0x1a, 0x78, 0x4d, 0x12, 0x86, 0x81, 0xcc, 0x22, 0xeb, 0x8d, 0xfc, 0xc0, 0x3b, 0xe5,
+	0x5b, 0xe3, 0x2f, 0x1a, 0xbc, 0xf2, 0xd0, 0xf5, 0xfa, 0x5d, 0xcc, 0xc8, 0xfb, 0xd5, 0x84, 0x33,
+	0x82, 0x1e, 0x1b, 0x3a, 0x83, 0xaf, 0xff, 0x1f, 0x9d, 0x71, 0x06, 0x28, 0x89, 0x5d, 0x19, 0xf7,
+	0xfb, 0x90, 0x6b, 0x75, 0x03, 0xfb, 0x51, 0xfc, 0x6e, 0xf6, 0x27, 0xed, 0x1a, 0x73, 0xb5, 0x6b,
+	0x9c, 0x30, 0x0e, 0x4e, 0xc9, 0x65, 0x7c, 0xb2, 0x0a, 0x97, 0xd2, 0x04, 0xe8, 0x2a, 0xe4, 0x54,
+	0x12, 0xd6, 0x04, 0x02, 0xb5, 0xe3, 0x76, 0xe2, 0x55, 0x5d, 0x19, 0x44, 0xac, 0xd1, 0x75, 0xd8,
+	0x3a, 0x27, 0xa4, 0x19, 0x12, 0xdb, 0xed, 0xb9, 0xc4, 0x67, 0xca, 0xe2, 0x9b, 0xe7, 0x84, 0x34,
+	0xe2, 0x33, 0x74, 0x0a, 0x05, 0xfe, 0x28, 0x9b, 0xe7, 0x84, 0x88, 0x4b, 0xad, 0xd7, 0x6e, 0xfc,
+	0xe3, 0x59, 0xe5, 0x70, 0x09, 0x5b, 0xdd, 0xf3, 0x19, 0x4f, 0xc8, 0x94, 0xbc, 0x43, 0x48, 0xca,
+	0xe7, 0x6b, 0x69, 0x9f, 0xd7, 0x61, 0xcd, 0xc6, 0xdd, 0x2e, 0x2d, 0xe7, 0x84, 0x11, 0x8e, 0x26,
+	0x8d, 0xf0, 0x80, 0x3a, 0xa7, 0xfc, 0x8c, 0xf4, 0xbd, 0xb3, 0x28, 0xb6, 0x9e, 0xb2, 0x85, 0xe4,
+	0x35, 0x3e, 0xce, 0xc6, 0x89, 0x29, 0xc4, 0x36, 0x39, 0x8b, 0xe2, 0xf8, 0xa8, 0x42, 0xc6, 0xa3,
+	0x8e, 0x7a, 0xf4, 0x95, 0x45, 0xa2, 0x39, 0x2d, 0x7a, 0x1b, 0x36, 0x19, 0x17, 0xd2, 0xb4, 0x03,
+	0xff, 0xdc, 0x75, 0x84, 0x55, 0xa6, 0xc6, 0xbc, 0x50, 0x55, 0x17, 0x44, 0x8d, 0x0d, 0x36, 0xda,
+	0xa0, 0x3a, 0x6c, 0xf6, 0x42, 0xd2, 0x26, 0x3c, 0xe2, 0x83, 0x90, 0x96, 0xb3, 0xfb, 0x99, 0x65,
+	0xb4, 0xa7, 0x98, 0x78, 0xa9, 0x17, 0x6e, 0x8e, 0x8b, 0xea, 0x9a, 0xf0, 0xe7, 0x86, 0x38, 0x93,
+	0x25, 0x15, 0xed, 0x02, 0x48, 0x12, 0x91, 0xf9, 0x73, 0xc2, 0x7b, 0xeb, 0xe2, 0x44, 0x34, 0x4b,
+	0xf5, 0xf8, 0xb7, 0xf0, 0x7c, 0x5e, 0x5c, 0x43, 0x37, 0x65, 0xb3, 0x67, 0xc6, 0xcd, 0x9e, 0x79,
+	0x16, 0x37, 0x7b, 0xb5, 0x02, 0x37, 0xe8, 0xa7, 0x5f, 0x56, 0x34, 0x25, 0x84, 0xff, 0x99, 0xfa,
+	0x66, 0x0a, 0xff, 0x9b, 0x37, 0xb3, 0x9e, 0x7a, 0x33, 0xc8, 0x80, 0x2d, 0x09, 0xdf, 0xc3, 0x51,
+	0x93, 0xe7, 0x2c, 0x48, 0x58, 0xe0, 0x01, 0x8e, 0xee, 0x62, 0xfa, 0xa3, 0x6c, 0x61, 0xb5, 0x98,
+	0x69, 0x14, 0x58, 0xd4, 0x74, 0xfd, 0x36, 0x89, 0x8c, 0x1b, 0xaa, 0x54, 0x0f, 0xa3, 0x60, 0x54,
+	0x47, 0xdb, 0x98, 0xe1, 0x38, 0x4d, 0xf0, 0xb5, 0xf1, 0xa7, 0x0c, 0x5c, 0x1d, 0x11, 0x8b, 0xe7,
+	0x93, 0x88, 0x1a, 0x16, 0xc5, 0xaf, 0x72, 0x71, 0xd4, 0xb0, 0x88, 0x7e, 0x0d, 0x51, 0xf3, 0x8d,
+	0xc3, 0x17, 0x3b, 0xdc, 0xb8, 0x09, 0xaf, 0x4d, 0xf8, 0x6c, 0x8e, 0x8f, 0x1d, 0xd8, 0x1d, 0x23,
+	0x7f, 0xc8, 0x42, 0x82, 0xbd, 0x21, 0xd3, 0x36, 0x0c, 0x83, 0x47, 0xd5, 0xc4, 0x3c, 0x8b, 0xee,
+	0xf1, 0x2d, 0x2f, 0x23, 0x2c, 0x4a, 0x8e, 0x21, 0x39, 0x16, 0x09, 0x33, 0xc7, 0x8a, 0x32, 0x09,
+	0x45, 0xaf, 0x0e, 0xbb, 0x5b, 0x91, 0xef, 0xe2, 0x6e, 0xe4, 0x03, 0x28, 0xa5, 0x8f, 0x95, 0xda,
+	0x64, 0x56, 0xd5, 0xbe, 0x72, 0x56, 0x3d, 0xf9, 0xeb, 0x65, 0x58, 0x13, 0xf2, 0xd1, 0xcf, 0x35,
+	0xc8, 0xab, 0xee, 0x1e, 0x1d, 0x4c, 0x06, 0xdd, 0x94, 0xf1, 0x4d, 0x3f, 0x5c, 0x44, 0x26, 0xb1,
+	0x1a, 0x47, 0x1f, 0xfd, 0xed, 0x5f, 0xbf, 0x5d, 0xbd, 0x86, 0x2a, 0x7c, 0xd8, 0x0c, 0x68, 0x3c,
+	0x72, 0xaa, 0xee, 0xde, 0xfa, 0x50, 0x05, 0xc9, 0x53, 0xf4, 0x3b, 0x0d, 0xb6, 0x52, 0x03, 0x14,
+	0xfa, 0xd6, 0x0c, 0x15, 0xd3, 0x06, 0x35, 0xfd, 0xad, 0xe5, 0x88, 0x15, 0x2a, 0x53, 0xa0, 0x3a,
+	0x46, 0x87, 0x69, 0x54, 0xf1, 0x9c, 0x36, 0x01, 0xee, 0x8f, 0x1a, 0x14, 0xc7, 0xe7, 0x20, 0x64,
+	0xce, 0x50, 0x39, 0x63, 0xfc, 0xd2, 0xad, 0xa5, 0xe9, 0x15, 0xca, 0xdb, 0x02, 0xe5, 0x2d, 0x64,
+	0xa6, 0x51, 0x0e, 0x62, 0xfa, 0x11, 0xd0, 0xe4, 0x58, 0xf7, 0x14, 0x7d, 0xa4, 0x41, 0x5e, 0x4d,
+	0x3b, 0x33, 0xdd, 0x99, 0x1e, 0xa4, 0xf4, 0xc3, 0x45, 0x64, 0x0a, 0xd2, 0xb1, 0x80, 0x64, 0xa0,
+	0xfd, 0x34, 0x24, 0x35, 0x39, 0xd1, 0x84, 0xc9, 0x7e, 0xa9, 0x41, 0x5e, 0xcd, 0x3c, 0x33, 0x41,
+	0xa4, 0x07, 0x2c, 0xfd, 0x70, 0x11, 0x99, 0x02, 0x71, 0x53, 0x80, 0x38, 0x42, 0x07, 0x69, 0x10,
+	0x54, 0x92, 0x8d, 0x30, 0x58, 0x1f, 0x3e, 0x22, 0x17, 0x4f, 0xd1, 0x00, 0xb2, 0x7c, 0x2c, 0x42,
+	0xc6, 0xcc, 0x10, 0x19, 0xce, 0x5a, 0xfa, 0xf5, 0xb9, 0x34, 0x4a, 0xff, 0x81, 0xd0, 0x5f, 0x41,
+	0xbb, 0xe3, 0xd1, 0xd3, 0x4e, 0x59, 0x80, 0x42, 0x4e, 0x4e, 0x05, 0xe8, 0x8d, 0x19, 0x52, 0x53,
+	0xc3, 0x87, 0x7e, 0xb0, 0x80, 0x4a, 0x69, 0xdf, 0x11, 0xda, 0xaf, 0xa2, 0x52, 0x5a, 0xbb, 0x1c,
+	0x39, 0x10, 0x83, 0xbc, 0x9a, 0x38, 0xd0, 0x94, 0x86, 0x30, 0x3d, 0x8c, 0xe8, 0xcb, 0x76, 0x4b,
+	0xc6, 0x9e, 0xd0, 0x59, 0x46, 0x57, 0xd3, 0x3a, 0x09, 0xeb, 0x34, 0x79, 0x07, 0x85, 0x9e, 0xc0,
+	0x46, 0x62, 0x5c, 0x58, 0x42, 0xf3, 0x94, 0xbb, 0x4e, 0x99, 0x37, 0x0c, 0x43, 0xe8, 0xdd, 0x41,
+	0xfa, 0x98, 0x5e, 0x45, 0xca, 0xf3, 0x3c, 0xfa, 0xb5, 0x06, 0xc5, 0xf1, 0x09, 0x64, 0x09, 0x04,
+	0x37, 0x26, 0x29, 0x66, 0xcd, 0x31, 0xb3, 0xa2, 0xde, 0x16, 0xf4, 0xcd, 0xc4, 0x88, 0x83, 0x9e,
+	0x00, 0x8c, 0x5a, 0x75, 0x74, 0x7d, 0x76, 0x4b, 0x3e, 0x1c, 0x42, 0xf4, 0x37, 0xe6, 0x13, 0x29,
+	0x08, 0xd7, 0x04, 0x84, 0xd7, 0xd1, 0xf6, 0x58, 0xcc, 0x2b, 0xca, 0xe6, 0xa0, 0x8a, 0x22, 0xc8,
+	0xab, 0xce, 0x65, 0xe6, 0x83, 0x4b, 0xf7, 0xb7, 0xfa, 0xe1, 0x22, 0xb2, 0xf9, 0xee, 0x97, 0x2d,
+	0x0b, 0x8b, 0xd0, 0xc7, 0x1a, 0xc0, 0xa8, 0x48, 0xa2, 0xe3, 0x79, 0x62, 0x93, 0xad, 0x92, 0xfe,
+	0xe6, 0x12, 0x94, 0xf3, 0x0d, 0x20, 0x31, 0x88, 0x22, 0x8f, 0x1e, 0x43, 0x71, 0xbc, 0x54, 0xbf,
+	0x04, 0x16, 0x6b, 0x21, 0x65, 0xba, 0xfa, 0x1b, 0x2b, 0xb7, 0x34, 0x6e, 0x73, 0x55, 0x9d, 0xe7,
+	0x64, 0xda, 0x64, 0x51, 0xd7, 0x0f, 0x17, 0x91, 0xcd, 0xb7, 0x79, 0x5c, 0xf8, 0x6b, 0x6f, 0x7f,
+	0xfe, 0x7c, 0x4f, 0xfb, 0xe2, 0xf9, 0x9e, 0xf6, 0xcf, 0xe7, 0x7b, 0xda, 0xa7, 0x2f, 0xf6, 0x56,
+	0xbe, 0x78, 0xb1, 0xb7, 0xf2, 0xf7, 0x17, 0x7b, 0x2b, 0x3f, 0x4b, 0x36, 0x02, 0x43, 0xde, 0x80,
+	0x5a, 0x83, 0xea, 0x77, 0xac, 0x48, 0xc8, 0x11, 0xcd, 0x40, 0x2b, 0x27, 0x9a, 0xba, 0x6f, 0xff,
+	0x77, 0x00, 0xa6, 0x94, 0xd2, 0x9f, 0x89, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1459,10 +1816,17 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (*QueryTraceBlockResponse, error)
+	// TraceBlockStream is a streaming variant of TraceBlock that sends the trace
+	// of each transaction of the block as soon as it's available
+	TraceBlockStream(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (Query_TraceBlockStreamClient, error)
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
@@ -1557,6 +1921,24 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateV1(ctx context.Context, in *SimulateV1Request, opts ...grpc.CallOption) (*SimulateV1Response, error) {
+	out := new(SimulateV1Response)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/SimulateV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1575,6 +1957,38 @@ func (c *queryClient) TraceBlock(ctx context.Context, in *QueryTraceBlockRequest
 	return out, nil
 }
 
+func (c *queryClient) TraceBlockStream(ctx context.Context, in *QueryTraceBlockRequest, opts ...grpc.CallOption) (Query_TraceBlockStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/ethermint.evm.v1.Query/TraceBlockStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryTraceBlockStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_TraceBlockStreamClient interface {
+	Recv() (*QueryTraceBlockStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryTraceBlockStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryTraceBlockStreamClient) Recv() (*QueryTraceBlockStreamResponse, error) {
+	m := new(QueryTraceBlockStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
 	out := new(QueryBaseFeeResponse)
 	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/BaseFee", in, out, opts...)
@@ -1606,10 +2020,17 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
+	// SimulateV1 implements the `eth_simulateV1` rpc api
+	SimulateV1(context.Context, *SimulateV1Request) (*SimulateV1Response, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
 	TraceBlock(context.Context, *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error)
+	// TraceBlockStream is a streaming variant of TraceBlock that sends the trace
+	// of each transaction of the block as soon as it's available
+	TraceBlockStream(*QueryTraceBlockRequest, Query_TraceBlockStreamServer) error
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
@@ -1646,12 +2067,21 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
+func (*UnimplementedQueryServer) SimulateV1(ctx context.Context, req *SimulateV1Request) (*SimulateV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateV1 not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
 func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTraceBlockRequest) (*QueryTraceBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceBlock not implemented")
 }
+func (*UnimplementedQueryServer) TraceBlockStream(req *QueryTraceBlockRequest, srv Query_TraceBlockStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method TraceBlockStream not implemented")
+}
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
@@ -1822,61 +2252,118 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceTxRequest)
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TraceTx(ctx, in)
+		return srv.(QueryServer).CreateAccessList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
+		FullMethod: "/ethermint.evm.v1.Query/CreateAccessList",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTraceBlockRequest)
+func _Query_SimulateV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateV1Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TraceBlock(ctx, in)
+		return srv.(QueryServer).SimulateV1(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/TraceBlock",
+		FullMethod: "/ethermint.evm.v1.Query/SimulateV1",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TraceBlock(ctx, req.(*QueryTraceBlockRequest))
+		return srv.(QueryServer).SimulateV1(ctx, req.(*SimulateV1Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseFeeRequest)
+func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BaseFee(ctx, in)
+		return srv.(QueryServer).TraceTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+		FullMethod: "/ethermint.evm.v1.Query/TraceTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+		return srv.(QueryServer).TraceTx(ctx, req.(*QueryTraceTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
+func _Query_TraceBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTraceBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TraceBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/TraceBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TraceBlock(ctx, req.(*QueryTraceBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TraceBlockStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryTraceBlockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).TraceBlockStream(m, &queryTraceBlockStreamServer{stream})
+}
+
+type Query_TraceBlockStreamServer interface {
+	Send(*QueryTraceBlockStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryTraceBlockStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryTraceBlockStreamServer) Send(m *QueryTraceBlockStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -1916,6 +2403,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
+		{
+			MethodName: "SimulateV1",
+			Handler:    _Query_SimulateV1_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -1929,7 +2424,13 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_BaseFee_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TraceBlockStream",
+			Handler:       _Query_TraceBlockStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ethermint/evm/v1/query.proto",
 }
 
@@ -2554,6 +3055,210 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		for iNdEx := len(m.AccessList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccessList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Opts) > 0 {
+		i -= len(m.Opts)
+		copy(dAtA[i:], m.Opts)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Opts)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateV1Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateV1Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateV1Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Time != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Number != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2798,6 +3503,48 @@ func (m *QueryTraceBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTraceBlockStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTraceBlockStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTraceBlockStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3137,14 +3884,106 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTraceTxRequest) Size() (n int) {
+func (m *CreateAccessListResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Msg != nil {
-		l = m.Msg.Size()
+	if len(m.AccessList) > 0 {
+		for _, e := range m.AccessList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateV1Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Opts)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovQuery(uint64(m.GasCap))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *SimulateV1Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulatedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovQuery(uint64(m.Number))
+	}
+	if m.Time != 0 {
+		n += 1 + sovQuery(uint64(m.Time))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTraceTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.TraceConfig != nil {
@@ -3243,6 +4082,26 @@ func (m *QueryTraceBlockResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTraceBlockStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryBaseFeeRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5012,7 +5871,7 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5035,15 +5894,15 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5070,18 +5929,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgEthereumTx{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccessList = append(m.AccessList, AccessTuple{})
+			if err := m.AccessList[len(m.AccessList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			var msglen int
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5091,33 +5948,16 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TraceConfig == nil {
-				m.TraceConfig = &TraceConfig{}
-			}
-			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5127,50 +5967,79 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
-			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateV1Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateV1Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateV1Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opts", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5180,29 +6049,31 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Opts = append(m.Opts[:0], dAtA[iNdEx:postIndex]...)
+			if m.Opts == nil {
+				m.Opts = []byte{}
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
 			}
-			var msglen int
+			m.GasCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5212,26 +6083,12 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasCap |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
 			}
@@ -5265,7 +6122,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				m.ProposerAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
@@ -5284,25 +6141,6 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
-			}
-			m.BlockMaxGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockMaxGas |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5324,7 +6162,7 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+func (m *SimulateV1Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5347,10 +6185,615 @@ func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateV1Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateV1Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, SimulatedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, MsgEthereumTxResponse{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgEthereumTx{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predecessors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predecessors = append(m.Predecessors, &MsgEthereumTx{})
+			if err := m.Predecessors[len(m.Predecessors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockMaxGas", wireType)
+			}
+			m.BlockMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockMaxGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTraceTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5768,6 +7211,141 @@ func (m *QueryTraceBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTraceBlockStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTraceBlockStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTraceBlockStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
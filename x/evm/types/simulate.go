@@ -0,0 +1,92 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxSimulateBlocks is the max number of blocks that can be simulated on a single
+// eth_simulateV1 request.
+const MaxSimulateBlocks = 256
+
+// SimOpts are the inputs of eth_simulateV1.
+type SimOpts struct {
+	// BlockStateCalls are the blocks to simulate, executed sequentially on top of
+	// the requested block.
+	BlockStateCalls []SimBlock `json:"blockStateCalls"`
+	// Validation enables the nonce, base fee and balance checks of the calls, which
+	// are skipped by default as in eth_call.
+	Validation bool `json:"validation"`
+}
+
+// SimBlock is a block of calls simulated sequentially, after applying the block and
+// state overrides.
+type SimBlock struct {
+	BlockOverrides *BlockOverrides   `json:"blockOverrides"`
+	StateOverrides StateOverride     `json:"stateOverrides"`
+	Calls          []TransactionArgs `json:"calls"`
+}
+
+// BlockOverrides is the set of block header fields to override on a simulated block.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// StateOverride is the collection of overridden accounts.
+// Duplicate definition since the JSON-RPC type cannot be imported by the module.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of an account. State and StateDiff
+// can't be specified at the same time. If State is set, the account storage is
+// replaced by it. Otherwise, the StateDiff slots are applied on top of the account
+// storage.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   *hexutil.Big                 `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Validate performs a stateless validation of the simulation inputs.
+func (opts SimOpts) Validate() error {
+	if len(opts.BlockStateCalls) == 0 {
+		return errors.New("empty input")
+	}
+
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return fmt.Errorf("too many blocks: %d > %d", len(opts.BlockStateCalls), MaxSimulateBlocks)
+	}
+
+	for _, block := range opts.BlockStateCalls {
+		if overrides := block.BlockOverrides; overrides != nil {
+			if overrides.Number != nil && (overrides.Number.ToInt().Sign() < 0 || !overrides.Number.ToInt().IsInt64()) {
+				return fmt.Errorf("invalid block number override %s", overrides.Number)
+			}
+
+			if overrides.BaseFeePerGas != nil && overrides.BaseFeePerGas.ToInt().Sign() < 0 {
+				return fmt.Errorf("base fee override cannot be negative: %s", overrides.BaseFeePerGas)
+			}
+		}
+
+		for addr, account := range block.StateOverrides {
+			if account.State != nil && account.StateDiff != nil {
+				return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+			}
+
+			if account.Balance != nil && account.Balance.ToInt().Sign() < 0 {
+				return fmt.Errorf("account %s balance override cannot be negative", addr.Hex())
+			}
+		}
+	}
+
+	return nil
+}
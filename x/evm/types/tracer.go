@@ -3,6 +3,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"math/big"
 	"os"
 	"time"
@@ -44,6 +46,34 @@ func NewTracer(tracer string, msg core.Message, cfg *params.ChainConfig, height
 	}
 }
 
+// UnmarshalJSON implements json.Unmarshaler. The tracer configuration can be
+// given either as a JSON object, as sent by the Ethereum clients, or as a JSON
+// encoded string.
+func (tc *TraceConfig) UnmarshalJSON(data []byte) error {
+	type traceConfig TraceConfig
+	aux := struct {
+		*traceConfig
+		TracerJSONConfig json.RawMessage `json:"tracerConfig"`
+	}{
+		traceConfig: (*traceConfig)(tc),
+	}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	tracerConfig := bytes.TrimSpace(aux.TracerJSONConfig)
+	switch {
+	case len(tracerConfig) == 0, bytes.Equal(tracerConfig, []byte("null")):
+		tc.TracerJsonConfig = ""
+	case tracerConfig[0] == '"':
+		return json.Unmarshal(tracerConfig, &tc.TracerJsonConfig)
+	default:
+		tc.TracerJsonConfig = string(tracerConfig)
+	}
+	return nil
+}
+
 // TxTraceResult is the result of a single transaction trace during a block trace.
 type TxTraceResult struct {
 	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
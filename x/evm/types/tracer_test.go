@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,3 +10,50 @@ import (
 func TestNewNoOpTracer(t *testing.T) {
 	require.Equal(t, &NoOpTracer{}, NewNoOpTracer())
 }
+
+func TestTraceConfigUnmarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      string
+		expConfig TraceConfig
+		expPass   bool
+	}{
+		{
+			"tracer config as object",
+			`{"tracer":"callTracer","tracerConfig":{"onlyTopCall":true}}`,
+			TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+			true,
+		},
+		{
+			"tracer config as string",
+			`{"tracer":"callTracer","tracerConfig":"{\"onlyTopCall\":true}"}`,
+			TraceConfig{Tracer: "callTracer", TracerJsonConfig: `{"onlyTopCall":true}`},
+			true,
+		},
+		{
+			"null tracer config",
+			`{"disableStack":true,"tracerConfig":null}`,
+			TraceConfig{DisableStack: true},
+			true,
+		},
+		{
+			"invalid trace config",
+			`{"tracer":1}`,
+			TraceConfig{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var config TraceConfig
+			err := json.Unmarshal([]byte(tc.data), &config)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expConfig, config)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
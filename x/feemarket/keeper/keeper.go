@@ -7,6 +7,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -101,6 +102,26 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	return result, nil
 }
 
+// GetTransientSenderTxCount returns the number of eth transactions of the given
+// sender that have been accepted in the current block from the transient store.
+func (k Keeper) GetTransientSenderTxCount(ctx sdk.Context, sender sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSenderTxCount)
+	bz := store.Get(sender.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementTransientSenderTxCount increments the number of eth transactions of the
+// given sender in the transient store and returns the updated value.
+func (k Keeper) IncrementTransientSenderTxCount(ctx sdk.Context, sender sdk.AccAddress) uint64 {
+	count := k.GetTransientSenderTxCount(ctx, sender) + 1
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientSenderTxCount)
+	store.Set(sender.Bytes(), sdk.Uint64ToBigEndian(count))
+	return count
+}
+
 // GetBaseFeeV1 get the base fee from v1 version of states.
 // return nil if base fee is not enabled
 // TODO: Figure out if this will be deleted ?
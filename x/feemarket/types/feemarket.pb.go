@@ -43,6 +43,14 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// contract_min_gas_prices defines minimum gas prices for eth transactions
+	// that call specific contracts or contract methods. They are enforced on
+	// top of the global min_gas_price.
+	ContractMinGasPrices []ContractMinGasPrice `protobuf:"bytes,9,rep,name=contract_min_gas_prices,json=contractMinGasPrices,proto3" json:"contract_min_gas_prices"`
+	// max_txs_per_sender_per_block defines the maximum number of eth transactions
+	// a single sender can get accepted into the mempool (CheckTx) per block.
+	// A value of 0 disables the limit.
+	MaxTxsPerSenderPerBlock uint64 `protobuf:"varint,10,opt,name=max_txs_per_sender_per_block,json=maxTxsPerSenderPerBlock,proto3" json:"max_txs_per_sender_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +114,83 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetContractMinGasPrices() []ContractMinGasPrice {
+	if m != nil {
+		return m.ContractMinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxsPerSenderPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTxsPerSenderPerBlock
+	}
+	return 0
+}
+
+// ContractMinGasPrice defines a minimum gas price for eth transactions whose
+// recipient is the given contract.
+type ContractMinGasPrice struct {
+	// contract_address is the hex address of the contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// method_selector is the optional hex encoded 4-byte method selector
+	// (e.g 0xa9059cbb). If empty, the min gas price applies to all calls to the
+	// contract.
+	MethodSelector string `protobuf:"bytes,2,opt,name=method_selector,json=methodSelector,proto3" json:"method_selector,omitempty"`
+	// min_gas_price defines the minimum gas price for the contract (or method)
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price"`
+}
+
+func (m *ContractMinGasPrice) Reset()         { *m = ContractMinGasPrice{} }
+func (m *ContractMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*ContractMinGasPrice) ProtoMessage()    {}
+func (*ContractMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *ContractMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMinGasPrice.Merge(m, src)
+}
+func (m *ContractMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMinGasPrice proto.InternalMessageInfo
+
+func (m *ContractMinGasPrice) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractMinGasPrice) GetMethodSelector() string {
+	if m != nil {
+		return m.MethodSelector
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*ContractMinGasPrice)(nil), "ethermint.feemarket.v1.ContractMinGasPrice")
 }
 
 func init() {
@@ -115,32 +198,41 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0x8a, 0xdb, 0x30,
-	0x10, 0xc6, 0xad, 0x66, 0x37, 0xeb, 0xd5, 0x36, 0x10, 0xc4, 0xb6, 0x98, 0x16, 0xbc, 0xa6, 0x85,
-	0xc5, 0x87, 0xd6, 0x26, 0x2c, 0x3d, 0xf6, 0x92, 0x2e, 0xdb, 0x6e, 0xa1, 0x10, 0x7c, 0x2c, 0x05,
-	0x21, 0x3b, 0x13, 0x5b, 0xc4, 0x92, 0x8c, 0xa5, 0x98, 0xe6, 0x2d, 0xfa, 0x58, 0x39, 0xe6, 0x54,
-	0x4a, 0x0f, 0xa1, 0x24, 0x2f, 0x52, 0x62, 0x27, 0x76, 0xae, 0xed, 0x45, 0x7f, 0xe6, 0xfb, 0xf4,
-	0x9b, 0x91, 0x34, 0xf8, 0x16, 0x4c, 0x06, 0xa5, 0xe0, 0xd2, 0x84, 0x33, 0x00, 0xc1, 0xca, 0x39,
-	0x98, 0xb0, 0x1a, 0x75, 0x9b, 0xa0, 0x28, 0x95, 0x51, 0xe4, 0x79, 0xeb, 0x0b, 0x3a, 0xa9, 0x1a,
-	0xbd, 0xb8, 0x4e, 0x55, 0xaa, 0x6a, 0x4b, 0xb8, 0x5f, 0x35, 0xee, 0x57, 0x3f, 0x7b, 0xb8, 0x3f,
-	0x61, 0x25, 0x13, 0x9a, 0xb8, 0xf8, 0x4a, 0x2a, 0x1a, 0x33, 0x0d, 0x74, 0x06, 0xe0, 0x20, 0x0f,
-	0xf9, 0x76, 0x74, 0x29, 0xd5, 0x98, 0x69, 0x78, 0x00, 0x20, 0xef, 0xf1, 0xcb, 0xa3, 0x48, 0x93,
-	0x8c, 0xc9, 0x14, 0xe8, 0x14, 0xa4, 0x12, 0x5c, 0x32, 0xa3, 0x4a, 0xe7, 0x89, 0x87, 0xfc, 0x41,
-	0xe4, 0xc4, 0x8d, 0xfb, 0x43, 0x6d, 0xb8, 0xef, 0x74, 0x72, 0x87, 0x9f, 0x41, 0xce, 0xb4, 0xe1,
-	0x09, 0x37, 0x4b, 0x2a, 0x16, 0xb9, 0xe1, 0x45, 0xce, 0xa1, 0x74, 0x7a, 0xf5, 0xc1, 0xeb, 0x4e,
-	0xfc, 0xd2, 0x6a, 0xe4, 0x35, 0x1e, 0x80, 0x64, 0x71, 0x0e, 0x34, 0x03, 0x9e, 0x66, 0xc6, 0x39,
-	0xf7, 0x90, 0xdf, 0x8b, 0x9e, 0x36, 0xc1, 0x4f, 0x75, 0x8c, 0x3c, 0x62, 0xbb, 0xad, 0xba, 0xef,
-	0x21, 0xff, 0x72, 0x1c, 0xac, 0x36, 0x37, 0xd6, 0xef, 0xcd, 0xcd, 0x6d, 0xca, 0x4d, 0xb6, 0x88,
-	0x83, 0x44, 0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x98, 0xde, 0xea, 0xe9, 0x3c, 0x34, 0xcb, 0x02,
-	0x74, 0xf0, 0x28, 0x4d, 0x74, 0x71, 0xa8, 0x9a, 0x44, 0x78, 0x20, 0xb8, 0xa4, 0x29, 0xd3, 0xb4,
-	0x28, 0x79, 0x02, 0xce, 0xc5, 0x3f, 0xf3, 0xee, 0x21, 0x89, 0xae, 0x04, 0x97, 0x1f, 0x99, 0x9e,
-	0xec, 0x11, 0xe4, 0x1b, 0x26, 0x47, 0xe6, 0xc9, 0xad, 0xed, 0xff, 0x02, 0x0f, 0x1b, 0x70, 0xf7,
-	0x42, 0x9f, 0xcf, 0xec, 0xb3, 0xe1, 0x79, 0x34, 0xe4, 0x92, 0x1b, 0xce, 0xf2, 0xf6, 0xfb, 0xc6,
-	0x0f, 0xab, 0xad, 0x8b, 0xd6, 0x5b, 0x17, 0xfd, 0xd9, 0xba, 0xe8, 0xc7, 0xce, 0xb5, 0xd6, 0x3b,
-	0xd7, 0xfa, 0xb5, 0x73, 0xad, 0xaf, 0x6f, 0x4e, 0x72, 0x41, 0xb5, 0x4f, 0xd5, 0x8c, 0xd5, 0xe8,
-	0x5d, 0xf8, 0xfd, 0xa4, 0xb7, 0xea, 0xac, 0x71, 0xbf, 0xee, 0x93, 0xbb, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x5a, 0x22, 0x3a, 0xef, 0x7f, 0x02, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6f, 0xd3, 0x3e,
+	0x1c, 0x6d, 0xbe, 0xed, 0xb6, 0xd6, 0xfb, 0x96, 0x55, 0xa6, 0xb0, 0x08, 0x50, 0x16, 0x0d, 0x69,
+	0x04, 0x01, 0x89, 0xca, 0xc4, 0x71, 0x07, 0xba, 0x69, 0x30, 0xa4, 0x49, 0x55, 0xc6, 0x09, 0x21,
+	0x59, 0x4e, 0xf2, 0x59, 0x62, 0x35, 0xb6, 0x2b, 0xdb, 0xab, 0xba, 0xff, 0x82, 0x7f, 0x88, 0xfb,
+	0x8e, 0x3b, 0x22, 0x0e, 0x13, 0x6a, 0xff, 0x10, 0x50, 0x93, 0xfe, 0x42, 0xdb, 0x05, 0xb8, 0x24,
+	0xce, 0x7b, 0xcf, 0x4f, 0xcf, 0xf1, 0xfb, 0xa0, 0x3d, 0x30, 0x19, 0x28, 0xce, 0x84, 0x09, 0xce,
+	0x01, 0x38, 0x55, 0x7d, 0x30, 0xc1, 0xb0, 0xb3, 0xfc, 0xf0, 0x07, 0x4a, 0x1a, 0x89, 0x1f, 0x2e,
+	0x74, 0xfe, 0x92, 0x1a, 0x76, 0x1e, 0xb5, 0x53, 0x99, 0xca, 0x42, 0x12, 0x4c, 0x57, 0xa5, 0x7a,
+	0xf7, 0x67, 0x0d, 0xad, 0xf7, 0xa8, 0xa2, 0x5c, 0x63, 0x07, 0x6d, 0x0a, 0x49, 0x22, 0xaa, 0x81,
+	0x9c, 0x03, 0xd8, 0x96, 0x6b, 0x79, 0xf5, 0xb0, 0x21, 0x64, 0x97, 0x6a, 0x38, 0x06, 0xc0, 0x07,
+	0xe8, 0xf1, 0x9c, 0x24, 0x71, 0x46, 0x45, 0x0a, 0x24, 0x01, 0x21, 0x39, 0x13, 0xd4, 0x48, 0x65,
+	0xff, 0xe7, 0x5a, 0x5e, 0x33, 0xb4, 0xa3, 0x52, 0x7d, 0x58, 0x08, 0x8e, 0x96, 0x3c, 0xde, 0x47,
+	0x0f, 0x20, 0xa7, 0xda, 0xb0, 0x98, 0x99, 0x4b, 0xc2, 0x2f, 0x72, 0xc3, 0x06, 0x39, 0x03, 0x65,
+	0x57, 0x8b, 0x8d, 0xed, 0x25, 0x79, 0xba, 0xe0, 0xf0, 0x53, 0xd4, 0x04, 0x41, 0xa3, 0x1c, 0x48,
+	0x06, 0x2c, 0xcd, 0x8c, 0xbd, 0xe6, 0x5a, 0x5e, 0x35, 0xfc, 0xbf, 0x04, 0xdf, 0x17, 0x18, 0x3e,
+	0x41, 0xf5, 0x45, 0xea, 0x75, 0xd7, 0xf2, 0x1a, 0x5d, 0xff, 0xea, 0x66, 0xa7, 0xf2, 0xfd, 0x66,
+	0x67, 0x2f, 0x65, 0x26, 0xbb, 0x88, 0xfc, 0x58, 0xf2, 0x20, 0x96, 0x9a, 0x4b, 0x3d, 0x7b, 0xbd,
+	0xd2, 0x49, 0x3f, 0x30, 0x97, 0x03, 0xd0, 0xfe, 0x89, 0x30, 0xe1, 0xc6, 0x2c, 0x35, 0x0e, 0x51,
+	0x93, 0x33, 0x41, 0x52, 0xaa, 0xc9, 0x40, 0xb1, 0x18, 0xec, 0x8d, 0x3f, 0xf6, 0x3b, 0x82, 0x38,
+	0xdc, 0xe4, 0x4c, 0xbc, 0xa3, 0xba, 0x37, 0xb5, 0xc0, 0x9f, 0x11, 0x9e, 0x7b, 0xae, 0x9c, 0xba,
+	0xfe, 0x57, 0xc6, 0xad, 0xd2, 0x78, 0xe5, 0x0f, 0x65, 0x68, 0x3b, 0x96, 0xc2, 0x28, 0x1a, 0x1b,
+	0xf2, 0x5b, 0x74, 0x6d, 0x37, 0xdc, 0xaa, 0xb7, 0xf9, 0xfa, 0x85, 0x7f, 0x77, 0x21, 0xfc, 0xc3,
+	0xd9, 0xb6, 0xd3, 0x65, 0xd6, 0x6e, 0x6d, 0x9a, 0x27, 0x6c, 0xc7, 0xb7, 0x29, 0x8d, 0x0f, 0xd0,
+	0x13, 0x4e, 0x47, 0xc4, 0x8c, 0x34, 0x19, 0x80, 0x22, 0x1a, 0x44, 0x02, 0xaa, 0x58, 0x46, 0xb9,
+	0x8c, 0xfb, 0x36, 0x72, 0x2d, 0xaf, 0x16, 0x6e, 0x73, 0x3a, 0xfa, 0x38, 0xd2, 0x3d, 0x50, 0x67,
+	0x85, 0xa0, 0x07, 0xaa, 0x3b, 0xa5, 0x3f, 0xd4, 0xea, 0xb5, 0xd6, 0x5a, 0xd8, 0x62, 0x82, 0x19,
+	0x46, 0xf3, 0x45, 0xcf, 0x76, 0xbf, 0x5a, 0xe8, 0xfe, 0x1d, 0x51, 0xf0, 0x73, 0xd4, 0x5a, 0x1c,
+	0x8c, 0x26, 0x89, 0x02, 0xad, 0x8b, 0x4e, 0x36, 0xc2, 0xad, 0x39, 0xfe, 0xb6, 0x84, 0xf1, 0x33,
+	0xb4, 0xc5, 0xc1, 0x64, 0x32, 0x21, 0x1a, 0x72, 0x88, 0xe7, 0x6d, 0x6c, 0x84, 0xf7, 0x4a, 0xf8,
+	0x6c, 0x86, 0xde, 0xbe, 0xde, 0xea, 0x3f, 0x5f, 0x6f, 0xf7, 0xf8, 0x6a, 0xec, 0x58, 0xd7, 0x63,
+	0xc7, 0xfa, 0x31, 0x76, 0xac, 0x2f, 0x13, 0xa7, 0x72, 0x3d, 0x71, 0x2a, 0xdf, 0x26, 0x4e, 0xe5,
+	0xd3, 0xcb, 0x15, 0x3b, 0x18, 0x4e, 0xdd, 0xca, 0xe7, 0xb0, 0xf3, 0x26, 0x18, 0xad, 0x0c, 0x71,
+	0x61, 0x1c, 0xad, 0x17, 0x03, 0xb9, 0xff, 0x6b, 0x00, 0x93, 0xfd, 0x7c, 0xd5, 0xe8, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +255,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxTxsPerSenderPerBlock != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxTxsPerSenderPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ContractMinGasPrices) > 0 {
+		for iNdEx := len(m.ContractMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeemarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -221,6 +332,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MethodSelector) > 0 {
+		i -= len(m.MethodSelector)
+		copy(dAtA[i:], m.MethodSelector)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.MethodSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -256,6 +414,34 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if len(m.ContractMinGasPrices) > 0 {
+		for _, e := range m.ContractMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovFeemarket(uint64(l))
+		}
+	}
+	if m.MaxTxsPerSenderPerBlock != 0 {
+		n += 1 + sovFeemarket(uint64(m.MaxTxsPerSenderPerBlock))
+	}
+	return n
+}
+
+func (m *ContractMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = len(m.MethodSelector)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
 	return n
 }
 
@@ -473,6 +659,207 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractMinGasPrices = append(m.ContractMinGasPrices, ContractMinGasPrice{})
+			if err := m.ContractMinGasPrices[len(m.ContractMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerSenderPerBlock", wireType)
+			}
+			m.MaxTxsPerSenderPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerSenderPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodSelector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodSelector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -26,6 +26,7 @@ const (
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientSenderTxCount
 )
 
 // KVStore key prefixes
@@ -36,4 +37,5 @@ var (
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientSenderTxCount  = []byte{prefixTransientSenderTxCount}
 )
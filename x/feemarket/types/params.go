@@ -3,11 +3,15 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -104,7 +108,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateMinGasPrice(p.MinGasPrice)
+	if err := validateMinGasPrice(p.MinGasPrice); err != nil {
+		return err
+	}
+
+	return validateContractMinGasPrices(p.ContractMinGasPrices)
 }
 
 func validateBool(i interface{}) error {
@@ -119,6 +127,36 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// GetContractMinGasPrice returns the minimum gas price for a call to the given
+// contract with the given input data. A min gas price defined for the called
+// method takes precedence over the one defined for the whole contract. It returns
+// false if no min gas price is defined for the call.
+func (p Params) GetContractMinGasPrice(contract common.Address, input []byte) (sdk.Dec, bool) {
+	var (
+		minGasPrice sdk.Dec
+		found       bool
+	)
+
+	for _, cmgp := range p.ContractMinGasPrices {
+		if common.HexToAddress(cmgp.ContractAddress) != contract {
+			continue
+		}
+
+		if cmgp.MethodSelector == "" {
+			// keep looking for a method specific min gas price
+			minGasPrice, found = cmgp.MinGasPrice, true
+			continue
+		}
+
+		selector := common.FromHex(cmgp.MethodSelector)
+		if len(input) >= len(selector) && bytes.Equal(input[:len(selector)], selector) {
+			return cmgp.MinGasPrice, true
+		}
+	}
+
+	return minGasPrice, found
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -137,6 +175,39 @@ func validateMinGasPrice(i interface{}) error {
 	return nil
 }
 
+func validateContractMinGasPrices(i interface{}) error {
+	contractMinGasPrices, ok := i.([]ContractMinGasPrice)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(contractMinGasPrices))
+	for _, cmgp := range contractMinGasPrices {
+		if !common.IsHexAddress(cmgp.ContractAddress) {
+			return fmt.Errorf("invalid contract address: %s", cmgp.ContractAddress)
+		}
+
+		if cmgp.MethodSelector != "" {
+			selector, err := hexutil.Decode(cmgp.MethodSelector)
+			if err != nil || len(selector) != 4 {
+				return fmt.Errorf("invalid method selector: %s", cmgp.MethodSelector)
+			}
+		}
+
+		key := strings.ToLower(common.HexToAddress(cmgp.ContractAddress).Hex() + cmgp.MethodSelector)
+		if seen[key] {
+			return fmt.Errorf("duplicate min gas price for contract %s and method selector %q", cmgp.ContractAddress, cmgp.MethodSelector)
+		}
+		seen[key] = true
+
+		if err := validateMinGasPrice(cmgp.MinGasPrice); err != nil {
+			return fmt.Errorf("invalid min gas price for contract %s: %w", cmgp.ContractAddress, err)
+		}
+	}
+
+	return nil
+}
+
 func validateBaseFeeChangeDenominator(i interface{}) error {
 	value, ok := i.(uint32)
 	if !ok {
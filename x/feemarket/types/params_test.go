@@ -59,6 +59,73 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
 			true,
 		},
+		{
+			"valid: contract min gas prices",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.NewInt(1),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractMinGasPrices: []ContractMinGasPrice{
+					{ContractAddress: "0xB5D85CBf7cB3EE0D56b3bB207D5Fc4B82f43F511", MinGasPrice: sdk.NewDec(10)},
+					{ContractAddress: "0xB5D85CBf7cB3EE0D56b3bB207D5Fc4B82f43F511", MethodSelector: "0xa9059cbb", MinGasPrice: sdk.NewDec(20)},
+				},
+			},
+			false,
+		},
+		{
+			"invalid: contract min gas price with invalid address",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.NewInt(1),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractMinGasPrices: []ContractMinGasPrice{
+					{ContractAddress: "0xinvalid", MinGasPrice: sdk.NewDec(10)},
+				},
+			},
+			true,
+		},
+		{
+			"invalid: contract min gas price with invalid method selector",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.NewInt(1),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractMinGasPrices: []ContractMinGasPrice{
+					{ContractAddress: "0xB5D85CBf7cB3EE0D56b3bB207D5Fc4B82f43F511", MethodSelector: "0xa9059c", MinGasPrice: sdk.NewDec(10)},
+				},
+			},
+			true,
+		},
+		{
+			"invalid: duplicate contract min gas price",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.NewInt(1),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractMinGasPrices: []ContractMinGasPrice{
+					{ContractAddress: "0xB5D85CBf7cB3EE0D56b3bB207D5Fc4B82f43F511", MinGasPrice: sdk.NewDec(10)},
+					{ContractAddress: "0xb5d85cbf7cb3ee0d56b3bb207d5fc4b82f43f511", MinGasPrice: sdk.NewDec(20)},
+				},
+			},
+			true,
+		},
+		{
+			"invalid: negative contract min gas price",
+			Params{
+				BaseFeeChangeDenominator: 8,
+				BaseFee:                  sdkmath.NewInt(1),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				ContractMinGasPrices: []ContractMinGasPrice{
+					{ContractAddress: "0xB5D85CBf7cB3EE0D56b3bB207D5Fc4B82f43F511", MinGasPrice: sdk.NewDec(-1)},
+				},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,274 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v15/ibc"
+	"github.com/evmos/evmos/v15/x/ibc/callbacks/types"
+)
+
+// OnAcknowledgementPacket invokes the onPacketAcknowledgement callback of the
+// contract that sent the packet, if requested in the packet memo. The callback
+// failures are logged and don't prevent the acknowledgement of the packet.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	ack channeltypes.Acknowledgement,
+	acknowledgement []byte,
+) {
+	callback := k.sourceCallback(ctx, packet, data)
+	if callback == nil {
+		return
+	}
+
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	input, err := types.CallbacksABI.Pack(
+		types.OnPacketAcknowledgementMethod,
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.Sequence,
+		coin.Denom,
+		coin.Amount.BigInt(),
+		data.Receiver,
+		ack.Success(),
+		acknowledgement,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack acknowledgement callback", "error", err.Error())
+		return
+	}
+
+	_ = k.executeCallback(
+		ctx, types.EventTypeSourceCallback, types.CallbackTypeAcknowledgement,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence, *callback, input,
+	)
+}
+
+// OnTimeoutPacket invokes the onPacketTimeout callback of the contract that
+// sent the packet, if requested in the packet memo. The callback failures are
+// logged and don't prevent the timeout of the packet.
+func (k Keeper) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) {
+	callback := k.sourceCallback(ctx, packet, data)
+	if callback == nil {
+		return
+	}
+
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+	input, err := types.CallbacksABI.Pack(
+		types.OnPacketTimeoutMethod,
+		packet.SourcePort,
+		packet.SourceChannel,
+		packet.Sequence,
+		coin.Denom,
+		coin.Amount.BigInt(),
+		data.Receiver,
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to pack timeout callback", "error", err.Error())
+		return
+	}
+
+	_ = k.executeCallback(
+		ctx, types.EventTypeSourceCallback, types.CallbackTypeTimeout,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence, *callback, input,
+	)
+}
+
+// OnRecvPacket invokes the onRecvPacket callback of the contract that received
+// the packet, if requested in the packet memo. An error is returned if the
+// callback is invalid or fails, so that the packet is acknowledged with an
+// error and the tokens are refunded to the sender.
+func (k Keeper) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) error {
+	if !k.GetParams(ctx).EnableCallbacks {
+		return nil
+	}
+
+	metadata, found, err := types.ParseCallbackMetadata(data.Memo)
+	if !found || err != nil {
+		return err
+	}
+
+	callback := metadata.DestCallback
+	if callback == nil {
+		return nil
+	}
+
+	_, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateCallback(ctx, *callback, recipient); err != nil {
+		return err
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+	input, err := types.CallbacksABI.Pack(
+		types.OnRecvPacketMethod,
+		packet.DestinationPort,
+		packet.DestinationChannel,
+		packet.Sequence,
+		coin.Denom,
+		coin.Amount.BigInt(),
+		data.Sender,
+	)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrCallbackFailed, err.Error())
+	}
+
+	return k.executeCallback(
+		ctx, types.EventTypeDestinationCallback, types.CallbackTypeReceive,
+		packet.DestinationPort, packet.DestinationChannel, packet.Sequence, *callback, input,
+	)
+}
+
+// sourceCallback returns the source callback requested in the memo of a sent
+// packet, or nil if there is none or if it cannot be executed.
+func (k Keeper) sourceCallback(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+) *types.CallbackData {
+	if !k.GetParams(ctx).EnableCallbacks {
+		return nil
+	}
+
+	metadata, found, err := types.ParseCallbackMetadata(data.Memo)
+	if !found || metadata.SrcCallback == nil {
+		return nil
+	}
+
+	if err == nil {
+		var sender sdk.AccAddress
+		sender, err = sdk.AccAddressFromBech32(data.Sender)
+		if err == nil {
+			err = k.validateCallback(ctx, *metadata.SrcCallback, sender)
+		}
+	}
+
+	if err != nil {
+		k.Logger(ctx).Error(
+			"invalid source callback",
+			"channel", packet.SourceChannel,
+			"sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+		return nil
+	}
+
+	return metadata.SrcCallback
+}
+
+// validateCallback checks that the callback contract is the given account, so
+// that contracts can only request callbacks for the packets they send or
+// receive.
+func (k Keeper) validateCallback(ctx sdk.Context, callback types.CallbackData, account sdk.AccAddress) error {
+	contract := callback.ContractAddress()
+	if !bytes.Equal(contract.Bytes(), account) {
+		return errorsmod.Wrapf(
+			types.ErrUnauthorizedCallback,
+			"contract %s is not the packet account %s", contract, account,
+		)
+	}
+
+	if !k.evmKeeper.IsContract(ctx, contract) {
+		return errorsmod.Wrapf(types.ErrUnauthorizedCallback, "account %s is not a contract", contract)
+	}
+
+	return nil
+}
+
+// executeCallback calls the callback contract with the given input from the
+// module address. The state changes of the callback are only committed if it
+// succeeds, and the gas it used is consumed from the context gas meter.
+//
+// The gas limit of the callback is bounded by the gas remaining in the context.
+// If a callback runs out of gas because the relayer provided less gas than
+// requested, the whole transaction runs out of gas, so that relayers cannot
+// make callbacks fail on purpose.
+func (k Keeper) executeCallback(
+	ctx sdk.Context,
+	eventType, callbackType string,
+	portID, channelID string,
+	sequence uint64,
+	callback types.CallbackData,
+	input []byte,
+) error {
+	gasLimit := callback.GetGasLimit(k.GetParams(ctx).MaxCallbackGas)
+	callbackGas := gasLimit
+	if remaining := ctx.GasMeter().GasRemaining(); remaining < callbackGas {
+		callbackGas = remaining
+	}
+
+	// the state is only committed if the callback succeeds, and the gas is
+	// consumed from the EVM execution instead of the store accesses
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	res, err := k.evmKeeper.CallContract(cacheCtx, types.ModuleAddress, callback.ContractAddress(), input, callbackGas)
+
+	gasUsed := callbackGas
+	if res != nil {
+		gasUsed = res.GasUsed
+	}
+
+	if err != nil && callbackGas < gasLimit && (res == nil || res.VmError == vm.ErrOutOfGas.Error()) {
+		ctx.GasMeter().ConsumeGas(gasLimit, "ibc callback out of gas")
+	}
+	ctx.GasMeter().ConsumeGas(gasUsed, "ibc callback")
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, callback.ContractAddress().Hex()),
+		sdk.NewAttribute(types.AttributeKeyCallbackType, callbackType),
+		sdk.NewAttribute(types.AttributeKeyPort, portID),
+		sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyGasLimit, strconv.FormatUint(callbackGas, 10)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+	}
+
+	if err != nil {
+		err = errorsmod.Wrap(types.ErrCallbackFailed, err.Error())
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+
+		k.Logger(ctx).Error(
+			"contract callback failed",
+			"contract", callback.ContractAddress().Hex(),
+			"callback_type", callbackType,
+			"channel", channelID,
+			"sequence", sequence,
+			"error", err.Error(),
+		)
+	} else {
+		writeFn()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(eventType, attributes...))
+
+	return err
+}
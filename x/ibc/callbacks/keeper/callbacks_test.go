@@ -0,0 +1,289 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/ibc/callbacks/types"
+)
+
+// callbackEvent returns the attributes of the callback event of the given type,
+// or nil if it was not emitted
+func callbackEvent(events sdk.Events, eventType string) map[string]string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		return attributes
+	}
+	return nil
+}
+
+func voucherDenom(baseDenom string, channels ...string) string {
+	denom := baseDenom
+	for i := len(channels) - 1; i >= 0; i-- {
+		denom = transfertypes.GetPrefixedDenom(transfertypes.PortID, channels[i], denom)
+	}
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+func callbackMemo(key string, contract common.Address, gasLimit uint64) string {
+	return fmt.Sprintf(`{"%s":{"address":"%s","gas_limit":"%d"}}`, key, contract.Hex(), gasLimit)
+}
+
+func (suite *CallbacksTestSuite) TestSourceCallbackAcknowledgement() {
+	testCases := []struct {
+		name        string
+		code        []byte
+		receiver    string
+		malleate    func(contract common.Address) string
+		expCalled   bool
+		expSuccess  bool
+		expRefunded bool
+	}{
+		{
+			"success - successful acknowledgement",
+			counterCode,
+			"",
+			func(contract common.Address) string {
+				return callbackMemo("src_callback", contract, 100_000)
+			},
+			true, true, false,
+		},
+		{
+			"success - error acknowledgement",
+			counterCode,
+			"invalid",
+			func(contract common.Address) string {
+				return callbackMemo("src_callback", contract, 100_000)
+			},
+			true, true, true,
+		},
+		{
+			"fail - callback reverts",
+			revertCode,
+			"",
+			func(contract common.Address) string {
+				return callbackMemo("src_callback", contract, 100_000)
+			},
+			true, false, false,
+		},
+		{
+			"fail - callback runs out of gas",
+			loopCode,
+			"",
+			func(contract common.Address) string {
+				return callbackMemo("src_callback", contract, 100_000)
+			},
+			true, false, false,
+		},
+		{
+			"no callback - contract is not the sender",
+			counterCode,
+			"",
+			func(common.Address) string {
+				other := suite.deployContract(counterCode)
+				return callbackMemo("src_callback", other, 100_000)
+			},
+			false, false, false,
+		},
+		{
+			"no callback - callbacks are disabled",
+			counterCode,
+			"",
+			func(contract common.Address) string {
+				params := types.DefaultParams()
+				params.EnableCallbacks = false
+				err := suite.evmosApp().CallbacksKeeper.SetParams(suite.EvmosChain.GetContext(), params)
+				suite.Require().NoError(err)
+				return callbackMemo("src_callback", contract, 100_000)
+			},
+			false, false, false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			contract := suite.deployContract(tc.code)
+			memo := tc.malleate(contract)
+
+			receiver := tc.receiver
+			if receiver == "" {
+				receiver = suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+			}
+
+			packet := suite.sendFromContract(contract, 100, receiver, memo, time.Hour)
+			_, ack := suite.recvPacket(suite.pathOsmosisEvmos.EndpointA, packet)
+			res := suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointB, packet, ack)
+
+			event := callbackEvent(res.GetEvents(), types.EventTypeSourceCallback)
+			if !tc.expCalled {
+				suite.Require().Nil(event)
+				suite.Require().Zero(suite.counter(contract))
+				return
+			}
+
+			suite.Require().NotNil(event)
+			suite.Require().Equal(contract.Hex(), event[types.AttributeKeyContract])
+			suite.Require().Equal(types.CallbackTypeAcknowledgement, event[types.AttributeKeyCallbackType])
+			suite.Require().Equal(fmt.Sprint(tc.expSuccess), event[types.AttributeKeySuccess])
+
+			if tc.expSuccess {
+				suite.Require().Equal(int64(1), suite.counter(contract))
+			}
+
+			balance := suite.evmosApp().BankKeeper.GetBalance(suite.EvmosChain.GetContext(), contract.Bytes(), utils.BaseDenom)
+			if tc.expRefunded {
+				suite.Require().Equal(sdk.NewInt(1000), balance.Amount)
+			} else {
+				suite.Require().Equal(sdk.NewInt(900), balance.Amount)
+			}
+		})
+	}
+}
+
+func (suite *CallbacksTestSuite) TestSourceCallbackTimeout() {
+	suite.SetupTest()
+
+	contract := suite.deployContract(counterCode)
+	receiver := suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+	memo := callbackMemo("src_callback", contract, 100_000)
+
+	packet := suite.sendFromContract(contract, 100, receiver, memo, time.Minute)
+
+	// time out the packet
+	suite.coordinator.IncrementTimeBy(2 * time.Minute)
+	suite.coordinator.CommitBlock(suite.IBCOsmosisChain)
+	res := suite.timeoutPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+
+	event := callbackEvent(res.GetEvents(), types.EventTypeSourceCallback)
+	suite.Require().NotNil(event)
+	suite.Require().Equal(types.CallbackTypeTimeout, event[types.AttributeKeyCallbackType])
+	suite.Require().Equal("true", event[types.AttributeKeySuccess])
+	suite.Require().Equal(int64(1), suite.counter(contract))
+
+	// the contract is refunded
+	balance := suite.evmosApp().BankKeeper.GetBalance(suite.EvmosChain.GetContext(), contract.Bytes(), utils.BaseDenom)
+	suite.Require().Equal(sdk.NewInt(1000), balance.Amount)
+}
+
+func (suite *CallbacksTestSuite) TestDestinationCallback() {
+	testCases := []struct {
+		name       string
+		code       []byte
+		malleate   func(contract common.Address) string
+		expCalled  bool
+		expSuccess bool
+	}{
+		{
+			"success - callback succeeds",
+			counterCode,
+			func(contract common.Address) string {
+				return callbackMemo("dest_callback", contract, 100_000)
+			},
+			true, true,
+		},
+		{
+			"fail - callback reverts",
+			revertCode,
+			func(contract common.Address) string {
+				return callbackMemo("dest_callback", contract, 100_000)
+			},
+			true, false,
+		},
+		{
+			"fail - callback runs out of gas",
+			loopCode,
+			func(contract common.Address) string {
+				return callbackMemo("dest_callback", contract, 100_000)
+			},
+			true, false,
+		},
+		{
+			"fail - contract is not the receiver",
+			counterCode,
+			func(common.Address) string {
+				other := suite.deployContract(counterCode)
+				return callbackMemo("dest_callback", other, 100_000)
+			},
+			false, false,
+		},
+		{
+			"fail - invalid callback metadata",
+			counterCode,
+			func(common.Address) string {
+				return `{"dest_callback":{"address":"evmos1"}}`
+			},
+			false, false,
+		},
+		{
+			"no callback - callbacks are disabled",
+			counterCode,
+			func(contract common.Address) string {
+				params := types.DefaultParams()
+				params.EnableCallbacks = false
+				err := suite.evmosApp().CallbacksKeeper.SetParams(suite.EvmosChain.GetContext(), params)
+				suite.Require().NoError(err)
+				return callbackMemo("dest_callback", contract, 100_000)
+			},
+			false, true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			contract := suite.deployContract(tc.code)
+			memo := tc.malleate(contract)
+			suite.coordinator.CommitBlock(suite.EvmosChain)
+
+			coin := sdk.NewCoin("uosmo", sdk.NewInt(10))
+			receiver := sdk.AccAddress(contract.Bytes()).String()
+			packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, receiver, memo)
+			res, ack := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+
+			// the events of a failed receipt are reverted along with its state
+			event := callbackEvent(res.GetEvents(), types.EventTypeDestinationCallback)
+			if tc.expCalled && tc.expSuccess {
+				suite.Require().NotNil(event)
+				suite.Require().Equal(types.CallbackTypeReceive, event[types.AttributeKeyCallbackType])
+				suite.Require().Equal("true", event[types.AttributeKeySuccess])
+			} else {
+				suite.Require().Nil(event)
+			}
+
+			var acknowledgement channeltypes.Acknowledgement
+			err := channeltypes.SubModuleCdc.UnmarshalJSON(ack, &acknowledgement)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, acknowledgement.Success())
+
+			// the tokens are only received if the callback succeeds
+			denom := voucherDenom("uosmo", suite.pathOsmosisEvmos.EndpointB.ChannelID)
+			balance := suite.evmosApp().BankKeeper.GetBalance(suite.EvmosChain.GetContext(), contract.Bytes(), denom)
+			if tc.expSuccess {
+				suite.Require().Equal(coin.Amount, balance.Amount)
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+
+			if tc.expCalled && tc.expSuccess {
+				suite.Require().Equal(int64(1), suite.counter(contract))
+			} else {
+				suite.Require().Zero(suite.counter(contract))
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/evmos/evmos/v15/app"
+	ibctesting "github.com/evmos/evmos/v15/ibc/testing"
+	"github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/evm/statedb"
+	inflationtypes "github.com/evmos/evmos/v15/x/inflation/v1/types"
+)
+
+var (
+	// counterCode increments the storage slot 0 on every call
+	counterCode = common.FromHex("0x60005460010160005500")
+	// revertCode reverts every call
+	revertCode = common.FromHex("0x600080fd")
+	// loopCode loops until it runs out of gas
+	loopCode = common.FromHex("0x5b600056")
+)
+
+type CallbacksTestSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	EvmosChain      *ibcgotesting.TestChain
+	IBCOsmosisChain *ibcgotesting.TestChain
+
+	pathOsmosisEvmos *ibctesting.Path
+}
+
+func TestCallbacksTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbacksTestSuite))
+}
+
+func (suite *CallbacksTestSuite) SetupTest() {
+	// initializes 2 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.EvmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCOsmosisChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.coordinator.CommitNBlocks(suite.EvmosChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 2)
+
+	// Fund sender address to pay fees
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+	err := suite.evmosApp().BankKeeper.MintCoins(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.evmosApp().BankKeeper.SendCoinsFromModuleToAccount(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, suite.EvmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// Mint the transferred coins and the IBC tx fees on the Osmosis chain
+	coins = sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, amt),
+		sdk.NewCoin("uosmo", sdk.NewInt(100)),
+	)
+	err = suite.IBCOsmosisChain.GetSimApp().BankKeeper.MintCoins(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.IBCOsmosisChain.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, suite.IBCOsmosisChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	evmParams := suite.evmosApp().EvmKeeper.GetParams(suite.EvmosChain.GetContext())
+	evmParams.EvmDenom = utils.BaseDenom
+	err = suite.evmosApp().EvmKeeper.SetParams(suite.EvmosChain.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.evmosApp().StakingKeeper.GetValidators(suite.EvmosChain.GetContext(), 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.EvmosChain.CurrentHeader.ProposerAddress = cons.Bytes()
+
+	err = suite.evmosApp().StakingKeeper.SetValidatorByConsAddr(suite.EvmosChain.GetContext(), validators[0])
+	suite.Require().NoError(err)
+
+	suite.pathOsmosisEvmos = ibctesting.NewTransferPath(suite.IBCOsmosisChain, suite.EvmosChain) // clientID, connectionID, channelID empty
+	ibctesting.SetupPath(suite.coordinator, suite.pathOsmosisEvmos)                              // clientID, connectionID, channelID filled
+}
+
+func (suite *CallbacksTestSuite) evmosApp() *app.Evmos {
+	return suite.EvmosChain.App.(*app.Evmos)
+}
+
+// deployContract sets the given runtime code on a new contract account funded
+// with native tokens and returns its address
+func (suite *CallbacksTestSuite) deployContract(code []byte) common.Address {
+	ctx := suite.EvmosChain.GetContext()
+	contract := tx.GenerateAddress()
+	codeHash := crypto.Keccak256Hash(code)
+
+	suite.evmosApp().EvmKeeper.SetCode(ctx, codeHash.Bytes(), code)
+	err := suite.evmosApp().EvmKeeper.SetAccount(ctx, contract, statedb.Account{Balance: big.NewInt(0), CodeHash: codeHash.Bytes()})
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000)))
+	err = suite.evmosApp().BankKeeper.MintCoins(ctx, inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.evmosApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, inflationtypes.ModuleName, contract.Bytes(), coins)
+	suite.Require().NoError(err)
+
+	return contract
+}
+
+// counter returns the value of the storage slot 0 of a contract
+func (suite *CallbacksTestSuite) counter(contract common.Address) int64 {
+	value := suite.evmosApp().EvmKeeper.GetState(suite.EvmosChain.GetContext(), contract, common.Hash{})
+	return value.Big().Int64()
+}
+
+// sendFromContract sends an ICS-20 transfer of native tokens from a contract
+// on Evmos and returns the sent packet
+func (suite *CallbacksTestSuite) sendFromContract(
+	contract common.Address,
+	amount int64,
+	receiver, memo string,
+	timeout time.Duration,
+) channeltypes.Packet {
+	ctx := suite.EvmosChain.GetContext()
+	endpoint := suite.pathOsmosisEvmos.EndpointB
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		sdk.NewCoin(utils.BaseDenom, sdk.NewInt(amount)),
+		sdk.AccAddress(contract.Bytes()).String(), receiver,
+		clienttypes.ZeroHeight(), uint64(suite.coordinator.CurrentTime.Add(timeout).UnixNano()), memo,
+	)
+
+	_, err := suite.evmosApp().TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(ctx.EventManager().Events())
+	suite.Require().NoError(err)
+
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+	return packet
+}
+
+// sendTransfer sends an ICS-20 transfer from the sender account of the chain
+// and returns the sent packet
+func (suite *CallbacksTestSuite) sendTransfer(
+	endpoint *ibctesting.Endpoint,
+	coin sdk.Coin,
+	receiver, memo string,
+) channeltypes.Packet {
+	timeout := uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano())
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		coin, endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+		clienttypes.ZeroHeight(), timeout, memo,
+	)
+
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// recvPacket receives a packet on the endpoint and returns the written
+// acknowledgement
+func (suite *CallbacksTestSuite) recvPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) (*sdk.Result, []byte) {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := endpoint.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return res, ack
+}
+
+// acknowledgePacket acknowledges a packet on the endpoint and returns the result
+func (suite *CallbacksTestSuite) acknowledgePacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) *sdk.Result {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+	return res
+}
+
+// timeoutPacket times out a packet on the endpoint and returns the result
+func (suite *CallbacksTestSuite) timeoutPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+	)
+	suite.Require().True(found)
+
+	msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+	return res
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The address calling the IBC callbacks, derived from the callbacks module name.
+address constant IBC_CALLBACKS_CALLER = 0x371C6a16fC69CB6daE5c3efDb73695498A15fA9F;
+
+/// @author Evmos Team
+/// @title IBC Callbacks Interface
+/// @dev The interface of the contracts receiving the callbacks of the ICS-20 packets they send or
+/// receive. The callbacks are requested in the packet memo with the ADR-008 format, e.g.
+/// {"src_callback":{"address":"0x...","gas_limit":"200000"}}, where the source callback contract
+/// must be the packet sender and the destination callback contract the packet receiver.
+/// The callbacks are executed with the gas limit of the memo, bounded by the max callback gas of the
+/// callbacks module, and contracts should check that the caller is IBC_CALLBACKS_CALLER.
+interface IIBCCallbacks {
+    /// @dev Called once a packet sent by the contract is acknowledged. The tokens were refunded to
+    /// the contract if the acknowledgement is an error.
+    /// @param sourcePort The port of the packet.
+    /// @param sourceChannel The channel of the packet.
+    /// @param sequence The sequence of the packet.
+    /// @param denom The denomination of the sent tokens.
+    /// @param amount The amount of the sent tokens.
+    /// @param receiver The receiver of the packet on the counterparty chain.
+    /// @param success True if the acknowledgement is successful.
+    /// @param acknowledgement The acknowledgement written by the counterparty chain.
+    function onPacketAcknowledgement(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        bool success,
+        bytes calldata acknowledgement
+    ) external;
+
+    /// @dev Called once a packet sent by the contract is timed out. The tokens were refunded to
+    /// the contract.
+    /// @param sourcePort The port of the packet.
+    /// @param sourceChannel The channel of the packet.
+    /// @param sequence The sequence of the packet.
+    /// @param denom The denomination of the sent tokens.
+    /// @param amount The amount of the sent tokens.
+    /// @param receiver The receiver of the packet on the counterparty chain.
+    function onPacketTimeout(
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 sequence,
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver
+    ) external;
+
+    /// @dev Called once the contract received the tokens of a packet. The packet is acknowledged
+    /// with an error and the tokens are refunded to the sender if the callback fails.
+    /// @param destinationPort The port on which the packet was received.
+    /// @param destinationChannel The channel on which the packet was received.
+    /// @param sequence The sequence of the packet.
+    /// @param denom The denomination of the received tokens.
+    /// @param amount The amount of the received tokens.
+    /// @param sender The sender of the packet on the counterparty chain.
+    function onRecvPacket(
+        string calldata destinationPort,
+        string calldata destinationChannel,
+        uint64 sequence,
+        string calldata denom,
+        uint256 amount,
+        string calldata sender
+    ) external;
+}
@@ -0,0 +1,41 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"bytes"
+	"embed"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+const (
+	// OnPacketAcknowledgementMethod defines the callback method of the acknowledged packets.
+	OnPacketAcknowledgementMethod = "onPacketAcknowledgement"
+	// OnPacketTimeoutMethod defines the callback method of the timed out packets.
+	OnPacketTimeoutMethod = "onPacketTimeout"
+	// OnRecvPacketMethod defines the callback method of the received packets.
+	OnRecvPacketMethod = "onRecvPacket"
+)
+
+// CallbacksABI is the ABI of the IIBCCallbacks interface implemented by the
+// callback contracts
+var CallbacksABI abi.ABI
+
+func init() {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		panic(err)
+	}
+
+	CallbacksABI, err = abi.JSON(bytes.NewReader(abiBz))
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidCallbackMetadata = errorsmod.Register(ModuleName, 2, "invalid callback metadata")
+	ErrCallbacksDisabled       = errorsmod.Register(ModuleName, 3, "callbacks are disabled")
+	ErrUnauthorizedCallback    = errorsmod.Register(ModuleName, 4, "unauthorized callback contract")
+	ErrCallbackFailed          = errorsmod.Register(ModuleName, 5, "contract callback failed")
+)
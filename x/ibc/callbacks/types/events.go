@@ -0,0 +1,24 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// callbacks events
+const (
+	EventTypeSourceCallback      = "ibc_src_callback"
+	EventTypeDestinationCallback = "ibc_dest_callback"
+
+	AttributeKeyContract     = "contract"
+	AttributeKeyCallbackType = "callback_type"
+	AttributeKeyPort         = "port"
+	AttributeKeyChannel      = "channel"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyGasLimit     = "gas_limit"
+	AttributeKeyGasUsed      = "gas_used"
+	AttributeKeySuccess      = "success"
+	AttributeKeyError        = "error"
+
+	CallbackTypeAcknowledgement = "acknowledgement"
+	CallbackTypeTimeout         = "timeout"
+	CallbackTypeReceive         = "receive"
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{
+			"empty genesis",
+			GenesisState{},
+			true,
+		},
+		{
+			"default genesis",
+			*DefaultGenesisState(),
+			false,
+		},
+		{
+			"custom genesis",
+			NewGenesisState(NewParams(false, 200_000)),
+			false,
+		},
+		{
+			"invalid max callback gas",
+			NewGenesisState(NewParams(true, 0)),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// ModuleName defines the callbacks module name
+	ModuleName = "callbacks"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the address calling the contract callbacks, which
+// contracts use to authenticate the callbacks
+var ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
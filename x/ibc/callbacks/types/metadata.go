@@ -0,0 +1,123 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// CallbackMetadata defines the contract callbacks of an ICS-20 packet memo,
+// following the ADR-008 format:
+//
+//	{"src_callback":{"address":"0x...","gas_limit":"100000"},"dest_callback":{"address":"0x..."}}
+//
+// The source callback is invoked on the contract that sent the packet once it
+// is acknowledged or timed out, and the destination callback on the contract
+// that received it.
+type CallbackMetadata struct {
+	// SrcCallback is the callback of the packet sender
+	SrcCallback *CallbackData `json:"src_callback,omitempty"`
+	// DestCallback is the callback of the packet receiver
+	DestCallback *CallbackData `json:"dest_callback,omitempty"`
+}
+
+// CallbackData defines the contract and the gas limit of a callback
+type CallbackData struct {
+	// Address is the hex address of the callback contract
+	Address string `json:"address"`
+	// GasLimit is the gas limit of the callback. The max callback gas is used
+	// if it is not set.
+	GasLimit GasLimit `json:"gas_limit,omitempty"`
+}
+
+// ParseCallbackMetadata returns the callback metadata of an ICS-20 packet memo.
+// It returns false if the memo doesn't define any callback.
+func ParseCallbackMetadata(memo string) (CallbackMetadata, bool, error) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return CallbackMetadata{}, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return CallbackMetadata{}, false, nil
+	}
+
+	_, hasSrc := fields["src_callback"]
+	_, hasDest := fields["dest_callback"]
+	if !hasSrc && !hasDest {
+		return CallbackMetadata{}, false, nil
+	}
+
+	var metadata CallbackMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return CallbackMetadata{}, true, errorsmod.Wrap(ErrInvalidCallbackMetadata, err.Error())
+	}
+
+	for _, callback := range []*CallbackData{metadata.SrcCallback, metadata.DestCallback} {
+		if callback == nil {
+			continue
+		}
+		if err := callback.Validate(); err != nil {
+			return CallbackMetadata{}, true, err
+		}
+	}
+
+	return metadata, true, nil
+}
+
+// Validate performs a stateless validation of the callback data
+func (c CallbackData) Validate() error {
+	if !common.IsHexAddress(c.Address) {
+		return errorsmod.Wrapf(ErrInvalidCallbackMetadata, "invalid contract address: %s", c.Address)
+	}
+
+	return nil
+}
+
+// ContractAddress returns the address of the callback contract
+func (c CallbackData) ContractAddress() common.Address {
+	return common.HexToAddress(c.Address)
+}
+
+// GetGasLimit returns the gas limit of the callback, bounded by the given max
+// callback gas
+func (c CallbackData) GetGasLimit(maxCallbackGas uint64) uint64 {
+	if c.GasLimit == 0 || uint64(c.GasLimit) > maxCallbackGas {
+		return maxCallbackGas
+	}
+	return uint64(c.GasLimit)
+}
+
+// GasLimit is a gas limit encoded in JSON either as a number or as a decimal
+// string
+type GasLimit uint64
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (g *GasLimit) UnmarshalJSON(bz []byte) error {
+	s := string(bz)
+	if len(bz) > 0 && bz[0] == '"' {
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return err
+		}
+	}
+
+	gasLimit, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*g = GasLimit(gasLimit)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (g GasLimit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(strconv.FormatUint(uint64(g), 10))
+}
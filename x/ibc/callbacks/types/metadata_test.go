@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testContract = "0x1D54EcB8583Ca25895c512A8308389fFD581F9c9"
+
+func TestParseCallbackMetadata(t *testing.T) {
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expError bool
+		expMeta  CallbackMetadata
+	}{
+		{"empty memo", "", false, false, CallbackMetadata{}},
+		{"text memo", "hello", false, false, CallbackMetadata{}},
+		{"invalid json memo", "{hello", false, false, CallbackMetadata{}},
+		{"json memo without callbacks", `{"forward":{"receiver":"cosmos1"}}`, false, false, CallbackMetadata{}},
+		{"invalid src callback", `{"src_callback":"0x"}`, true, true, CallbackMetadata{}},
+		{"invalid address", `{"src_callback":{"address":"evmos1"}}`, true, true, CallbackMetadata{}},
+		{"invalid gas limit", `{"dest_callback":{"address":"` + testContract + `","gas_limit":"-1"}}`, true, true, CallbackMetadata{}},
+		{
+			"src callback without gas limit",
+			`{"src_callback":{"address":"` + testContract + `"}}`,
+			true, false,
+			CallbackMetadata{SrcCallback: &CallbackData{Address: testContract}},
+		},
+		{
+			"src and dest callbacks with gas limits",
+			`{"src_callback":{"address":"` + testContract + `","gas_limit":"50000"},"dest_callback":{"address":"` + testContract + `","gas_limit":100000}}`,
+			true, false,
+			CallbackMetadata{
+				SrcCallback:  &CallbackData{Address: testContract, GasLimit: 50000},
+				DestCallback: &CallbackData{Address: testContract, GasLimit: 100000},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		metadata, found, err := ParseCallbackMetadata(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMeta, metadata, tc.name)
+	}
+}
+
+func TestCallbackDataGetGasLimit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		gasLimit GasLimit
+		expGas   uint64
+	}{
+		{"no gas limit", 0, 1000},
+		{"gas limit below max", 500, 500},
+		{"gas limit above max", 5000, 1000},
+	}
+
+	for _, tc := range testCases {
+		callback := CallbackData{Address: testContract, GasLimit: tc.gasLimit}
+		require.Equal(t, tc.expGas, callback.GetGasLimit(1000), tc.name)
+	}
+}
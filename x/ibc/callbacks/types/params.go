@@ -0,0 +1,45 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+)
+
+// ParamsKey params store key
+var ParamsKey = []byte("Params")
+
+// DefaultMaxCallbackGas defines the default max gas limit of the contract
+// callbacks
+var (
+	DefaultEnableCallbacks = true
+	DefaultMaxCallbackGas  = uint64(1_000_000)
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	enableCallbacks bool, maxCallbackGas uint64,
+) Params {
+	return Params{
+		EnableCallbacks: enableCallbacks,
+		MaxCallbackGas:  maxCallbackGas,
+	}
+}
+
+// DefaultParams defines the default params for the callbacks module
+func DefaultParams() Params {
+	return Params{
+		EnableCallbacks: DefaultEnableCallbacks,
+		MaxCallbackGas:  DefaultMaxCallbackGas,
+	}
+}
+
+// Validate checks that the fields have valid values
+func (p Params) Validate() error {
+	if p.MaxCallbackGas == 0 {
+		return fmt.Errorf("max callback gas cannot be 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v15/ibc"
+	"github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+// GetOrCreateIntermediateAccount returns the module account that holds the
+// tokens received from a sender on a channel until they are forwarded. The
+// account is created if it doesn't exist yet.
+func (k Keeper) GetOrCreateIntermediateAccount(ctx sdk.Context, channelID, sender string) (sdk.AccAddress, error) {
+	address := types.GetIntermediateAddress(channelID, sender)
+
+	if acc := k.accountKeeper.GetAccount(ctx, address); acc != nil {
+		if _, isModuleAccount := acc.(authtypes.ModuleAccountI); !isModuleAccount {
+			return nil, errorsmod.Wrapf(
+				types.ErrInvalidIntermediate,
+				"account %s is not a module account", address,
+			)
+		}
+		return address, nil
+	}
+
+	macc := authtypes.NewEmptyModuleAccount(types.GetIntermediateAccountName(channelID, sender))
+	k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccount(ctx, macc))
+	return address, nil
+}
+
+// ForwardPacket sends the tokens of a received packet, held by the intermediate
+// account, to the next hop defined in the forward metadata of the packet memo.
+// The forwarded packet is stored until it is acknowledged or timed out, so that
+// the acknowledgement of the received packet can be written asynchronously.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+	intermediate sdk.AccAddress,
+) error {
+	params := k.GetParams(ctx)
+
+	timeout := time.Duration(metadata.Timeout)
+	if timeout == 0 {
+		timeout = params.DefaultTimeout
+	}
+
+	retries := uint32(types.DefaultRetries)
+	if metadata.Retries != nil {
+		retries = uint32(*metadata.Retries)
+	}
+	if retries > params.MaxRetries {
+		retries = params.MaxRetries
+	}
+
+	next, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	inFlightPacket := types.NewInFlightPacket(packet, data.Sender, metadata, 0, timeout, retries, next)
+	return k.sendForwardPacket(ctx, inFlightPacket, intermediate, types.EventTypeForwardPacket)
+}
+
+// OnAcknowledgementPacket writes the acknowledgement of the received packet
+// once its forwarded packet is acknowledged. The tokens are refunded to the
+// original sender if the forwarded packet failed. It is a no-op for the packets
+// that were not forwarded.
+//
+// CONTRACT: the underlying transfer application must have processed the
+// acknowledgement, so that the intermediate account is refunded on error.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	ack channeltypes.Acknowledgement,
+) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, inFlightPacket)
+
+	if !ack.Success() {
+		return k.refundPacket(ctx, inFlightPacket, errorsmod.Wrap(types.ErrForwardFailed, ack.GetError()))
+	}
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, ack)
+}
+
+// OnTimeoutPacket sends the forwarded packet again if it has retries remaining,
+// or refunds the tokens to the original sender otherwise. It is a no-op for the
+// packets that were not forwarded.
+//
+// CONTRACT: the underlying transfer application must have processed the
+// timeout, so that the intermediate account is refunded.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, inFlightPacket)
+
+	if inFlightPacket.RetriesRemaining == 0 {
+		return k.refundPacket(ctx, inFlightPacket, types.ErrForwardTimeout)
+	}
+
+	inFlightPacket.RetriesRemaining--
+	intermediate := types.GetIntermediateAddress(inFlightPacket.RefundChannelId, inFlightPacket.OriginalSender)
+
+	// send the packet again in a cached context, so that the tokens can be
+	// refunded if it cannot be sent
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := k.sendForwardPacket(cacheCtx, inFlightPacket, intermediate, types.EventTypeRetryPacket); err != nil {
+		k.Logger(ctx).Error(
+			"failed to retry forwarded packet",
+			"channel", inFlightPacket.ChannelId,
+			"sequence", inFlightPacket.Sequence,
+			"error", err.Error(),
+		)
+		return k.refundPacket(ctx, inFlightPacket, errorsmod.Wrap(types.ErrForwardTimeout, err.Error()))
+	}
+
+	writeFn()
+	return nil
+}
+
+// sendForwardPacket transfers the tokens of the received packet from the
+// intermediate account to the receiver of the in-flight packet and stores the
+// in-flight packet with the sequence of the sent packet.
+func (k Keeper) sendForwardPacket(
+	ctx sdk.Context,
+	inFlightPacket types.InFlightPacket,
+	intermediate sdk.AccAddress,
+	eventType string,
+) error {
+	received, err := inFlightPacket.ReceivedPacket()
+	if err != nil {
+		return err
+	}
+
+	coin, err := receivedCoin(received)
+	if err != nil {
+		return err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		inFlightPacket.PortId,
+		inFlightPacket.ChannelId,
+		coin,
+		intermediate.String(),
+		inFlightPacket.Receiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(inFlightPacket.Timeout).UnixNano()),
+		inFlightPacket.Next,
+	)
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	inFlightPacket.Sequence = res.Sequence
+	k.SetInFlightPacket(ctx, inFlightPacket)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlightPacket.Receiver),
+			sdk.NewAttribute(types.AttributeKeyPort, inFlightPacket.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannel, inFlightPacket.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlightPacket.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(inFlightPacket.RetriesRemaining), 10)),
+		),
+	)
+
+	return nil
+}
+
+// refundPacket reverts the receipt of the tokens held by the intermediate
+// account and writes an error acknowledgement for the received packet, so that
+// the source chain refunds the original sender.
+func (k Keeper) refundPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket, reason error) error {
+	received, err := inFlightPacket.ReceivedPacket()
+	if err != nil {
+		return err
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(received.GetData(), &data); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidInFlightPacket, err.Error())
+	}
+
+	coin, err := receivedCoin(received)
+	if err != nil {
+		return err
+	}
+
+	intermediate := types.GetIntermediateAddress(received.DestinationChannel, data.Sender)
+	coins := sdk.NewCoins(coin)
+
+	if transfertypes.ReceiverChainIsSource(received.SourcePort, received.SourceChannel, data.Denom) {
+		// the tokens were unescrowed on receipt, so they are escrowed again
+		escrowAddress := transfertypes.GetEscrowAddress(received.DestinationPort, received.DestinationChannel)
+		if err := k.bankKeeper.SendCoins(ctx, intermediate, escrowAddress, coins); err != nil {
+			return err
+		}
+
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(coin))
+	} else {
+		// the vouchers were minted on receipt, so they are burned
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediate, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundPacket,
+			sdk.NewAttribute(types.AttributeKeyReceiver, inFlightPacket.Receiver),
+			sdk.NewAttribute(types.AttributeKeyPort, inFlightPacket.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannel, inFlightPacket.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(inFlightPacket.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRefundChannel, inFlightPacket.RefundChannelId),
+			sdk.NewAttribute(types.AttributeKeyRefundSequence, strconv.FormatUint(inFlightPacket.RefundSequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, reason.Error()),
+		),
+	)
+
+	return k.writeAcknowledgement(ctx, inFlightPacket, channeltypes.NewErrorAcknowledgement(reason))
+}
+
+// writeAcknowledgement writes the acknowledgement of the received packet of an
+// in-flight packet
+func (k Keeper) writeAcknowledgement(
+	ctx sdk.Context,
+	inFlightPacket types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	received, err := inFlightPacket.ReceivedPacket()
+	if err != nil {
+		return err
+	}
+
+	_, chanCap, err := k.channelKeeper.LookupModuleByChannel(ctx, received.DestinationPort, received.DestinationChannel)
+	if err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, received, ack)
+}
+
+// receivedCoin returns the coin received on this chain for an ICS-20 packet
+func receivedCoin(packet channeltypes.Packet) (sdk.Coin, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidInFlightPacket, err.Error())
+	}
+
+	return ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	), nil
+}
@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+// forwardMemo returns the memo of a packet forwarded to the Cosmos chain
+func (suite *ForwardTestSuite) forwardMemo(receiver, extra string) string {
+	return fmt.Sprintf(
+		`{"forward":{"receiver":"%s","port":"transfer","channel":"%s"%s}}`,
+		receiver, suite.pathCosmosEvmos.EndpointB.ChannelID, extra,
+	)
+}
+
+// voucherDenom returns the IBC denom of a token received through the given
+// channels, from the last hop to the first one
+func voucherDenom(baseDenom string, channels ...string) string {
+	denom := baseDenom
+	for i := len(channels) - 1; i >= 0; i-- {
+		denom = transfertypes.GetPrefixedDenom(transfertypes.PortID, channels[i], denom)
+	}
+	return transfertypes.ParseDenomTrace(denom).IBCDenom()
+}
+
+func (suite *ForwardTestSuite) TestForwardPacket() {
+	suite.SetupTest()
+
+	coin := sdk.NewCoin("uosmo", sdk.NewInt(10))
+	sender := suite.IBCOsmosisChain.SenderAccount.GetAddress()
+	receiver := suite.IBCCosmosChain.SenderAccount.GetAddress()
+
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, "pfm", suite.forwardMemo(receiver.String(), ""))
+	res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+
+	// the acknowledgement is written once the forwarded packet is acknowledged
+	_, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().Error(err)
+
+	forwarded, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	ctx := suite.EvmosChain.GetContext()
+	inFlightPacket, found := suite.evmosApp().ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(packet.Sequence, inFlightPacket.RefundSequence)
+	suite.Require().Equal(uint32(types.DefaultRetries), inFlightPacket.RetriesRemaining)
+	suite.Require().Equal(types.DefaultTimeout, inFlightPacket.Timeout)
+
+	res = suite.recvPacket(suite.pathCosmosEvmos.EndpointA, forwarded)
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.acknowledgePacket(suite.pathCosmosEvmos.EndpointB, forwarded, ack)
+	forwardedAck, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(ack, forwardedAck)
+
+	suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointA, packet, forwardedAck)
+
+	// the tokens are received on the Cosmos chain
+	denom := voucherDenom("uosmo", suite.pathCosmosEvmos.EndpointA.ChannelID, suite.pathOsmosisEvmos.EndpointB.ChannelID)
+	balance := suite.IBCCosmosChain.GetSimApp().BankKeeper.GetBalance(suite.IBCCosmosChain.GetContext(), receiver, denom)
+	suite.Require().Equal(coin.Amount, balance.Amount)
+
+	balance = suite.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), sender, "uosmo")
+	suite.Require().Equal(sdk.NewInt(90), balance.Amount)
+
+	// no tokens are left on Evmos
+	ctx = suite.EvmosChain.GetContext()
+	intermediate := types.GetIntermediateAddress(suite.pathOsmosisEvmos.EndpointB.ChannelID, sender.String())
+	suite.Require().True(suite.evmosApp().BankKeeper.GetAllBalances(ctx, intermediate).IsZero())
+	suite.Require().Empty(suite.evmosApp().ForwardKeeper.GetInFlightPackets(ctx))
+}
+
+func (suite *ForwardTestSuite) TestRefundFailedPacket() {
+	suite.SetupTest()
+
+	coin := sdk.NewCoin("uosmo", sdk.NewInt(10))
+	sender := suite.IBCOsmosisChain.SenderAccount.GetAddress()
+
+	// the receiver is invalid on the Cosmos chain
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, "pfm", suite.forwardMemo("invalid", ""))
+	res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+	forwarded, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.recvPacket(suite.pathCosmosEvmos.EndpointA, forwarded)
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.acknowledgePacket(suite.pathCosmosEvmos.EndpointB, forwarded, ack)
+	forwardedAck, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed).Acknowledgement(), forwardedAck)
+
+	suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointA, packet, forwardedAck)
+
+	// the sender is refunded
+	balance := suite.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), sender, "uosmo")
+	suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+	// the vouchers are burned on Evmos
+	ctx := suite.EvmosChain.GetContext()
+	denom := voucherDenom("uosmo", suite.pathOsmosisEvmos.EndpointB.ChannelID)
+	suite.Require().True(suite.evmosApp().BankKeeper.GetSupply(ctx, denom).IsZero())
+	suite.Require().Empty(suite.evmosApp().ForwardKeeper.GetInFlightPackets(ctx))
+}
+
+func (suite *ForwardTestSuite) TestRefundFailedPacketToEscrow() {
+	suite.SetupTest()
+
+	// send native tokens from Evmos to Osmosis
+	coin := sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000))
+	sender := suite.IBCOsmosisChain.SenderAccount.GetAddress()
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointB, coin, sender.String(), "")
+	err := suite.pathOsmosisEvmos.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	ctx := suite.EvmosChain.GetContext()
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, suite.pathOsmosisEvmos.EndpointB.ChannelID)
+	escrowBalance := suite.evmosApp().BankKeeper.GetBalance(ctx, escrowAddress, utils.BaseDenom)
+	totalEscrow := suite.evmosApp().TransferKeeper.GetTotalEscrowForDenom(ctx, utils.BaseDenom)
+	suite.Require().Equal(coin.Amount, escrowBalance.Amount)
+
+	// forward the vouchers back through Evmos to an invalid receiver
+	voucher := sdk.NewCoin(voucherDenom(utils.BaseDenom, suite.pathOsmosisEvmos.EndpointA.ChannelID), coin.Amount)
+	packet = suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, voucher, "pfm", suite.forwardMemo("invalid", ""))
+	res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+	forwarded, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.recvPacket(suite.pathCosmosEvmos.EndpointA, forwarded)
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	res = suite.acknowledgePacket(suite.pathCosmosEvmos.EndpointB, forwarded, ack)
+	forwardedAck, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointA, packet, forwardedAck)
+
+	// the tokens are escrowed again on Evmos
+	ctx = suite.EvmosChain.GetContext()
+	suite.Require().Equal(escrowBalance, suite.evmosApp().BankKeeper.GetBalance(ctx, escrowAddress, utils.BaseDenom))
+	suite.Require().Equal(totalEscrow, suite.evmosApp().TransferKeeper.GetTotalEscrowForDenom(ctx, utils.BaseDenom))
+
+	balance := suite.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), sender, voucher.Denom)
+	suite.Require().Equal(voucher, balance)
+}
+
+func (suite *ForwardTestSuite) TestRetryTimedOutPacket() {
+	testCases := []struct {
+		name       string
+		retries    int
+		expForward bool
+	}{
+		{"refund without retries", 0, false},
+		{"retry and forward", 1, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			coin := sdk.NewCoin("uosmo", sdk.NewInt(10))
+			sender := suite.IBCOsmosisChain.SenderAccount.GetAddress()
+			receiver := suite.IBCCosmosChain.SenderAccount.GetAddress()
+			memo := suite.forwardMemo(receiver.String(), fmt.Sprintf(`,"timeout":"1m","retries":%d`, tc.retries))
+
+			packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, "pfm", memo)
+			res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+			forwarded, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			// time out the forwarded packet
+			suite.coordinator.IncrementTimeBy(2 * time.Minute)
+			suite.coordinator.CommitBlock(suite.IBCCosmosChain)
+			res = suite.timeoutPacket(suite.pathCosmosEvmos.EndpointB, forwarded)
+
+			if !tc.expForward {
+				ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(types.ErrForwardTimeout).Acknowledgement(), ack)
+
+				suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointA, packet, ack)
+				balance := suite.IBCOsmosisChain.GetSimApp().BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), sender, "uosmo")
+				suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+				suite.Require().Empty(suite.evmosApp().ForwardKeeper.GetInFlightPackets(suite.EvmosChain.GetContext()))
+				return
+			}
+
+			retried, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Equal(forwarded.Sequence+1, retried.Sequence)
+
+			inFlightPacket, found := suite.evmosApp().ForwardKeeper.GetInFlightPacket(
+				suite.EvmosChain.GetContext(), retried.SourcePort, retried.SourceChannel, retried.Sequence,
+			)
+			suite.Require().True(found)
+			suite.Require().Equal(uint32(0), inFlightPacket.RetriesRemaining)
+
+			res = suite.recvPacket(suite.pathCosmosEvmos.EndpointA, retried)
+			ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			res = suite.acknowledgePacket(suite.pathCosmosEvmos.EndpointB, retried, ack)
+			forwardedAck, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointA, packet, forwardedAck)
+
+			denom := voucherDenom("uosmo", suite.pathCosmosEvmos.EndpointA.ChannelID, suite.pathOsmosisEvmos.EndpointB.ChannelID)
+			balance := suite.IBCCosmosChain.GetSimApp().BankKeeper.GetBalance(suite.IBCCosmosChain.GetContext(), receiver, denom)
+			suite.Require().Equal(coin.Amount, balance.Amount)
+		})
+	}
+}
+
+func (suite *ForwardTestSuite) TestOnRecvPacket() {
+	testCases := []struct {
+		name     string
+		malleate func() string
+		expPass  bool
+	}{
+		{
+			"pass - memo without forward",
+			func() string { return "hello" },
+			true,
+		},
+		{
+			"pass - json memo without forward",
+			func() string { return `{"wasm":{}}` },
+			true,
+		},
+		{
+			"fail - invalid forward metadata",
+			func() string { return `{"forward":{"receiver":"cosmos1","port":"transfer"}}` },
+			false,
+		},
+		{
+			"fail - forwarding disabled",
+			func() string {
+				params := types.DefaultParams()
+				params.EnableForwarding = false
+				err := suite.evmosApp().ForwardKeeper.SetParams(suite.EvmosChain.GetContext(), params)
+				suite.Require().NoError(err)
+				return suite.forwardMemo("cosmos1", "")
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			coin := sdk.NewCoin("uosmo", sdk.NewInt(10))
+			receiver := suite.EvmosChain.SenderAccount.GetAddress()
+			memo := tc.malleate()
+			suite.coordinator.CommitBlock(suite.EvmosChain)
+
+			packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, receiver.String(), memo)
+			res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+
+			bz, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			err = transfertypes.ModuleCdc.UnmarshalJSON(bz, &ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPass, ack.Success())
+
+			denom := voucherDenom("uosmo", suite.pathOsmosisEvmos.EndpointB.ChannelID)
+			balance := suite.evmosApp().BankKeeper.GetBalance(suite.EvmosChain.GetContext(), receiver, denom)
+			if tc.expPass {
+				suite.Require().Equal(coin.Amount, balance.Amount)
+			} else {
+				suite.Require().True(balance.IsZero())
+			}
+		})
+	}
+}
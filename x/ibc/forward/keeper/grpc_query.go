@@ -0,0 +1,64 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the module parameters
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// InFlightPackets returns the packets forwarded and waiting for an
+// acknowledgement or a timeout
+func (k Keeper) InFlightPackets(
+	c context.Context,
+	req *types.QueryInFlightPacketsRequest,
+) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var packets []types.InFlightPacket
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var packet types.InFlightPacket
+		if err := k.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+		packets = append(packets, packet)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{
+		InFlightPackets: packets,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/forward/types"
+)
+
+// GetInFlightPackets returns all the packets forwarded and waiting for an
+// acknowledgement or a timeout.
+func (k Keeper) GetInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	packets := []types.InFlightPacket{}
+
+	k.IterateInFlightPackets(ctx, func(packet types.InFlightPacket) (stop bool) {
+		packets = append(packets, packet)
+		return false
+	})
+
+	return packets
+}
+
+// IterateInFlightPackets iterates over all the stored in-flight packets.
+func (k Keeper) IterateInFlightPackets(ctx sdk.Context, cb func(packet types.InFlightPacket) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixInFlightPacket)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+
+		if cb(packet) {
+			break
+		}
+	}
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded with the given
+// port, channel and sequence.
+func (k Keeper) GetInFlightPacket(
+	ctx sdk.Context,
+	portID, channelID string,
+	sequence uint64,
+) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var packet types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetInFlightPacket stores an in-flight packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	key := types.InFlightPacketKey(packet.PortId, packet.ChannelId, packet.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// DeleteInFlightPacket removes an in-flight packet
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, packet types.InFlightPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Delete(types.InFlightPacketKey(packet.PortId, packet.ChannelId, packet.Sequence))
+}
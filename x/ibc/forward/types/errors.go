@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidForwardMetadata = errorsmod.Register(ModuleName, 2, "invalid forward metadata")
+	ErrForwardingDisabled     = errorsmod.Register(ModuleName, 3, "forwarding is disabled")
+	ErrInvalidIntermediate    = errorsmod.Register(ModuleName, 4, "invalid intermediate account")
+	ErrForwardFailed          = errorsmod.Register(ModuleName, 5, "forwarded packet failed")
+	ErrForwardTimeout         = errorsmod.Register(ModuleName, 6, "forwarded packet timed out")
+	ErrInvalidInFlightPacket  = errorsmod.Register(ModuleName, 7, "invalid in-flight packet")
+)
@@ -0,0 +1,20 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// forward events
+const (
+	EventTypeForwardPacket = "forward_packet"
+	EventTypeRetryPacket   = "forward_retry_packet"
+	EventTypeRefundPacket  = "forward_refund_packet"
+
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyPort             = "port"
+	AttributeKeyChannel          = "channel"
+	AttributeKeySequence         = "sequence"
+	AttributeKeyRefundChannel    = "refund_channel"
+	AttributeKeyRefundSequence   = "refund_sequence"
+	AttributeKeyRetriesRemaining = "retries_remaining"
+	AttributeKeyError            = "error"
+)
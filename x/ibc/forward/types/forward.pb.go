@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/forward/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a packet received and forwarded to the next chain,
+// whose acknowledgement is written once the forwarded packet is acknowledged
+type InFlightPacket struct {
+	// port_id is the port of the forwarded packet
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel of the forwarded packet
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the forwarded packet
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// original_sender is the sender of the received packet on the source chain
+	OriginalSender string `protobuf:"bytes,4,opt,name=original_sender,json=originalSender,proto3" json:"original_sender,omitempty"`
+	// refund_port_id is the destination port of the received packet
+	RefundPortId string `protobuf:"bytes,5,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	// refund_channel_id is the destination channel of the received packet
+	RefundChannelId string `protobuf:"bytes,6,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	// refund_sequence is the sequence of the received packet
+	RefundSequence uint64 `protobuf:"varint,7,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	// packet_src_port_id is the source port of the received packet
+	PacketSrcPortId string `protobuf:"bytes,8,opt,name=packet_src_port_id,json=packetSrcPortId,proto3" json:"packet_src_port_id,omitempty"`
+	// packet_src_channel_id is the source channel of the received packet
+	PacketSrcChannelId string `protobuf:"bytes,9,opt,name=packet_src_channel_id,json=packetSrcChannelId,proto3" json:"packet_src_channel_id,omitempty"`
+	// packet_data is the data of the received packet
+	PacketData []byte `protobuf:"bytes,10,opt,name=packet_data,json=packetData,proto3" json:"packet_data,omitempty"`
+	// packet_timeout_height is the timeout height of the received packet
+	PacketTimeoutHeight string `protobuf:"bytes,11,opt,name=packet_timeout_height,json=packetTimeoutHeight,proto3" json:"packet_timeout_height,omitempty"`
+	// packet_timeout_timestamp is the timeout timestamp of the received packet
+	PacketTimeoutTimestamp uint64 `protobuf:"varint,12,opt,name=packet_timeout_timestamp,json=packetTimeoutTimestamp,proto3" json:"packet_timeout_timestamp,omitempty"`
+	// receiver is the receiver of the forwarded packet on the next chain
+	Receiver string `protobuf:"bytes,13,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout is the timeout of the forwarded packet
+	Timeout time.Duration `protobuf:"bytes,14,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// retries_remaining is the number of times the forwarded packet is sent again
+	// after a timeout
+	RetriesRemaining uint32 `protobuf:"varint,15,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// next is the memo of the forwarded packet
+	Next string `protobuf:"bytes,16,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a68db2b475342e61, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetPacketSrcPortId() string {
+	if m != nil {
+		return m.PacketSrcPortId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketSrcChannelId() string {
+	if m != nil {
+		return m.PacketSrcChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketData() []byte {
+	if m != nil {
+		return m.PacketData
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetPacketTimeoutHeight() string {
+	if m != nil {
+		return m.PacketTimeoutHeight
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetPacketTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.PacketTimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "evmos.forward.v1.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("evmos/forward/v1/forward.proto", fileDescriptor_a68db2b475342e61) }
+
+var fileDescriptor_a68db2b475342e61 = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0xcd, 0x8e, 0xd3, 0x3c,
+	0x14, 0xad, 0xbf, 0xaf, 0xd3, 0x1f, 0xb7, 0xd3, 0x16, 0xf3, 0x67, 0x2a, 0x91, 0x46, 0x08, 0x69,
+	0x22, 0x46, 0x4a, 0xd4, 0x41, 0x48, 0x6c, 0xd8, 0x0c, 0x23, 0x34, 0xdd, 0x8d, 0xd2, 0x59, 0xb1,
+	0x89, 0xdc, 0xc4, 0x4d, 0x2d, 0x1a, 0x3b, 0x38, 0x4e, 0x18, 0xde, 0x02, 0x89, 0x0d, 0x8f, 0x34,
+	0xcb, 0x59, 0xb2, 0x02, 0xd4, 0xbe, 0x08, 0x8a, 0x9d, 0x84, 0xc2, 0x26, 0xf1, 0x3d, 0xe7, 0xdc,
+	0x7b, 0x4f, 0x72, 0xaf, 0xa1, 0x45, 0x8b, 0x44, 0x64, 0xde, 0x5a, 0xc8, 0x4f, 0x44, 0x46, 0x5e,
+	0x31, 0xaf, 0x8f, 0x6e, 0x2a, 0x85, 0x12, 0x68, 0xa2, 0x79, 0xb7, 0x06, 0x8b, 0xf9, 0xf4, 0x41,
+	0x2c, 0x62, 0xa1, 0x49, 0xaf, 0x3c, 0x19, 0xdd, 0xd4, 0x8a, 0x85, 0x88, 0xb7, 0xd4, 0xd3, 0xd1,
+	0x2a, 0x5f, 0x7b, 0x51, 0x2e, 0x89, 0x62, 0x82, 0x1b, 0xfe, 0xd9, 0xd7, 0x23, 0x38, 0x5a, 0xf0,
+	0x77, 0x5b, 0x16, 0x6f, 0xd4, 0x15, 0x09, 0x3f, 0x50, 0x85, 0x1e, 0xc3, 0x6e, 0x2a, 0xa4, 0x0a,
+	0x58, 0x84, 0x81, 0x0d, 0x9c, 0xbe, 0xdf, 0x29, 0xc3, 0x45, 0x84, 0x9e, 0x42, 0x18, 0x6e, 0x08,
+	0xe7, 0x74, 0x5b, 0x72, 0xff, 0x69, 0xae, 0x5f, 0x21, 0x8b, 0x08, 0x4d, 0x61, 0x2f, 0xa3, 0x1f,
+	0x73, 0xca, 0x43, 0x8a, 0xff, 0xb7, 0x81, 0xd3, 0xf6, 0x9b, 0x18, 0x9d, 0xc0, 0xb1, 0x90, 0x2c,
+	0x66, 0x9c, 0x6c, 0x83, 0x8c, 0xf2, 0x88, 0x4a, 0xdc, 0xd6, 0xf9, 0xa3, 0x1a, 0x5e, 0x6a, 0x14,
+	0x3d, 0x87, 0x23, 0x49, 0xd7, 0x39, 0x8f, 0x82, 0xda, 0xc3, 0x91, 0xd6, 0x0d, 0x0d, 0x7a, 0x65,
+	0x9c, 0xbc, 0x80, 0xf7, 0x2a, 0xd5, 0x81, 0xa1, 0x8e, 0x16, 0x8e, 0x0d, 0xf1, 0xb6, 0xb1, 0x75,
+	0x02, 0x2b, 0x28, 0x68, 0xdc, 0x75, 0xb5, 0xbb, 0xaa, 0xd1, 0xb2, 0xf6, 0x78, 0x0a, 0x51, 0xaa,
+	0xff, 0x40, 0x90, 0xc9, 0xb0, 0x69, 0xdf, 0x33, 0x55, 0x0d, 0xb3, 0x94, 0x61, 0xe5, 0x60, 0x0e,
+	0x1f, 0x1e, 0x88, 0x0f, 0x5c, 0xf4, 0xb5, 0x1e, 0x35, 0xfa, 0x3f, 0x46, 0x66, 0x70, 0x50, 0xa5,
+	0x44, 0x44, 0x11, 0x0c, 0x6d, 0xe0, 0x0c, 0x7d, 0x68, 0xa0, 0x0b, 0xa2, 0x08, 0x3a, 0x6b, 0x6a,
+	0x2a, 0x96, 0x50, 0x91, 0xab, 0x60, 0x43, 0xcb, 0xb9, 0xe0, 0x81, 0xae, 0x79, 0xdf, 0x90, 0xd7,
+	0x86, 0xbb, 0xd4, 0x14, 0x7a, 0x0d, 0xf1, 0x3f, 0x39, 0xe5, 0x3b, 0x53, 0x24, 0x49, 0xf1, 0x50,
+	0x7f, 0xe6, 0xa3, 0xbf, 0xd2, 0xae, 0x6b, 0xb6, 0x1c, 0x97, 0xa4, 0x21, 0x65, 0x05, 0x95, 0xf8,
+	0x58, 0x37, 0x68, 0x62, 0xf4, 0x06, 0x76, 0xab, 0x72, 0x78, 0x64, 0x03, 0x67, 0x70, 0xf6, 0xc4,
+	0x35, 0x7b, 0xe4, 0xd6, 0x7b, 0xe4, 0x5e, 0x54, 0x7b, 0x74, 0xde, 0xbb, 0xfd, 0x31, 0x6b, 0x7d,
+	0xfb, 0x39, 0x03, 0x7e, 0x9d, 0x83, 0x4e, 0xcb, 0xf1, 0x28, 0xc9, 0x68, 0x16, 0x48, 0x9a, 0x10,
+	0xc6, 0x19, 0x8f, 0xf1, 0xd8, 0x06, 0xce, 0xb1, 0x3f, 0xa9, 0x08, 0xbf, 0xc6, 0x11, 0x82, 0x6d,
+	0x4e, 0x6f, 0x14, 0x9e, 0x68, 0x0f, 0xfa, 0x7c, 0x7e, 0x79, 0xbb, 0xb3, 0xc0, 0xdd, 0xce, 0x02,
+	0xbf, 0x76, 0x16, 0xf8, 0xb2, 0xb7, 0x5a, 0x77, 0x7b, 0xab, 0xf5, 0x7d, 0x6f, 0xb5, 0xde, 0xbb,
+	0x31, 0x53, 0x9b, 0x7c, 0xe5, 0x86, 0x22, 0xf1, 0xcc, 0x15, 0x31, 0xcf, 0x62, 0xfe, 0xca, 0xbb,
+	0xf1, 0xd8, 0x2a, 0x6c, 0xae, 0x8c, 0xfa, 0x9c, 0xd2, 0x6c, 0xd5, 0xd1, 0x86, 0x5f, 0xfe, 0x1e,
+	0x00, 0x98, 0x52, 0x69, 0x91, 0x50, 0x03, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Next) > 0 {
+		i -= len(m.Next)
+		copy(dAtA[i:], m.Next)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.Next)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x78
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintForward(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x72
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.PacketTimeoutTimestamp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PacketTimeoutHeight) > 0 {
+		i -= len(m.PacketTimeoutHeight)
+		copy(dAtA[i:], m.PacketTimeoutHeight)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketTimeoutHeight)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PacketData) > 0 {
+		i -= len(m.PacketData)
+		copy(dAtA[i:], m.PacketData)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketData)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PacketSrcChannelId) > 0 {
+		i -= len(m.PacketSrcChannelId)
+		copy(dAtA[i:], m.PacketSrcChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketSrcChannelId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PacketSrcPortId) > 0 {
+		i -= len(m.PacketSrcPortId)
+		copy(dAtA[i:], m.PacketSrcPortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PacketSrcPortId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovForward(uint64(m.Sequence))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovForward(uint64(m.RefundSequence))
+	}
+	l = len(m.PacketSrcPortId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.PacketSrcChannelId)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.PacketData)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = len(m.PacketTimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.PacketTimeoutTimestamp != 0 {
+		n += 1 + sovForward(uint64(m.PacketTimeoutTimestamp))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovForward(uint64(l))
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovForward(uint64(m.RetriesRemaining))
+	}
+	l = len(m.Next)
+	if l > 0 {
+		n += 2 + l + sovForward(uint64(l))
+	}
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketData = append(m.PacketData[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketData == nil {
+				m.PacketData = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketTimeoutHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketTimeoutTimestamp", wireType)
+			}
+			m.PacketTimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketTimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Next = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, inFlightPackets []InFlightPacket) GenesisState {
+	return GenesisState{
+		Params:          params,
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// DefaultGenesisState sets default forward genesis state with default params
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.InFlightPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(InFlightPacketKey(packet.PortId, packet.ChannelId, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated in-flight packet %s/%s/%d", packet.PortId, packet.ChannelId, packet.Sequence)
+		}
+		seenPackets[key] = true
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+)
+
+func validInFlightPacket(sequence uint64) InFlightPacket {
+	data := transfertypes.NewFungibleTokenPacketData("uosmo", "10", "osmo1sender", "pfm", "")
+	received := channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		"transfer", "channel-1",
+		"transfer", "channel-0",
+		clienttypes.ZeroHeight(), 100,
+	)
+	metadata := ForwardMetadata{Receiver: "cosmos1receiver", Port: "transfer", Channel: "channel-2"}
+	return NewInFlightPacket(received, "osmo1sender", metadata, sequence, time.Minute, 1, "")
+}
+
+func TestGenesisValidate(t *testing.T) {
+	invalidPacket := validInFlightPacket(1)
+	invalidPacket.Timeout = 0
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{
+			"empty genesis",
+			GenesisState{},
+			true,
+		},
+		{
+			"default genesis",
+			*DefaultGenesisState(),
+			false,
+		},
+		{
+			"custom genesis",
+			NewGenesisState(NewParams(true, time.Hour, 2), []InFlightPacket{validInFlightPacket(1), validInFlightPacket(2)}),
+			false,
+		},
+		{
+			"invalid params",
+			NewGenesisState(NewParams(true, time.Hour, 256), nil),
+			true,
+		},
+		{
+			"invalid in-flight packet",
+			NewGenesisState(DefaultParams(), []InFlightPacket{invalidPacket}),
+			true,
+		},
+		{
+			"duplicated in-flight packet",
+			NewGenesisState(DefaultParams(), []InFlightPacket{validInFlightPacket(1), validInFlightPacket(1)}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestInFlightPacketReceivedPacket(t *testing.T) {
+	packet := validInFlightPacket(1)
+
+	received, err := packet.ReceivedPacket()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), received.Sequence)
+	require.Equal(t, "channel-1", received.SourceChannel)
+	require.Equal(t, "channel-0", received.DestinationChannel)
+	require.Equal(t, packet.PacketData, received.Data)
+	require.Equal(t, clienttypes.ZeroHeight(), received.TimeoutHeight)
+}
@@ -0,0 +1,101 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewInFlightPacket returns an instance of InFlightPacket for a received
+// packet forwarded with the given sequence
+func NewInFlightPacket(
+	received channeltypes.Packet,
+	originalSender string,
+	metadata ForwardMetadata,
+	sequence uint64,
+	timeout time.Duration,
+	retries uint32,
+	next string,
+) InFlightPacket {
+	return InFlightPacket{
+		PortId:                 metadata.Port,
+		ChannelId:              metadata.Channel,
+		Sequence:               sequence,
+		OriginalSender:         originalSender,
+		RefundPortId:           received.DestinationPort,
+		RefundChannelId:        received.DestinationChannel,
+		RefundSequence:         received.Sequence,
+		PacketSrcPortId:        received.SourcePort,
+		PacketSrcChannelId:     received.SourceChannel,
+		PacketData:             received.Data,
+		PacketTimeoutHeight:    received.TimeoutHeight.String(),
+		PacketTimeoutTimestamp: received.TimeoutTimestamp,
+		Receiver:               metadata.Receiver,
+		Timeout:                timeout,
+		RetriesRemaining:       retries,
+		Next:                   next,
+	}
+}
+
+// ReceivedPacket returns the received packet that was forwarded
+func (p InFlightPacket) ReceivedPacket() (channeltypes.Packet, error) {
+	timeoutHeight, err := clienttypes.ParseHeight(p.PacketTimeoutHeight)
+	if err != nil {
+		return channeltypes.Packet{}, errorsmod.Wrapf(ErrInvalidInFlightPacket, "invalid timeout height: %s", err)
+	}
+
+	return channeltypes.NewPacket(
+		p.PacketData,
+		p.RefundSequence,
+		p.PacketSrcPortId,
+		p.PacketSrcChannelId,
+		p.RefundPortId,
+		p.RefundChannelId,
+		timeoutHeight,
+		p.PacketTimeoutTimestamp,
+	), nil
+}
+
+// Validate performs a stateless validation of an InFlightPacket
+func (p InFlightPacket) Validate() error {
+	for _, id := range []string{p.PortId, p.RefundPortId, p.PacketSrcPortId} {
+		if err := host.PortIdentifierValidator(id); err != nil {
+			return errorsmod.Wrap(ErrInvalidInFlightPacket, err.Error())
+		}
+	}
+
+	for _, id := range []string{p.ChannelId, p.RefundChannelId, p.PacketSrcChannelId} {
+		if err := host.ChannelIdentifierValidator(id); err != nil {
+			return errorsmod.Wrap(ErrInvalidInFlightPacket, err.Error())
+		}
+	}
+
+	if p.Sequence == 0 || p.RefundSequence == 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "sequence cannot be 0")
+	}
+
+	if p.OriginalSender == "" || p.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "sender and receiver cannot be empty")
+	}
+
+	if len(p.PacketData) == 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, "packet data cannot be empty")
+	}
+
+	if _, err := p.ReceivedPacket(); err != nil {
+		return err
+	}
+
+	if p.Timeout <= 0 {
+		return errorsmod.Wrap(ErrInvalidInFlightPacket, fmt.Sprintf("timeout must be positive: %s", p.Timeout))
+	}
+
+	return nil
+}
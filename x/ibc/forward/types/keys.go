@@ -0,0 +1,51 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// ModuleName defines the forward module name
+	ModuleName = "forward"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the forward persistent store
+const (
+	prefixInFlightPacket = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixInFlightPacket = []byte{prefixInFlightPacket}
+)
+
+// InFlightPacketKey returns the key of an in-flight packet in the
+// `<port_id>/<channel_id>/<sequence>` format
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/%s/", portID, channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetIntermediateAccountName returns the name of the module account holding the
+// tokens received from a sender on a channel until they are forwarded
+func GetIntermediateAccountName(channelID, sender string) string {
+	return fmt.Sprintf("%s/%s/%s", ModuleName, channelID, sender)
+}
+
+// GetIntermediateAddress returns the address of the module account holding the
+// tokens received from a sender on a channel until they are forwarded
+func GetIntermediateAddress(channelID, sender string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(GetIntermediateAccountName(channelID, sender))
+}
@@ -0,0 +1,159 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// PacketMetadata defines the memo of the ICS-20 packets to forward, which is
+// compatible with the packet forward middleware format:
+//
+//	{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-0","timeout":"10m","retries":2,"next":{...}}}
+type PacketMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata defines the next hop of a forwarded packet
+type ForwardMetadata struct {
+	// Receiver is the receiver of the forwarded packet on the next chain
+	Receiver string `json:"receiver"`
+	// Port is the port used to forward the packet
+	Port string `json:"port"`
+	// Channel is the channel used to forward the packet
+	Channel string `json:"channel"`
+	// Timeout is the timeout of the forwarded packet
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded packet is sent again after
+	// a timeout
+	Retries *uint8 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet, either as a JSON object or as
+	// a string
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata returns the forward metadata of an ICS-20 packet memo.
+// It returns false if the memo doesn't define a forward.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, bool, error) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return nil, false, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return nil, false, nil
+	}
+
+	if _, ok := fields["forward"]; !ok {
+		return nil, false, nil
+	}
+
+	var metadata PacketMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, true, errorsmod.Wrap(ErrInvalidForwardMetadata, err.Error())
+	}
+
+	if metadata.Forward == nil {
+		return nil, true, errorsmod.Wrap(ErrInvalidForwardMetadata, "forward cannot be null")
+	}
+
+	if err := metadata.Forward.Validate(); err != nil {
+		return nil, true, err
+	}
+
+	return metadata.Forward, true, nil
+}
+
+// Validate performs a stateless validation of the forward metadata
+func (m ForwardMetadata) Validate() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err)
+	}
+
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidForwardMetadata, "timeout cannot be negative: %s", time.Duration(m.Timeout))
+	}
+
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NextMemo returns the memo of the forwarded packet
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+
+	switch next[0] {
+	case '{':
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, next); err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid next: %s", err)
+		}
+		return buf.String(), nil
+	case '"':
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "invalid next: %s", err)
+		}
+		return memo, nil
+	default:
+		return "", errorsmod.Wrapf(ErrInvalidForwardMetadata, "next must be an object or a string: %s", next)
+	}
+}
+
+// Duration is a time.Duration encoded in JSON either as a number of
+// nanoseconds or as a duration string (e.g. "10m")
+type Duration time.Duration
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	if len(bz) > 0 && bz[0] == '"' {
+		var s string
+		if err := json.Unmarshal(bz, &s); err != nil {
+			return err
+		}
+
+		duration, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+
+		*d = Duration(duration)
+		return nil
+	}
+
+	nanoseconds, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(nanoseconds)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
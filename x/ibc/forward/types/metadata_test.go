@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	retries := uint8(3)
+
+	testCases := []struct {
+		name     string
+		memo     string
+		expFound bool
+		expError bool
+		expMeta  *ForwardMetadata
+	}{
+		{"empty memo", "", false, false, nil},
+		{"text memo", "hello", false, false, nil},
+		{"invalid json memo", "{hello", false, false, nil},
+		{"json memo without forward", `{"wasm":{"contract":"osmo1"}}`, false, false, nil},
+		{"null forward", `{"forward":null}`, true, true, nil},
+		{"invalid forward", `{"forward":"cosmos1"}`, true, true, nil},
+		{"empty receiver", `{"forward":{"receiver":"","port":"transfer","channel":"channel-0"}}`, true, true, nil},
+		{"invalid port", `{"forward":{"receiver":"cosmos1","port":"","channel":"channel-0"}}`, true, true, nil},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"0"}}`, true, true, nil},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"1x"}}`, true, true, nil},
+		{"negative timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"-1m"}}`, true, true, nil},
+		{"invalid next", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","next":1}}`, true, true, nil},
+		{
+			"minimal forward",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0"}}`,
+			true, false,
+			&ForwardMetadata{Receiver: "cosmos1", Port: "transfer", Channel: "channel-0"},
+		},
+		{
+			"forward with timeout string and retries",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":"10m","retries":3}}`,
+			true, false,
+			&ForwardMetadata{Receiver: "cosmos1", Port: "transfer", Channel: "channel-0", Timeout: Duration(10 * time.Minute), Retries: &retries},
+		},
+		{
+			"forward with timeout in nanoseconds",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":60000000000}}`,
+			true, false,
+			&ForwardMetadata{Receiver: "cosmos1", Port: "transfer", Channel: "channel-0", Timeout: Duration(time.Minute)},
+		},
+	}
+
+	for _, tc := range testCases {
+		metadata, found, err := ParseForwardMetadata(tc.memo)
+		require.Equal(t, tc.expFound, found, tc.name)
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMeta, metadata, tc.name)
+	}
+}
+
+func TestForwardMetadataNextMemo(t *testing.T) {
+	testCases := []struct {
+		name    string
+		next    string
+		expMemo string
+	}{
+		{"no next", "", ""},
+		{"null next", "null", ""},
+		{"string next", `"hello"`, "hello"},
+		{"object next", `{ "forward": { "receiver": "osmo1" } }`, `{"forward":{"receiver":"osmo1"}}`},
+	}
+
+	for _, tc := range testCases {
+		metadata := ForwardMetadata{Next: json.RawMessage(tc.next)}
+		memo, err := metadata.NextMemo()
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMemo, memo, tc.name)
+	}
+}
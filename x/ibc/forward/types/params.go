@@ -0,0 +1,55 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// ParamsKey params store key
+var ParamsKey = []byte("Params")
+
+// DefaultTimeout defines the default timeout of the forwarded packets and
+// DefaultRetries the default number of retries after a timeout
+var (
+	DefaultEnableForwarding = true
+	DefaultTimeout          = 10 * time.Minute
+	DefaultMaxRetries       = uint32(5)
+	DefaultRetries          = uint8(1)
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	enableForwarding bool, defaultTimeout time.Duration, maxRetries uint32,
+) Params {
+	return Params{
+		EnableForwarding: enableForwarding,
+		DefaultTimeout:   defaultTimeout,
+		MaxRetries:       maxRetries,
+	}
+}
+
+// DefaultParams defines the default params for the forward module
+func DefaultParams() Params {
+	return Params{
+		EnableForwarding: DefaultEnableForwarding,
+		DefaultTimeout:   DefaultTimeout,
+		MaxRetries:       DefaultMaxRetries,
+	}
+}
+
+// Validate checks that the fields have valid values
+func (p Params) Validate() error {
+	if p.DefaultTimeout <= 0 {
+		return fmt.Errorf("default timeout must be positive: %s", p.DefaultTimeout)
+	}
+
+	if p.MaxRetries > math.MaxUint8 {
+		return fmt.Errorf("max retries cannot be above %d: %d", math.MaxUint8, p.MaxRetries)
+	}
+
+	return nil
+}
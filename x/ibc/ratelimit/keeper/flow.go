@@ -0,0 +1,151 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+// checkSendPacket adds the amount of a sent transfer to the outflow of the
+// rate limit of its denom over the channel. It returns false if the transfer
+// is not rate limited, and fails if the send quota is exceeded. The updated
+// rate limit is not stored.
+func (k Keeper) checkSendPacket(
+	ctx sdk.Context,
+	channelID string,
+	data []byte,
+) (types.RateLimit, sdkmath.Int, bool, error) {
+	if !k.GetParams(ctx).EnableRateLimits {
+		return types.RateLimit{}, sdkmath.Int{}, false, nil
+	}
+
+	var transfer transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &transfer); err != nil {
+		return types.RateLimit{}, sdkmath.Int{}, false, nil
+	}
+
+	// the sent denom is the full trace of vouchers and the base denom of the
+	// native tokens
+	denom := transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+
+	rateLimit, found := k.GetCurrentRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, sdkmath.Int{}, false, nil
+	}
+
+	amount, ok := sdkmath.NewIntFromString(transfer.Amount)
+	if !ok {
+		return types.RateLimit{}, sdkmath.Int{}, false, errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins, "invalid transfer amount %s", transfer.Amount,
+		)
+	}
+
+	if err := rateLimit.AddFlow(types.DirectionSend, amount); err != nil {
+		k.emitQuotaExceededEvent(ctx, channelID, denom, amount, types.DirectionSend)
+		return types.RateLimit{}, sdkmath.Int{}, false, err
+	}
+
+	return rateLimit, amount, true, nil
+}
+
+// OnRecvPacket adds the amount of a received transfer to the inflow of the
+// rate limit of its denom over the channel. It fails if the receive quota is
+// exceeded.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if !k.GetParams(ctx).EnableRateLimits {
+		return nil
+	}
+
+	var transfer transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &transfer); err != nil {
+		return nil
+	}
+
+	denom := ReceivedDenom(packet, transfer.Denom)
+	channelID := packet.DestinationChannel
+
+	rateLimit, found := k.GetCurrentRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	amount, ok := sdkmath.NewIntFromString(transfer.Amount)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid transfer amount %s", transfer.Amount)
+	}
+
+	if err := rateLimit.AddFlow(types.DirectionRecv, amount); err != nil {
+		k.emitQuotaExceededEvent(ctx, channelID, denom, amount, types.DirectionRecv)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// OnAcknowledgementPacket removes the pending send packet and undoes its
+// outflow if the transfer failed.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	k.settlePendingSendPacket(ctx, packet, !ack.Success())
+}
+
+// OnTimeoutPacket removes the pending send packet and undoes its outflow.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.settlePendingSendPacket(ctx, packet, true)
+}
+
+// settlePendingSendPacket removes the pending send packet. The refunded amount
+// is removed from the outflow if the bucket in which the packet was sent is
+// still within the window, as the outflow of the older buckets is not tracked
+// anymore.
+func (k Keeper) settlePendingSendPacket(ctx sdk.Context, packet channeltypes.Packet, refunded bool) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+
+	k.DeletePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+	if !refunded {
+		return
+	}
+
+	rateLimit, found := k.GetCurrentRateLimit(ctx, pending.ChannelId, pending.Denom)
+	if !found || !rateLimit.UndoSend(pending.Amount, pending.BucketStart) {
+		return
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+}
+
+// ReceivedDenom returns the denomination on Evmos of the tokens received with
+// a transfer packet. The returning vouchers are unwrapped, while the other
+// tokens are received as vouchers prefixed with the destination channel.
+func ReceivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+func (k Keeper) emitQuotaExceededEvent(ctx sdk.Context, channelID, denom string, amount sdkmath.Int, direction string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+		),
+	)
+}
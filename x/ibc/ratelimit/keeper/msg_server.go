@@ -0,0 +1,113 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// AddRateLimit implements the gRPC MsgServer interface. When an AddRateLimit
+// proposal passes, it adds the rate limit of the denom over the channel and
+// starts a window with an empty flow.
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "channel %s, denom %s", req.ChannelId, req.Denom)
+	}
+
+	rateLimit := k.NewRateLimit(ctx, req.ChannelId, req.Denom, req.Quota)
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, req.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. When an
+// UpdateRateLimit proposal passes, it replaces the quota of the rate limit of
+// the denom over the channel and starts a new window with an empty flow.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", req.ChannelId, req.Denom)
+	}
+
+	rateLimit := k.NewRateLimit(ctx, req.ChannelId, req.Denom, req.Quota)
+	k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, req.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. When a
+// RemoveRateLimit proposal passes, it removes the rate limit of the denom over
+// the channel.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.ChannelId, req.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", req.ChannelId, req.Denom)
+	}
+
+	k.DeleteRateLimit(ctx, req.ChannelId, req.Denom)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRateLimit,
+			sdk.NewAttribute(types.AttributeKeyChannel, req.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Denom),
+		),
+	)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+func (suite *RateLimitTestSuite) TestRateLimitMsgs() {
+	suite.SetupTest()
+
+	k := suite.evmosApp().RateLimitKeeper
+	ctx := suite.EvmosChain.GetContext()
+	channelID := suite.pathOsmosisEvmos.EndpointB.ChannelID
+	quota := types.NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+
+	addMsg := &types.MsgAddRateLimit{Authority: authority, ChannelId: channelID, Denom: utils.BaseDenom, Quota: quota}
+	updateMsg := &types.MsgUpdateRateLimit{Authority: authority, ChannelId: channelID, Denom: utils.BaseDenom, Quota: quota}
+	removeMsg := &types.MsgRemoveRateLimit{Authority: authority, ChannelId: channelID, Denom: utils.BaseDenom}
+
+	invalidAddMsg := *addMsg
+	invalidAddMsg.Authority = suite.EvmosChain.SenderAccount.GetAddress().String()
+	_, err := k.AddRateLimit(ctx, &invalidAddMsg)
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateRateLimit(ctx, updateMsg)
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	_, err = k.AddRateLimit(ctx, addMsg)
+	suite.Require().NoError(err)
+	_, err = k.AddRateLimit(ctx, addMsg)
+	suite.Require().ErrorIs(err, types.ErrRateLimitAlreadyExists)
+
+	// the channel value is the supply of the denom
+	rateLimit, found := k.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.evmosApp().BankKeeper.GetSupply(ctx, utils.BaseDenom).Amount, rateLimit.Flow.ChannelValue)
+	suite.Require().Equal(quota.BucketStart(ctx.BlockTime()), rateLimit.CurrentBucketStart())
+
+	// the update resets the flow
+	rateLimit.Flow.Outflow = sdk.NewInt(100)
+	k.SetRateLimit(ctx, rateLimit)
+
+	updateMsg.Quota.MaxPercentSend = 20
+	_, err = k.UpdateRateLimit(ctx, updateMsg)
+	suite.Require().NoError(err)
+
+	rateLimit, _ = k.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().Equal(uint32(20), rateLimit.Quota.MaxPercentSend)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	queryRes, err := k.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.RateLimits, 1)
+
+	_, err = k.RemoveRateLimit(ctx, removeMsg)
+	suite.Require().NoError(err)
+	_, err = k.RemoveRateLimit(ctx, removeMsg)
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	suite.Require().Empty(k.GetRateLimits(ctx))
+}
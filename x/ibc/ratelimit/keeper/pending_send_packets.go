@@ -0,0 +1,56 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+// GetPendingSendPackets returns all the packets sent on rate limited channels
+// and waiting for an acknowledgement or a timeout.
+func (k Keeper) GetPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	packets := []types.PendingSendPacket{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingSendPacket)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// GetPendingSendPacket returns the pending packet sent with the given channel
+// and sequence.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// SetPendingSendPacket stores a pending send packet
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	key := types.PendingSendPacketKey(packet.ChannelId, packet.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&packet))
+}
+
+// DeletePendingSendPacket removes a pending send packet
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
@@ -0,0 +1,97 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+// GetRateLimits returns all the rate limits.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over all the stored rate limits.
+func (k Keeper) IterateRateLimits(ctx sdk.Context, cb func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetRateLimit returns the stored rate limit of the denom over the channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// GetCurrentRateLimit returns the rate limit of the denom over the channel with
+// the flow of the rolling window ending at the current block time.
+func (k Keeper) GetCurrentRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	return k.currentWindow(ctx, rateLimit), true
+}
+
+// SetRateLimit stores a rate limit
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	key := types.RateLimitKey(rateLimit.ChannelId, rateLimit.Denom)
+	store.Set(key, k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes the rate limit of the denom over the channel
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(types.RateLimitKey(channelID, denom))
+}
+
+// NewRateLimit returns a rate limit with an empty flow, starting a bucket at the
+// current block time. The channel value is the current supply of the denom.
+func (k Keeper) NewRateLimit(ctx sdk.Context, channelID, denom string, quota types.Quota) types.RateLimit {
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	return types.NewRateLimit(channelID, denom, quota, supply.Amount, ctx.BlockTime())
+}
+
+// currentWindow removes the flow of the buckets older than the window from the
+// rate limit, and starts a bucket at the current block time with the current
+// supply of the denom as channel value if needed
+func (k Keeper) currentWindow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	now := ctx.BlockTime()
+	if rateLimit.Roll(now) {
+		return rateLimit
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, rateLimit.Denom)
+	rateLimit.StartBucket(rateLimit.Quota.BucketStart(now), supply.Amount)
+	return rateLimit
+}
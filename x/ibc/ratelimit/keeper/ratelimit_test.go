@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/evmos/evmos/v15/utils"
+	"github.com/evmos/evmos/v15/x/ibc/ratelimit/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+// addRateLimit adds a rate limit of the denom over the Evmos channel of the
+// Osmosis path
+func (suite *RateLimitTestSuite) addRateLimit(denom string, quota types.Quota) {
+	_, err := suite.evmosApp().RateLimitKeeper.AddRateLimit(suite.EvmosChain.GetContext(), &types.MsgAddRateLimit{
+		Authority: authority,
+		ChannelId: suite.pathOsmosisEvmos.EndpointB.ChannelID,
+		Denom:     denom,
+		Quota:     quota,
+	})
+	suite.Require().NoError(err)
+}
+
+func (suite *RateLimitTestSuite) TestSendRateLimit() {
+	suite.SetupTest()
+
+	quota := types.NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), time.Hour)
+	suite.addRateLimit(utils.BaseDenom, quota)
+
+	channelID := suite.pathOsmosisEvmos.EndpointB.ChannelID
+	sender := suite.EvmosChain.SenderAccount.GetAddress()
+	receiver := suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+
+	// the transfer is refunded by an error acknowledgement
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointB, sdk.NewCoin(utils.BaseDenom, sdk.NewInt(60)), "invalid", "")
+
+	ctx := suite.EvmosChain.GetContext()
+	rateLimit, found := suite.evmosApp().RateLimitKeeper.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(60), rateLimit.Flow.Outflow)
+
+	pending, found := suite.evmosApp().RateLimitKeeper.GetPendingSendPacket(ctx, channelID, packet.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(60), pending.Amount)
+	suite.Require().Equal(rateLimit.CurrentBucketStart(), pending.BucketStart)
+
+	// the send quota is exceeded
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID, channelID,
+		sdk.NewCoin(utils.BaseDenom, sdk.NewInt(50)), sender.String(), receiver,
+		clienttypes.ZeroHeight(), uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano()), "",
+	)
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := suite.evmosApp().TransferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointA, packet)
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointB, packet, ack)
+
+	ctx = suite.EvmosChain.GetContext()
+	rateLimit, _ = suite.evmosApp().RateLimitKeeper.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.evmosApp().RateLimitKeeper.GetPendingSendPackets(ctx))
+
+	// the whole quota can be used once the failed transfer is refunded
+	packet = suite.sendTransfer(suite.pathOsmosisEvmos.EndpointB, sdk.NewCoin(utils.BaseDenom, sdk.NewInt(100)), receiver, "")
+	res = suite.recvPacket(suite.pathOsmosisEvmos.EndpointA, packet)
+	ack, err = ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.acknowledgePacket(suite.pathOsmosisEvmos.EndpointB, packet, ack)
+
+	ctx = suite.EvmosChain.GetContext()
+	rateLimit, _ = suite.evmosApp().RateLimitKeeper.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+	suite.Require().Empty(suite.evmosApp().RateLimitKeeper.GetPendingSendPackets(ctx))
+}
+
+func (suite *RateLimitTestSuite) TestSendTimeout() {
+	suite.SetupTest()
+
+	quota := types.NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), 24*time.Hour)
+	suite.addRateLimit(utils.BaseDenom, quota)
+
+	channelID := suite.pathOsmosisEvmos.EndpointB.ChannelID
+	receiver := suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointB, sdk.NewCoin(utils.BaseDenom, sdk.NewInt(60)), receiver, "")
+
+	suite.coordinator.IncrementTimeBy(2 * time.Hour)
+	suite.coordinator.CommitBlock(suite.IBCOsmosisChain)
+	suite.timeoutPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+
+	ctx := suite.EvmosChain.GetContext()
+	rateLimit, _ := suite.evmosApp().RateLimitKeeper.GetRateLimit(ctx, channelID, utils.BaseDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.evmosApp().RateLimitKeeper.GetPendingSendPackets(ctx))
+}
+
+func (suite *RateLimitTestSuite) TestRecvRateLimit() {
+	suite.SetupTest()
+
+	channelID := suite.pathOsmosisEvmos.EndpointB.ChannelID
+	denom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "uosmo")).IBCDenom()
+	quota := types.NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.NewInt(50), time.Hour)
+	suite.addRateLimit(denom, quota)
+
+	coin := sdk.NewCoin("uosmo", sdk.NewInt(30))
+	receiver := suite.EvmosChain.SenderAccount.GetAddress()
+
+	packet := suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, receiver.String(), "")
+	res := suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+
+	// the receive quota is exceeded
+	packet = suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, receiver.String(), "")
+	res = suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+	ack, err = ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Contains(string(ack), "error")
+
+	ctx := suite.EvmosChain.GetContext()
+	balance := suite.evmosApp().BankKeeper.GetBalance(ctx, receiver, denom)
+	suite.Require().Equal(sdk.NewInt(30), balance.Amount)
+
+	queryRes, err := suite.evmosApp().RateLimitKeeper.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(30), queryRes.Recv.NetFlow)
+	suite.Require().Equal(sdk.NewInt(50), queryRes.Recv.MaxFlow)
+	suite.Require().Equal(sdk.NewDec(60), queryRes.Recv.PercentUsed)
+	suite.Require().True(queryRes.Send.MaxFlow.IsZero())
+
+	// the flow is still within the rolling window a window later, as the flow
+	// of its bucket is only removed once the whole bucket is out of the window
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+
+	ctx = suite.EvmosChain.GetContext()
+	queryRes, err = suite.evmosApp().RateLimitKeeper.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(30), queryRes.RateLimit.Flow.Inflow)
+
+	suite.coordinator.IncrementTimeBy(quota.BucketDuration())
+	suite.coordinator.CommitBlock(suite.EvmosChain)
+
+	ctx = suite.EvmosChain.GetContext()
+	queryRes, err = suite.evmosApp().RateLimitKeeper.RateLimit(ctx, &types.QueryRateLimitRequest{ChannelId: channelID, Denom: denom})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.RateLimit.Flow.Inflow.IsZero())
+	suite.Require().Equal(sdk.NewInt(30), queryRes.RateLimit.Flow.ChannelValue)
+
+	packet = suite.sendTransfer(suite.pathOsmosisEvmos.EndpointA, coin, receiver.String(), "")
+	res = suite.recvPacket(suite.pathOsmosisEvmos.EndpointB, packet)
+	ack, err = ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(), ack)
+}
+
+func (suite *RateLimitTestSuite) TestRateLimitsDisabled() {
+	suite.SetupTest()
+
+	quota := types.NewQuota(0, 0, sdkmath.NewInt(1), sdkmath.ZeroInt(), time.Hour)
+	suite.addRateLimit(utils.BaseDenom, quota)
+
+	err := suite.evmosApp().RateLimitKeeper.SetParams(suite.EvmosChain.GetContext(), types.NewParams(false))
+	suite.Require().NoError(err)
+
+	receiver := suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+	suite.sendTransfer(suite.pathOsmosisEvmos.EndpointB, sdk.NewCoin(utils.BaseDenom, sdk.NewInt(10)), receiver, "")
+
+	ctx := suite.EvmosChain.GetContext()
+	rateLimit, _ := suite.evmosApp().RateLimitKeeper.GetRateLimit(ctx, suite.pathOsmosisEvmos.EndpointB.ChannelID, utils.BaseDenom)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Empty(suite.evmosApp().RateLimitKeeper.GetPendingSendPackets(ctx))
+}
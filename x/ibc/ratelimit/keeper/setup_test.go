@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/evmos/evmos/v15/app"
+	ibctesting "github.com/evmos/evmos/v15/ibc/testing"
+	"github.com/evmos/evmos/v15/utils"
+	inflationtypes "github.com/evmos/evmos/v15/x/inflation/v1/types"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	EvmosChain      *ibcgotesting.TestChain
+	IBCOsmosisChain *ibcgotesting.TestChain
+
+	pathOsmosisEvmos *ibctesting.Path
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	// initializes 2 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.EvmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCOsmosisChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.coordinator.CommitNBlocks(suite.EvmosChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 2)
+
+	// Fund sender address to pay fees
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+	err := suite.evmosApp().BankKeeper.MintCoins(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.evmosApp().BankKeeper.SendCoinsFromModuleToAccount(suite.EvmosChain.GetContext(), inflationtypes.ModuleName, suite.EvmosChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// Mint the transferred coins and the IBC tx fees on the Osmosis chain
+	osmosisApp := suite.IBCOsmosisChain.GetSimApp()
+	coins = sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, amt),
+		sdk.NewCoin("uosmo", sdk.NewInt(100)),
+	)
+	err = osmosisApp.BankKeeper.MintCoins(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = osmosisApp.BankKeeper.SendCoinsFromModuleToAccount(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, suite.IBCOsmosisChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	evmParams := suite.evmosApp().EvmKeeper.GetParams(suite.EvmosChain.GetContext())
+	evmParams.EvmDenom = utils.BaseDenom
+	err = suite.evmosApp().EvmKeeper.SetParams(suite.EvmosChain.GetContext(), evmParams)
+	suite.Require().NoError(err)
+
+	suite.pathOsmosisEvmos = ibctesting.NewTransferPath(suite.IBCOsmosisChain, suite.EvmosChain) // clientID, connectionID, channelID empty
+	ibctesting.SetupPath(suite.coordinator, suite.pathOsmosisEvmos)                              // clientID, connectionID, channelID filled
+}
+
+func (suite *RateLimitTestSuite) evmosApp() *app.Evmos {
+	return suite.EvmosChain.App.(*app.Evmos)
+}
+
+// sendTransfer sends an ICS-20 transfer from the sender account of the chain
+// and returns the sent packet
+func (suite *RateLimitTestSuite) sendTransfer(
+	endpoint *ibctesting.Endpoint,
+	coin sdk.Coin,
+	receiver, memo string,
+) channeltypes.Packet {
+	timeout := uint64(suite.coordinator.CurrentTime.Add(time.Hour).UnixNano())
+	msg := transfertypes.NewMsgTransfer(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		coin, endpoint.Chain.SenderAccount.GetAddress().String(), receiver,
+		clienttypes.ZeroHeight(), timeout, memo,
+	)
+
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// recvPacket receives a packet on the endpoint and returns the result
+func (suite *RateLimitTestSuite) recvPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := endpoint.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	return res
+}
+
+// acknowledgePacket acknowledges a packet on the endpoint and returns the result
+func (suite *RateLimitTestSuite) acknowledgePacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet, ack []byte) *sdk.Result {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+
+	msg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+	return res
+}
+
+// timeoutPacket times out a packet on the endpoint and returns the result
+func (suite *RateLimitTestSuite) timeoutPacket(endpoint *ibctesting.Endpoint, packet channeltypes.Packet) *sdk.Result {
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	packetKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.Counterparty.QueryProof(packetKey)
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(
+		endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID,
+	)
+	suite.Require().True(found)
+
+	msg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+	res, err := ibctesting.SendMsgs(endpoint.Chain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+	return res
+}
@@ -0,0 +1,18 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidQuota             = errorsmod.Register(ModuleName, 2, "invalid quota")
+	ErrInvalidRateLimit         = errorsmod.Register(ModuleName, 3, "invalid rate limit")
+	ErrRateLimitNotFound        = errorsmod.Register(ModuleName, 4, "rate limit not found")
+	ErrQuotaExceeded            = errorsmod.Register(ModuleName, 5, "quota exceeded")
+	ErrInvalidPendingSendPacket = errorsmod.Register(ModuleName, 6, "invalid pending send packet")
+	ErrRateLimitAlreadyExists   = errorsmod.Register(ModuleName, 7, "rate limit already exists")
+)
@@ -0,0 +1,23 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// ratelimit events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeQuotaExceeded   = "rate_limit_quota_exceeded"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyDirection = "direction"
+)
+
+// flow directions
+const (
+	DirectionSend = "send"
+	DirectionRecv = "recv"
+)
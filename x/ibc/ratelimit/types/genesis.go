@@ -0,0 +1,60 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	rateLimits []RateLimit,
+	pendingSendPackets []PendingSendPacket,
+) GenesisState {
+	return GenesisState{
+		Params:             params,
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState sets default ratelimit genesis state with default params
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RateLimitKey(rateLimit.ChannelId, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicated rate limit %s", key)
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.PendingSendPackets {
+		if err := packet.Validate(); err != nil {
+			return err
+		}
+
+		key := string(PendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated pending send packet %s/%d", packet.ChannelId, packet.Sequence)
+		}
+		seenPackets[key] = true
+	}
+
+	return gs.Params.Validate()
+}
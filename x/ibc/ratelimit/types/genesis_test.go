@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func validRateLimit(channelID string) RateLimit {
+	quota := NewQuota(10, 20, sdkmath.NewInt(1000), sdkmath.ZeroInt(), time.Hour)
+	return NewRateLimit(channelID, "aevmos", quota, sdkmath.NewInt(5000), time.Unix(100, 0))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	invalidRateLimit := validRateLimit("channel-0")
+	invalidRateLimit.Quota.Window = 0
+
+	invalidPacket := NewPendingSendPacket("channel-0", 1, "aevmos", sdkmath.ZeroInt(), time.Unix(100, 0))
+	validPacket := NewPendingSendPacket("channel-0", 1, "aevmos", sdkmath.NewInt(10), time.Unix(100, 0))
+
+	testCases := []struct {
+		name     string
+		genesis  GenesisState
+		expError bool
+	}{
+		{
+			"empty genesis",
+			GenesisState{},
+			false,
+		},
+		{
+			"default genesis",
+			*DefaultGenesisState(),
+			false,
+		},
+		{
+			"custom genesis",
+			NewGenesisState(
+				NewParams(false),
+				[]RateLimit{validRateLimit("channel-0"), validRateLimit("channel-1")},
+				[]PendingSendPacket{validPacket},
+			),
+			false,
+		},
+		{
+			"invalid rate limit",
+			NewGenesisState(DefaultParams(), []RateLimit{invalidRateLimit}, nil),
+			true,
+		},
+		{
+			"invalid rate limit channel",
+			NewGenesisState(DefaultParams(), []RateLimit{validRateLimit("channel")}, nil),
+			true,
+		},
+		{
+			"duplicated rate limit",
+			NewGenesisState(DefaultParams(), []RateLimit{validRateLimit("channel-0"), validRateLimit("channel-0")}, nil),
+			true,
+		},
+		{
+			"invalid pending send packet",
+			NewGenesisState(DefaultParams(), nil, []PendingSendPacket{invalidPacket}),
+			true,
+		},
+		{
+			"duplicated pending send packet",
+			NewGenesisState(DefaultParams(), nil, []PendingSendPacket{validPacket, validPacket}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genesis.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// ModuleName defines the ratelimit module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the ratelimit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixPendingSendPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit         = []byte{prefixRateLimit}
+	KeyPrefixPendingSendPacket = []byte{prefixPendingSendPacket}
+)
+
+// RateLimitKey returns the key of a rate limit in the `<channel_id>/<denom>`
+// format
+func RateLimitKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", channelID, denom))
+}
+
+// PendingSendPacketKey returns the key of a pending send packet in the
+// `<channel_id>/<sequence>` format
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append([]byte(fmt.Sprintf("%s/", channelID)), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,30 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+// ParamsKey params store key
+var ParamsKey = []byte("Params")
+
+// DefaultEnableRateLimits defines whether the rate limits are enforced by
+// default
+var DefaultEnableRateLimits = true
+
+// NewParams creates a new Params instance
+func NewParams(enableRateLimits bool) Params {
+	return Params{
+		EnableRateLimits: enableRateLimits,
+	}
+}
+
+// DefaultParams defines the default params for the ratelimit module
+func DefaultParams() Params {
+	return Params{
+		EnableRateLimits: DefaultEnableRateLimits,
+	}
+}
+
+// Validate checks that the fields have valid values
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,320 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// WindowBuckets is the number of buckets a rate limit window is split in. The
+// flow of a bucket is removed once the whole bucket is older than the window,
+// so the net amount within any period of the window duration never exceeds the
+// quota, while the flow is tracked for at most one bucket more than the window.
+const WindowBuckets = 10
+
+// NewQuota returns an instance of Quota
+func NewQuota(
+	maxPercentSend, maxPercentRecv uint32,
+	maxAmountSend, maxAmountRecv sdkmath.Int,
+	window time.Duration,
+) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		Window:         window,
+	}
+}
+
+// Validate performs a stateless validation of a Quota
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return errorsmod.Wrapf(
+			ErrInvalidQuota,
+			"max percentages cannot be above 100: send %d, recv %d", q.MaxPercentSend, q.MaxPercentRecv,
+		)
+	}
+
+	if q.MaxAmountSend.IsNil() || q.MaxAmountSend.IsNegative() ||
+		q.MaxAmountRecv.IsNil() || q.MaxAmountRecv.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max amounts cannot be nil or negative")
+	}
+
+	if q.Window < WindowBuckets*time.Second {
+		return errorsmod.Wrapf(ErrInvalidQuota, "window must be at least %ds: %s", WindowBuckets, q.Window)
+	}
+
+	return nil
+}
+
+// BucketDuration returns the duration of the buckets of the window
+func (q Quota) BucketDuration() time.Duration {
+	return q.Window / WindowBuckets
+}
+
+// BucketStart returns the start time of the window bucket including the given
+// time. The buckets are aligned on multiples of their duration.
+func (q Quota) BucketStart(now time.Time) time.Time {
+	return now.Truncate(q.BucketDuration()).UTC()
+}
+
+// MaxFlow returns the max net amount of the given direction within a window,
+// which is the lowest of the enabled limits. The percentage limit is ignored
+// when the channel value is zero. It returns false when the direction is not
+// limited.
+func (q Quota) MaxFlow(direction string, channelValue sdkmath.Int) (sdkmath.Int, bool) {
+	maxPercent, maxAmount := q.MaxPercentSend, q.MaxAmountSend
+	if direction == DirectionRecv {
+		maxPercent, maxAmount = q.MaxPercentRecv, q.MaxAmountRecv
+	}
+
+	maxFlow, limited := sdkmath.ZeroInt(), false
+	if maxPercent > 0 && channelValue.IsPositive() {
+		maxFlow = channelValue.MulRaw(int64(maxPercent)).QuoRaw(100)
+		limited = true
+	}
+
+	if maxAmount.IsPositive() && (!limited || maxAmount.LT(maxFlow)) {
+		maxFlow = maxAmount
+		limited = true
+	}
+
+	return maxFlow, limited
+}
+
+// NewFlow returns an empty Flow with a first bucket starting at the given time
+func NewFlow(channelValue sdkmath.Int, bucketStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+		Buckets:      []FlowBucket{NewFlowBucket(bucketStart)},
+	}
+}
+
+// NewFlowBucket returns an empty FlowBucket starting at the given time
+func NewFlowBucket(start time.Time) FlowBucket {
+	return FlowBucket{
+		Inflow:  sdkmath.ZeroInt(),
+		Outflow: sdkmath.ZeroInt(),
+		Start:   start,
+	}
+}
+
+// NetFlow returns the net amount of the given direction, which is negative
+// when more tokens flowed in the opposite direction
+func (f Flow) NetFlow(direction string) sdkmath.Int {
+	if direction == DirectionRecv {
+		return f.Inflow.Sub(f.Outflow)
+	}
+	return f.Outflow.Sub(f.Inflow)
+}
+
+// Validate performs a stateless validation of a Flow
+func (f Flow) Validate() error {
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() ||
+		f.Outflow.IsNil() || f.Outflow.IsNegative() ||
+		f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "flow amounts cannot be nil or negative")
+	}
+
+	if len(f.Buckets) == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "flow must have a bucket")
+	}
+
+	inflow, outflow := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for i, bucket := range f.Buckets {
+		if bucket.Inflow.IsNil() || bucket.Inflow.IsNegative() ||
+			bucket.Outflow.IsNil() || bucket.Outflow.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidRateLimit, "bucket amounts cannot be nil or negative")
+		}
+
+		if i > 0 && !bucket.Start.After(f.Buckets[i-1].Start) {
+			return errorsmod.Wrap(ErrInvalidRateLimit, "buckets must be sorted by start time")
+		}
+
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	if !inflow.Equal(f.Inflow) || !outflow.Equal(f.Outflow) {
+		return errorsmod.Wrapf(
+			ErrInvalidRateLimit,
+			"flow amounts don't match the buckets: inflow %s, outflow %s", inflow, outflow,
+		)
+	}
+
+	return nil
+}
+
+// NewRateLimit returns an instance of RateLimit with an empty flow
+func NewRateLimit(
+	channelID, denom string,
+	quota Quota,
+	channelValue sdkmath.Int,
+	now time.Time,
+) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      NewFlow(channelValue, quota.BucketStart(now)),
+	}
+}
+
+// CurrentBucketStart returns the start time of the latest bucket of the flow
+func (r RateLimit) CurrentBucketStart() time.Time {
+	return r.Flow.Buckets[len(r.Flow.Buckets)-1].Start
+}
+
+// Roll removes the buckets that ended before the window at the given time from
+// the flow. It returns false if the latest bucket doesn't include the given
+// time, in which case a new bucket must be started with StartBucket.
+func (r *RateLimit) Roll(now time.Time) bool {
+	bucketStart := r.Quota.BucketStart(now)
+	windowStart := bucketStart.Add(-r.Quota.Window - r.Quota.BucketDuration())
+
+	buckets := make([]FlowBucket, 0, len(r.Flow.Buckets))
+	for _, bucket := range r.Flow.Buckets {
+		if bucket.Start.After(windowStart) {
+			buckets = append(buckets, bucket)
+			continue
+		}
+
+		r.Flow.Inflow = r.Flow.Inflow.Sub(bucket.Inflow)
+		r.Flow.Outflow = r.Flow.Outflow.Sub(bucket.Outflow)
+	}
+	r.Flow.Buckets = buckets
+
+	return len(buckets) > 0 && buckets[len(buckets)-1].Start.Equal(bucketStart)
+}
+
+// StartBucket adds an empty bucket starting at the given time to the flow and
+// updates the channel value the percentage limits are applied to
+func (r *RateLimit) StartBucket(start time.Time, channelValue sdkmath.Int) {
+	r.Flow.Buckets = append(r.Flow.Buckets, NewFlowBucket(start))
+	r.Flow.ChannelValue = channelValue
+}
+
+// AddFlow adds the amount to the flow of the given direction and to the latest
+// bucket. It fails if the net amount of the direction within the window
+// exceeds its quota.
+func (r *RateLimit) AddFlow(direction string, amount sdkmath.Int) error {
+	flow := r.Flow
+	flow.Buckets = append([]FlowBucket{}, r.Flow.Buckets...)
+	bucket := &flow.Buckets[len(flow.Buckets)-1]
+	if direction == DirectionRecv {
+		flow.Inflow = flow.Inflow.Add(amount)
+		bucket.Inflow = bucket.Inflow.Add(amount)
+	} else {
+		flow.Outflow = flow.Outflow.Add(amount)
+		bucket.Outflow = bucket.Outflow.Add(amount)
+	}
+
+	maxFlow, limited := r.Quota.MaxFlow(direction, flow.ChannelValue)
+	if limited && flow.NetFlow(direction).GT(maxFlow) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded,
+			"%s %s%s over %s exceeds the net flow quota of %s within the window; current net flow: %s",
+			direction, amount, r.Denom, r.ChannelId, maxFlow, r.Flow.NetFlow(direction),
+		)
+	}
+
+	r.Flow = flow
+	return nil
+}
+
+// UndoSend removes the amount of a failed transfer from the outflow of the
+// bucket in which it was sent. It returns false if the bucket is not within
+// the window anymore.
+func (r *RateLimit) UndoSend(amount sdkmath.Int, bucketStart time.Time) bool {
+	for i := range r.Flow.Buckets {
+		bucket := &r.Flow.Buckets[i]
+		if !bucket.Start.Equal(bucketStart) {
+			continue
+		}
+
+		amount = sdkmath.MinInt(amount, bucket.Outflow)
+		bucket.Outflow = bucket.Outflow.Sub(amount)
+		r.Flow.Outflow = r.Flow.Outflow.Sub(amount)
+		return true
+	}
+
+	return false
+}
+
+// Utilization returns the utilization of the quota of the given direction
+func (r RateLimit) Utilization(direction string) Utilization {
+	netFlow := r.Flow.NetFlow(direction)
+	maxFlow, limited := r.Quota.MaxFlow(direction, r.Flow.ChannelValue)
+
+	percentUsed := sdk.ZeroDec()
+	if limited && maxFlow.IsPositive() && netFlow.IsPositive() {
+		percentUsed = sdk.NewDecFromInt(netFlow).MulInt64(100).QuoInt(maxFlow)
+	}
+
+	return Utilization{
+		NetFlow:     netFlow,
+		MaxFlow:     maxFlow,
+		PercentUsed: percentUsed,
+	}
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (r RateLimit) Validate() error {
+	if err := validateChannelDenom(r.ChannelId, r.Denom); err != nil {
+		return err
+	}
+
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return r.Flow.Validate()
+}
+
+// NewPendingSendPacket returns an instance of PendingSendPacket
+func NewPendingSendPacket(
+	channelID string,
+	sequence uint64,
+	denom string,
+	amount sdkmath.Int,
+	bucketStart time.Time,
+) PendingSendPacket {
+	return PendingSendPacket{
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		Denom:       denom,
+		Amount:      amount,
+		BucketStart: bucketStart,
+	}
+}
+
+// Validate performs a stateless validation of a PendingSendPacket
+func (p PendingSendPacket) Validate() error {
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, err.Error())
+	}
+
+	if p.Sequence == 0 {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, "sequence cannot be 0")
+	}
+
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, err.Error())
+	}
+
+	if p.Amount.IsNil() || !p.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPendingSendPacket, fmt.Sprintf("amount must be positive: %s", p.Amount))
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		quota    Quota
+		expError bool
+	}{
+		{
+			"valid quota",
+			NewQuota(10, 10, sdkmath.NewInt(100), sdkmath.NewInt(100), time.Hour),
+			false,
+		},
+		{
+			"unlimited quota",
+			NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			false,
+		},
+		{
+			"percentage above 100",
+			NewQuota(101, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			true,
+		},
+		{
+			"negative amount",
+			NewQuota(10, 10, sdkmath.NewInt(-1), sdkmath.ZeroInt(), time.Hour),
+			true,
+		},
+		{
+			"nil amount",
+			Quota{MaxPercentSend: 10, MaxAmountSend: sdkmath.ZeroInt(), Window: time.Hour},
+			true,
+		},
+		{
+			"zero window",
+			NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0),
+			true,
+		},
+		{
+			"window shorter than a second per bucket",
+			NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), 9*time.Second),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaMaxFlow(t *testing.T) {
+	testCases := []struct {
+		name         string
+		quota        Quota
+		channelValue sdkmath.Int
+		expMaxFlow   sdkmath.Int
+		expLimited   bool
+	}{
+		{
+			"not limited",
+			NewQuota(0, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.NewInt(1000),
+			sdkmath.ZeroInt(),
+			false,
+		},
+		{
+			"percentage limit",
+			NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(100),
+			true,
+		},
+		{
+			"percentage limit ignored without channel value",
+			NewQuota(10, 0, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			false,
+		},
+		{
+			"amount limit",
+			NewQuota(0, 0, sdkmath.NewInt(50), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(50),
+			true,
+		},
+		{
+			"lowest limit - amount",
+			NewQuota(10, 0, sdkmath.NewInt(50), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(50),
+			true,
+		},
+		{
+			"lowest limit - percentage",
+			NewQuota(10, 0, sdkmath.NewInt(500), sdkmath.ZeroInt(), time.Hour),
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(100),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		maxFlow, limited := tc.quota.MaxFlow(DirectionSend, tc.channelValue)
+		require.Equal(t, tc.expLimited, limited, tc.name)
+		require.Equal(t, tc.expMaxFlow.String(), maxFlow.String(), tc.name)
+
+		// the receive direction is not limited
+		_, limited = tc.quota.MaxFlow(DirectionRecv, tc.channelValue)
+		require.False(t, limited, tc.name)
+	}
+}
+
+func TestRateLimitAddFlow(t *testing.T) {
+	quota := NewQuota(10, 10, sdkmath.ZeroInt(), sdkmath.ZeroInt(), time.Hour)
+	rateLimit := NewRateLimit("channel-0", "aevmos", quota, sdkmath.NewInt(1000), time.Unix(100, 0))
+
+	require.NoError(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(100)))
+	require.ErrorIs(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(1)), ErrQuotaExceeded)
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.Outflow)
+
+	// the inflow offsets the outflow
+	require.NoError(t, rateLimit.AddFlow(DirectionRecv, sdkmath.NewInt(50)))
+	require.NoError(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(50)))
+	require.Equal(t, sdkmath.NewInt(100), rateLimit.Flow.NetFlow(DirectionSend))
+
+	send := rateLimit.Utilization(DirectionSend)
+	require.Equal(t, sdkmath.NewInt(100), send.MaxFlow)
+	require.Equal(t, sdk.NewDec(100), send.PercentUsed)
+
+	recv := rateLimit.Utilization(DirectionRecv)
+	require.Equal(t, sdkmath.NewInt(-100), recv.NetFlow)
+	require.Equal(t, sdk.ZeroDec(), recv.PercentUsed)
+
+	require.True(t, rateLimit.UndoSend(sdkmath.NewInt(40), rateLimit.CurrentBucketStart()))
+	require.Equal(t, sdkmath.NewInt(110), rateLimit.Flow.Outflow)
+	require.Equal(t, sdk.NewDec(60), rateLimit.Utilization(DirectionSend).PercentUsed)
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	// the outflow of a bucket out of the window isn't undone
+	require.False(t, rateLimit.UndoSend(sdkmath.NewInt(40), time.Unix(100, 0).Add(-time.Hour)))
+}
+
+func TestRateLimitRoll(t *testing.T) {
+	quota := NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), time.Hour)
+	start := time.Unix(3600, 0)
+	rateLimit := NewRateLimit("channel-0", "aevmos", quota, sdkmath.NewInt(1000), start)
+	require.Equal(t, start.UTC(), rateLimit.CurrentBucketStart())
+
+	// the quota is used at the end of the first bucket
+	require.True(t, rateLimit.Roll(start.Add(5*time.Minute)))
+	require.NoError(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(100)))
+
+	// the flow is still within the window at the start of a new window, so
+	// the quota can't be used twice across the window boundary
+	now := start.Add(time.Hour)
+	require.False(t, rateLimit.Roll(now))
+	rateLimit.StartBucket(quota.BucketStart(now), sdkmath.NewInt(2000))
+	require.Equal(t, sdkmath.NewInt(2000), rateLimit.Flow.ChannelValue)
+	require.ErrorIs(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(1)), ErrQuotaExceeded)
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+
+	// the flow of the first bucket is removed once it's out of the window
+	now = start.Add(time.Hour + 6*time.Minute)
+	require.False(t, rateLimit.Roll(now))
+	rateLimit.StartBucket(quota.BucketStart(now), sdkmath.NewInt(2000))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.Len(t, rateLimit.Flow.Buckets, 2)
+	require.NoError(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(100)))
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	// the latest bucket includes the times until the next bucket starts
+	require.True(t, rateLimit.Roll(now.Add(5*time.Minute)))
+	require.False(t, rateLimit.Roll(now.Add(6*time.Minute)))
+}
+
+func TestFlowValidate(t *testing.T) {
+	rateLimit := NewRateLimit("channel-0", "aevmos", NewQuota(0, 0, sdkmath.NewInt(100), sdkmath.ZeroInt(), time.Hour), sdkmath.NewInt(1000), time.Unix(0, 0))
+	require.NoError(t, rateLimit.AddFlow(DirectionSend, sdkmath.NewInt(10)))
+	require.NoError(t, rateLimit.Flow.Validate())
+
+	noBuckets := rateLimit.Flow
+	noBuckets.Buckets = nil
+	require.Error(t, noBuckets.Validate())
+
+	unmatched := rateLimit.Flow
+	unmatched.Outflow = sdkmath.NewInt(20)
+	require.Error(t, unmatched.Validate())
+
+	unsorted := rateLimit.Flow
+	unsorted.Buckets = []FlowBucket{rateLimit.Flow.Buckets[0], NewFlowBucket(time.Unix(0, 0))}
+	require.Error(t, unsorted.Validate())
+}
@@ -6,6 +6,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -32,6 +33,9 @@ func GetQueryCmd() *cobra.Command {
 		GetGasMeterCmd(),
 		GetAllocationMetersCmd(),
 		GetAllocationMeterCmd(),
+		GetIncentiveProgramsCmd(),
+		GetIncentiveProgramCmd(),
+		GetPendingRewardsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -262,6 +266,112 @@ func GetAllocationMeterCmd() *cobra.Command {
 	return cmd
 }
 
+// GetIncentiveProgramsCmd queries the list of incentive programs
+func GetIncentiveProgramsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-programs",
+		Short: "Gets all incentive programs",
+		Long:  "Gets all incentive programs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentiveProgramsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.IncentivePrograms(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetIncentiveProgramCmd queries a given incentive program
+func GetIncentiveProgramCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-program PROGRAM_ID",
+		Short: "Gets the incentive program with the given id",
+		Long:  "Gets the incentive program with the given id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIncentiveProgramRequest{
+				Id: id,
+			}
+
+			res, err := queryClient.IncentiveProgram(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetPendingRewardsCmd queries the pending rewards of a participant
+func GetPendingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards PARTICIPANT_ADDRESS",
+		Short: "Gets the rewards of a participant that can be claimed",
+		Long:  "Gets the rewards of a participant that can be claimed, for each incentivized contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRewardsRequest{
+				Participant: args[0],
+			}
+
+			res, err := queryClient.PendingRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetParamsCmd queries the module parameters
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -20,6 +21,175 @@ import (
 	"github.com/evmos/evmos/v15/x/incentives/types"
 )
 
+// FlagParticipantCap is the flag of the max rewards of a participant per epoch
+const FlagParticipantCap = "participant-cap"
+
+// Flags of the weighting strategy of an incentive
+const (
+	FlagMaxGasPerEpoch = "max-gas-per-epoch"
+	FlagMinAccountAge  = "min-account-age"
+	FlagDiscount       = "discount"
+	FlagGasPercentile  = "gas-percentile"
+)
+
+// NewTxCmd returns a root CLI command handler for incentives
+// transaction commands.
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Incentives transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewCreateIncentiveProgramCmd(),
+		NewCancelIncentiveProgramCmd(),
+		NewClaimIncentiveRewardsCmd(),
+	)
+
+	return txCmd
+}
+
+// NewCreateIncentiveProgramCmd returns a CLI command handler for creating a
+// self-funded incentive program.
+func NewCreateIncentiveProgramCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-program CONTRACT_ADDRESS AMOUNT START_EPOCH END_EPOCH",
+		Args:  cobra.ExactArgs(4),
+		Short: "Create an incentive program for a contract, funded by the sender",
+		Long: `Create an incentive program for a contract, funded by the sender (--from).
+The amount is escrowed and distributed to the contract participants at the end of each
+epoch from the start epoch to the end epoch, according to the gas they spent. The remaining
+amount is refunded at the end of the program. The incentive program creation fee of the
+module params is burned from the sender.`,
+		Example: fmt.Sprintf(
+			"$ %s tx incentives create-program <contract> 1000000000000000000aevmos 10 20 --participant-cap=1000000000000000aevmos --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endEpoch, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			capStr, err := cmd.Flags().GetString(FlagParticipantCap)
+			if err != nil {
+				return err
+			}
+
+			participantCap, err := sdk.ParseCoinsNormalized(capStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateIncentiveProgram(
+				clientCtx.GetFromAddress(),
+				common.HexToAddress(args[0]),
+				amount,
+				participantCap,
+				startEpoch,
+				endEpoch,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagParticipantCap, "", "max amount rewarded to a participant per epoch (defaults to no cap)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelIncentiveProgramCmd returns a CLI command handler for canceling an
+// incentive program.
+func NewCancelIncentiveProgramCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-program PROGRAM_ID",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel an incentive program and refund the remaining amount",
+		Long:  "Cancel an incentive program and refund the remaining amount. Must be requested by the program creator (--from).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelIncentiveProgram(clientCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimIncentiveRewardsCmd returns a CLI command handler for claiming the
+// incentive rewards of a participant.
+func NewClaimIncentiveRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards [CONTRACT_ADDRESS...]",
+		Short: "Claim the incentive rewards of the given contracts",
+		Long:  "Claim the incentive rewards of the participant (--from) for the given contracts, or for all the contracts if none is given.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contracts := make([]common.Address, len(args))
+			for i, arg := range args {
+				if !common.IsHexAddress(arg) {
+					return fmt.Errorf("invalid contract address %s", arg)
+				}
+				contracts[i] = common.HexToAddress(arg)
+			}
+
+			msg := types.NewMsgClaimIncentiveRewards(clientCtx.GetFromAddress(), contracts)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterIncentiveProposalCmd implements the command to submit a register
 //
 //	incentive proposal
@@ -72,8 +242,14 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 
 			contract := args[0]
 
+			strategy, err := parseWeightingStrategyFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
 			content := types.NewRegisterIncentiveProposal(title, description, contract, allocation, uint32(epochs))
+			content.(*types.RegisterIncentiveProposal).WeightingStrategy = strategy
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -91,6 +267,10 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal") //nolint:staticcheck,nolintlint
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().Uint64(FlagMaxGasPerEpoch, 0, "max gas credited to a participant per epoch (defaults to no cap)")
+	cmd.Flags().Uint64(FlagMinAccountAge, 0, "blocks since the first interaction of a participant with the incentive before its gas is credited")
+	cmd.Flags().String(FlagDiscount, "0", "share of the gas not credited for reverting participants and transactions above the gas percentile")
+	cmd.Flags().Uint32(FlagGasPercentile, 0, "percentile of the gas used per transaction above which the gas is discounted (defaults to disabled)")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -175,3 +355,34 @@ func NewCancelIncentiveProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// parseWeightingStrategyFlags returns the weighting strategy of the flags of a
+// register incentive proposal
+func parseWeightingStrategyFlags(cmd *cobra.Command) (types.WeightingStrategy, error) {
+	maxGasPerEpoch, err := cmd.Flags().GetUint64(FlagMaxGasPerEpoch)
+	if err != nil {
+		return types.WeightingStrategy{}, err
+	}
+
+	minAccountAge, err := cmd.Flags().GetUint64(FlagMinAccountAge)
+	if err != nil {
+		return types.WeightingStrategy{}, err
+	}
+
+	discountStr, err := cmd.Flags().GetString(FlagDiscount)
+	if err != nil {
+		return types.WeightingStrategy{}, err
+	}
+
+	discount, err := sdk.NewDecFromStr(discountStr)
+	if err != nil {
+		return types.WeightingStrategy{}, fmt.Errorf("invalid discount: %w", err)
+	}
+
+	gasPercentile, err := cmd.Flags().GetUint32(FlagGasPercentile)
+	if err != nil {
+		return types.WeightingStrategy{}, err
+	}
+
+	return types.NewWeightingStrategy(maxGasPerEpoch, minAccountAge, discount, gasPercentile), nil
+}
@@ -63,13 +63,34 @@ func InitGenesis(
 	for _, gasMeter := range data.GasMeters {
 		k.SetGasMeter(ctx, gasMeter)
 	}
+
+	// Set incentive programs
+	nextProgramID := uint64(1)
+	for _, program := range data.IncentivePrograms {
+		k.SetIncentiveProgram(ctx, program)
+		if program.Id >= nextProgramID {
+			nextProgramID = program.Id + 1
+		}
+	}
+	k.SetNextIncentiveProgramID(ctx, nextProgramID)
+
+	// Set reward indexes
+	for _, ri := range data.RewardIndexes {
+		k.SetRewardIndex(ctx, ri)
+	}
+	k.SetUnclaimedRewards(ctx, data.UnclaimedRewards)
+	k.SetLastEpochNumber(ctx, data.LastEpochNumber)
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		Incentives: k.GetAllIncentives(ctx),
-		GasMeters:  k.GetIncentivesGasMeters(ctx),
+		Params:            k.GetParams(ctx),
+		Incentives:        k.GetAllIncentives(ctx),
+		GasMeters:         k.GetIncentivesGasMeters(ctx),
+		IncentivePrograms: k.GetAllIncentivePrograms(ctx),
+		RewardIndexes:     k.GetAllRewardIndexes(ctx),
+		UnclaimedRewards:  k.GetUnclaimedRewards(ctx),
+		LastEpochNumber:   k.GetLastEpochNumber(ctx),
 	}
 }
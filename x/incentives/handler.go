@@ -20,6 +20,18 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 			// execute state transition
 			res, err := server.UpdateParams(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateIncentiveProgram:
+			res, err := server.CreateIncentiveProgram(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelIncentiveProgram:
+			res, err := server.CancelIncentiveProgram(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimIncentiveRewards:
+			res, err := server.ClaimIncentiveRewards(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateWeightingStrategy:
+			res, err := server.UpdateWeightingStrategy(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -18,14 +18,16 @@ import (
 	"github.com/evmos/evmos/v15/x/incentives/types"
 )
 
-// DistributeRewards transfers the allocated rewards to the participants of a given
-// incentive.
+// DistributeRewards allocates the rewards of the given epoch to the participants
+// of the incentives and of the incentive programs, which can claim them
+// afterwards.
 //   - allocates the amount to be distributed from the inflation pool
-//   - distributes the rewards to all participants
-//   - deletes all gas meters
-//   - updates the remaining epochs of each incentive
+//   - allocates the epoch rewards of the incentive programs from their escrow
+//   - records the cumulative reward per gas index of each incentivized contract
+//   - updates the remaining epochs of each incentive and finalizes the
+//     incentive programs ending with the epoch
 //   - sets the cumulative totalGas to zero
-func (k Keeper) DistributeRewards(ctx sdk.Context) error {
+func (k Keeper) DistributeRewards(ctx sdk.Context, epoch uint64) error {
 	logger := k.Logger(ctx)
 
 	rewardAllocations, totalRewards, err := k.rewardAllocations(ctx)
@@ -33,18 +35,38 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 		return err
 	}
 
+	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	rewardScaler := k.GetParams(ctx).RewardScaler
+	allocated := sdk.DecCoins{}
+
+	programs, programContracts := k.incentiveProgramsByContract(ctx)
+	distributed := make(map[common.Address]bool)
+	// contracts whose incentive or incentive programs ended with the epoch
+	ended := []common.Address{}
+
 	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
-		_, _ = k.rewardParticipants(ctx, incentive, rewardAllocations)
+		contract := common.HexToAddress(incentive.Contract)
+		distributed[contract] = true
+
+		rewardPerGas, rewards := k.incentiveRewardPerGas(ctx, incentive, rewardAllocations, mintDenom, rewardScaler)
+		programsPerGas, programsRewards := k.distributeIncentivePrograms(ctx, programs[contract], epoch, mintDenom, rewardScaler)
+		k.updateRewardIndex(ctx, contract, epoch, rewardPerGas.Add(programsPerGas...))
+		allocated = allocated.Add(rewards...).Add(programsRewards...)
 
 		incentive.Epochs--
 
 		// Update Incentive and reset its total gas count. Remove incentive if it
-		// has no remaining epochs left.
+		// has no remaining epochs left. The gas meters are kept until the
+		// participants claim their rewards.
 		if incentive.IsActive() {
+			if percentile := incentive.WeightingStrategy.GasPercentile; percentile > 0 {
+				incentive.GasThreshold = k.computeGasThreshold(ctx, contract, percentile)
+			}
 			k.SetIncentive(ctx, incentive)
 			k.SetIncentiveTotalGas(ctx, incentive, 0)
 		} else {
 			k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
+			ended = append(ended, contract)
 			logger.Info(
 				"incentive finalized",
 				"contract", incentive.Contract,
@@ -59,11 +81,35 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 					types.AttributeKeyEpochs,
 					strconv.FormatUint(uint64(incentive.Epochs), 10),
 				),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
 			),
 		)
 		return false
 	})
 
+	// the contracts with incentive programs but without incentive
+	for _, contract := range programContracts {
+		if distributed[contract] {
+			continue
+		}
+
+		programsPerGas, programsRewards := k.distributeIncentivePrograms(ctx, programs[contract], epoch, mintDenom, rewardScaler)
+		k.updateRewardIndex(ctx, contract, epoch, programsPerGas)
+		allocated = allocated.Add(programsRewards...)
+		ended = append(ended, contract)
+	}
+
+	k.SetUnclaimedRewards(ctx, k.GetUnclaimedRewards(ctx).Add(allocated...))
+
+	// The gas meters of the contracts that aren't incentivized anymore are
+	// pruned once the reward indexes of the epoch are recorded, keeping the
+	// ones with pending rewards.
+	for _, contract := range ended {
+		if !k.IsIncentiveRegistered(ctx, contract) && !k.HasIncentiveProgram(ctx, contract) {
+			k.pruneGasMeters(ctx, contract)
+		}
+	}
+
 	defer func() {
 		for _, r := range totalRewards {
 			if r.Amount.IsInt64() {
@@ -92,9 +138,20 @@ func (k Keeper) rewardAllocations(
 
 	escrow := sdk.Coins{}
 
+	// the rewards escrowed by the incentive programs and the rewards not
+	// claimed yet can't be allocated
+	programsEscrow := k.incentiveProgramsEscrow(ctx)
+	unclaimed := k.GetUnclaimedRewards(ctx)
+
 	// iterate over the module account balance insert elements to the denom -> amount
 	// lookup map
 	k.bankKeeper.IterateAccountBalances(ctx, moduleAddr, func(coin sdk.Coin) bool {
+		coin.Amount = coin.Amount.Sub(programsEscrow.AmountOf(coin.Denom))
+		coin.Amount = coin.Amount.Sub(unclaimed.AmountOf(coin.Denom).Ceil().TruncateInt())
+		if !coin.Amount.IsPositive() {
+			return false
+		}
+
 		denomBalances[coin.Denom] = coin.Amount
 		// NOTE: all coins have different denomination so we can safely append instead
 		// of using Add
@@ -153,29 +210,29 @@ func (k Keeper) rewardAllocations(
 	return rewardAllocations, rewards, nil
 }
 
-// rewardParticipants reward participants of a given Incentive, delete their gas
-// meters and returns a count of all gas meters
+// incentiveRewardPerGas returns the rewards per unit of gas of an incentive
+// for the epoch, along with the rewards allocated to its participants
 //   - Check if participants spent gas on interacting with incentive
-//   - Iterate over the incentive participants' gas meters
-//   - Allocate rewards according to participants gasRatio and cap them at 100% of their gas spent on interaction with incentive
-//   - Send rewards to participants
-//   - Delete gas meter
-func (k Keeper) rewardParticipants(
+//   - Split the contract allocation per unit of gas spent on the contract and
+//     cap the rewards in mint denom at 100% of the gas spent
+func (k Keeper) incentiveRewardPerGas(
 	ctx sdk.Context,
 	incentive types.Incentive,
 	coinsAllocated map[common.Address]sdk.Coins,
-) (rewards sdk.Coins, count uint64) {
+	mintDenom string,
+	rewardScaler sdk.Dec,
+) (sdk.DecCoins, sdk.DecCoins) {
 	logger := k.Logger(ctx)
+	contract := common.HexToAddress(incentive.Contract)
 
 	// Check if coin allocation was successful
-	contract := common.HexToAddress(incentive.Contract)
 	contractAllocation, ok := coinsAllocated[contract]
 	if !ok {
 		logger.Debug(
 			"contract allocation coins not found",
 			"contract", incentive.Contract,
 		)
-		return sdk.Coins{}, 0
+		return sdk.DecCoins{}, sdk.DecCoins{}
 	}
 
 	// Check if participants spent gas on interacting with incentive
@@ -185,71 +242,137 @@ func (k Keeper) rewardParticipants(
 			"no gas spent on incentive during epoch",
 			"contract", incentive.Contract,
 		)
-		return sdk.Coins{}, 0
+		return sdk.DecCoins{}, sdk.DecCoins{}
 	}
 
 	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
-	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	rewardScaler := k.GetParams(ctx).RewardScaler
+	rewardPerGas := rewardPerGas(totalGasDec, contractAllocation, mintDenom, rewardScaler)
+	return rewardPerGas, rewardPerGas.MulDecTruncate(totalGasDec)
+}
 
-	// Iterate over the incentive's gas meters and distribute rewards
-	k.IterateIncentiveGasMeters(
-		ctx,
-		contract,
-		func(gm types.GasMeter) (stop bool) {
-			// Get participant's ratio of `gas spent / total gas spent`
-			cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gm.CumulativeGas))
-			gasRatio := cumulativeGas.Quo(totalGasDec)
-			coins := sdk.Coins{}
+// updateRewardIndex adds the rewards per unit of gas allocated during the given
+// epoch to the cumulative reward index of a contract, and records it at the end
+// of the epoch. The index is recorded on every epoch, even if no rewards are
+// allocated, to settle the gas meters with the epoch during which their gas was
+// spent. The epoch gas of the contract is reset.
+func (k Keeper) updateRewardIndex(
+	ctx sdk.Context,
+	contract common.Address,
+	epoch uint64,
+	rewardPerGas sdk.DecCoins,
+) {
+	index := k.GetLatestRewardIndex(ctx, contract).Add(rewardPerGas...)
+	k.SetRewardIndex(ctx, types.NewRewardIndex(contract, epoch, index))
+	k.deleteContractEpochGas(ctx, contract)
+}
 
-			// Allocate rewards according to gasRatio
-			for _, allocation := range incentive.Allocations {
-				coinAllocated := contractAllocation.AmountOf(allocation.Denom)
-				reward := gasRatio.MulInt(coinAllocated)
-				if !reward.IsPositive() {
-					continue
-				}
+// rewardPerGas returns the rewards per unit of gas out of the rewards allocated
+// to a contract. The rewards in mint denom (i.e. aevmos) are capped to 100% of
+// the gas spent to prevent gaming.
+func rewardPerGas(
+	totalGas sdk.Dec,
+	allocation sdk.Coins,
+	mintDenom string,
+	rewardScaler sdk.Dec,
+) sdk.DecCoins {
+	rewards := sdk.DecCoins{}
+	for _, coinAllocated := range allocation {
+		reward := sdk.NewDecFromInt(coinAllocated.Amount).QuoTruncate(totalGas)
+		if mintDenom == coinAllocated.Denom {
+			reward = sdk.MinDec(reward, rewardScaler)
+		}
 
-				// Cap rewards in mint denom (i.e. aevmos) to receive only up to 100% of
-				// the participant's gas spent and prevent gaming
-				if mintDenom == allocation.Denom {
-					rewardCap := cumulativeGas.Mul(rewardScaler)
-					reward = sdk.MinDec(reward, rewardCap)
-				}
+		if !reward.IsPositive() {
+			continue
+		}
 
-				// NOTE: ignore denom validation
-				coin := sdk.Coin{Denom: allocation.Denom, Amount: reward.TruncateInt()}
-				coins = coins.Add(coin)
-			}
+		rewards = rewards.Add(sdk.NewDecCoinFromDec(coinAllocated.Denom, reward))
+	}
 
-			rewards = rewards.Add(coins...)
+	return rewards
+}
 
-			// Send rewards to participant
-			participant := common.HexToAddress(gm.Participant)
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.ModuleName,
-				sdk.AccAddress(participant.Bytes()),
-				coins,
+// distributeIncentivePrograms allocates the epoch rewards of the incentive
+// programs of a contract to its participants, and returns the allocated
+// rewards per unit of gas along with their total.
+//   - Split the epoch rewards of each program per unit of gas spent on the
+//     contract during the epoch
+//   - Cap the rewards per unit of gas so that no participant is rewarded more
+//     than the program participant cap, and the rewards in mint denom at 100%
+//     of the gas spent
+//   - Refund the remaining rewards of the programs ending with the epoch and
+//     delete them
+//
+// The rewards that aren't allocated remain escrowed by the program and are
+// distributed in the next epochs.
+func (k Keeper) distributeIncentivePrograms(
+	ctx sdk.Context,
+	programs []types.IncentiveProgram,
+	epoch uint64,
+	mintDenom string,
+	rewardScaler sdk.Dec,
+) (sdk.DecCoins, sdk.DecCoins) {
+	logger := k.Logger(ctx)
+	rewardsPerGas := sdk.DecCoins{}
+	allocated := sdk.DecCoins{}
+
+	for _, program := range programs {
+		if epoch < program.StartEpoch {
+			continue
+		}
+
+		totalGas, maxParticipantGas := k.GetContractEpochGas(ctx, common.HexToAddress(program.Contract))
+		distributed := sdk.Coins{}
+
+		if epochRewards := program.EpochRewards(epoch); !epochRewards.IsZero() && totalGas > 0 {
+			totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
+			perGas := program.CapRewardPerGas(
+				rewardPerGas(totalGasDec, epochRewards, mintDenom, rewardScaler),
+				maxParticipantGas,
 			)
-			if err != nil {
-				logger.Debug(
-					"failed to distribute incentive",
-					"address", gm.Participant,
-					"allocation", coins.String(),
-					"incentive", gm.Contract,
-					"error", err.Error(),
-				)
-				return true // break iteration
+			rewards := perGas.MulDecTruncate(totalGasDec)
+
+			// the escrow covers the decimal rewards, which are dropped on claim
+			for _, coin := range rewards {
+				distributed = distributed.Add(sdk.NewCoin(coin.Denom, coin.Amount.Ceil().TruncateInt()))
 			}
 
-			// Remove gas meter once the rewards are distributed
-			k.DeleteGasMeter(ctx, gm)
-			count++
+			rewardsPerGas = rewardsPerGas.Add(perGas...)
+			allocated = allocated.Add(rewards...)
+			program.RemainingRewards = program.RemainingRewards.Sub(distributed...)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeIncentiveProgram,
+				sdk.NewAttribute(types.AttributeKeyProgramID, strconv.FormatUint(program.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyContract, program.Contract),
+				sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, distributed.String()),
+			),
+		)
 
-			return false
-		},
-	)
+		if epoch < program.EndEpoch {
+			k.SetIncentiveProgram(ctx, program)
+			continue
+		}
+
+		if err := k.finalizeIncentiveProgram(ctx, program); err != nil {
+			logger.Error(
+				"failed to finalize incentive program",
+				"id", program.Id,
+				"error", err.Error(),
+			)
+			k.SetIncentiveProgram(ctx, program)
+			continue
+		}
+
+		logger.Info(
+			"incentive program finalized",
+			"id", program.Id,
+			"contract", program.Contract,
+		)
+	}
 
-	return rewards, count
+	return rewardsPerGas, allocated
 }
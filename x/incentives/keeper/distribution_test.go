@@ -88,9 +88,11 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 			balance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, tc.denom)
 			suite.Require().True(balance.IsPositive())
 
-			// create Gas Meter
+			// create Gas Meter with the gas spent during the ended epoch
 			gm := types.NewGasMeter(contract, participant, gasUsed)
+			gm.Epoch = 1
 			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, gm)
+			suite.app.IncentivesKeeper.SetLastEpochNumber(suite.ctx, 1)
 
 			// Set total gas meter
 			suite.app.IncentivesKeeper.SetIncentiveTotalGas(
@@ -100,14 +102,15 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 			)
 			suite.Commit()
 
-			err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+			err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx, 1)
 
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
 
-				// distributes the rewards to all participants
+				// records the rewards of the participants without sending them
 				sdkParticipant := sdk.AccAddress(participant.Bytes())
 				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
+				suite.Require().True(balance.IsZero())
 
 				gasRatio := sdk.NewDec(int64(gasUsed)).QuoInt64(int64(totalGasUsed))
 				coinAllocated := sdk.NewDec(tc.mintAmount).MulInt64(allocationRate).QuoInt64(100)
@@ -115,11 +118,29 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 				params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
 				expBalance = sdk.MinDec(expBalance, params.RewardScaler.MulInt64(int64(gasUsed)))
 
+				_, pending := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+				suite.Require().Equal(expBalance.TruncateInt(), pending.AmountOf(tc.denom), tc.name)
+
+				// the allocated rewards are reserved until claimed
+				unclaimed := suite.app.IncentivesKeeper.GetUnclaimedRewards(suite.ctx)
+				suite.Require().True(unclaimed.AmountOf(tc.denom).GTE(expBalance), tc.name)
+
+				// the participant claims the rewards
+				claimed, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant, nil)
+				suite.Require().NoError(err)
+				suite.Require().Equal(pending, claimed)
+
+				balance = suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
 				suite.Require().Equal(expBalance.TruncateInt(), balance.Amount, tc.name)
 
-				// deletes all gas meters
+				// deletes the gas meters without gas nor pending rewards
 				_, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
 				suite.Require().False(found)
+				suite.Require().Equal(
+					unclaimed.AmountOf(tc.denom).Sub(expBalance),
+					suite.app.IncentivesKeeper.GetUnclaimedRewards(suite.ctx).AmountOf(tc.denom),
+					tc.name,
+				)
 
 				// updates the remaining epochs of each incentive and sets the cumulative
 				// totalGas to zero OR deletes incentive
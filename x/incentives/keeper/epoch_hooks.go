@@ -9,11 +9,21 @@ import (
 	epochstypes "github.com/evmos/evmos/v15/x/epochs/types"
 )
 
-// BeforeEpochStart performs a no-op
-func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+// BeforeEpochStart records the number of the starting epoch as the last ended
+// epoch number, as the epochs module ends an epoch with the number of the next
+// one. The gas spent during an epoch is thus tracked with the number of its
+// end, which records the reward indexes, starting with the first epoch.
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier != k.GetParams(ctx).IncentivesEpochIdentifier {
+		return
+	}
 
-// AfterEpochEnd distributes the contract incentives at the end of each epoch
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	k.SetLastEpochNumber(ctx, uint64(epochNumber)) //#nosec G701 -- epoch numbers are positive
+}
+
+// AfterEpochEnd distributes the contract incentives and the incentive programs
+// rewards at the end of each epoch
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	params := k.GetParams(ctx)
 
 	// check if epochIdentifier signal equals the identifier in the params
@@ -21,12 +31,15 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64)
 		return
 	}
 
+	epoch := uint64(epochNumber) //#nosec G701 -- epoch numbers are positive
+	k.SetLastEpochNumber(ctx, epoch)
+
 	// check if the Incentives are globally enabled
 	if !params.EnableIncentives {
 		return
 	}
 
-	if err := k.DistributeRewards(ctx); err != nil {
+	if err := k.DistributeRewards(ctx, epoch); err != nil {
 		panic(err)
 	}
 }
@@ -17,7 +17,10 @@ import (
 	"github.com/evmos/evmos/v15/x/incentives/types"
 )
 
-var _ evmtypes.EvmHooks = Hooks{}
+var (
+	_ evmtypes.EvmHooks         = Hooks{}
+	_ evmtypes.EvmFailedTxHooks = Hooks{}
+)
 
 // PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
 // the module keeper
@@ -25,9 +28,15 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 	return h.k.PostTxProcessing(ctx, msg, receipt)
 }
 
+// PostFailedTxProcessing is a wrapper for calling the EVM PostFailedTxProcessing
+// hook on the module keeper
+func (h Hooks) PostFailedTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.PostFailedTxProcessing(ctx, msg, receipt)
+}
+
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
-// interaction with an incentivized contract, the participants's GasUsed is
-// added to its gasMeter.
+// interaction with an incentivized contract, the participants's GasUsed,
+// weighted by the incentive's weighting strategy, is added to its gasMeter.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	// check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
@@ -38,24 +47,38 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	contract := msg.To()
 	participant := msg.From()
 
-	// If theres no incentive registered for the contract, do nothing
-	if contract == nil || !k.IsIncentiveRegistered(ctx, *contract) {
+	if contract == nil {
 		return nil
 	}
 
-	// safety check: only distribute incentives to EOAs.
-	acc := k.accountKeeper.GetAccount(ctx, participant.Bytes())
-	if acc == nil {
+	// If theres no incentive or incentive program registered for the contract,
+	// do nothing
+	incentive, registered := k.GetIncentive(ctx, *contract)
+	if !registered && !k.HasIncentiveProgram(ctx, *contract) {
 		return nil
 	}
 
-	ethAccount, ok := acc.(evmostypes.EthAccountI)
-	if ok && ethAccount.Type() == evmostypes.AccountTypeContract {
+	// safety check: only distribute incentives to EOAs.
+	if !k.isEOA(ctx, participant) {
 		return nil
 	}
 
-	k.addGasToIncentive(ctx, *contract, receipt.GasUsed)
-	k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
+	gm := k.getSettledGasMeter(ctx, *contract, participant)
+	gasCredited := receipt.GasUsed
+	if registered {
+		k.setParticipantFirstSeen(ctx, incentive, participant)
+
+		if incentive.WeightingStrategy.GasPercentile > 0 {
+			k.addToGasHistogram(ctx, *contract, receipt.GasUsed)
+		}
+
+		gasCredited = k.weightGas(ctx, incentive, gm, receipt.GasUsed)
+		k.addGasToIncentive(ctx, *contract, gasCredited)
+	}
+
+	gm.CumulativeGas += gasCredited
+	k.SetGasMeter(ctx, gm)
+	k.addContractEpochGas(ctx, *contract, gasCredited, gm.CumulativeGas)
 
 	defer func() {
 		telemetry.IncrCounter(
@@ -74,6 +97,53 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	return nil
 }
 
+// PostFailedTxProcessing implements EvmFailedTxHooks.PostFailedTxProcessing.
+// The reverted interactions with an incentivized contract are counted in the
+// participant's gasMeter, when the incentive's weighting strategy discounts
+// them.
+func (k Keeper) PostFailedTxProcessing(ctx sdk.Context, msg core.Message, _ *ethtypes.Receipt) error {
+	// check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return nil
+	}
+
+	contract := msg.To()
+	participant := msg.From()
+
+	if contract == nil {
+		return nil
+	}
+
+	incentive, found := k.GetIncentive(ctx, *contract)
+	if !found || !k.isEOA(ctx, participant) {
+		return nil
+	}
+
+	k.setParticipantFirstSeen(ctx, incentive, participant)
+
+	if incentive.WeightingStrategy.GetDiscount().IsZero() {
+		return nil
+	}
+
+	gm := k.getSettledGasMeter(ctx, *contract, participant)
+	gm.RevertedTxs++
+	k.SetGasMeter(ctx, gm)
+
+	return nil
+}
+
+// isEOA returns true if the participant is an existing externally owned account
+func (k Keeper) isEOA(ctx sdk.Context, participant common.Address) bool {
+	acc := k.accountKeeper.GetAccount(ctx, participant.Bytes())
+	if acc == nil {
+		return false
+	}
+
+	ethAccount, ok := acc.(evmostypes.EthAccountI)
+	return !ok || ethAccount.Type() != evmostypes.AccountTypeContract
+}
+
 // addGasToIncentive adds gasUsed to an incentive's cumulated totalGas
 func (k Keeper) addGasToIncentive(
 	ctx sdk.Context,
@@ -86,18 +156,16 @@ func (k Keeper) addGasToIncentive(
 	k.SetIncentive(ctx, incentive)
 }
 
-// addGasToParticipant adds gasUsed to a participant's gas meter's cumulative
-// gas used
-func (k Keeper) addGasToParticipant(
+// getSettledGasMeter returns a participant's gas meter, once the rewards of the
+// gas spent during the previous epochs are settled
+func (k Keeper) getSettledGasMeter(
 	ctx sdk.Context,
 	contract, participant common.Address,
-	gasUsed uint64,
-) {
-	previousGas, found := k.GetGasMeter(ctx, contract, participant)
-	if found {
-		gasUsed += previousGas
+) types.GasMeter {
+	gm, found := k.GetGasMeter(ctx, contract, participant)
+	if !found {
+		gm = types.NewGasMeter(contract, participant, 0)
 	}
 
-	gm := types.NewGasMeter(contract, participant, gasUsed)
-	k.SetGasMeter(ctx, gm)
+	return k.settleGasMeter(ctx, gm)
 }
@@ -153,9 +153,9 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().True(found)
-				suite.Require().NotZero(gm)
+				suite.Require().NotZero(gm.CumulativeGas)
 				suite.Require().NotZero(totalGas)
-				suite.Require().Equal(expGasUsed, gm)
+				suite.Require().Equal(expGasUsed, gm.CumulativeGas)
 				suite.Require().Equal(expGasUsed, totalGas)
 			} else {
 				suite.Require().NoError(err)
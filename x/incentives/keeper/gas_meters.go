@@ -21,15 +21,8 @@ func (k Keeper) GetIncentivesGasMeters(ctx sdk.Context) []types.GasMeter {
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
-		gas := sdk.BigEndianToUint64(iterator.Value())
-
-		gm := types.GasMeter{
-			Contract:      contract.String(),
-			Participant:   userAddress.String(),
-			CumulativeGas: gas,
-		}
-
+		var gm types.GasMeter
+		k.cdc.MustUnmarshal(iterator.Value(), &gm)
 		gms = append(gms, gm)
 	}
 
@@ -67,13 +60,8 @@ func (k Keeper) IterateIncentiveGasMeters(
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
-		gas := sdk.BigEndianToUint64(iterator.Value())
-		gm := types.GasMeter{
-			Contract:      contract.String(),
-			Participant:   userAddress.String(),
-			CumulativeGas: gas,
-		}
+		var gm types.GasMeter
+		k.cdc.MustUnmarshal(iterator.Value(), &gm)
 
 		if handlerFn(gm) {
 			break
@@ -81,36 +69,93 @@ func (k Keeper) IterateIncentiveGasMeters(
 	}
 }
 
-// GetGasMeter - get cumulativeGas from gas meter
+// GetParticipantContracts returns the contracts of the gas meters of a
+// participant
+func (k Keeper) GetParticipantContracts(ctx sdk.Context, participant common.Address) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeterByParticipant)
+
+	iterator := sdk.KVStorePrefixIterator(store, participant.Bytes())
+	defer iterator.Close()
+
+	contracts := []common.Address{}
+	for ; iterator.Valid(); iterator.Next() {
+		_, contract := types.SplitGasMeterKey(iterator.Key())
+		contracts = append(contracts, contract)
+	}
+
+	return contracts
+}
+
+// GetGasMeter - get the gas meter of a participant
 func (k Keeper) GetGasMeter(
 	ctx sdk.Context,
 	contract, participant common.Address,
-) (uint64, bool) {
+) (types.GasMeter, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	key := append(contract.Bytes(), participant.Bytes()...)
 
 	bz := store.Get(key)
 	if len(bz) == 0 {
-		return 0, false
+		return types.GasMeter{}, false
 	}
 
-	return sdk.BigEndianToUint64(bz), true
+	var gm types.GasMeter
+	k.cdc.MustUnmarshal(bz, &gm)
+	return gm, true
 }
 
-// SetGasMeter stores a gasMeter
+// SetGasMeter stores a gasMeter and indexes it by participant
 func (k Keeper) SetGasMeter(ctx sdk.Context, gm types.GasMeter) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	contract := common.HexToAddress(gm.Contract)
 	participant := common.HexToAddress(gm.Participant)
 	key := append(contract.Bytes(), participant.Bytes()...)
-	store.Set(key, sdk.Uint64ToBigEndian(gm.CumulativeGas))
+	store.Set(key, k.cdc.MustMarshal(&gm))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeterByParticipant)
+	indexStore.Set(append(participant.Bytes(), contract.Bytes()...), []byte{1})
 }
 
-// DeleteGasMeter removes a gasMeter.
+// DeleteGasMeter removes a gasMeter and its participant index.
 func (k Keeper) DeleteGasMeter(ctx sdk.Context, gm types.GasMeter) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	contract := common.HexToAddress(gm.Contract)
 	participant := common.HexToAddress(gm.Participant)
 	key := append(contract.Bytes(), participant.Bytes()...)
 	store.Delete(key)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeterByParticipant)
+	indexStore.Delete(append(participant.Bytes(), contract.Bytes()...))
+}
+
+// GetContractEpochGas returns the total gas credited to the participants of a
+// contract during the current epoch, and the highest gas credited to one of
+// them
+func (k Keeper) GetContractEpochGas(ctx sdk.Context, contract common.Address) (total, maxParticipantGas uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractEpochGas)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return 0, 0
+	}
+
+	return sdk.BigEndianToUint64(bz[:8]), sdk.BigEndianToUint64(bz[8:])
+}
+
+// addContractEpochGas adds the gas credited to a participant to the epoch gas
+// of a contract, given the gas credited to the participant during the epoch
+func (k Keeper) addContractEpochGas(ctx sdk.Context, contract common.Address, credited, participantGas uint64) {
+	total, maxParticipantGas := k.GetContractEpochGas(ctx, contract)
+	total += credited
+	if participantGas > maxParticipantGas {
+		maxParticipantGas = participantGas
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractEpochGas)
+	store.Set(contract.Bytes(), append(sdk.Uint64ToBigEndian(total), sdk.Uint64ToBigEndian(maxParticipantGas)...))
+}
+
+// deleteContractEpochGas resets the epoch gas of a contract
+func (k Keeper) deleteContractEpochGas(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractEpochGas)
+	store.Delete(contract.Bytes())
 }
@@ -132,7 +132,7 @@ func (suite *KeeperTestSuite) GetGasMeter() {
 		)
 		if tc.ok {
 			suite.Require().True(found, tc.name)
-			suite.Require().Equal(expGm.CumulativeGas, gm, tc.name)
+			suite.Require().Equal(expGm.CumulativeGas, gm.CumulativeGas, tc.name)
 		} else {
 			suite.Require().False(found, tc.name)
 		}
@@ -171,7 +171,7 @@ func (suite *KeeperTestSuite) TestDeleteGasMeter() {
 		)
 		if tc.ok {
 			suite.Require().True(found, tc.name)
-			suite.Require().Equal(regGm.CumulativeGas, gm, tc.name)
+			suite.Require().Equal(regGm.CumulativeGas, gm.CumulativeGas, tc.name)
 		} else {
 			suite.Require().False(found, tc.name)
 		}
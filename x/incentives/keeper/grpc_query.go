@@ -129,14 +129,10 @@ func (k Keeper) GasMeters(
 	pageRes, err := query.Paginate(
 		store,
 		req.Pagination,
-		func(key, value []byte) error {
-			participant := common.BytesToAddress(key)
-			cumulativeGas := sdk.BigEndianToUint64(value)
-
-			gm := types.GasMeter{
-				Contract:      req.Contract,
-				Participant:   participant.Hex(),
-				CumulativeGas: cumulativeGas,
+		func(_, value []byte) error {
+			var gm types.GasMeter
+			if err := k.cdc.Unmarshal(value, &gm); err != nil {
+				return err
 			}
 
 			gms = append(gms, gm)
@@ -209,7 +205,9 @@ func (k Keeper) GasMeter(
 		)
 	}
 
-	return &types.QueryGasMeterResponse{GasMeter: gm}, nil
+	// only the gas spent during the current epoch is returned
+	gm = k.settleGasMeter(ctx, gm)
+	return &types.QueryGasMeterResponse{GasMeter: gm.CumulativeGas}, nil
 }
 
 // AllocationMeters return registered allocation meters
@@ -273,6 +271,90 @@ func (k Keeper) AllocationMeter(
 	return &types.QueryAllocationMeterResponse{AllocationMeter: allocationMeter}, nil
 }
 
+// IncentivePrograms returns the incentive programs
+func (k Keeper) IncentivePrograms(
+	c context.Context,
+	req *types.QueryIncentiveProgramsRequest,
+) (*types.QueryIncentiveProgramsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var programs []types.IncentiveProgram
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgram)
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var program types.IncentiveProgram
+			if err := k.cdc.Unmarshal(value, &program); err != nil {
+				return err
+			}
+			programs = append(programs, program)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryIncentiveProgramsResponse{
+		IncentivePrograms: programs,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// IncentiveProgram returns a given incentive program
+func (k Keeper) IncentiveProgram(
+	c context.Context,
+	req *types.QueryIncentiveProgramRequest,
+) (*types.QueryIncentiveProgramResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	program, found := k.GetIncentiveProgram(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"incentive program with id '%d'",
+			req.Id,
+		)
+	}
+
+	return &types.QueryIncentiveProgramResponse{IncentiveProgram: program}, nil
+}
+
+// PendingRewards returns the rewards of a participant that can be claimed
+func (k Keeper) PendingRewards(
+	c context.Context,
+	req *types.QueryPendingRewardsRequest,
+) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// check if the participant is a hex address
+	if err := evmostypes.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid participant address %s", req.Participant).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards, total := k.GetPendingRewards(ctx, common.HexToAddress(req.Participant))
+
+	return &types.QueryPendingRewardsResponse{
+		Rewards: rewards,
+		Total:   total,
+	}, nil
+}
+
 // Params return hub contract param
 func (k Keeper) Params(
 	c context.Context,
@@ -301,6 +301,7 @@ func (suite *KeeperTestSuite) TestGasMeter() {
 			"gas meter found",
 			func() {
 				gm := types.NewGasMeter(contract, participant, 1)
+				gm.Epoch = suite.app.IncentivesKeeper.GetLastEpochNumber(suite.ctx) + 1
 				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, gm)
 				suite.Commit()
 
@@ -0,0 +1,310 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// RegisterIncentiveProgram creates a self-funded incentive program for a
+// contract, escrowing the program rewards from the creator account. The
+// creation fee is burned and the number of programs of a contract is capped,
+// as every program is distributed at the end of each epoch.
+func (k Keeper) RegisterIncentiveProgram(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	contract common.Address,
+	amount, participantCap sdk.Coins,
+	startEpoch, endEpoch uint64,
+) (*types.IncentiveProgram, error) {
+	params := k.GetParams(ctx)
+
+	// Check if the Incentives are globally enabled
+	if !params.EnableIncentives {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	// Check if contract exists
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"contract doesn't exist: %s", contract,
+		)
+	}
+
+	// Check that the program doesn't start in an epoch that already ended
+	if lastEpoch := k.GetLastEpochNumber(ctx); startEpoch <= lastEpoch {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"start epoch %d must be after the last ended epoch %d", startEpoch, lastEpoch,
+		)
+	}
+
+	// Check that the contract doesn't have too many programs
+	if count := k.countIncentivePrograms(ctx, contract); count >= params.MaxIncentiveProgramsPerContract {
+		return nil, errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"contract %s already has %d incentive programs, the maximum is %d",
+			contract, count, params.MaxIncentiveProgramsPerContract,
+		)
+	}
+
+	if err := k.burnCreationFee(ctx, creator, params.IncentiveProgramCreationFee); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, amount); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to escrow the incentive program rewards")
+	}
+
+	id := k.GetNextIncentiveProgramID(ctx)
+	k.SetNextIncentiveProgramID(ctx, id+1)
+
+	program := types.NewIncentiveProgram(id, creator, contract, amount, participantCap, startEpoch, endEpoch)
+	k.SetIncentiveProgram(ctx, program)
+
+	return &program, nil
+}
+
+// burnCreationFee burns the incentive program creation fee from the creator
+// account. An empty fee isn't charged.
+func (k Keeper) burnCreationFee(ctx sdk.Context, creator sdk.AccAddress, fee sdk.Coin) error {
+	if fee.Amount.IsNil() || fee.IsZero() {
+		return nil
+	}
+
+	fees := sdk.Coins{fee}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, fees); err != nil {
+		return errorsmod.Wrap(err, "failed to pay the incentive program creation fee")
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees); err != nil {
+		return errorsmod.Wrap(err, "failed to burn the incentive program creation fee")
+	}
+
+	return nil
+}
+
+// RefundIncentiveProgram cancels an incentive program and refunds its remaining
+// rewards to the creator.
+func (k Keeper) RefundIncentiveProgram(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	id uint64,
+) (sdk.Coins, error) {
+	program, found := k.GetIncentiveProgram(ctx, id)
+	if !found {
+		return nil, errorsmod.Wrapf(errortypes.ErrNotFound, "incentive program %d", id)
+	}
+
+	if program.Creator != creator.String() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"%s is not the creator of incentive program %d", creator, id,
+		)
+	}
+
+	if err := k.finalizeIncentiveProgram(ctx, program); err != nil {
+		return nil, err
+	}
+
+	// Prune the contract gas meters if they aren't used by other incentives
+	contract := common.HexToAddress(program.Contract)
+	if !k.IsIncentiveRegistered(ctx, contract) && !k.HasIncentiveProgram(ctx, contract) {
+		k.pruneGasMeters(ctx, contract)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelIncentiveProgram,
+			sdk.NewAttribute(types.AttributeKeyProgramID, strconv.FormatUint(program.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, program.Contract),
+			sdk.NewAttribute(types.AttributeKeyCreator, program.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, program.RemainingRewards.String()),
+		),
+	)
+
+	return program.RemainingRewards, nil
+}
+
+// finalizeIncentiveProgram refunds the remaining rewards of an incentive
+// program to its creator and deletes it.
+func (k Keeper) finalizeIncentiveProgram(ctx sdk.Context, program types.IncentiveProgram) error {
+	if !program.RemainingRewards.IsZero() {
+		creator := sdk.MustAccAddressFromBech32(program.Creator)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, program.RemainingRewards); err != nil {
+			return errorsmod.Wrapf(err, "failed to refund incentive program %d", program.Id)
+		}
+	}
+
+	k.DeleteIncentiveProgram(ctx, program)
+	return nil
+}
+
+// GetAllIncentivePrograms - get all the incentive programs
+func (k Keeper) GetAllIncentivePrograms(ctx sdk.Context) []types.IncentiveProgram {
+	programs := []types.IncentiveProgram{}
+
+	k.IterateIncentivePrograms(ctx, func(program types.IncentiveProgram) (stop bool) {
+		programs = append(programs, program)
+		return false
+	})
+
+	return programs
+}
+
+// IterateIncentivePrograms iterates over all the incentive programs, by
+// identifier, and performs a callback.
+func (k Keeper) IterateIncentivePrograms(
+	ctx sdk.Context,
+	handlerFn func(program types.IncentiveProgram) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixIncentiveProgram)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var program types.IncentiveProgram
+		k.cdc.MustUnmarshal(iterator.Value(), &program)
+
+		if handlerFn(program) {
+			break
+		}
+	}
+}
+
+// GetIncentiveProgram - get an incentive program from its identifier
+func (k Keeper) GetIncentiveProgram(ctx sdk.Context, id uint64) (types.IncentiveProgram, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgram)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if len(bz) == 0 {
+		return types.IncentiveProgram{}, false
+	}
+
+	var program types.IncentiveProgram
+	k.cdc.MustUnmarshal(bz, &program)
+	return program, true
+}
+
+// SetIncentiveProgram stores an incentive program and indexes it by contract
+func (k Keeper) SetIncentiveProgram(ctx sdk.Context, program types.IncentiveProgram) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgram)
+	bz := k.cdc.MustMarshal(&program)
+	store.Set(sdk.Uint64ToBigEndian(program.Id), bz)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgramByContract)
+	indexStore.Set(incentiveProgramIndexKey(program), []byte{1})
+}
+
+// DeleteIncentiveProgram removes an incentive program and its contract index
+func (k Keeper) DeleteIncentiveProgram(ctx sdk.Context, program types.IncentiveProgram) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgram)
+	store.Delete(sdk.Uint64ToBigEndian(program.Id))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgramByContract)
+	indexStore.Delete(incentiveProgramIndexKey(program))
+}
+
+// HasIncentiveProgram returns true if a contract has at least one incentive
+// program
+func (k Keeper) HasIncentiveProgram(ctx sdk.Context, contract common.Address) bool {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgramByContract)
+	iterator := sdk.KVStorePrefixIterator(indexStore, contract.Bytes())
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// countIncentivePrograms returns the number of incentive programs of a
+// contract
+func (k Keeper) countIncentivePrograms(ctx sdk.Context, contract common.Address) uint32 {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentiveProgramByContract)
+	iterator := sdk.KVStorePrefixIterator(indexStore, contract.Bytes())
+	defer iterator.Close()
+
+	count := uint32(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// incentiveProgramIndexKey returns the `<contract_address>|<id>` key of an
+// incentive program in the contract index
+func incentiveProgramIndexKey(program types.IncentiveProgram) []byte {
+	contract := common.HexToAddress(program.Contract)
+	return append(contract.Bytes(), sdk.Uint64ToBigEndian(program.Id)...)
+}
+
+// GetNextIncentiveProgramID returns the identifier of the next incentive
+// program. The identifiers start at 1.
+func (k Keeper) GetNextIncentiveProgramID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyIncentiveProgramID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextIncentiveProgramID sets the identifier of the next incentive program
+func (k Keeper) SetNextIncentiveProgramID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyIncentiveProgramID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetLastEpochNumber returns the number of the last ended epoch of the
+// incentives epoch identifier
+func (k Keeper) GetLastEpochNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyLastEpochNumber)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEpochNumber sets the number of the last ended epoch of the incentives
+// epoch identifier
+func (k Keeper) SetLastEpochNumber(ctx sdk.Context, epoch uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyLastEpochNumber, sdk.Uint64ToBigEndian(epoch))
+}
+
+// incentiveProgramsByContract returns the incentive programs grouped by
+// contract, along with the contracts in the order of their first program.
+func (k Keeper) incentiveProgramsByContract(ctx sdk.Context) (map[common.Address][]types.IncentiveProgram, []common.Address) {
+	programs := make(map[common.Address][]types.IncentiveProgram)
+	contracts := []common.Address{}
+
+	k.IterateIncentivePrograms(ctx, func(program types.IncentiveProgram) (stop bool) {
+		contract := common.HexToAddress(program.Contract)
+		if _, found := programs[contract]; !found {
+			contracts = append(contracts, contract)
+		}
+		programs[contract] = append(programs[contract], program)
+		return false
+	})
+
+	return programs, contracts
+}
+
+// incentiveProgramsEscrow returns the total rewards escrowed by the incentive
+// programs, which can't be allocated to the governance incentives.
+func (k Keeper) incentiveProgramsEscrow(ctx sdk.Context) sdk.Coins {
+	escrow := sdk.Coins{}
+	k.IterateIncentivePrograms(ctx, func(program types.IncentiveProgram) (stop bool) {
+		escrow = escrow.Add(program.RemainingRewards...)
+		return false
+	})
+	return escrow
+}
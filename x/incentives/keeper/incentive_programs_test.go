@@ -0,0 +1,278 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// fundCreator funds the creator of an incentive program with the given rewards
+// and the program creation fee
+func (suite *KeeperTestSuite) fundCreator(creator sdk.AccAddress, amount sdk.Coins) {
+	fee := suite.app.IncentivesKeeper.GetParams(suite.ctx).IncentiveProgramCreationFee
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, creator, amount.Add(fee))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestRegisterIncentiveProgram() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		contract   func() common.Address
+		startEpoch uint64
+		expPass    bool
+	}{
+		{
+			"fail - incentives are disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+			},
+			func() common.Address { return contract },
+			2,
+			false,
+		},
+		{
+			"fail - contract doesn't exist",
+			func() {},
+			func() common.Address { return utiltx.GenerateAddress() },
+			2,
+			false,
+		},
+		{
+			"fail - start epoch already ended",
+			func() {
+				suite.app.IncentivesKeeper.SetLastEpochNumber(suite.ctx, 5)
+			},
+			func() common.Address { return contract },
+			5,
+			false,
+		},
+		{
+			"fail - max incentive programs of the contract reached",
+			func() {
+				params := types.DefaultParams()
+				params.MaxIncentiveProgramsPerContract = 1
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+
+				creator2 := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+				suite.fundCreator(creator2, amount)
+				_, err := suite.app.IncentivesKeeper.RegisterIncentiveProgram(suite.ctx, creator2, contract, amount, nil, 2, 3)
+				suite.Require().NoError(err)
+			},
+			func() common.Address { return contract },
+			2,
+			false,
+		},
+		{
+			"fail - creation fee not paid",
+			func() {
+				params := types.DefaultParams()
+				params.IncentiveProgramCreationFee.Amount = params.IncentiveProgramCreationFee.Amount.MulRaw(2)
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+			},
+			func() common.Address { return contract },
+			2,
+			false,
+		},
+		{
+			"fail - insufficient creator balance",
+			func() {
+				amount = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 2000))
+			},
+			func() common.Address { return contract },
+			2,
+			false,
+		},
+		{
+			"ok",
+			func() {},
+			func() common.Address { return contract },
+			2,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+			amount = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+			suite.fundCreator(creator, amount)
+
+			tc.malleate()
+
+			program, err := suite.app.IncentivesKeeper.RegisterIncentiveProgram(
+				suite.ctx, creator, tc.contract(), amount, nil, tc.startEpoch, tc.startEpoch+1,
+			)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Nil(program)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), program.Id)
+			suite.Require().Equal(uint64(2), suite.app.IncentivesKeeper.GetNextIncentiveProgramID(suite.ctx))
+			suite.Require().True(suite.app.IncentivesKeeper.HasIncentiveProgram(suite.ctx, contract))
+
+			stored, found := suite.app.IncentivesKeeper.GetIncentiveProgram(suite.ctx, program.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(*program, stored)
+
+			// the rewards are escrowed and the creation fee is burned
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, creator).IsZero())
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundIncentiveProgram() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	testCases := []struct {
+		name    string
+		sender  sdk.AccAddress
+		id      uint64
+		expPass bool
+	}{
+		{"fail - program doesn't exist", creator, 2, false},
+		{"fail - sender is not the creator", sdk.AccAddress(utiltx.GenerateAddress().Bytes()), 1, false},
+		{"ok", creator, 1, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.deployContracts()
+
+			suite.fundCreator(creator, amount)
+			_, err := suite.app.IncentivesKeeper.RegisterIncentiveProgram(suite.ctx, creator, contract, amount, nil, 2, 3)
+			suite.Require().NoError(err)
+
+			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+
+			refund, err := suite.app.IncentivesKeeper.RefundIncentiveProgram(suite.ctx, tc.sender, tc.id)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().True(suite.app.IncentivesKeeper.HasIncentiveProgram(suite.ctx, contract))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(amount, refund)
+			suite.Require().Equal(amount, suite.app.BankKeeper.GetAllBalances(suite.ctx, creator))
+
+			_, found := suite.app.IncentivesKeeper.GetIncentiveProgram(suite.ctx, tc.id)
+			suite.Require().False(found)
+			suite.Require().False(suite.app.IncentivesKeeper.HasIncentiveProgram(suite.ctx, contract))
+
+			// the gas meters of the contract are deleted
+			_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentivePrograms() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1001))
+	participantCap := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 300))
+
+	suite.fundCreator(creator, amount)
+	program, err := suite.app.IncentivesKeeper.RegisterIncentiveProgram(suite.ctx, creator, contract, amount, participantCap, 3, 4)
+	suite.Require().NoError(err)
+
+	pending := func(participant common.Address) int64 {
+		_, rewards := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+		return rewards.AmountOf(denomCoin).Int64()
+	}
+	// the participants spend gas on the contract and the epoch ends
+	spendGasAndEndEpoch := func(epoch uint64) {
+		suite.spendGas(participant, 300)
+		suite.spendGas(participant2, 100)
+		suite.endEpoch(epoch)
+	}
+
+	// the program hasn't started
+	spendGasAndEndEpoch(2)
+	suite.Require().Zero(pending(participant))
+	suite.Require().Zero(pending(participant2))
+
+	// half of the rewards are split by gas, with the rewards per gas capped so
+	// that the participant with the most gas is rewarded the participant cap
+	spendGasAndEndEpoch(3)
+	suite.Require().Equal(int64(300), pending(participant))
+	suite.Require().Equal(int64(100), pending(participant2))
+
+	stored, found := suite.app.IncentivesKeeper.GetIncentiveProgram(suite.ctx, program.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(601), stored.RemainingRewards.AmountOf(denomCoin).Int64())
+
+	// the last epoch distributes the remaining rewards and refunds the creator
+	spendGasAndEndEpoch(4)
+	suite.Require().Equal(int64(600), pending(participant))
+	suite.Require().Equal(int64(200), pending(participant2))
+	suite.Require().Equal(int64(201), suite.app.BankKeeper.GetBalance(suite.ctx, creator, denomCoin).Amount.Int64())
+
+	_, found = suite.app.IncentivesKeeper.GetIncentiveProgram(suite.ctx, program.Id)
+	suite.Require().False(found)
+
+	// the gas meters are kept until the rewards are claimed
+	for _, p := range []common.Address{participant, participant2} {
+		_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, p, nil)
+		suite.Require().NoError(err)
+		_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, p)
+		suite.Require().False(found)
+	}
+
+	suite.Require().Equal(int64(600), suite.app.BankKeeper.GetBalance(suite.ctx, participant.Bytes(), denomCoin).Amount.Int64())
+	suite.Require().Equal(int64(200), suite.app.BankKeeper.GetBalance(suite.ctx, participant2.Bytes(), denomCoin).Amount.Int64())
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).IsZero())
+	suite.Require().True(suite.app.IncentivesKeeper.GetUnclaimedRewards(suite.ctx).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestRewardAllocationsExcludeIncentivePrograms() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	suite.fundCreator(creator, amount)
+	_, err := suite.app.IncentivesKeeper.RegisterIncentiveProgram(suite.ctx, creator, contract, amount, nil, 2, 3)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract2, allocations, epochs)
+	suite.Require().NoError(err)
+
+	gm := types.NewGasMeter(contract2, participant, 100)
+	gm.Epoch = 2
+	suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, gm)
+	suite.app.IncentivesKeeper.SetLastEpochNumber(suite.ctx, 2)
+	regIn, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract2)
+	suite.Require().True(found)
+	suite.app.IncentivesKeeper.SetIncentiveTotalGas(suite.ctx, regIn, 100)
+
+	// the module only holds the program escrow, so there is nothing to allocate
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx, 2)
+	suite.Require().NoError(err)
+
+	_, pending := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+	suite.Require().True(pending.IsZero())
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(amount.AmountOf(denomCoin), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, denomCoin).Amount)
+}
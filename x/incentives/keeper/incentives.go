@@ -79,6 +79,8 @@ func (k Keeper) DeleteIncentiveAndUpdateAllocationMeters(ctx sdk.Context, incent
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentive)
 	key := common.HexToAddress(incentive.Contract)
 	store.Delete(key.Bytes())
+	k.deleteGasHistogram(ctx, key)
+	k.deleteParticipantsFirstSeen(ctx, key)
 
 	// Subtract allocations from allocation meters
 	for _, al := range incentive.Allocations {
@@ -124,7 +124,7 @@ var _ = Describe("Distribution", Ordered, func() {
 			})
 			It("should not reset the participants gas meter", func() {
 				gm, _ := s.app.IncentivesKeeper.GetGasMeter(s.ctx, contractAddr, s.address)
-				Expect(gm).ToNot(BeZero())
+				Expect(gm.CumulativeGas).ToNot(BeZero())
 			})
 			It("should not distribute usage incentives to the participant", func() {
 				actual := s.app.BankKeeper.GetBalance(s.ctx, participantAcc, denomMint)
@@ -142,12 +142,27 @@ var _ = Describe("Distribution", Ordered, func() {
 				Expect(balance.IsZero()).ToNot(BeTrue())
 			})
 			It("should reset the participant gas meter", func() {
-				gm, _ := s.app.IncentivesKeeper.GetGasMeter(s.ctx, contractAddr, s.address)
-				Expect(gm).To(BeZero())
+				res, err := s.app.IncentivesKeeper.GasMeter(
+					sdk.WrapSDKContext(s.ctx),
+					&types.QueryGasMeterRequest{Contract: contractAddr.Hex(), Participant: s.address.Hex()},
+				)
+				Expect(err).To(BeNil())
+				Expect(res.GasMeter).To(BeZero())
+			})
+			It("should record the usage incentives of the participant", func() {
+				_, pending := s.app.IncentivesKeeper.GetPendingRewards(s.ctx, s.address)
+				Expect(pending.AmountOf(denomMint).IsPositive()).To(BeTrue())
+
+				actual := s.app.BankKeeper.GetBalance(s.ctx, participantAcc, denomMint)
+				Expect(actual).To(Equal(balanceBefore))
 			})
-			It("should distribute usage incentives to the participant", func() {
+			It("should distribute usage incentives to the participant once claimed", func() {
+				claimed, err := s.app.IncentivesKeeper.ClaimRewards(s.ctx, s.address, []common.Address{contractAddr})
+				Expect(err).To(BeNil())
+				Expect(claimed.AmountOf(denomMint).IsPositive()).To(BeTrue())
+
 				actual := s.app.BankKeeper.GetBalance(s.ctx, participantAcc, denomMint)
-				Expect(actual).ToNot(Equal(balanceBefore))
+				Expect(actual.Amount).To(Equal(balanceBefore.Amount.Add(claimed.AmountOf(denomMint))))
 			})
 		})
 	})
@@ -5,10 +5,13 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/evmos/evmos/v15/x/incentives/types"
 )
 
@@ -28,3 +31,106 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// CreateIncentiveProgram implements the gRPC MsgServer interface. It creates a
+// self-funded incentive program for a contract, escrowing the program rewards
+// from the creator account.
+func (k *Keeper) CreateIncentiveProgram(
+	goCtx context.Context,
+	msg *types.MsgCreateIncentiveProgram,
+) (*types.MsgCreateIncentiveProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	program, err := k.RegisterIncentiveProgram(
+		ctx,
+		creator,
+		common.HexToAddress(msg.Contract),
+		msg.Amount,
+		msg.ParticipantCap,
+		msg.StartEpoch,
+		msg.EndEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateIncentiveProgram,
+			sdk.NewAttribute(types.AttributeKeyProgramID, strconv.FormatUint(program.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyContract, program.Contract),
+			sdk.NewAttribute(types.AttributeKeyCreator, program.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgCreateIncentiveProgramResponse{Id: program.Id}, nil
+}
+
+// CancelIncentiveProgram implements the gRPC MsgServer interface. It cancels an
+// incentive program and refunds its remaining rewards to the creator.
+func (k *Keeper) CancelIncentiveProgram(
+	goCtx context.Context,
+	msg *types.MsgCancelIncentiveProgram,
+) (*types.MsgCancelIncentiveProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator := sdk.MustAccAddressFromBech32(msg.Creator)
+	refund, err := k.RefundIncentiveProgram(ctx, creator, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelIncentiveProgramResponse{Refund: refund}, nil
+}
+
+// ClaimIncentiveRewards implements the gRPC MsgServer interface. It sends the
+// pending rewards of a participant of the incentivized contracts.
+func (k *Keeper) ClaimIncentiveRewards(
+	goCtx context.Context,
+	msg *types.MsgClaimIncentiveRewards,
+) (*types.MsgClaimIncentiveRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	participant := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Participant))
+	contracts := make([]common.Address, len(msg.Contracts))
+	for i, contract := range msg.Contracts {
+		contracts[i] = common.HexToAddress(contract)
+	}
+
+	amount, err := k.ClaimRewards(ctx, participant, contracts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimIncentiveRewards,
+			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgClaimIncentiveRewardsResponse{Amount: amount}, nil
+}
+
+// UpdateWeightingStrategy implements the gRPC MsgServer interface. When an
+// UpdateWeightingStrategy proposal passes, it updates the weighting strategy
+// of an incentive. The update can only be performed if the requested authority
+// is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateWeightingStrategy(
+	goCtx context.Context,
+	req *types.MsgUpdateWeightingStrategy,
+) (*types.MsgUpdateWeightingStrategyResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.SetWeightingStrategy(ctx, common.HexToAddress(req.Contract), req.WeightingStrategy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateWeightingStrategyResponse{}, nil
+}
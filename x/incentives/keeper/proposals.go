@@ -124,10 +124,10 @@ func (k Keeper) CancelIncentive(
 
 	k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
 
-	// Delete incentive's gas meters
-	gms := k.GetIncentiveGasMeters(ctx, contract)
-	for _, gm := range gms {
-		k.DeleteGasMeter(ctx, gm)
+	// Prune the incentive's gas meters, unless they are used by incentive
+	// programs. The gas meters with pending rewards are kept until claimed.
+	if !k.HasIncentiveProgram(ctx, contract) {
+		k.pruneGasMeters(ctx, contract)
 	}
 
 	return nil
@@ -137,6 +137,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() { //nolint:govet // we can
 				Allocations: allocations,
 				Epochs:      epochs,
 				StartTime:   suite.ctx.BlockTime(),
+
+				WeightingStrategy: types.DefaultWeightingStrategy(),
 			}
 
 			allocationMeters := suite.app.IncentivesKeeper.GetAllAllocationMeters(suite.ctx)
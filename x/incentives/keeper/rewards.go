@@ -0,0 +1,276 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// ClaimRewards sends the pending rewards of a participant of the given
+// contracts, or of all the contracts of the participant if none is given, and
+// returns the claimed rewards.
+func (k Keeper) ClaimRewards(
+	ctx sdk.Context,
+	participant common.Address,
+	contracts []common.Address,
+) (sdk.Coins, error) {
+	if len(contracts) == 0 {
+		contracts = k.GetParticipantContracts(ctx, participant)
+	}
+
+	pending := sdk.DecCoins{}
+	claimed := sdk.Coins{}
+
+	for _, contract := range contracts {
+		gm, found := k.GetGasMeter(ctx, contract, participant)
+		if !found {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrNotFound,
+				"no rewards for participant %s of contract %s", participant, contract,
+			)
+		}
+
+		gm = k.settleGasMeter(ctx, gm)
+
+		// the decimal rewards are dropped and can be allocated again
+		coins, _ := gm.PendingRewards.TruncateDecimal()
+		pending = pending.Add(gm.PendingRewards...)
+		claimed = claimed.Add(coins...)
+
+		gm.PendingRewards = sdk.DecCoins{}
+		if gm.CumulativeGas == 0 {
+			k.DeleteGasMeter(ctx, gm)
+		} else {
+			k.SetGasMeter(ctx, gm)
+		}
+	}
+
+	if !claimed.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, participant.Bytes(), claimed); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to send the claimed rewards")
+		}
+	}
+
+	k.subUnclaimedRewards(ctx, pending)
+
+	return claimed, nil
+}
+
+// GetPendingRewards returns the rewards of a participant that can be claimed,
+// for each contract with pending rewards, along with their total.
+func (k Keeper) GetPendingRewards(
+	ctx sdk.Context,
+	participant common.Address,
+) ([]types.ContractRewards, sdk.Coins) {
+	rewards := []types.ContractRewards{}
+	total := sdk.Coins{}
+
+	for _, contract := range k.GetParticipantContracts(ctx, participant) {
+		gm, found := k.GetGasMeter(ctx, contract, participant)
+		if !found {
+			continue
+		}
+
+		coins, _ := k.settleGasMeter(ctx, gm).PendingRewards.TruncateDecimal()
+		if coins.IsZero() {
+			continue
+		}
+
+		rewards = append(rewards, types.ContractRewards{
+			Contract: gm.Contract,
+			Rewards:  coins,
+		})
+		total = total.Add(coins...)
+	}
+
+	return rewards, total
+}
+
+// settleGasMeter returns the gas meter with the rewards of the gas spent during
+// an ended epoch added to its pending rewards, and with its cumulative gas
+// reset for the current epoch. The gas meter is returned unchanged if its gas
+// was spent during the current epoch.
+func (k Keeper) settleGasMeter(ctx sdk.Context, gm types.GasMeter) types.GasMeter {
+	epoch := k.currentEpoch(ctx)
+	if gm.Epoch >= epoch {
+		return gm
+	}
+
+	contract := common.HexToAddress(gm.Contract)
+	if gm.CumulativeGas > 0 {
+		// the gas is only rewarded with the index recorded at the end of its
+		// epoch, and is dropped when the contract wasn't incentivized at the
+		// end of the epoch or the incentives were disabled
+		if index, found := k.GetRewardIndex(ctx, contract, gm.Epoch); found {
+			gas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gm.CumulativeGas))
+			rewardPerGas := index.Sub(gm.RewardIndex)
+			gm.PendingRewards = gm.PendingRewards.Add(rewardPerGas.MulDecTruncate(gas)...)
+		}
+	}
+
+	gm.CumulativeGas = 0
+	gm.RevertedTxs = 0
+	gm.Epoch = epoch
+	gm.RewardIndex = k.GetLatestRewardIndex(ctx, contract)
+	return gm
+}
+
+// pruneGasMeters settles the gas meters of a contract and deletes the ones
+// without pending rewards. The gas spent during the current epoch is dropped,
+// so it must only be called once the contract isn't rewarded anymore for the
+// current epoch.
+func (k Keeper) pruneGasMeters(ctx sdk.Context, contract common.Address) {
+	k.deleteContractEpochGas(ctx, contract)
+
+	for _, gm := range k.GetIncentiveGasMeters(ctx, contract) {
+		gm = k.settleGasMeter(ctx, gm)
+		gm.CumulativeGas = 0
+
+		if gm.PendingRewards.IsZero() {
+			k.DeleteGasMeter(ctx, gm)
+		} else {
+			k.SetGasMeter(ctx, gm)
+		}
+	}
+}
+
+// GetEVMDenom returns the EVM denomination, which is the denomination of the
+// rewards minted for the incentives
+func (k Keeper) GetEVMDenom(ctx sdk.Context) string {
+	return k.evmKeeper.GetParams(ctx).EvmDenom
+}
+
+// currentEpoch returns the number of the current epoch of the incentives
+// epoch identifier
+func (k Keeper) currentEpoch(ctx sdk.Context) uint64 {
+	return k.GetLastEpochNumber(ctx) + 1
+}
+
+// GetRewardIndex returns the reward index of a contract recorded at the end of
+// the given epoch
+func (k Keeper) GetRewardIndex(
+	ctx sdk.Context,
+	contract common.Address,
+	epoch uint64,
+) (sdk.DecCoins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndex)
+	bz := store.Get(rewardIndexKey(contract, epoch))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var ri types.RewardIndex
+	k.cdc.MustUnmarshal(bz, &ri)
+	return ri.Index, true
+}
+
+// GetLatestRewardIndex returns the last reward index recorded for a contract,
+// which is empty if no rewards were allocated to the contract participants yet
+func (k Keeper) GetLatestRewardIndex(ctx sdk.Context, contract common.Address) sdk.DecCoins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndex)
+	iterator := sdk.KVStoreReversePrefixIterator(store, contract.Bytes())
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return sdk.DecCoins{}
+	}
+
+	var ri types.RewardIndex
+	k.cdc.MustUnmarshal(iterator.Value(), &ri)
+	return ri.Index
+}
+
+// SetRewardIndex stores the reward index of a contract at the end of an epoch
+func (k Keeper) SetRewardIndex(ctx sdk.Context, ri types.RewardIndex) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardIndex)
+	bz := k.cdc.MustMarshal(&ri)
+	store.Set(rewardIndexKey(common.HexToAddress(ri.Contract), ri.Epoch), bz)
+}
+
+// GetAllRewardIndexes returns the reward indexes of all the contracts
+func (k Keeper) GetAllRewardIndexes(ctx sdk.Context) []types.RewardIndex {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixRewardIndex)
+	defer iterator.Close()
+
+	indexes := []types.RewardIndex{}
+	for ; iterator.Valid(); iterator.Next() {
+		var ri types.RewardIndex
+		k.cdc.MustUnmarshal(iterator.Value(), &ri)
+		indexes = append(indexes, ri)
+	}
+
+	return indexes
+}
+
+// rewardIndexKey returns the `<contract_address>|<epoch>` key of a reward index
+func rewardIndexKey(contract common.Address, epoch uint64) []byte {
+	return append(contract.Bytes(), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+// GetUnclaimedRewards returns the rewards allocated to the participants that
+// are not claimed yet
+func (k Keeper) GetUnclaimedRewards(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixUnclaimedRewards)
+	defer iterator.Close()
+
+	rewards := sdk.DecCoins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Dec
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal amount value %v", err))
+		}
+
+		denom := string(iterator.Key()[1:])
+		rewards = rewards.Add(sdk.NewDecCoinFromDec(denom, amount))
+	}
+
+	return rewards
+}
+
+// SetUnclaimedRewards sets the rewards allocated to the participants that are
+// not claimed yet
+func (k Keeper) SetUnclaimedRewards(ctx sdk.Context, rewards sdk.DecCoins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixUnclaimedRewards)
+
+	// Remove the denoms that are fully claimed
+	for _, coin := range k.GetUnclaimedRewards(ctx) {
+		store.Delete([]byte(coin.Denom))
+	}
+
+	for _, coin := range rewards {
+		if !coin.IsPositive() {
+			continue
+		}
+
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(fmt.Errorf("unable to marshal amount value %v", err))
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// subUnclaimedRewards subtracts the claimed rewards from the unclaimed
+// rewards, down to zero
+func (k Keeper) subUnclaimedRewards(ctx sdk.Context, claimed sdk.DecCoins) {
+	unclaimed := sdk.DecCoins{}
+	for _, coin := range k.GetUnclaimedRewards(ctx) {
+		coin.Amount = coin.Amount.Sub(claimed.AmountOf(coin.Denom))
+		if coin.Amount.IsPositive() {
+			unclaimed = unclaimed.Add(coin)
+		}
+	}
+	k.SetUnclaimedRewards(ctx, unclaimed)
+}
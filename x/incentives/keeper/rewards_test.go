@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// spendGas adds the gas spent by a participant on the contract, as an EVM
+// transaction does
+func (suite *KeeperTestSuite) spendGas(participant common.Address, gas uint64) {
+	if suite.app.AccountKeeper.GetAccount(suite.ctx, participant.Bytes()) == nil {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, participant.Bytes())
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	msg := ethtypes.NewMessage(participant, &contract, 0, big.NewInt(0), gas, big.NewInt(0), nil, nil, nil, nil, true)
+	err := suite.app.IncentivesKeeper.PostTxProcessing(suite.ctx, msg, &ethtypes.Receipt{GasUsed: gas})
+	suite.Require().NoError(err)
+}
+
+// setupRewards registers an incentive for the contract, funds the module with
+// the given amount and ends the given epoch once the participants spent gas
+func (suite *KeeperTestSuite) setupRewards(amount int64, epoch uint64, gasSpent map[common.Address]uint64) {
+	suite.mintRewards(amount)
+
+	if !suite.app.IncentivesKeeper.IsIncentiveRegistered(suite.ctx, contract) {
+		_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract, allocations, epochs)
+		suite.Require().NoError(err)
+	}
+
+	for participant, gas := range gasSpent {
+		suite.spendGas(participant, gas)
+	}
+
+	suite.endEpoch(epoch)
+}
+
+// mintRewards funds the module with the given amount
+func (suite *KeeperTestSuite) mintRewards(amount int64) {
+	if amount == 0 {
+		return
+	}
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.Coins{sdk.NewInt64Coin(denomCoin, amount)},
+	)
+	suite.Require().NoError(err)
+}
+
+// endEpoch distributes the rewards at the end of the given epoch
+func (suite *KeeperTestSuite) endEpoch(epoch uint64) {
+	suite.app.IncentivesKeeper.SetLastEpochNumber(suite.ctx, epoch)
+	err := suite.app.IncentivesKeeper.DistributeRewards(suite.ctx, epoch)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestClaimRewards() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		contracts  func() []common.Address
+		expPass    bool
+		expClaimed int64
+	}{
+		{
+			"fail - no gas meter for the contract",
+			func() {},
+			func() []common.Address { return []common.Address{contract2} },
+			false,
+			0,
+		},
+		{
+			"pass - no rewards",
+			func() {},
+			func() []common.Address { return nil },
+			true,
+			0,
+		},
+		{
+			"pass - rewards of a single epoch",
+			func() {
+				// 5% of 1000 are allocated, split 3:1 by gas
+				suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 300, participant2: 100})
+			},
+			func() []common.Address { return []common.Address{contract} },
+			true,
+			37,
+		},
+		{
+			"pass - rewards accumulated over several epochs",
+			func() {
+				suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 300, participant2: 100})
+				suite.setupRewards(1000, 3, map[common.Address]uint64{participant: 100, participant2: 100})
+			},
+			func() []common.Address { return nil },
+			true,
+			86,
+		},
+		{
+			"pass - the gas of the current epoch isn't rewarded yet",
+			func() {
+				suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 300, participant2: 100})
+				suite.spendGas(participant, 500)
+			},
+			func() []common.Address { return nil },
+			true,
+			37,
+		},
+		{
+			"pass - the gas of an epoch ended with the incentives disabled isn't rewarded",
+			func() {
+				suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 300, participant2: 100})
+				suite.spendGas(participant, 500)
+
+				params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				suite.app.IncentivesKeeper.AfterEpochEnd(suite.ctx, params.IncentivesEpochIdentifier, 3)
+
+				// the gas of the epoch 3 isn't rewarded by the next reward index
+				params.EnableIncentives = true
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params) //nolint:errcheck
+				suite.setupRewards(1000, 4, nil)
+			},
+			func() []common.Address { return nil },
+			true,
+			37,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+
+			// the participant spent gas on the contract
+			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 0))
+			tc.malleate()
+			contracts := tc.contracts()
+
+			_, pending := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+
+			claimed, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant, contracts)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expClaimed, claimed.AmountOf(denomCoin).Int64())
+			suite.Require().Equal(pending, claimed)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+			suite.Require().Equal(tc.expClaimed, balance.Amount.Int64())
+
+			// the rewards can't be claimed twice
+			_, pending = suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+			suite.Require().True(pending.IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRewardAllocationsExcludeUnclaimedRewards() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 100})
+	unclaimed := suite.app.IncentivesKeeper.GetUnclaimedRewards(suite.ctx)
+	suite.Require().Equal(int64(50), unclaimed.AmountOf(denomCoin).TruncateInt64())
+
+	// the next epoch allocates 5% of the 950 not reserved for the participants
+	suite.setupRewards(0, 3, map[common.Address]uint64{participant: 100})
+	_, pending := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+	suite.Require().Equal(int64(97), pending.AmountOf(denomCoin).Int64())
+
+	claimed, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pending, claimed)
+	suite.Require().True(suite.app.IncentivesKeeper.GetUnclaimedRewards(suite.ctx).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestCancelIncentiveKeepsPendingRewards() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 100})
+
+	// the gas spent on the canceled incentive isn't rewarded
+	suite.spendGas(participant2, 100)
+
+	err := suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	_, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant2)
+	suite.Require().False(found)
+
+	_, pending := suite.app.IncentivesKeeper.GetPendingRewards(suite.ctx, participant)
+	suite.Require().Equal(int64(50), pending.AmountOf(denomCoin).Int64())
+
+	claimed, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant, []common.Address{contract})
+	suite.Require().NoError(err)
+	suite.Require().Equal(pending, claimed)
+
+	_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestMsgClaimIncentiveRewards() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 100})
+
+	sdkParticipant := sdk.AccAddress(participant.Bytes())
+	msg := types.NewMsgClaimIncentiveRewards(sdkParticipant, nil)
+	res, err := suite.app.IncentivesKeeper.ClaimIncentiveRewards(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 50)), res.Amount)
+
+	// claiming the rewards of a contract without gas meter fails
+	msg = types.NewMsgClaimIncentiveRewards(sdkParticipant, []common.Address{utiltx.GenerateAddress()})
+	_, err = suite.app.IncentivesKeeper.ClaimIncentiveRewards(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestPendingRewards() {
+	suite.SetupTest()
+	suite.deployContracts()
+
+	suite.setupRewards(1000, 2, map[common.Address]uint64{participant: 100})
+	suite.Commit()
+
+	_, err := suite.queryClient.PendingRewards(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryPendingRewardsRequest{Participant: "participant"},
+	)
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.PendingRewards(
+		sdk.WrapSDKContext(suite.ctx),
+		&types.QueryPendingRewardsRequest{Participant: participant.Hex()},
+	)
+	suite.Require().NoError(err)
+
+	expRewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 50))
+	suite.Require().Equal([]types.ContractRewards{{Contract: contract.Hex(), Rewards: expRewards}}, res.Rewards)
+	suite.Require().Equal(expRewards, res.Total)
+}
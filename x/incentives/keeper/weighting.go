@@ -0,0 +1,252 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"math"
+	"math/bits"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// SetWeightingStrategy updates the weighting strategy of an incentive. The gas
+// threshold of a new gas percentile is computed at the end of the epoch.
+func (k Keeper) SetWeightingStrategy(
+	ctx sdk.Context,
+	contract common.Address,
+	strategy types.WeightingStrategy,
+) (*types.Incentive, error) {
+	if err := strategy.Validate(); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	incentive, found := k.GetIncentive(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress,
+			"unmatching contract '%s' ", contract,
+		)
+	}
+
+	if strategy.GasPercentile == 0 {
+		incentive.GasThreshold = 0
+		k.deleteGasHistogram(ctx, contract)
+	}
+
+	if strategy.MinAccountAge == 0 {
+		k.deleteParticipantsFirstSeen(ctx, contract)
+	}
+
+	incentive.WeightingStrategy = strategy
+	k.SetIncentive(ctx, incentive)
+	return &incentive, nil
+}
+
+// weightGas returns the gas credited to a participant for a transaction on an
+// incentivized contract, according to the weighting strategy of the incentive:
+//   - no gas is credited until the participant is older than the min account
+//     age, counted from its first interaction with the incentive
+//   - the gas is discounted when the transaction uses more gas than the gas
+//     threshold, or when the participant had reverted transactions during the epoch
+//   - the gas credited during the epoch is capped to the max gas per epoch
+//
+// The gas meter must be settled for the current epoch.
+func (k Keeper) weightGas(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	gm types.GasMeter,
+	gasUsed uint64,
+) uint64 {
+	strategy := incentive.WeightingStrategy
+
+	if strategy.MinAccountAge > 0 {
+		firstSeen, _ := k.GetParticipantFirstSeen(
+			ctx,
+			common.HexToAddress(gm.Contract),
+			common.HexToAddress(gm.Participant),
+		)
+		if uint64(ctx.BlockHeight()) < firstSeen+strategy.MinAccountAge {
+			return 0
+		}
+	}
+
+	credited := gasUsed
+	discount := strategy.GetDiscount()
+	aboveThreshold := incentive.GasThreshold > 0 && gasUsed > incentive.GasThreshold
+	if !discount.IsZero() && (aboveThreshold || gm.RevertedTxs > 0) {
+		credited = sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).
+			Mul(sdk.OneDec().Sub(discount)).
+			TruncateInt().
+			Uint64()
+	}
+
+	if strategy.MaxGasPerEpoch > 0 {
+		if gm.CumulativeGas >= strategy.MaxGasPerEpoch {
+			return 0
+		}
+		if remaining := strategy.MaxGasPerEpoch - gm.CumulativeGas; credited > remaining {
+			credited = remaining
+		}
+	}
+
+	return credited
+}
+
+// GetParticipantFirstSeen returns the block height of the first interaction
+// of a participant with the incentive of a contract. It is only recorded while
+// the weighting strategy of the incentive has a min account age, so the age of
+// a participant isn't its account age but the blocks since it first interacted
+// with the incentive.
+func (k Keeper) GetParticipantFirstSeen(
+	ctx sdk.Context,
+	contract, participant common.Address,
+) (uint64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantFirstSeen)
+	bz := store.Get(participantFirstSeenKey(contract, participant))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// setParticipantFirstSeen stores the current block height as the first seen
+// height of a participant on the incentive of a contract, unless the weighting
+// strategy has no min account age or the height is already set
+func (k Keeper) setParticipantFirstSeen(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	participant common.Address,
+) {
+	if incentive.WeightingStrategy.MinAccountAge == 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantFirstSeen)
+	key := participantFirstSeenKey(common.HexToAddress(incentive.Contract), participant)
+	if store.Has(key) {
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// deleteParticipantsFirstSeen removes the first seen heights of the
+// participants of a contract incentive
+func (k Keeper) deleteParticipantsFirstSeen(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantFirstSeen)
+
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// addToGasHistogram counts a transaction in the gas histogram of a contract.
+// The transactions are bucketed by the bit length of their gas used, so the
+// gas threshold is approximated to the next power of two.
+func (k Keeper) addToGasHistogram(ctx sdk.Context, contract common.Address, gasUsed uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasHistogram)
+	key := gasHistogramKey(contract, uint8(bits.Len64(gasUsed)))
+
+	count := uint64(0)
+	if bz := store.Get(key); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(count+1))
+}
+
+// computeGasThreshold returns the gas threshold of the given percentile of the
+// transactions counted in the gas histogram of a contract, and resets the
+// histogram. It returns 0 if no transaction was counted.
+func (k Keeper) computeGasThreshold(ctx sdk.Context, contract common.Address, percentile uint32) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasHistogram)
+
+	// the buckets are iterated in increasing order of gas
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	keys := [][]byte{}
+	counts := []uint64{}
+	total := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count := sdk.BigEndianToUint64(iterator.Value())
+		keys = append(keys, iterator.Key())
+		counts = append(counts, count)
+		total += count
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	// number of transactions at or below the threshold, rounded up
+	target := sdk.NewIntFromUint64(total).
+		MulRaw(int64(percentile)).
+		AddRaw(types.MaxGasPercentile - 1).
+		QuoRaw(types.MaxGasPercentile).
+		Uint64()
+
+	cumulative := uint64(0)
+	for i, count := range counts {
+		cumulative += count
+		if cumulative >= target {
+			return bucketUpperBound(keys[i][common.AddressLength])
+		}
+	}
+
+	return math.MaxUint64
+}
+
+// deleteGasHistogram removes the gas histogram of a contract
+func (k Keeper) deleteGasHistogram(ctx sdk.Context, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasHistogram)
+
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// gasHistogramKey returns the key of a gas histogram bucket in the
+// `<contract_address>|<bucket>` format
+func gasHistogramKey(contract common.Address, bucket uint8) []byte {
+	return append(contract.Bytes(), bucket)
+}
+
+// participantFirstSeenKey returns the key of the first seen height of a
+// participant in the `<contract_address>|<participant_address>` format
+func participantFirstSeenKey(contract, participant common.Address) []byte {
+	return append(contract.Bytes(), participant.Bytes()...)
+}
+
+// bucketUpperBound returns the highest gas of a gas histogram bucket
+func bucketUpperBound(bucket uint8) uint64 {
+	if bucket >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bucket - 1
+}
@@ -0,0 +1,331 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// registerWeightedIncentive registers an incentive for the contract with the
+// given weighting strategy
+func (suite *KeeperTestSuite) registerWeightedIncentive(strategy types.WeightingStrategy) {
+	suite.mintRewards(1000)
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(suite.ctx, contract, allocations, epochs)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.SetWeightingStrategy(suite.ctx, contract, strategy)
+	suite.Require().NoError(err)
+}
+
+// revertTx counts a reverted transaction of a participant on the contract, as
+// a failed EVM transaction does
+func (suite *KeeperTestSuite) revertTx(participant common.Address) {
+	if suite.app.AccountKeeper.GetAccount(suite.ctx, participant.Bytes()) == nil {
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, participant.Bytes())
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	msg := ethtypes.NewMessage(participant, &contract, 0, big.NewInt(0), 100, big.NewInt(0), nil, nil, nil, nil, true)
+	receipt := &ethtypes.Receipt{Status: ethtypes.ReceiptStatusFailed, GasUsed: 100}
+	err := suite.app.IncentivesKeeper.PostFailedTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestWeightGas() {
+	discount := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name        string
+		strategy    types.WeightingStrategy
+		malleate    func()
+		expCredited uint64
+	}{
+		{
+			"raw gas without strategy",
+			types.DefaultWeightingStrategy(),
+			func() {
+				suite.spendGas(participant, 100)
+				suite.spendGas(participant, 100)
+			},
+			200,
+		},
+		{
+			"gas capped per epoch",
+			types.NewWeightingStrategy(150, 0, sdk.ZeroDec(), 0),
+			func() {
+				suite.spendGas(participant, 100)
+				suite.spendGas(participant, 100)
+				suite.spendGas(participant, 100)
+			},
+			150,
+		},
+		{
+			"gas of young participants ignored",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.spendGas(participant, 100)
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 9)
+				suite.spendGas(participant, 100)
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+				suite.spendGas(participant, 100)
+			},
+			100,
+		},
+		{
+			"reverted txs age the participant",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.revertTx(participant)
+				suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 10)
+				suite.spendGas(participant, 100)
+			},
+			100,
+		},
+		{
+			"gas of reverting participants discounted",
+			types.NewWeightingStrategy(0, 0, discount, 0),
+			func() {
+				suite.spendGas(participant, 100)
+				suite.revertTx(participant)
+				suite.spendGas(participant, 100)
+			},
+			150,
+		},
+		{
+			"gas above the gas threshold discounted",
+			types.NewWeightingStrategy(0, 0, discount, 50),
+			func() {
+				incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+				incentive.GasThreshold = 127
+				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, incentive)
+
+				suite.spendGas(participant, 100)
+				suite.spendGas(participant, 200)
+			},
+			200,
+		},
+		{
+			"discounted gas capped per epoch",
+			types.NewWeightingStrategy(120, 0, discount, 0),
+			func() {
+				suite.revertTx(participant)
+				suite.spendGas(participant, 200)
+				suite.spendGas(participant, 200)
+			},
+			120,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+			suite.registerWeightedIncentive(tc.strategy)
+
+			tc.malleate()
+
+			gm, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expCredited, gm.CumulativeGas)
+
+			incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+			suite.Require().Equal(tc.expCredited, incentive.TotalGas)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParticipantFirstSeen() {
+	testCases := []struct {
+		name     string
+		strategy types.WeightingStrategy
+		malleate func()
+		expFound bool
+	}{
+		{
+			"not recorded without min account age",
+			types.NewWeightingStrategy(0, 0, sdk.NewDecWithPrec(5, 1), 0),
+			func() {
+				suite.spendGas(participant, 100)
+				suite.revertTx(participant)
+			},
+			false,
+		},
+		{
+			"recorded on the first interaction",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.spendGas(participant, 100)
+			},
+			true,
+		},
+		{
+			"recorded on the first reverted interaction",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.revertTx(participant)
+			},
+			true,
+		},
+		{
+			"pruned when the min account age is removed",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.spendGas(participant, 100)
+
+				_, err := suite.app.IncentivesKeeper.SetWeightingStrategy(suite.ctx, contract, types.DefaultWeightingStrategy())
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"pruned when the incentive ends",
+			types.NewWeightingStrategy(0, 10, sdk.ZeroDec(), 0),
+			func() {
+				suite.spendGas(participant, 100)
+
+				incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+				suite.app.IncentivesKeeper.DeleteIncentiveAndUpdateAllocationMeters(suite.ctx, incentive)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+			suite.registerWeightedIncentive(tc.strategy)
+
+			tc.malleate()
+
+			firstSeen, found := suite.app.IncentivesKeeper.GetParticipantFirstSeen(suite.ctx, contract, participant)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(uint64(suite.ctx.BlockHeight()), firstSeen)
+			}
+
+			// the first interaction with another incentive isn't recorded
+			_, found = suite.app.IncentivesKeeper.GetParticipantFirstSeen(suite.ctx, contract2, participant)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRevertedTxsResetEachEpoch() {
+	suite.SetupTest()
+	suite.deployContracts()
+	suite.registerWeightedIncentive(types.NewWeightingStrategy(0, 0, sdk.NewDecWithPrec(5, 1), 0))
+
+	suite.revertTx(participant)
+	suite.spendGas(participant, 100)
+	suite.endEpoch(2)
+
+	suite.spendGas(participant, 100)
+	gm, _ := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+	suite.Require().Equal(uint64(0), gm.RevertedTxs)
+	suite.Require().Equal(uint64(100), gm.CumulativeGas)
+}
+
+func (suite *KeeperTestSuite) TestGasThreshold() {
+	suite.SetupTest()
+	suite.deployContracts()
+	suite.registerWeightedIncentive(types.NewWeightingStrategy(0, 0, sdk.NewDecWithPrec(5, 1), 75))
+
+	for i := 0; i < 3; i++ {
+		suite.spendGas(participant, 100)
+	}
+	suite.spendGas(participant2, 1000)
+	suite.endEpoch(2)
+
+	// 75% of the txs used at most 127 gas
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().Equal(uint64(127), incentive.GasThreshold)
+
+	// the threshold applies to the txs of the next epoch
+	suite.spendGas(participant2, 1000)
+	gm, _ := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant2)
+	suite.Require().Equal(uint64(500), gm.CumulativeGas)
+
+	suite.spendGas(participant2, 1000)
+	suite.endEpoch(3)
+
+	// the percentile covers all the txs of the epoch
+	incentive, _ = suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().Equal(uint64(1023), incentive.GasThreshold)
+
+	// the threshold is disabled when there is no tx during the epoch
+	suite.endEpoch(4)
+	incentive, _ = suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().Equal(uint64(0), incentive.GasThreshold)
+}
+
+func (suite *KeeperTestSuite) TestUpdateWeightingStrategy() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	strategy := types.NewWeightingStrategy(1000, 10, sdk.NewDecWithPrec(5, 1), 90)
+
+	testCases := []struct {
+		name    string
+		request func() *types.MsgUpdateWeightingStrategy
+		expPass bool
+	}{
+		{
+			"fail - invalid authority",
+			func() *types.MsgUpdateWeightingStrategy {
+				return &types.MsgUpdateWeightingStrategy{Authority: "foobar", Contract: contract.String(), WeightingStrategy: strategy}
+			},
+			false,
+		},
+		{
+			"fail - incentive not registered",
+			func() *types.MsgUpdateWeightingStrategy {
+				return &types.MsgUpdateWeightingStrategy{Authority: authority, Contract: utiltx.GenerateAddress().String(), WeightingStrategy: strategy}
+			},
+			false,
+		},
+		{
+			"fail - invalid strategy",
+			func() *types.MsgUpdateWeightingStrategy {
+				invalid := types.NewWeightingStrategy(0, 0, sdk.NewDec(2), 0)
+				return &types.MsgUpdateWeightingStrategy{Authority: authority, Contract: contract.String(), WeightingStrategy: invalid}
+			},
+			false,
+		},
+		{
+			"pass",
+			func() *types.MsgUpdateWeightingStrategy {
+				return &types.MsgUpdateWeightingStrategy{Authority: authority, Contract: contract.String(), WeightingStrategy: strategy}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			suite.deployContracts()
+			suite.registerWeightedIncentive(types.DefaultWeightingStrategy())
+
+			_, err := suite.app.IncentivesKeeper.UpdateWeightingStrategy(sdk.WrapSDKContext(suite.ctx), tc.request())
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			res, err := suite.queryClient.Incentive(
+				sdk.WrapSDKContext(suite.ctx),
+				&types.QueryIncentiveRequest{Contract: contract.String()},
+			)
+			suite.Require().NoError(err)
+			suite.Require().Equal(strategy, res.Incentive.WeightingStrategy)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+// MigrateStore migrates the x/incentives module state from the consensus version 2 to
+// version 3. Specifically, it replaces the cumulative gas stored in the gas meters by
+// the gas meters themselves, tracking the epoch of the gas and the pending rewards of
+// the participants, and indexes the gas meters by participant.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+	gasMeterStore := prefix.NewStore(store, types.KeyPrefixGasMeter)
+	indexStore := prefix.NewStore(store, types.KeyPrefixGasMeterByParticipant)
+
+	// the gas meters were deleted at the end of each epoch, so their gas was
+	// spent during the current epoch
+	epoch := uint64(1)
+	if bz := store.Get(types.KeyLastEpochNumber); len(bz) > 0 {
+		epoch = sdk.BigEndianToUint64(bz) + 1
+	}
+
+	iterator := gasMeterStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	gasMeters := []types.GasMeter{}
+	for ; iterator.Valid(); iterator.Next() {
+		contract, participant := types.SplitGasMeterKey(iterator.Key())
+		gm := types.NewGasMeter(contract, participant, sdk.BigEndianToUint64(iterator.Value()))
+		gm.Epoch = epoch
+
+		keys = append(keys, iterator.Key())
+		gasMeters = append(gasMeters, gm)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, gm := range gasMeters {
+		bz, err := cdc.Marshal(&gm)
+		if err != nil {
+			return err
+		}
+		gasMeterStore.Set(keys[i], bz)
+
+		contract, participant := types.SplitGasMeterKey(keys[i])
+		indexStore.Set(append(participant.Bytes(), contract.Bytes()...), []byte{1})
+	}
+
+	return nil
+}
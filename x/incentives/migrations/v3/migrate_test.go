@@ -0,0 +1,44 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/evmos/evmos/v15/app"
+	"github.com/evmos/evmos/v15/encoding"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	v3 "github.com/evmos/evmos/v15/x/incentives/migrations/v3"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	contract := utiltx.GenerateAddress()
+	participant := utiltx.GenerateAddress()
+
+	kvStore.Set(types.KeyLastEpochNumber, sdk.Uint64ToBigEndian(4))
+	gasMeterStore := prefix.NewStore(kvStore, types.KeyPrefixGasMeter)
+	gasMeterStore.Set(append(contract.Bytes(), participant.Bytes()...), sdk.Uint64ToBigEndian(100))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	var gm types.GasMeter
+	cdc.MustUnmarshal(gasMeterStore.Get(append(contract.Bytes(), participant.Bytes()...)), &gm)
+
+	expGm := types.NewGasMeter(contract, participant, 100)
+	expGm.Epoch = 5
+	require.Equal(t, expGm, gm)
+
+	indexStore := prefix.NewStore(kvStore, types.KeyPrefixGasMeterByParticipant)
+	require.True(t, indexStore.Has(append(participant.Bytes(), contract.Bytes()...)))
+}
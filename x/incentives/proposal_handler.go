@@ -40,6 +40,12 @@ func handleRegisterIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types
 	if err != nil {
 		return err
 	}
+	if p.WeightingStrategy.IsEnabled() {
+		in, err = k.SetWeightingStrategy(ctx, common.HexToAddress(p.Contract), p.WeightingStrategy)
+		if err != nil {
+			return err
+		}
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeRegisterIncentive,
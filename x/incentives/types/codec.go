@@ -23,7 +23,11 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "evmos/incentives/MsgUpdateParams"
+	updateParamsName            = "evmos/incentives/MsgUpdateParams"
+	createIncentiveProgramName  = "evmos/incentives/MsgCreateIncentiveProgram"
+	cancelIncentiveProgramName  = "evmos/incentives/MsgCancelIncentiveProgram"
+	claimIncentiveRewardsName   = "evmos/incentives/MsgClaimIncentiveRewards"
+	updateWeightingStrategyName = "evmos/incentives/MsgUpdateWeightingStrategy"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,6 +41,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateIncentiveProgram{},
+		&MsgCancelIncentiveProgram{},
+		&MsgClaimIncentiveRewards{},
+		&MsgUpdateWeightingStrategy{},
 	)
 
 	registry.RegisterImplementations(
@@ -51,4 +59,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCreateIncentiveProgram{}, createIncentiveProgramName, nil)
+	cdc.RegisterConcrete(&MsgCancelIncentiveProgram{}, cancelIncentiveProgramName, nil)
+	cdc.RegisterConcrete(&MsgClaimIncentiveRewards{}, claimIncentiveRewardsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateWeightingStrategy{}, updateWeightingStrategyName, nil)
 }
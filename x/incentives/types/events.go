@@ -9,6 +9,17 @@ const (
 	EventTypeCancelIncentive      = "cancel_incentive"
 	EventTypeDistributeIncentives = "distribute_incentives"
 
-	AttributeKeyContract = "contract"
-	AttributeKeyEpochs   = "epochs"
+	EventTypeCreateIncentiveProgram     = "create_incentive_program"
+	EventTypeCancelIncentiveProgram     = "cancel_incentive_program"
+	EventTypeDistributeIncentiveProgram = "distribute_incentive_program"
+	EventTypeClaimIncentiveRewards      = "claim_incentive_rewards"
+
+	AttributeKeyContract  = "contract"
+	AttributeKeyEpochs    = "epochs"
+	AttributeKeyProgramID = "program_id"
+	AttributeKeyCreator   = "creator"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyEpoch     = "epoch"
+
+	AttributeKeyParticipant = "participant"
 )
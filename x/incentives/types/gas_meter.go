@@ -4,6 +4,8 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	evmostypes "github.com/evmos/evmos/v15/types"
 )
@@ -27,5 +29,17 @@ func (gm GasMeter) Validate() error {
 		return err
 	}
 
-	return evmostypes.ValidateAddress(gm.Participant)
+	if err := evmostypes.ValidateAddress(gm.Participant); err != nil {
+		return err
+	}
+
+	if err := gm.RewardIndex.Validate(); err != nil {
+		return fmt.Errorf("invalid reward index: %w", err)
+	}
+
+	if err := gm.PendingRewards.Validate(); err != nil {
+		return fmt.Errorf("invalid pending rewards: %w", err)
+	}
+
+	return nil
 }
@@ -63,45 +63,45 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 		{
 			"Register gas meter - invalid contract address (no hex)",
 			types.GasMeter{
-				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
-				utiltx.GenerateAddress().String(),
-				10,
+				Contract:      "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
+				Participant:   utiltx.GenerateAddress().String(),
+				CumulativeGas: 10,
 			},
 			false,
 		},
 		{
 			"Register gas meter - invalid participant address (no hex)",
 			types.GasMeter{
-				utiltx.GenerateAddress().String(),
-				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
-				10,
+				Contract:      utiltx.GenerateAddress().String(),
+				Participant:   "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
+				CumulativeGas: 10,
 			},
 			false,
 		},
 		{
 			"Register gas meter - invalid address (invalid length 1)",
 			types.GasMeter{
-				"0x5dCA2483280D9727c80b5518faC4556617fb19",
-				utiltx.GenerateAddress().String(),
-				10,
+				Contract:      "0x5dCA2483280D9727c80b5518faC4556617fb19",
+				Participant:   utiltx.GenerateAddress().String(),
+				CumulativeGas: 10,
 			},
 			false,
 		},
 		{
 			"Register gas meter - invalid address (invalid length 2)",
 			types.GasMeter{
-				"0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
-				utiltx.GenerateAddress().String(),
-				10,
+				Contract:      "0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
+				Participant:   utiltx.GenerateAddress().String(),
+				CumulativeGas: 10,
 			},
 			false,
 		},
 		{
 			"pass",
 			types.GasMeter{
-				utiltx.GenerateAddress().String(),
-				utiltx.GenerateAddress().String(),
-				10,
+				Contract:      utiltx.GenerateAddress().String(),
+				Participant:   utiltx.GenerateAddress().String(),
+				CumulativeGas: 10,
 			},
 			true,
 		},
@@ -60,5 +60,40 @@ func (gs GenesisState) Validate() error {
 		seenGasMeters[gm.Contract+gm.Participant] = true
 	}
 
+	seenPrograms := make(map[uint64]bool)
+	for _, program := range gs.IncentivePrograms {
+		if seenPrograms[program.Id] {
+			return fmt.Errorf("incentive program duplicated on genesis '%d'", program.Id)
+		}
+
+		if err := program.Validate(); err != nil {
+			return err
+		}
+
+		seenPrograms[program.Id] = true
+	}
+
+	seenRewardIndexes := make(map[string]bool)
+	for _, ri := range gs.RewardIndexes {
+		// only one reward index per contract+epoch combination
+		key := fmt.Sprintf("%s/%d", ri.Contract, ri.Epoch)
+		if seenRewardIndexes[key] {
+			return fmt.Errorf(
+				"reward index duplicated on genesis contract: '%s', epoch: %d",
+				ri.Contract, ri.Epoch,
+			)
+		}
+
+		if err := ri.Validate(); err != nil {
+			return err
+		}
+
+		seenRewardIndexes[key] = true
+	}
+
+	if err := gs.UnclaimedRewards.Validate(); err != nil {
+		return fmt.Errorf("invalid unclaimed rewards: %w", err)
+	}
+
 	return gs.Params.Validate()
 }
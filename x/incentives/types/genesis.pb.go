@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -32,6 +33,15 @@ type GenesisState struct {
 	Incentives []Incentive `protobuf:"bytes,2,rep,name=incentives,proto3" json:"incentives"`
 	// gas_meters is a slice of active Gasmeters
 	GasMeters []GasMeter `protobuf:"bytes,3,rep,name=gas_meters,json=gasMeters,proto3" json:"gas_meters"`
+	// incentive_programs is a slice of the self-funded incentive programs
+	IncentivePrograms []IncentiveProgram `protobuf:"bytes,4,rep,name=incentive_programs,json=incentivePrograms,proto3" json:"incentive_programs"`
+	// reward_indexes is a slice of the reward indexes of the incentivized contracts
+	RewardIndexes []RewardIndex `protobuf:"bytes,5,rep,name=reward_indexes,json=rewardIndexes,proto3" json:"reward_indexes"`
+	// unclaimed_rewards are the rewards allocated to the participants that are not claimed yet
+	UnclaimedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=unclaimed_rewards,json=unclaimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unclaimed_rewards"`
+	// last_epoch_number is the number of the last ended epoch of the incentives epoch identifier,
+	// which the gas meters and reward indexes are recorded with
+	LastEpochNumber uint64 `protobuf:"varint,7,opt,name=last_epoch_number,json=lastEpochNumber,proto3" json:"last_epoch_number,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +98,34 @@ func (m *GenesisState) GetGasMeters() []GasMeter {
 	return nil
 }
 
+func (m *GenesisState) GetIncentivePrograms() []IncentiveProgram {
+	if m != nil {
+		return m.IncentivePrograms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardIndexes() []RewardIndex {
+	if m != nil {
+		return m.RewardIndexes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnclaimedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.UnclaimedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastEpochNumber() uint64 {
+	if m != nil {
+		return m.LastEpochNumber
+	}
+	return 0
+}
+
 // Params defines the incentives module params
 type Params struct {
 	// enable_incentives is the parameter to enable incentives
@@ -98,6 +136,12 @@ type Params struct {
 	IncentivesEpochIdentifier string `protobuf:"bytes,3,opt,name=incentives_epoch_identifier,json=incentivesEpochIdentifier,proto3" json:"incentives_epoch_identifier,omitempty"`
 	// reward_scaler is the scaling factor for capping rewards
 	RewardScaler github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_scaler,json=rewardScaler,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_scaler"`
+	// incentive_program_creation_fee is the fee burned on the creation of a self-funded incentive
+	// program, on top of the escrowed rewards
+	IncentiveProgramCreationFee types.Coin `protobuf:"bytes,5,opt,name=incentive_program_creation_fee,json=incentiveProgramCreationFee,proto3" json:"incentive_program_creation_fee"`
+	// max_incentive_programs_per_contract is the maximum number of incentive programs of a contract.
+	// No incentive program can be created when it is 0.
+	MaxIncentiveProgramsPerContract uint32 `protobuf:"varint,6,opt,name=max_incentive_programs_per_contract,json=maxIncentiveProgramsPerContract,proto3" json:"max_incentive_programs_per_contract,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -147,6 +191,20 @@ func (m *Params) GetIncentivesEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetIncentiveProgramCreationFee() types.Coin {
+	if m != nil {
+		return m.IncentiveProgramCreationFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxIncentiveProgramsPerContract() uint32 {
+	if m != nil {
+		return m.MaxIncentiveProgramsPerContract
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.incentives.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.incentives.v1.Params")
@@ -155,33 +213,46 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/genesis.proto", fileDescriptor_7bb1f7c7e8ad160b) }
 
 var fileDescriptor_7bb1f7c7e8ad160b = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xea, 0xd3, 0x40,
-	0x10, 0x87, 0x93, 0xf6, 0x4f, 0xb1, 0xdb, 0x8a, 0x35, 0x7a, 0x88, 0x2d, 0xa6, 0xb5, 0x88, 0x14,
-	0xa4, 0x1b, 0x5a, 0xf1, 0xe0, 0xc5, 0x43, 0xa8, 0x94, 0x82, 0x82, 0xa4, 0x27, 0xbd, 0x84, 0x6d,
-	0x3a, 0xa6, 0x8b, 0x49, 0x36, 0x64, 0xd7, 0xa8, 0x6f, 0xe1, 0x4b, 0xf8, 0x2e, 0x3d, 0x16, 0xbc,
-	0x88, 0x87, 0x22, 0xed, 0x8b, 0xc8, 0xee, 0x46, 0x93, 0x43, 0x4e, 0x5e, 0x92, 0x9d, 0xe4, 0x9b,
-	0xef, 0x37, 0x09, 0x83, 0x1e, 0x41, 0x91, 0x30, 0xee, 0xd2, 0x34, 0x84, 0x54, 0xd0, 0x02, 0xb8,
-	0x5b, 0x2c, 0xdc, 0x08, 0x52, 0xe0, 0x94, 0xe3, 0x2c, 0x67, 0x82, 0x59, 0xf7, 0x14, 0x82, 0x2b,
-	0x04, 0x17, 0x8b, 0xe1, 0xe3, 0xa6, 0xbe, 0x1a, 0xa2, 0x5a, 0x87, 0xf7, 0x23, 0x16, 0x31, 0x75,
-	0x74, 0xe5, 0x49, 0x3f, 0x9d, 0xfe, 0x30, 0x51, 0x7f, 0xad, 0x23, 0xb6, 0x82, 0x08, 0xb0, 0x5e,
-	0xa0, 0x4e, 0x46, 0x72, 0x92, 0x70, 0xdb, 0x9c, 0x98, 0xb3, 0xde, 0x72, 0x84, 0x1b, 0x22, 0xf1,
-	0x5b, 0x85, 0x78, 0x37, 0xc7, 0xf3, 0xd8, 0xf0, 0xcb, 0x06, 0x6b, 0x85, 0x50, 0x45, 0xd9, 0xad,
-	0x49, 0x7b, 0xd6, 0x5b, 0x3a, 0x8d, 0xed, 0x9b, 0xbf, 0x55, 0x69, 0xa8, 0xf5, 0x59, 0x1e, 0x42,
-	0x11, 0xe1, 0x41, 0x02, 0x02, 0x72, 0x6e, 0xb7, 0x95, 0xe5, 0x61, 0xa3, 0x65, 0x4d, 0xf8, 0x1b,
-	0x49, 0x95, 0x92, 0x6e, 0x54, 0xd6, 0x7c, 0xfa, 0xbd, 0x85, 0x3a, 0x7a, 0x44, 0xeb, 0x29, 0xba,
-	0x0b, 0x29, 0xd9, 0xc5, 0x10, 0xd4, 0x66, 0x93, 0x9f, 0x76, 0xcb, 0x1f, 0xe8, 0x17, 0x9b, 0x2a,
-	0xfb, 0x1d, 0x1a, 0x90, 0x38, 0x66, 0x21, 0x11, 0x94, 0xa5, 0x41, 0x4c, 0x13, 0x2a, 0xec, 0xd6,
-	0xc4, 0x9c, 0x75, 0x3d, 0x2c, 0x23, 0x7e, 0x9d, 0xc7, 0x4f, 0x22, 0x2a, 0x0e, 0x9f, 0x76, 0x38,
-	0x64, 0x89, 0x1b, 0x32, 0x2e, 0xff, 0xbb, 0xbe, 0xcd, 0xf9, 0xfe, 0xa3, 0x2b, 0xbe, 0x66, 0xc0,
-	0xf1, 0x0a, 0x42, 0xff, 0x4e, 0xe5, 0x79, 0x2d, 0x35, 0xd6, 0x4b, 0x34, 0xaa, 0x06, 0x08, 0x20,
-	0x63, 0xe1, 0x21, 0xa0, 0x7b, 0x59, 0x7f, 0xa0, 0x90, 0xdb, 0x6d, 0x99, 0xe2, 0x3f, 0xa8, 0x90,
-	0x57, 0x92, 0xd8, 0xfc, 0x03, 0xac, 0x2d, 0xba, 0x9d, 0xc3, 0x67, 0x92, 0xef, 0x03, 0x1e, 0x92,
-	0x18, 0x72, 0xfb, 0xe6, 0xbf, 0xe6, 0xea, 0x6b, 0xc9, 0x56, 0x39, 0xbc, 0xf5, 0xf1, 0xe2, 0x98,
-	0xa7, 0x8b, 0x63, 0xfe, 0xbe, 0x38, 0xe6, 0xb7, 0xab, 0x63, 0x9c, 0xae, 0x8e, 0xf1, 0xf3, 0xea,
-	0x18, 0xef, 0xe7, 0x35, 0x9f, 0x5e, 0x2f, 0x7d, 0x2d, 0x16, 0xcf, 0xdd, 0x2f, 0xf5, 0x55, 0x53,
-	0xea, 0x5d, 0x47, 0x6d, 0xd3, 0xb3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0xc7, 0xac, 0x17,
-	0xc3, 0x02, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x4f, 0xdb, 0x30,
+	0x14, 0xc7, 0x1b, 0x5a, 0xba, 0x61, 0x60, 0x50, 0x6f, 0x87, 0x00, 0x5b, 0xda, 0xb1, 0x1f, 0xaa,
+	0x86, 0x48, 0x54, 0xd0, 0x0e, 0xbb, 0xec, 0x50, 0xd8, 0x50, 0x25, 0x98, 0x50, 0x38, 0x8d, 0x8b,
+	0xe5, 0xa6, 0x8f, 0x60, 0x2d, 0x89, 0x2b, 0xdb, 0x74, 0xdd, 0x65, 0x7f, 0xc3, 0xfe, 0x0e, 0xfe,
+	0x12, 0x8e, 0x1c, 0xb7, 0x1d, 0xd8, 0x04, 0xff, 0xc8, 0x64, 0x3b, 0x90, 0x0a, 0xa2, 0x69, 0xda,
+	0xa5, 0x8d, 0x9f, 0xbf, 0xef, 0x93, 0xf7, 0xfc, 0x7d, 0x31, 0x7a, 0x0a, 0xa3, 0x94, 0xcb, 0x80,
+	0x65, 0x11, 0x64, 0x8a, 0x8d, 0x40, 0x06, 0xa3, 0x4e, 0x10, 0x43, 0x06, 0x92, 0x49, 0x7f, 0x28,
+	0xb8, 0xe2, 0xf8, 0xa1, 0x91, 0xf8, 0x85, 0xc4, 0x1f, 0x75, 0x96, 0xbd, 0x88, 0x4b, 0x9d, 0xd8,
+	0xa7, 0x12, 0x82, 0x51, 0xa7, 0x0f, 0x8a, 0x76, 0x82, 0x88, 0xb3, 0xcc, 0x26, 0x2d, 0x3f, 0x2f,
+	0xe3, 0x4e, 0x20, 0xac, 0xea, 0x51, 0xcc, 0x63, 0x6e, 0x1e, 0x03, 0xfd, 0x64, 0xa3, 0xab, 0xa7,
+	0x35, 0x34, 0xb7, 0x63, 0x4b, 0x38, 0x50, 0x54, 0x01, 0x7e, 0x83, 0xea, 0x43, 0x2a, 0x68, 0x2a,
+	0x5d, 0xa7, 0xe5, 0xb4, 0x67, 0x37, 0x56, 0xfc, 0x92, 0x92, 0xfc, 0x7d, 0x23, 0xe9, 0xd6, 0xce,
+	0x2e, 0x9a, 0x95, 0x30, 0x4f, 0xc0, 0xdb, 0x08, 0x15, 0x2a, 0x77, 0xaa, 0x55, 0x6d, 0xcf, 0x6e,
+	0x78, 0xa5, 0xe9, 0xbd, 0xeb, 0x55, 0x4e, 0x98, 0xc8, 0xc3, 0x5d, 0x84, 0x62, 0x2a, 0x49, 0x0a,
+	0x0a, 0x84, 0x74, 0xab, 0x86, 0xf2, 0xa4, 0x94, 0xb2, 0x43, 0xe5, 0x9e, 0x56, 0xe5, 0x90, 0x99,
+	0x38, 0x5f, 0x4b, 0x7c, 0x88, 0xf0, 0x8d, 0x94, 0x0c, 0x05, 0x8f, 0x4d, 0x43, 0x35, 0xc3, 0x7a,
+	0xf1, 0xf7, 0x8a, 0xf6, 0xad, 0x3a, 0x67, 0x36, 0xd8, 0xad, 0xb8, 0xc4, 0x7b, 0xe8, 0x81, 0x80,
+	0xcf, 0x54, 0x0c, 0x08, 0xcb, 0x06, 0x30, 0x06, 0xe9, 0x4e, 0x1b, 0x6e, 0xab, 0x94, 0x1b, 0x1a,
+	0x69, 0x4f, 0x2b, 0x73, 0xe4, 0xbc, 0x28, 0x42, 0x20, 0xf1, 0x57, 0xd4, 0x38, 0xc9, 0xa2, 0x84,
+	0xb2, 0x14, 0x06, 0xc4, 0x6e, 0x49, 0xb7, 0x6e, 0x88, 0x8f, 0x7d, 0x6b, 0xbc, 0xaf, 0x8d, 0xf7,
+	0x73, 0xe3, 0xfd, 0x6d, 0x88, 0xb6, 0x38, 0xcb, 0xba, 0x9b, 0x9a, 0x76, 0xfa, 0xab, 0xb9, 0x16,
+	0x33, 0x75, 0x7c, 0xd2, 0xf7, 0x23, 0x9e, 0x06, 0xf9, 0xa0, 0xd8, 0xbf, 0x75, 0x39, 0xf8, 0x14,
+	0xa8, 0x2f, 0x43, 0x90, 0xd7, 0x39, 0x32, 0x5c, 0xbc, 0x79, 0x97, 0x2d, 0x4c, 0xe2, 0x57, 0xa8,
+	0x91, 0x50, 0xa9, 0x08, 0x0c, 0x79, 0x74, 0x4c, 0xb2, 0x93, 0xb4, 0x0f, 0xc2, 0xbd, 0xd7, 0x72,
+	0xda, 0xb5, 0x70, 0x41, 0x6f, 0xbc, 0xd3, 0xf1, 0x0f, 0x26, 0xbc, 0xfa, 0xa3, 0x8a, 0xea, 0xd6,
+	0x79, 0xbc, 0x86, 0x1a, 0x90, 0xd1, 0x7e, 0x02, 0x64, 0xc2, 0x72, 0x3d, 0x31, 0xf7, 0xc3, 0x45,
+	0xbb, 0xd1, 0x2b, 0x2c, 0xfd, 0x88, 0x16, 0x69, 0x92, 0xf0, 0x88, 0x2a, 0xc6, 0x33, 0x92, 0xb0,
+	0x94, 0x29, 0x77, 0xaa, 0xe5, 0xb4, 0x67, 0xba, 0xbe, 0x6e, 0xe2, 0xe7, 0x45, 0xf3, 0xe5, 0xbf,
+	0x35, 0x11, 0x2e, 0x14, 0x9c, 0x5d, 0x8d, 0xc1, 0x6f, 0xd1, 0x4a, 0x51, 0x40, 0xde, 0x04, 0x1b,
+	0xe8, 0xf5, 0x11, 0x03, 0xe1, 0x56, 0xf5, 0x5b, 0xc2, 0xa5, 0x42, 0x62, 0xda, 0xe9, 0xdd, 0x08,
+	0xf0, 0x01, 0xca, 0xfd, 0x20, 0x32, 0xa2, 0x09, 0x08, 0xb7, 0xf6, 0x5f, 0x75, 0xcd, 0x59, 0xc8,
+	0x81, 0x61, 0xe0, 0x01, 0xf2, 0xee, 0x8c, 0x1f, 0x89, 0x04, 0xd8, 0xfe, 0x8f, 0x00, 0xdc, 0x69,
+	0xf3, 0x6d, 0x2d, 0x95, 0x1a, 0x6c, 0xdc, 0xb5, 0xb3, 0xb2, 0x72, 0x7b, 0xfc, 0xb6, 0x72, 0xc8,
+	0x7b, 0x00, 0xbc, 0x8b, 0x9e, 0xa5, 0x74, 0x4c, 0xee, 0x0e, 0x3a, 0x19, 0x82, 0x20, 0x11, 0xcf,
+	0x94, 0xa0, 0x91, 0x72, 0xeb, 0x2d, 0xa7, 0x3d, 0x1f, 0x36, 0x53, 0x3a, 0xbe, 0x3d, 0xe3, 0x72,
+	0x1f, 0xc4, 0x56, 0x2e, 0xeb, 0xee, 0x9c, 0x5d, 0x7a, 0xce, 0xf9, 0xa5, 0xe7, 0xfc, 0xbe, 0xf4,
+	0x9c, 0x6f, 0x57, 0x5e, 0xe5, 0xfc, 0xca, 0xab, 0x7c, 0xbf, 0xf2, 0x2a, 0x87, 0xeb, 0x13, 0x67,
+	0x60, 0x6f, 0x1a, 0xfb, 0x3b, 0xea, 0xbc, 0x0e, 0xc6, 0x93, 0xb7, 0x8e, 0x39, 0x8e, 0x7e, 0xdd,
+	0x5c, 0x2c, 0x9b, 0x7f, 0x06, 0x00, 0x51, 0x64, 0x32, 0xf7, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +275,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastEpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastEpochNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UnclaimedRewards) > 0 {
+		for iNdEx := len(m.UnclaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for iNdEx := len(m.RewardIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.IncentivePrograms) > 0 {
+		for iNdEx := len(m.IncentivePrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GasMeters) > 0 {
 		for iNdEx := len(m.GasMeters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +383,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxIncentiveProgramsPerContract != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxIncentiveProgramsPerContract))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.IncentiveProgramCreationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.RewardScaler.Size()
 		i -= size
@@ -336,6 +469,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.IncentivePrograms) > 0 {
+		for _, e := range m.IncentivePrograms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardIndexes) > 0 {
+		for _, e := range m.RewardIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnclaimedRewards) > 0 {
+		for _, e := range m.UnclaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastEpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.LastEpochNumber))
+	}
 	return n
 }
 
@@ -356,6 +510,11 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RewardScaler.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.IncentiveProgramCreationFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxIncentiveProgramsPerContract != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxIncentiveProgramsPerContract))
+	}
 	return n
 }
 
@@ -495,6 +654,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePrograms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePrograms = append(m.IncentivePrograms, IncentiveProgram{})
+			if err := m.IncentivePrograms[len(m.IncentivePrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndexes = append(m.RewardIndexes, RewardIndex{})
+			if err := m.RewardIndexes[len(m.RewardIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnclaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnclaimedRewards = append(m.UnclaimedRewards, types.DecCoin{})
+			if err := m.UnclaimedRewards[len(m.UnclaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochNumber", wireType)
+			}
+			m.LastEpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -665,6 +945,58 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveProgramCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveProgramCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncentiveProgramsPerContract", wireType)
+			}
+			m.MaxIncentiveProgramsPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncentiveProgramsPerContract |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,8 @@ func NewIncentive(
 		Allocations: allocations,
 		Epochs:      epochs,
 		TotalGas:    0,
+
+		WeightingStrategy: DefaultWeightingStrategy(),
 	}
 }
 
@@ -47,7 +49,8 @@ func (i Incentive) Validate() error {
 	if i.Epochs == 0 {
 		return fmt.Errorf("epoch cannot be 0")
 	}
-	return nil
+
+	return i.WeightingStrategy.Validate()
 }
 
 // IsActive returns true if the Incentive has remaining Epochs
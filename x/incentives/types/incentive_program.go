@@ -0,0 +1,109 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v15/types"
+)
+
+// NewIncentiveProgram returns an instance of IncentiveProgram
+func NewIncentiveProgram(
+	id uint64,
+	creator sdk.AccAddress,
+	contract common.Address,
+	amount, participantCap sdk.Coins,
+	startEpoch, endEpoch uint64,
+) IncentiveProgram {
+	return IncentiveProgram{
+		Id:               id,
+		Creator:          creator.String(),
+		Contract:         contract.String(),
+		RemainingRewards: amount,
+		ParticipantCap:   participantCap,
+		StartEpoch:       startEpoch,
+		EndEpoch:         endEpoch,
+	}
+}
+
+// Validate performs a stateless validation of an IncentiveProgram
+func (p IncentiveProgram) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	if err := evmostypes.ValidateAddress(p.Contract); err != nil {
+		return err
+	}
+
+	if err := p.RemainingRewards.Validate(); err != nil {
+		return fmt.Errorf("invalid remaining rewards: %w", err)
+	}
+
+	if err := p.ParticipantCap.Validate(); err != nil {
+		return fmt.Errorf("invalid participant cap: %w", err)
+	}
+
+	return validateEpochRange(p.StartEpoch, p.EndEpoch)
+}
+
+// validateEpochRange checks that the start epoch is positive and isn't after
+// the end epoch.
+func validateEpochRange(startEpoch, endEpoch uint64) error {
+	if startEpoch == 0 {
+		return fmt.Errorf("start epoch cannot be 0")
+	}
+
+	if endEpoch < startEpoch {
+		return fmt.Errorf("end epoch %d cannot be before start epoch %d", endEpoch, startEpoch)
+	}
+
+	return nil
+}
+
+// EpochRewards returns the rewards to be distributed at the end of the given
+// epoch, which is the remaining rewards split evenly over the remaining epochs.
+// As the rewards not distributed in an epoch remain escrowed, they are
+// distributed in the next epochs.
+func (p IncentiveProgram) EpochRewards(epoch uint64) sdk.Coins {
+	if epoch < p.StartEpoch || epoch > p.EndEpoch {
+		return sdk.Coins{}
+	}
+
+	remainingEpochs := sdk.NewIntFromUint64(p.EndEpoch - epoch + 1)
+	rewards := sdk.Coins{}
+	for _, coin := range p.RemainingRewards {
+		amount := coin.Amount.Quo(remainingEpochs)
+		if amount.IsPositive() {
+			rewards = rewards.Add(sdk.Coin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+	return rewards
+}
+
+// CapRewardPerGas caps the given rewards per unit of gas, so that the
+// participant who spent the given gas during the epoch isn't rewarded more than
+// the participant cap of the program. As every participant spent at most that
+// gas, none of them is rewarded more than the cap.
+func (p IncentiveProgram) CapRewardPerGas(rewardPerGas sdk.DecCoins, participantGas uint64) sdk.DecCoins {
+	if participantGas == 0 {
+		return rewardPerGas
+	}
+
+	gas := sdk.NewDecFromInt(sdk.NewIntFromUint64(participantGas))
+	capped := sdk.DecCoins{}
+	for _, coin := range rewardPerGas {
+		if maxAmount := p.ParticipantCap.AmountOf(coin.Denom); maxAmount.IsPositive() {
+			coin.Amount = sdk.MinDec(coin.Amount, sdk.NewDecFromInt(maxAmount).QuoTruncate(gas))
+		}
+
+		if coin.IsPositive() {
+			capped = capped.Add(coin)
+		}
+	}
+	return capped
+}
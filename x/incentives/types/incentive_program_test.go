@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+type IncentiveProgramTestSuite struct {
+	suite.Suite
+}
+
+func TestIncentiveProgramSuite(t *testing.T) {
+	suite.Run(t, new(IncentiveProgramTestSuite))
+}
+
+func (suite *IncentiveProgramTestSuite) TestIncentiveProgramValidate() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin("acoin", 100))
+
+	testCases := []struct {
+		name       string
+		program    types.IncentiveProgram
+		expectPass bool
+	}{
+		{
+			"pass",
+			types.NewIncentiveProgram(1, creator, utiltx.GenerateAddress(), amount, nil, 1, 1),
+			true,
+		},
+		{
+			"invalid creator",
+			types.IncentiveProgram{Id: 1, Creator: "creator", Contract: utiltx.GenerateAddress().String(), StartEpoch: 1, EndEpoch: 1},
+			false,
+		},
+		{
+			"invalid contract",
+			types.IncentiveProgram{Id: 1, Creator: creator.String(), Contract: "contract", StartEpoch: 1, EndEpoch: 1},
+			false,
+		},
+		{
+			"zero start epoch",
+			types.NewIncentiveProgram(1, creator, utiltx.GenerateAddress(), amount, nil, 0, 1),
+			false,
+		},
+		{
+			"end epoch before start epoch",
+			types.NewIncentiveProgram(1, creator, utiltx.GenerateAddress(), amount, nil, 2, 1),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.program.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *IncentiveProgramTestSuite) TestEpochRewards() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("acoin", 100), sdk.NewInt64Coin("bcoin", 2))
+	program := types.NewIncentiveProgram(1, sdk.AccAddress{}, utiltx.GenerateAddress(), amount, nil, 2, 4)
+
+	suite.Require().True(program.EpochRewards(1).IsZero())
+	suite.Require().True(program.EpochRewards(5).IsZero())
+	// the amounts lower than the remaining epochs aren't distributed yet
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("acoin", 33)), program.EpochRewards(2))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("acoin", 50), sdk.NewInt64Coin("bcoin", 1)), program.EpochRewards(3))
+	suite.Require().Equal(amount, program.EpochRewards(4))
+}
+
+func (suite *IncentiveProgramTestSuite) TestCapRewardPerGas() {
+	participantCap := sdk.NewCoins(sdk.NewInt64Coin("acoin", 10))
+	program := types.NewIncentiveProgram(1, sdk.AccAddress{}, utiltx.GenerateAddress(), nil, participantCap, 1, 1)
+
+	rewardPerGas := sdk.NewDecCoins(sdk.NewInt64DecCoin("acoin", 2), sdk.NewInt64DecCoin("bcoin", 2))
+
+	// the participant who spent 10 gas would be rewarded 20 acoin
+	suite.Require().Equal(
+		sdk.NewDecCoins(sdk.NewInt64DecCoin("acoin", 1), sdk.NewInt64DecCoin("bcoin", 2)),
+		program.CapRewardPerGas(rewardPerGas, 10),
+	)
+	// the participant who spent 5 gas is rewarded 10 acoin
+	suite.Require().Equal(rewardPerGas, program.CapRewardPerGas(rewardPerGas, 5))
+	suite.Require().Equal(rewardPerGas, program.CapRewardPerGas(rewardPerGas, 0))
+}
+
+func (suite *IncentiveProgramTestSuite) TestMsgCreateIncentiveProgramValidateBasic() {
+	creator := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	contract := utiltx.GenerateAddress().String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("acoin", 100))
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgCreateIncentiveProgram
+		expectPass bool
+	}{
+		{"pass", &types.MsgCreateIncentiveProgram{Creator: creator, Contract: contract, Amount: amount, StartEpoch: 1, EndEpoch: 2}, true},
+		{"invalid creator", &types.MsgCreateIncentiveProgram{Creator: "creator", Contract: contract, Amount: amount, StartEpoch: 1, EndEpoch: 2}, false},
+		{"invalid contract", &types.MsgCreateIncentiveProgram{Creator: creator, Contract: "contract", Amount: amount, StartEpoch: 1, EndEpoch: 2}, false},
+		{"empty amount", &types.MsgCreateIncentiveProgram{Creator: creator, Contract: contract, StartEpoch: 1, EndEpoch: 2}, false},
+		{"invalid epoch range", &types.MsgCreateIncentiveProgram{Creator: creator, Contract: contract, Amount: amount, StartEpoch: 2, EndEpoch: 1}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -98,6 +98,8 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -109,6 +111,8 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -120,6 +124,8 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -131,6 +137,8 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			true,
 		},
@@ -161,6 +169,8 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			true,
 		},
@@ -172,6 +182,8 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				0,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -42,6 +43,11 @@ type Incentive struct {
 	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// total_gas is the cumulative gas spent by all gas meters of the incentive during the epoch
 	TotalGas uint64 `protobuf:"varint,5,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// weighting_strategy defines how the gas spent by the participants is credited
+	WeightingStrategy WeightingStrategy `protobuf:"bytes,6,opt,name=weighting_strategy,json=weightingStrategy,proto3" json:"weighting_strategy"`
+	// gas_threshold is the gas used by a transaction above which its gas is discounted,
+	// computed at the end of each epoch from the gas percentile of the weighting strategy
+	GasThreshold uint64 `protobuf:"varint,7,opt,name=gas_threshold,json=gasThreshold,proto3" json:"gas_threshold,omitempty"`
 }
 
 func (m *Incentive) Reset()         { *m = Incentive{} }
@@ -112,7 +118,94 @@ func (m *Incentive) GetTotalGas() uint64 {
 	return 0
 }
 
-// GasMeter tracks the cumulative gas spent per participant in one epoch
+func (m *Incentive) GetWeightingStrategy() WeightingStrategy {
+	if m != nil {
+		return m.WeightingStrategy
+	}
+	return WeightingStrategy{}
+}
+
+func (m *Incentive) GetGasThreshold() uint64 {
+	if m != nil {
+		return m.GasThreshold
+	}
+	return 0
+}
+
+// WeightingStrategy defines how the gas spent by the participants of an
+// incentive is credited to their gas meters, to prevent rewarding wash trading
+// and gas wasting transactions. Each rule is disabled by its zero value.
+type WeightingStrategy struct {
+	// max_gas_per_epoch is the max gas credited to a participant per epoch
+	MaxGasPerEpoch uint64 `protobuf:"varint,1,opt,name=max_gas_per_epoch,json=maxGasPerEpoch,proto3" json:"max_gas_per_epoch,omitempty"`
+	// min_account_age is the number of blocks since the first interaction of a
+	// participant with the incentive before its gas is credited
+	MinAccountAge uint64 `protobuf:"varint,2,opt,name=min_account_age,json=minAccountAge,proto3" json:"min_account_age,omitempty"`
+	// discount is the share of the gas that isn't credited for the transactions
+	// above the gas threshold and for the participants with reverted transactions
+	// during the epoch
+	Discount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=discount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"discount"`
+	// gas_percentile is the percentile of the gas used by the transactions on the
+	// contract during an epoch that defines the gas threshold of the next epoch
+	GasPercentile uint32 `protobuf:"varint,4,opt,name=gas_percentile,json=gasPercentile,proto3" json:"gas_percentile,omitempty"`
+}
+
+func (m *WeightingStrategy) Reset()         { *m = WeightingStrategy{} }
+func (m *WeightingStrategy) String() string { return proto.CompactTextString(m) }
+func (*WeightingStrategy) ProtoMessage()    {}
+func (*WeightingStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{1}
+}
+func (m *WeightingStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightingStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightingStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightingStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightingStrategy.Merge(m, src)
+}
+func (m *WeightingStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightingStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightingStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightingStrategy proto.InternalMessageInfo
+
+func (m *WeightingStrategy) GetMaxGasPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxGasPerEpoch
+	}
+	return 0
+}
+
+func (m *WeightingStrategy) GetMinAccountAge() uint64 {
+	if m != nil {
+		return m.MinAccountAge
+	}
+	return 0
+}
+
+func (m *WeightingStrategy) GetGasPercentile() uint32 {
+	if m != nil {
+		return m.GasPercentile
+	}
+	return 0
+}
+
+// GasMeter tracks the cumulative gas spent per participant in one epoch, along
+// with the participant rewards of the previous epochs that are not claimed yet
 type GasMeter struct {
 	// contract is the hex address of the incentivized smart contract
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
@@ -120,13 +213,22 @@ type GasMeter struct {
 	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
 	// cumulative_gas spent during the epoch
 	CumulativeGas uint64 `protobuf:"varint,3,opt,name=cumulative_gas,json=cumulativeGas,proto3" json:"cumulative_gas,omitempty"`
+	// epoch of the incentives epoch identifier during which the cumulative gas was spent
+	Epoch uint64 `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// reward_index is the reward per gas index of the contract at the start of the epoch
+	RewardIndex github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=reward_index,json=rewardIndex,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_index"`
+	// pending_rewards are the rewards of the previous epochs that are not claimed yet
+	PendingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=pending_rewards,json=pendingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"pending_rewards"`
+	// reverted_txs is the number of transactions of the participant on the contract
+	// reverted during the epoch
+	RevertedTxs uint64 `protobuf:"varint,7,opt,name=reverted_txs,json=revertedTxs,proto3" json:"reverted_txs,omitempty"`
 }
 
 func (m *GasMeter) Reset()         { *m = GasMeter{} }
 func (m *GasMeter) String() string { return proto.CompactTextString(m) }
 func (*GasMeter) ProtoMessage()    {}
 func (*GasMeter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{1}
+	return fileDescriptor_95b81e40854aec77, []int{2}
 }
 func (m *GasMeter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,6 +278,202 @@ func (m *GasMeter) GetCumulativeGas() uint64 {
 	return 0
 }
 
+func (m *GasMeter) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GasMeter) GetRewardIndex() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardIndex
+	}
+	return nil
+}
+
+func (m *GasMeter) GetPendingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GasMeter) GetRevertedTxs() uint64 {
+	if m != nil {
+		return m.RevertedTxs
+	}
+	return 0
+}
+
+// RewardIndex defines the cumulative rewards per unit of gas allocated to the
+// participants of an incentivized contract up to the end of an epoch
+type RewardIndex struct {
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// epoch of the incentives epoch identifier at the end of which the index was recorded
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// index is the cumulative rewards per unit of gas
+	Index github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=index,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"index"`
+}
+
+func (m *RewardIndex) Reset()         { *m = RewardIndex{} }
+func (m *RewardIndex) String() string { return proto.CompactTextString(m) }
+func (*RewardIndex) ProtoMessage()    {}
+func (*RewardIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{3}
+}
+func (m *RewardIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardIndex.Merge(m, src)
+}
+func (m *RewardIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardIndex proto.InternalMessageInfo
+
+func (m *RewardIndex) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *RewardIndex) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *RewardIndex) GetIndex() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Index
+	}
+	return nil
+}
+
+// IncentiveProgram defines a self-funded incentive of a smart contract. Its
+// rewards are escrowed by the creator and distributed to the contract
+// participants at the end of each epoch between the start and end epochs.
+type IncentiveProgram struct {
+	// id is the unique identifier of the program
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the bech32 address of the account funding the program
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// remaining_rewards are the escrowed rewards that are not distributed yet
+	RemainingRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=remaining_rewards,json=remainingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_rewards"`
+	// participant_cap is the max amount of each denom rewarded to a participant per epoch.
+	// The denoms without a cap are not capped.
+	ParticipantCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=participant_cap,json=participantCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"participant_cap"`
+	// start_epoch is the first epoch of the incentives epoch identifier rewarded by the program
+	StartEpoch uint64 `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch of the incentives epoch identifier rewarded by the program
+	EndEpoch uint64 `protobuf:"varint,7,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+}
+
+func (m *IncentiveProgram) Reset()         { *m = IncentiveProgram{} }
+func (m *IncentiveProgram) String() string { return proto.CompactTextString(m) }
+func (*IncentiveProgram) ProtoMessage()    {}
+func (*IncentiveProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{4}
+}
+func (m *IncentiveProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentiveProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveProgram.Merge(m, src)
+}
+func (m *IncentiveProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveProgram proto.InternalMessageInfo
+
+func (m *IncentiveProgram) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *IncentiveProgram) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *IncentiveProgram) GetRemainingRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingRewards
+	}
+	return nil
+}
+
+func (m *IncentiveProgram) GetParticipantCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ParticipantCap
+	}
+	return nil
+}
+
+func (m *IncentiveProgram) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *IncentiveProgram) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
 // RegisterIncentiveProposal is a gov Content type to register an incentive
 type RegisterIncentiveProposal struct {
 	// title of the proposal
@@ -188,13 +486,15 @@ type RegisterIncentiveProposal struct {
 	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
 	// epochs is the number of remaining epochs for the incentive
 	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// weighting_strategy defines how the gas spent by the participants is credited
+	WeightingStrategy WeightingStrategy `protobuf:"bytes,6,opt,name=weighting_strategy,json=weightingStrategy,proto3" json:"weighting_strategy"`
 }
 
 func (m *RegisterIncentiveProposal) Reset()         { *m = RegisterIncentiveProposal{} }
 func (m *RegisterIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterIncentiveProposal) ProtoMessage()    {}
 func (*RegisterIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{2}
+	return fileDescriptor_95b81e40854aec77, []int{5}
 }
 func (m *RegisterIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +558,13 @@ func (m *RegisterIncentiveProposal) GetEpochs() uint32 {
 	return 0
 }
 
+func (m *RegisterIncentiveProposal) GetWeightingStrategy() WeightingStrategy {
+	if m != nil {
+		return m.WeightingStrategy
+	}
+	return WeightingStrategy{}
+}
+
 // CancelIncentiveProposal is a gov Content type to cancel an incentive
 type CancelIncentiveProposal struct {
 	// title of the proposal
@@ -272,7 +579,7 @@ func (m *CancelIncentiveProposal) Reset()         { *m = CancelIncentiveProposal
 func (m *CancelIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelIncentiveProposal) ProtoMessage()    {}
 func (*CancelIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{3}
+	return fileDescriptor_95b81e40854aec77, []int{6}
 }
 func (m *CancelIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +631,10 @@ func (m *CancelIncentiveProposal) GetContract() string {
 
 func init() {
 	proto.RegisterType((*Incentive)(nil), "evmos.incentives.v1.Incentive")
+	proto.RegisterType((*WeightingStrategy)(nil), "evmos.incentives.v1.WeightingStrategy")
 	proto.RegisterType((*GasMeter)(nil), "evmos.incentives.v1.GasMeter")
+	proto.RegisterType((*RewardIndex)(nil), "evmos.incentives.v1.RewardIndex")
+	proto.RegisterType((*IncentiveProgram)(nil), "evmos.incentives.v1.IncentiveProgram")
 	proto.RegisterType((*RegisterIncentiveProposal)(nil), "evmos.incentives.v1.RegisterIncentiveProposal")
 	proto.RegisterType((*CancelIncentiveProposal)(nil), "evmos.incentives.v1.CancelIncentiveProposal")
 }
@@ -334,38 +644,63 @@ func init() {
 }
 
 var fileDescriptor_95b81e40854aec77 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbf, 0x6f, 0xd4, 0x30,
-	0x18, 0x4d, 0xee, 0x47, 0x75, 0xe7, 0x53, 0x19, 0x42, 0x05, 0xe1, 0x40, 0x49, 0x74, 0x02, 0x29,
-	0x12, 0xaa, 0xad, 0xb4, 0x62, 0x61, 0xbc, 0x43, 0x3a, 0x31, 0x20, 0xa1, 0x88, 0x89, 0xa5, 0x72,
-	0x5c, 0x93, 0x5a, 0x24, 0x71, 0x14, 0x7f, 0x17, 0xc1, 0xca, 0x5f, 0xd0, 0x89, 0x99, 0x99, 0xbf,
-	0xa4, 0x63, 0x47, 0x26, 0x8a, 0xee, 0x16, 0xfe, 0x0c, 0x64, 0x27, 0x29, 0xe9, 0xd2, 0xf1, 0x96,
-	0xc4, 0xef, 0xd9, 0x9f, 0xde, 0x7b, 0xdf, 0x67, 0xa3, 0xe7, 0xbc, 0xce, 0xa5, 0x22, 0xa2, 0x60,
-	0xbc, 0x00, 0x51, 0x73, 0x45, 0xea, 0xa8, 0x87, 0x70, 0x59, 0x49, 0x90, 0xce, 0x43, 0x73, 0x0a,
-	0xf7, 0xf8, 0x3a, 0x9a, 0x7b, 0x4c, 0x2a, 0x5d, 0x9b, 0x50, 0xc5, 0x49, 0x1d, 0x25, 0x1c, 0x68,
-	0x44, 0x98, 0x14, 0x45, 0x53, 0x34, 0x3f, 0x4a, 0x65, 0x2a, 0xcd, 0x92, 0xe8, 0x55, 0xcb, 0xfa,
-	0xa9, 0x94, 0x69, 0xc6, 0x89, 0x41, 0xc9, 0xe6, 0x13, 0x01, 0x91, 0x73, 0x05, 0x34, 0x2f, 0x9b,
-	0x03, 0x8b, 0xef, 0x03, 0x34, 0x7d, 0xdb, 0x09, 0x39, 0x73, 0x34, 0x61, 0xb2, 0x80, 0x8a, 0x32,
-	0x70, 0xed, 0xc0, 0x0e, 0xa7, 0xf1, 0x2d, 0x76, 0x14, 0x9a, 0xd1, 0x2c, 0x93, 0x8c, 0x82, 0x90,
-	0x85, 0x72, 0x07, 0xc1, 0x30, 0x9c, 0x9d, 0x3c, 0xc3, 0x8d, 0x2d, 0xac, 0x6d, 0xe1, 0xd6, 0x16,
-	0x7e, 0xc3, 0xd9, 0x4a, 0x8a, 0x62, 0x79, 0x7a, 0xf5, 0xdb, 0xb7, 0x7e, 0xde, 0xf8, 0x2f, 0x53,
-	0x01, 0x17, 0x9b, 0x04, 0x33, 0x99, 0x93, 0x36, 0x46, 0xf3, 0x3b, 0x56, 0xe7, 0x9f, 0x09, 0x7c,
-	0x2d, 0xb9, 0xea, 0x6a, 0x54, 0xdc, 0x57, 0x71, 0x1e, 0xa1, 0x03, 0x5e, 0x4a, 0x76, 0xa1, 0xdc,
-	0x61, 0x60, 0x87, 0x87, 0x71, 0x8b, 0x9c, 0x15, 0x42, 0x0a, 0x68, 0x05, 0x67, 0x3a, 0x8f, 0x3b,
-	0x0a, 0xec, 0x70, 0x76, 0x32, 0xc7, 0x4d, 0x58, 0xdc, 0x85, 0xc5, 0x1f, 0xba, 0xb0, 0xcb, 0x89,
-	0x76, 0x72, 0x79, 0xe3, 0xdb, 0xf1, 0xd4, 0xd4, 0xe9, 0x1d, 0xe7, 0x29, 0x9a, 0x82, 0x04, 0x9a,
-	0x9d, 0xa5, 0x54, 0xb9, 0xe3, 0xc0, 0x0e, 0x47, 0xf1, 0xc4, 0x10, 0x6b, 0xaa, 0x16, 0x12, 0x4d,
-	0xd6, 0x54, 0xbd, 0xe3, 0xc0, 0xab, 0x7b, 0xdb, 0x12, 0xa0, 0x59, 0x49, 0x2b, 0x10, 0x4c, 0x94,
-	0xb4, 0x00, 0x77, 0x60, 0xb6, 0xfb, 0x94, 0xf3, 0x02, 0x3d, 0x60, 0x9b, 0x7c, 0x93, 0x51, 0xdd,
-	0x62, 0xa3, 0x35, 0x34, 0x5a, 0x87, 0xff, 0x59, 0x2d, 0xf8, 0x6d, 0x80, 0x9e, 0xc4, 0x3c, 0x15,
-	0x0a, 0x78, 0x75, 0x3b, 0x91, 0xf7, 0x95, 0x2c, 0xa5, 0xa2, 0x99, 0x73, 0x84, 0xc6, 0x20, 0x20,
-	0xe3, 0xad, 0x7e, 0x03, 0xb4, 0xf8, 0x39, 0x57, 0xac, 0x12, 0xa5, 0x6e, 0x57, 0x27, 0xde, 0xa3,
-	0xee, 0x58, 0x1f, 0xde, 0x3f, 0xd1, 0xd1, 0x9e, 0x27, 0x3a, 0xee, 0x4f, 0xf4, 0xf5, 0xe8, 0xef,
-	0x0f, 0xdf, 0x5a, 0x28, 0xf4, 0x78, 0x45, 0x0b, 0xc6, 0xb3, 0xbd, 0x74, 0xa0, 0x11, 0x5d, 0xae,
-	0xaf, 0xb6, 0x9e, 0x7d, 0xbd, 0xf5, 0xec, 0x3f, 0x5b, 0xcf, 0xbe, 0xdc, 0x79, 0xd6, 0xf5, 0xce,
-	0xb3, 0x7e, 0xed, 0x3c, 0xeb, 0xe3, 0x71, 0x2f, 0x66, 0xf3, 0x74, 0x9b, 0x6f, 0x1d, 0xbd, 0x22,
-	0x5f, 0xfa, 0xcf, 0xd8, 0x24, 0x4e, 0x0e, 0xcc, 0xcd, 0x3b, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0xe0, 0x4a, 0xfe, 0xe7, 0x03, 0x00, 0x00,
+	// 891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xf3, 0x6b, 0x93, 0xc9, 0x36, 0xdd, 0x0e, 0x2b, 0x70, 0xbb, 0x28, 0x09, 0x01, 0xaa,
+	0x20, 0x54, 0x9b, 0xec, 0x8a, 0x0b, 0xe2, 0xb2, 0x29, 0xa8, 0xda, 0x03, 0x52, 0x65, 0x2a, 0x81,
+	0xe0, 0x60, 0x4d, 0xec, 0x87, 0x33, 0xc2, 0x9e, 0xb1, 0x66, 0x26, 0x69, 0x96, 0x3f, 0x80, 0xf3,
+	0x1e, 0x11, 0x27, 0x8e, 0x88, 0x33, 0x7f, 0xc4, 0x1e, 0x57, 0x9c, 0x10, 0x87, 0x2d, 0x6a, 0x0f,
+	0xf0, 0x67, 0xa0, 0x99, 0xb1, 0x83, 0xb7, 0xac, 0xaa, 0x3d, 0xd0, 0x5e, 0xda, 0xbc, 0xef, 0xcd,
+	0xf8, 0x7b, 0xdf, 0xfb, 0x9e, 0x67, 0x8c, 0xde, 0x81, 0x55, 0xc6, 0xa5, 0x4f, 0x59, 0x04, 0x4c,
+	0xd1, 0x15, 0x48, 0x7f, 0x35, 0xad, 0x44, 0x5e, 0x2e, 0xb8, 0xe2, 0xf8, 0x35, 0xb3, 0xca, 0xab,
+	0xe0, 0xab, 0xe9, 0xde, 0x20, 0xe2, 0x52, 0xef, 0x9d, 0x13, 0x09, 0xfe, 0x6a, 0x3a, 0x07, 0x45,
+	0xa6, 0x7e, 0xc4, 0x29, 0xb3, 0x9b, 0xf6, 0x76, 0x6d, 0x3e, 0x34, 0x91, 0x6f, 0x83, 0x22, 0x75,
+	0x37, 0xe1, 0x09, 0xb7, 0xb8, 0xfe, 0x55, 0xa0, 0xc3, 0x84, 0xf3, 0x24, 0x05, 0xdf, 0x44, 0xf3,
+	0xe5, 0x37, 0xbe, 0xa2, 0x19, 0x48, 0x45, 0xb2, 0xdc, 0x2e, 0x18, 0xff, 0xd0, 0x40, 0xdd, 0x47,
+	0x65, 0x0d, 0x78, 0x0f, 0x75, 0x22, 0xce, 0x94, 0x20, 0x91, 0x72, 0x9d, 0x91, 0x33, 0xe9, 0x06,
+	0x9b, 0x18, 0x4b, 0xd4, 0x23, 0x69, 0xca, 0x23, 0xa2, 0x28, 0x67, 0xd2, 0xad, 0x8f, 0x1a, 0x93,
+	0xde, 0xfd, 0x37, 0xbd, 0xa2, 0x08, 0x5d, 0xb1, 0x57, 0x54, 0xec, 0x7d, 0x02, 0xd1, 0x21, 0xa7,
+	0x6c, 0xf6, 0xe0, 0xe9, 0xf3, 0x61, 0xed, 0x97, 0xb3, 0xe1, 0xfb, 0x09, 0x55, 0x8b, 0xe5, 0xdc,
+	0x8b, 0x78, 0x56, 0x14, 0x5d, 0xfc, 0x3b, 0x90, 0xf1, 0xb7, 0xbe, 0x7a, 0x9c, 0x83, 0x2c, 0xf7,
+	0xc8, 0xa0, 0xca, 0x82, 0x5f, 0x47, 0x6d, 0xc8, 0x79, 0xb4, 0x90, 0x6e, 0x63, 0xe4, 0x4c, 0xb6,
+	0x82, 0x22, 0xc2, 0x87, 0x08, 0x49, 0x45, 0x84, 0x0a, 0xb5, 0x1e, 0xb7, 0x39, 0x72, 0x26, 0xbd,
+	0xfb, 0x7b, 0x9e, 0x15, 0xeb, 0x95, 0x62, 0xbd, 0x93, 0x52, 0xec, 0xac, 0xa3, 0x2b, 0x79, 0x72,
+	0x36, 0x74, 0x82, 0xae, 0xd9, 0xa7, 0x33, 0xf8, 0x1e, 0xea, 0x2a, 0xae, 0x48, 0x1a, 0x26, 0x44,
+	0xba, 0xad, 0x91, 0x33, 0x69, 0x06, 0x1d, 0x03, 0x1c, 0x11, 0x89, 0xbf, 0x46, 0xf8, 0x14, 0x68,
+	0xb2, 0x50, 0x94, 0x25, 0xa1, 0x54, 0x82, 0x28, 0x48, 0x1e, 0xbb, 0x6d, 0xc3, 0xb4, 0xef, 0xbd,
+	0xc4, 0x3c, 0xef, 0x8b, 0x72, 0xf9, 0xe7, 0xc5, 0xea, 0x59, 0x53, 0xb3, 0x06, 0x3b, 0xa7, 0x97,
+	0x13, 0xf8, 0x6d, 0xb4, 0x95, 0x10, 0x19, 0xaa, 0x85, 0x00, 0xb9, 0xe0, 0x69, 0xec, 0xde, 0x32,
+	0xec, 0xb7, 0x13, 0x22, 0x4f, 0x4a, 0x6c, 0xfc, 0x97, 0x83, 0x76, 0xfe, 0xf3, 0x4c, 0xfc, 0x1e,
+	0xda, 0xc9, 0xc8, 0x5a, 0x97, 0x1c, 0xe6, 0x20, 0x42, 0xd3, 0x0f, 0xe3, 0x55, 0x33, 0xe8, 0x67,
+	0x64, 0x7d, 0x44, 0xe4, 0x31, 0x88, 0x4f, 0x35, 0x8a, 0xf7, 0xd1, 0x76, 0x46, 0x59, 0x48, 0xa2,
+	0x88, 0x2f, 0x99, 0x0a, 0x49, 0x02, 0x6e, 0xdd, 0x2c, 0xdc, 0xca, 0x28, 0x7b, 0x68, 0xd1, 0x87,
+	0x09, 0xe0, 0x2f, 0x51, 0x27, 0xa6, 0xd2, 0x84, 0xa6, 0xcd, 0xdd, 0xd9, 0xc7, 0xba, 0xf0, 0x3f,
+	0x9e, 0x0f, 0xf7, 0x5f, 0xcd, 0xb8, 0xdf, 0x7e, 0x3d, 0x40, 0x16, 0xd7, 0x51, 0xb0, 0x79, 0x1a,
+	0x7e, 0x17, 0xf5, 0x8b, 0x42, 0x4d, 0xa3, 0x52, 0x6b, 0xd5, 0x56, 0xa0, 0xd5, 0x1f, 0x6f, 0xc0,
+	0xf1, 0x8f, 0x0d, 0xd4, 0x39, 0x22, 0xf2, 0x33, 0x50, 0x20, 0xae, 0x9c, 0xc1, 0x11, 0xea, 0xe5,
+	0x44, 0x28, 0x1a, 0xd1, 0x9c, 0x30, 0x65, 0xd4, 0x74, 0x83, 0x2a, 0xa4, 0x19, 0xa3, 0x65, 0xb6,
+	0x4c, 0x89, 0xb6, 0xc5, 0x18, 0xdb, 0xb0, 0x92, 0xff, 0x45, 0xb5, 0xbb, 0x77, 0x51, 0xcb, 0x76,
+	0xae, 0x69, 0xb2, 0x36, 0xc0, 0x0a, 0xdd, 0x16, 0x70, 0x4a, 0x44, 0x1c, 0x52, 0x16, 0xc3, 0xda,
+	0x6d, 0x5d, 0xdb, 0x8c, 0x5b, 0x9a, 0x47, 0x9a, 0x05, 0x7f, 0x87, 0xb6, 0x73, 0x60, 0xb1, 0x9e,
+	0x33, 0x0b, 0x4b, 0xb7, 0x7d, 0x5d, 0xc4, 0xfd, 0x82, 0x29, 0xb0, 0x44, 0xf8, 0x2d, 0xad, 0x78,
+	0x05, 0x42, 0x41, 0x1c, 0xaa, 0xb5, 0x2c, 0xe6, 0xb0, 0x57, 0x62, 0x27, 0x6b, 0x39, 0xfe, 0xd9,
+	0x41, 0xbd, 0xa0, 0x52, 0xee, 0x55, 0xfe, 0x6c, 0xda, 0x5a, 0xaf, 0xb6, 0x35, 0x41, 0x2d, 0xdb,
+	0xcf, 0xc6, 0x75, 0xc9, 0xb2, 0xcf, 0x1f, 0x7f, 0xdf, 0x40, 0x77, 0x36, 0x87, 0xd9, 0xb1, 0xe0,
+	0x89, 0x20, 0x19, 0xee, 0xa3, 0x3a, 0x8d, 0x8b, 0x37, 0xa4, 0x4e, 0x63, 0xec, 0xa2, 0x5b, 0x91,
+	0x00, 0xa2, 0xb8, 0x28, 0xe6, 0xa7, 0x0c, 0x5f, 0x50, 0xd6, 0xb8, 0xa4, 0x6c, 0x8d, 0x76, 0x04,
+	0x64, 0x84, 0xb2, 0xaa, 0x4d, 0x4d, 0xa3, 0x67, 0xf7, 0xa5, 0x7a, 0x8c, 0x98, 0x0f, 0x0a, 0x31,
+	0x93, 0x57, 0x10, 0x63, 0x95, 0xdc, 0xd9, 0xb0, 0x94, 0x16, 0x29, 0xb4, 0x5d, 0x19, 0xf0, 0x30,
+	0x22, 0xb9, 0xdb, 0xfa, 0xff, 0x79, 0xfb, 0x15, 0x8e, 0x43, 0x92, 0xe3, 0x21, 0xea, 0xd9, 0x03,
+	0xd6, 0xfa, 0xd9, 0x36, 0xed, 0xb3, 0x67, 0xae, 0x3d, 0x5c, 0xee, 0xa1, 0x2e, 0xb0, 0xb8, 0x48,
+	0xdb, 0xb1, 0xe9, 0x00, 0x8b, 0x4d, 0x72, 0x7c, 0x56, 0x47, 0xbb, 0x01, 0x24, 0x54, 0x2a, 0x10,
+	0x55, 0x43, 0x72, 0x2e, 0x49, 0xaa, 0xa7, 0x44, 0x51, 0x95, 0x42, 0x31, 0x3e, 0x36, 0xd0, 0xef,
+	0x76, 0x0c, 0x32, 0x12, 0x34, 0xd7, 0x47, 0x7f, 0xf9, 0x6e, 0x57, 0xa0, 0x2b, 0xfd, 0xb9, 0x74,
+	0x3b, 0x35, 0x6f, 0xf8, 0x76, 0x6a, 0xbd, 0x70, 0x3b, 0x5d, 0xe7, 0xdd, 0xf1, 0x51, 0xf3, 0xef,
+	0x9f, 0x86, 0xb5, 0xb1, 0x44, 0x6f, 0x1c, 0x12, 0x16, 0x41, 0x7a, 0x23, 0xed, 0xb5, 0xa4, 0xb3,
+	0xa3, 0xa7, 0xe7, 0x03, 0xe7, 0xd9, 0xf9, 0xc0, 0xf9, 0xf3, 0x7c, 0xe0, 0x3c, 0xb9, 0x18, 0xd4,
+	0x9e, 0x5d, 0x0c, 0x6a, 0xbf, 0x5f, 0x0c, 0x6a, 0x5f, 0x1d, 0x54, 0x7a, 0x68, 0x3f, 0x7f, 0xec,
+	0xdf, 0xd5, 0xf4, 0x43, 0x7f, 0x5d, 0xfd, 0x14, 0x32, 0xed, 0x9c, 0xb7, 0xcd, 0x15, 0xfd, 0xe0,
+	0x9f, 0x01, 0x00, 0x28, 0x6d, 0x37, 0x9f, 0x2b, 0x09, 0x00, 0x00,
 }
 
 func (m *Incentive) Marshal() (dAtA []byte, err error) {
@@ -388,17 +723,32 @@ func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasThreshold != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GasThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.WeightingStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.TotalGas != 0 {
 		i = encodeVarintIncentives(dAtA, i, uint64(m.TotalGas))
 		i--
 		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintIncentives(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintIncentives(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if m.Epochs != 0 {
@@ -430,7 +780,7 @@ func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GasMeter) Marshal() (dAtA []byte, err error) {
+func (m *WeightingStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -440,39 +790,45 @@ func (m *GasMeter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GasMeter) MarshalTo(dAtA []byte) (int, error) {
+func (m *WeightingStrategy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WeightingStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CumulativeGas != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
+	if m.GasPercentile != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GasPercentile))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x20
 	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.MinAccountAge != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MinAccountAge))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+	if m.MaxGasPerEpoch != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MaxGasPerEpoch))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
+func (m *GasMeter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -482,25 +838,25 @@ func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasMeter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Epochs != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+	if m.RevertedTxs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.RevertedTxs))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x38
 	}
-	if len(m.Allocations) > 0 {
-		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -508,34 +864,51 @@ func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintIncentives(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+	if len(m.RewardIndex) > 0 {
+		for iNdEx := len(m.RewardIndex) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardIndex[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x20
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+	if m.CumulativeGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CancelIncentiveProposal) Marshal() (dAtA []byte, err error) {
+func (m *RewardIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -545,22 +918,224 @@ func (m *CancelIncentiveProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CancelIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Index) > 0 {
+		for iNdEx := len(m.Index) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Index[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ParticipantCap) > 0 {
+		for iNdEx := len(m.ParticipantCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RemainingRewards) > 0 {
+		for iNdEx := len(m.RemainingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WeightingStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
@@ -614,6 +1189,31 @@ func (m *Incentive) Size() (n int) {
 	if m.TotalGas != 0 {
 		n += 1 + sovIncentives(uint64(m.TotalGas))
 	}
+	l = m.WeightingStrategy.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.GasThreshold != 0 {
+		n += 1 + sovIncentives(uint64(m.GasThreshold))
+	}
+	return n
+}
+
+func (m *WeightingStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxGasPerEpoch != 0 {
+		n += 1 + sovIncentives(uint64(m.MaxGasPerEpoch))
+	}
+	if m.MinAccountAge != 0 {
+		n += 1 + sovIncentives(uint64(m.MinAccountAge))
+	}
+	l = m.Discount.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.GasPercentile != 0 {
+		n += 1 + sovIncentives(uint64(m.GasPercentile))
+	}
 	return n
 }
 
@@ -634,6 +1234,84 @@ func (m *GasMeter) Size() (n int) {
 	if m.CumulativeGas != 0 {
 		n += 1 + sovIncentives(uint64(m.CumulativeGas))
 	}
+	if m.Epoch != 0 {
+		n += 1 + sovIncentives(uint64(m.Epoch))
+	}
+	if len(m.RewardIndex) > 0 {
+		for _, e := range m.RewardIndex {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.RevertedTxs != 0 {
+		n += 1 + sovIncentives(uint64(m.RevertedTxs))
+	}
+	return n
+}
+
+func (m *RewardIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovIncentives(uint64(m.Epoch))
+	}
+	if len(m.Index) > 0 {
+		for _, e := range m.Index {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentives(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.RemainingRewards) > 0 {
+		for _, e := range m.RemainingRewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.ParticipantCap) > 0 {
+		for _, e := range m.ParticipantCap {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovIncentives(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovIncentives(uint64(m.EndEpoch))
+	}
 	return n
 }
 
@@ -661,40 +1339,661 @@ func (m *RegisterIncentiveProposal) Size() (n int) {
 			n += 1 + l + sovIncentives(uint64(l))
 		}
 	}
-	if m.Epochs != 0 {
-		n += 1 + sovIncentives(uint64(m.Epochs))
-	}
-	return n
-}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	l = m.WeightingStrategy.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
+func (m *CancelIncentiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	return n
+}
+
+func sovIncentives(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentives(x uint64) (n int) {
+	return sovIncentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Incentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightingStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasThreshold", wireType)
+			}
+			m.GasThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightingStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightingStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightingStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerEpoch", wireType)
+			}
+			m.MaxGasPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAccountAge", wireType)
+			}
+			m.MinAccountAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAccountAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPercentile", wireType)
+			}
+			m.GasPercentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPercentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMeter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+			}
+			m.CumulativeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardIndex = append(m.RewardIndex, types.DecCoin{})
+			if err := m.RewardIndex[len(m.RewardIndex)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, types.DecCoin{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertedTxs", wireType)
+			}
+			m.RevertedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevertedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *CancelIncentiveProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIncentives(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIncentives(x uint64) (n int) {
-	return sovIncentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Incentive) Unmarshal(dAtA []byte) error {
+func (m *RewardIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -717,10 +2016,10 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -756,44 +2055,10 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIncentives
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allocations = append(m.Allocations, types.DecCoin{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Epochs = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -803,14 +2068,14 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epochs |= uint32(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -837,29 +2102,11 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Index = append(m.Index, types.DecCoin{})
+			if err := m.Index[len(m.Index)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
-			}
-			m.TotalGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIncentives
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -881,7 +2128,7 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasMeter) Unmarshal(dAtA []byte) error {
+func (m *IncentiveProgram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -904,15 +2151,34 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveProgram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveProgram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -940,11 +2206,11 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -972,13 +2238,81 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingRewards = append(m.RemainingRewards, types.Coin{})
+			if err := m.RemainingRewards[len(m.RemainingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantCap = append(m.ParticipantCap, types.Coin{})
+			if err := m.ParticipantCap[len(m.ParticipantCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			m.CumulativeGas = 0
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -988,7 +2322,26 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CumulativeGas |= uint64(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1192,6 +2545,39 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightingStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -32,6 +32,16 @@ const (
 	prefixIncentive = iota + 1
 	prefixGasMeter
 	prefixAllocationMeter
+	prefixIncentiveProgram
+	prefixIncentiveProgramByContract
+	prefixIncentiveProgramID
+	prefixLastEpochNumber
+	prefixGasMeterByParticipant
+	prefixRewardIndex
+	prefixUnclaimedRewards
+	prefixParticipantFirstSeen
+	prefixGasHistogram
+	prefixContractEpochGas
 )
 
 // KVStore key prefixes
@@ -39,6 +49,19 @@ var (
 	KeyPrefixIncentive       = []byte{prefixIncentive}
 	KeyPrefixGasMeter        = []byte{prefixGasMeter}
 	KeyPrefixAllocationMeter = []byte{prefixAllocationMeter}
+
+	KeyPrefixIncentiveProgram           = []byte{prefixIncentiveProgram}
+	KeyPrefixIncentiveProgramByContract = []byte{prefixIncentiveProgramByContract}
+	KeyIncentiveProgramID               = []byte{prefixIncentiveProgramID}
+	KeyLastEpochNumber                  = []byte{prefixLastEpochNumber}
+
+	KeyPrefixGasMeterByParticipant = []byte{prefixGasMeterByParticipant}
+	KeyPrefixRewardIndex           = []byte{prefixRewardIndex}
+	KeyPrefixUnclaimedRewards      = []byte{prefixUnclaimedRewards}
+
+	KeyPrefixParticipantFirstSeen = []byte{prefixParticipantFirstSeen}
+	KeyPrefixGasHistogram         = []byte{prefixGasHistogram}
+	KeyPrefixContractEpochGas     = []byte{prefixContractEpochGas}
 )
 
 // SplitGasMeterKey is a helper to split up KV-store keys in a
@@ -6,6 +6,10 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmostypes "github.com/evmos/evmos/v15/types"
 )
 
 var _ sdk.Msg = &MsgUpdateParams{}
@@ -29,3 +33,185 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+var _ sdk.Msg = &MsgUpdateWeightingStrategy{}
+
+// GetSigners returns the expected signers for a MsgUpdateWeightingStrategy message.
+func (m *MsgUpdateWeightingStrategy) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateWeightingStrategy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", m.Contract)
+	}
+
+	return m.WeightingStrategy.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateWeightingStrategy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+var (
+	_ sdk.Msg = &MsgCreateIncentiveProgram{}
+	_ sdk.Msg = &MsgCancelIncentiveProgram{}
+	_ sdk.Msg = &MsgClaimIncentiveRewards{}
+)
+
+const (
+	TypeMsgCreateIncentiveProgram = "create_incentive_program"
+	TypeMsgCancelIncentiveProgram = "cancel_incentive_program"
+	TypeMsgClaimIncentiveRewards  = "claim_incentive_rewards"
+)
+
+// NewMsgCreateIncentiveProgram creates new instance of MsgCreateIncentiveProgram
+func NewMsgCreateIncentiveProgram(
+	creator sdk.AccAddress,
+	contract common.Address,
+	amount, participantCap sdk.Coins,
+	startEpoch, endEpoch uint64,
+) *MsgCreateIncentiveProgram {
+	return &MsgCreateIncentiveProgram{
+		Creator:        creator.String(),
+		Contract:       contract.String(),
+		Amount:         amount,
+		ParticipantCap: participantCap,
+		StartEpoch:     startEpoch,
+		EndEpoch:       endEpoch,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgCreateIncentiveProgram) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateIncentiveProgram
+func (m MsgCreateIncentiveProgram) Type() string { return TypeMsgCreateIncentiveProgram }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCreateIncentiveProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	if err := evmostypes.ValidateNonZeroAddress(m.Contract); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", m.Contract)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.IsZero() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid program amount: %s", m.Amount)
+	}
+
+	if err := m.ParticipantCap.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid participant cap: %s", err)
+	}
+
+	if err := validateEpochRange(m.StartEpoch, m.EndEpoch); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCreateIncentiveProgram) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgCreateIncentiveProgram) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelIncentiveProgram creates new instance of MsgCancelIncentiveProgram
+func NewMsgCancelIncentiveProgram(creator sdk.AccAddress, id uint64) *MsgCancelIncentiveProgram {
+	return &MsgCancelIncentiveProgram{
+		Creator: creator.String(),
+		Id:      id,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgCancelIncentiveProgram) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCancelIncentiveProgram
+func (m MsgCancelIncentiveProgram) Type() string { return TypeMsgCancelIncentiveProgram }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCancelIncentiveProgram) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return errorsmod.Wrap(err, "invalid creator address")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelIncentiveProgram) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgCancelIncentiveProgram) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimIncentiveRewards creates new instance of MsgClaimIncentiveRewards
+func NewMsgClaimIncentiveRewards(participant sdk.AccAddress, contracts []common.Address) *MsgClaimIncentiveRewards {
+	hexContracts := make([]string, len(contracts))
+	for i, contract := range contracts {
+		hexContracts[i] = contract.String()
+	}
+
+	return &MsgClaimIncentiveRewards{
+		Participant: participant.String(),
+		Contracts:   hexContracts,
+	}
+}
+
+// Route returns the name of the module
+func (m MsgClaimIncentiveRewards) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClaimIncentiveRewards
+func (m MsgClaimIncentiveRewards) Type() string { return TypeMsgClaimIncentiveRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgClaimIncentiveRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Participant); err != nil {
+		return errorsmod.Wrap(err, "invalid participant address")
+	}
+
+	seenContracts := make(map[string]bool)
+	for _, contract := range m.Contracts {
+		if err := evmostypes.ValidateNonZeroAddress(contract); err != nil {
+			return errorsmod.Wrapf(err, "invalid contract address %s", contract)
+		}
+
+		if seenContracts[contract] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated contract %s", contract)
+		}
+		seenContracts[contract] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgClaimIncentiveRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgClaimIncentiveRewards) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Participant)
+	return []sdk.AccAddress{addr}
+}
@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/evmos/v15/utils"
 	epochstypes "github.com/evmos/evmos/v15/x/epochs/types"
 )
 
@@ -19,6 +21,12 @@ var (
 	DefaultAllocationLimit           = sdk.NewDecWithPrec(5, 2)
 	DefaultIncentivesEpochIdentifier = epochstypes.WeekEpochID
 	DefaultRewardScalar              = sdk.NewDecWithPrec(12, 1)
+	// DefaultIncentiveProgramCreationFee is the default fee of 10 EVMOS burned
+	// on the creation of an incentive program
+	DefaultIncentiveProgramCreationFee = sdk.NewCoin(utils.BaseDenom, math.NewIntWithDecimal(10, 18))
+	// DefaultMaxIncentiveProgramsPerContract is the default maximum number of
+	// incentive programs of a contract
+	DefaultMaxIncentiveProgramsPerContract = uint32(10)
 )
 
 // NewParams creates a new Params object
@@ -27,21 +35,27 @@ func NewParams(
 	allocationLimit sdk.Dec,
 	epochIdentifier string,
 	rewardScaler sdk.Dec,
+	incentiveProgramCreationFee sdk.Coin,
+	maxIncentiveProgramsPerContract uint32,
 ) Params {
 	return Params{
-		EnableIncentives:          enableIncentives,
-		AllocationLimit:           allocationLimit,
-		IncentivesEpochIdentifier: epochIdentifier,
-		RewardScaler:              rewardScaler,
+		EnableIncentives:                enableIncentives,
+		AllocationLimit:                 allocationLimit,
+		IncentivesEpochIdentifier:       epochIdentifier,
+		RewardScaler:                    rewardScaler,
+		IncentiveProgramCreationFee:     incentiveProgramCreationFee,
+		MaxIncentiveProgramsPerContract: maxIncentiveProgramsPerContract,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableIncentives:          DefaultEnableIncentives,
-		AllocationLimit:           DefaultAllocationLimit,
-		IncentivesEpochIdentifier: DefaultIncentivesEpochIdentifier,
-		RewardScaler:              DefaultRewardScalar,
+		EnableIncentives:                DefaultEnableIncentives,
+		AllocationLimit:                 DefaultAllocationLimit,
+		IncentivesEpochIdentifier:       DefaultIncentivesEpochIdentifier,
+		RewardScaler:                    DefaultRewardScalar,
+		IncentiveProgramCreationFee:     DefaultIncentiveProgramCreationFee,
+		MaxIncentiveProgramsPerContract: DefaultMaxIncentiveProgramsPerContract,
 	}
 }
 
@@ -87,6 +101,25 @@ func validateUncappedPercentage(i interface{}) error {
 	return nil
 }
 
+// validateCreationFee validates the incentive program creation fee. An empty
+// coin is valid and means that no fee is charged.
+func validateCreationFee(i interface{}) error {
+	fee, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if fee.Denom == "" && fee.Amount.IsNil() {
+		return nil
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid incentive program creation fee: %w", err)
+	}
+
+	return nil
+}
+
 func (p Params) Validate() error {
 	if err := validateBool(p.EnableIncentives); err != nil {
 		return err
@@ -100,5 +133,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCreationFee(p.IncentiveProgramCreationFee); err != nil {
+		return err
+	}
+
 	return epochstypes.ValidateEpochIdentifierString(p.IncentivesEpochIdentifier)
 }
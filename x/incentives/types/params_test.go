@@ -35,6 +35,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(5, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				DefaultIncentiveProgramCreationFee,
+				DefaultMaxIncentiveProgramsPerContract,
 			),
 			false,
 		},
@@ -45,6 +47,8 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				DefaultIncentiveProgramCreationFee,
+				DefaultMaxIncentiveProgramsPerContract,
 			),
 			false,
 		},
@@ -55,9 +59,35 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(10, 0),
+				DefaultIncentiveProgramCreationFee,
+				DefaultMaxIncentiveProgramsPerContract,
 			),
 			false,
 		},
+		{
+			"valid - no incentive program creation fee",
+			NewParams(
+				true,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				sdk.Coin{},
+				0,
+			),
+			false,
+		},
+		{
+			"invalid - negative incentive program creation fee",
+			NewParams(
+				true,
+				sdk.NewDecWithPrec(5, 2),
+				epochstypes.WeekEpochID,
+				sdk.NewDecWithPrec(15, 1),
+				sdk.Coin{Denom: "aevmos", Amount: sdk.NewInt(-1)},
+				DefaultMaxIncentiveProgramsPerContract,
+			),
+			true,
+		},
 		{
 			"invalid - empty Params",
 			Params{},
@@ -69,6 +69,10 @@ func (rip *RegisterIncentiveProposal) ValidateBasic() error {
 		return err
 	}
 
+	if err := rip.WeightingStrategy.Validate(); err != nil {
+		return err
+	}
+
 	return govv1beta1.ValidateAbstract(rip)
 }
 
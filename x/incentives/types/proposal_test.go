@@ -44,6 +44,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			true,
 		},
@@ -57,6 +59,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -70,6 +74,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -83,6 +89,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -96,6 +104,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -109,6 +119,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -122,6 +134,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -135,6 +149,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -148,6 +164,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -161,6 +179,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				0,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -174,6 +194,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -214,6 +236,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				5,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			true,
 		},
@@ -227,6 +251,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -240,6 +266,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -253,6 +281,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -266,6 +296,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
@@ -279,6 +311,8 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				types.WeightingStrategy{},
+				0,
 			},
 			false,
 		},
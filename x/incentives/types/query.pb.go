@@ -639,6 +639,362 @@ func (m *QueryAllocationMeterResponse) GetAllocationMeter() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryIncentiveProgramsRequest is the request type for the
+// Query/IncentivePrograms RPC method.
+type QueryIncentiveProgramsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentiveProgramsRequest) Reset()         { *m = QueryIncentiveProgramsRequest{} }
+func (m *QueryIncentiveProgramsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveProgramsRequest) ProtoMessage()    {}
+func (*QueryIncentiveProgramsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{12}
+}
+func (m *QueryIncentiveProgramsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveProgramsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveProgramsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveProgramsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveProgramsRequest.Merge(m, src)
+}
+func (m *QueryIncentiveProgramsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveProgramsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveProgramsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveProgramsRequest proto.InternalMessageInfo
+
+func (m *QueryIncentiveProgramsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentiveProgramsResponse is the response type for the
+// Query/IncentivePrograms RPC method.
+type QueryIncentiveProgramsResponse struct {
+	// incentive_programs is a slice of the incentive programs
+	IncentivePrograms []IncentiveProgram `protobuf:"bytes,1,rep,name=incentive_programs,json=incentivePrograms,proto3" json:"incentive_programs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentiveProgramsResponse) Reset()         { *m = QueryIncentiveProgramsResponse{} }
+func (m *QueryIncentiveProgramsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveProgramsResponse) ProtoMessage()    {}
+func (*QueryIncentiveProgramsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{13}
+}
+func (m *QueryIncentiveProgramsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveProgramsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveProgramsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveProgramsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveProgramsResponse.Merge(m, src)
+}
+func (m *QueryIncentiveProgramsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveProgramsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveProgramsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveProgramsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveProgramsResponse) GetIncentivePrograms() []IncentiveProgram {
+	if m != nil {
+		return m.IncentivePrograms
+	}
+	return nil
+}
+
+func (m *QueryIncentiveProgramsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentiveProgramRequest is the request type for the
+// Query/IncentiveProgram RPC method.
+type QueryIncentiveProgramRequest struct {
+	// id is the identifier of the incentive program
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryIncentiveProgramRequest) Reset()         { *m = QueryIncentiveProgramRequest{} }
+func (m *QueryIncentiveProgramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveProgramRequest) ProtoMessage()    {}
+func (*QueryIncentiveProgramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{14}
+}
+func (m *QueryIncentiveProgramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveProgramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveProgramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveProgramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveProgramRequest.Merge(m, src)
+}
+func (m *QueryIncentiveProgramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveProgramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveProgramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveProgramRequest proto.InternalMessageInfo
+
+func (m *QueryIncentiveProgramRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryIncentiveProgramResponse is the response type for the
+// Query/IncentiveProgram RPC method.
+type QueryIncentiveProgramResponse struct {
+	// incentive_program is the queried incentive program
+	IncentiveProgram IncentiveProgram `protobuf:"bytes,1,opt,name=incentive_program,json=incentiveProgram,proto3" json:"incentive_program"`
+}
+
+func (m *QueryIncentiveProgramResponse) Reset()         { *m = QueryIncentiveProgramResponse{} }
+func (m *QueryIncentiveProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentiveProgramResponse) ProtoMessage()    {}
+func (*QueryIncentiveProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{15}
+}
+func (m *QueryIncentiveProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentiveProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentiveProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentiveProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentiveProgramResponse.Merge(m, src)
+}
+func (m *QueryIncentiveProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentiveProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentiveProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentiveProgramResponse proto.InternalMessageInfo
+
+func (m *QueryIncentiveProgramResponse) GetIncentiveProgram() IncentiveProgram {
+	if m != nil {
+		return m.IncentiveProgram
+	}
+	return IncentiveProgram{}
+}
+
+// QueryPendingRewardsRequest is the request type for the Query/PendingRewards
+// RPC method.
+type QueryPendingRewardsRequest struct {
+	// participant is the hex address of the participant
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{16}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// ContractRewards defines the rewards of a participant of an incentivized
+// contract.
+type ContractRewards struct {
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// rewards are the claimable rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ContractRewards) Reset()         { *m = ContractRewards{} }
+func (m *ContractRewards) String() string { return proto.CompactTextString(m) }
+func (*ContractRewards) ProtoMessage()    {}
+func (*ContractRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{17}
+}
+func (m *ContractRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractRewards.Merge(m, src)
+}
+func (m *ContractRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractRewards proto.InternalMessageInfo
+
+func (m *ContractRewards) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QueryPendingRewardsResponse is the response type for the
+// Query/PendingRewards RPC method.
+type QueryPendingRewardsResponse struct {
+	// rewards are the pending rewards of each incentivized contract
+	Rewards []ContractRewards `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards"`
+	// total is the sum of the pending rewards
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{18}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetRewards() []ContractRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *QueryPendingRewardsResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -647,7 +1003,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{12}
+	return fileDescriptor_ee5d2766935e7631, []int{19}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +1043,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{13}
+	return fileDescriptor_ee5d2766935e7631, []int{20}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,6 +1092,13 @@ func init() {
 	proto.RegisterType((*QueryAllocationMetersResponse)(nil), "evmos.incentives.v1.QueryAllocationMetersResponse")
 	proto.RegisterType((*QueryAllocationMeterRequest)(nil), "evmos.incentives.v1.QueryAllocationMeterRequest")
 	proto.RegisterType((*QueryAllocationMeterResponse)(nil), "evmos.incentives.v1.QueryAllocationMeterResponse")
+	proto.RegisterType((*QueryIncentiveProgramsRequest)(nil), "evmos.incentives.v1.QueryIncentiveProgramsRequest")
+	proto.RegisterType((*QueryIncentiveProgramsResponse)(nil), "evmos.incentives.v1.QueryIncentiveProgramsResponse")
+	proto.RegisterType((*QueryIncentiveProgramRequest)(nil), "evmos.incentives.v1.QueryIncentiveProgramRequest")
+	proto.RegisterType((*QueryIncentiveProgramResponse)(nil), "evmos.incentives.v1.QueryIncentiveProgramResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "evmos.incentives.v1.QueryPendingRewardsRequest")
+	proto.RegisterType((*ContractRewards)(nil), "evmos.incentives.v1.ContractRewards")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "evmos.incentives.v1.QueryPendingRewardsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.incentives.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.incentives.v1.QueryParamsResponse")
 }
@@ -743,60 +1106,77 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/query.proto", fileDescriptor_ee5d2766935e7631) }
 
 var fileDescriptor_ee5d2766935e7631 = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xc7, 0x3b, 0x3c, 0x40, 0xda, 0xe1, 0x00, 0xcf, 0xd0, 0xe7, 0x91, 0xb4, 0xb0, 0x85, 0xd5,
-	0x40, 0x2d, 0xb0, 0x4b, 0x8b, 0x1a, 0xf5, 0xa4, 0x95, 0x48, 0x3c, 0x98, 0x60, 0xe3, 0xc9, 0x98,
-	0xe8, 0x74, 0x19, 0xd7, 0x8d, 0x74, 0x67, 0xe9, 0x2c, 0x8d, 0xa4, 0x62, 0x8c, 0xaf, 0x80, 0xc4,
-	0x8b, 0x07, 0x6f, 0xc6, 0x44, 0x3d, 0xf8, 0x3a, 0x38, 0x92, 0x98, 0x18, 0x4f, 0x6a, 0xc0, 0x83,
-	0x2f, 0xc3, 0x74, 0x76, 0x66, 0xbb, 0xbb, 0x6c, 0xe9, 0x62, 0xf0, 0x02, 0xed, 0xec, 0xef, 0xcf,
-	0xe7, 0xfb, 0xfb, 0x31, 0xdf, 0x05, 0x16, 0x48, 0xab, 0x41, 0x99, 0x6e, 0xd9, 0x06, 0xb1, 0x5d,
-	0xab, 0x45, 0x98, 0xde, 0x2a, 0xeb, 0x9b, 0x5b, 0xa4, 0xb9, 0xad, 0x39, 0x4d, 0xea, 0x52, 0x34,
-	0xce, 0x03, 0xb4, 0x6e, 0x80, 0xd6, 0x2a, 0xe7, 0x4a, 0x06, 0x65, 0x9d, 0xb4, 0x3a, 0x66, 0xc4,
-	0x8b, 0xd6, 0x5b, 0xe5, 0x3a, 0x71, 0x71, 0x59, 0x77, 0xb0, 0x69, 0xd9, 0xd8, 0xb5, 0xa8, 0xed,
-	0x15, 0xc8, 0x29, 0xc1, 0x58, 0x19, 0x65, 0x50, 0x4b, 0x3e, 0x9f, 0x89, 0x23, 0x30, 0x89, 0x4d,
-	0x98, 0xc5, 0x44, 0xc8, 0xb9, 0xb8, 0x90, 0x00, 0x91, 0x17, 0x95, 0x35, 0xa9, 0x49, 0xf9, 0x47,
-	0xbd, 0xf3, 0x49, 0x9c, 0x4e, 0x9a, 0x94, 0x9a, 0x1b, 0x44, 0xc7, 0x8e, 0xa5, 0x63, 0xdb, 0xa6,
-	0x2e, 0x67, 0x13, 0x39, 0xea, 0x43, 0xf8, 0xff, 0x9d, 0x0e, 0xfe, 0x2d, 0xbf, 0x58, 0x8d, 0x6c,
-	0x6e, 0x11, 0xe6, 0xa2, 0x9b, 0x10, 0x76, 0xa5, 0x4c, 0x80, 0x69, 0x50, 0x1c, 0xa9, 0xcc, 0x6a,
-	0x9e, 0x16, 0xad, 0xa3, 0x45, 0xf3, 0xa6, 0x24, 0x14, 0x69, 0x6b, 0xd8, 0x24, 0x22, 0xb7, 0x16,
-	0xc8, 0x54, 0xdf, 0x03, 0x78, 0xe6, 0x48, 0x0b, 0xe6, 0x50, 0x9b, 0x11, 0xb4, 0x02, 0x61, 0x57,
-	0xc5, 0x04, 0x98, 0xfe, 0xa7, 0x38, 0x52, 0x51, 0xb4, 0x98, 0x81, 0x6b, 0x7e, 0x72, 0x75, 0x70,
-	0xef, 0x5b, 0x21, 0x55, 0x0b, 0xe4, 0xa1, 0xd5, 0x10, 0xe9, 0x00, 0x27, 0x9d, 0xeb, 0x4b, 0xea,
-	0x21, 0x84, 0x50, 0x97, 0xe1, 0x7f, 0x61, 0x52, 0x39, 0x8b, 0x1c, 0x4c, 0x1b, 0xd4, 0x76, 0x9b,
-	0xd8, 0x70, 0xf9, 0x24, 0x32, 0x35, 0xff, 0xbb, 0x7a, 0x3f, 0x3a, 0x41, 0x5f, 0x5d, 0x15, 0x66,
-	0x7c, 0x4a, 0x31, 0xc0, 0x64, 0xe2, 0xba, 0x69, 0x6a, 0x5b, 0x20, 0xad, 0x62, 0x76, 0x9b, 0xb8,
-	0xa4, 0xc9, 0x12, 0x20, 0x45, 0x56, 0x37, 0xf0, 0xc7, 0xab, 0x7b, 0x07, 0x84, 0xb6, 0x40, 0x77,
-	0x5f, 0x1b, 0x34, 0x31, 0x7b, 0xd0, 0xe0, 0xa7, 0x62, 0x73, 0x53, 0xb1, 0xe2, 0x64, 0xae, 0xd4,
-	0x66, 0xca, 0x5a, 0xa7, 0xb7, 0xb7, 0xbb, 0x30, 0x1b, 0xc2, 0x4c, 0x32, 0xa3, 0x69, 0x38, 0xe2,
-	0xe0, 0xa6, 0x6b, 0x19, 0x96, 0x83, 0x6d, 0x97, 0x77, 0xcf, 0xd4, 0x82, 0x47, 0xea, 0x85, 0xc8,
-	0xe8, 0x7d, 0xed, 0x79, 0x98, 0xf1, 0xb5, 0xf3, 0xba, 0x83, 0xb5, 0xb4, 0x54, 0xa5, 0x3e, 0x82,
-	0x93, 0x3c, 0xeb, 0xfa, 0xc6, 0x06, 0x35, 0x38, 0x5e, 0x78, 0x6f, 0xa7, 0x75, 0xad, 0x7e, 0x01,
-	0x38, 0xd5, 0xa3, 0x91, 0xc0, 0x7c, 0x0e, 0xff, 0xc5, 0xfe, 0xb3, 0xf0, 0xa6, 0x26, 0x43, 0x0d,
-	0x65, 0xab, 0x15, 0x62, 0xdc, 0xa0, 0x96, 0x5d, 0x5d, 0xee, 0x2c, 0xea, 0xe3, 0xf7, 0xc2, 0xbc,
-	0x69, 0xb9, 0x8f, 0xb7, 0xea, 0x9a, 0x41, 0x1b, 0xba, 0xf0, 0x30, 0xef, 0xd7, 0x22, 0x5b, 0x7f,
-	0xa2, 0xbb, 0xdb, 0x0e, 0x61, 0x32, 0x87, 0xd5, 0xc6, 0x70, 0x84, 0xe3, 0x34, 0xaf, 0x65, 0x3e,
-	0x4e, 0xa9, 0x9c, 0x68, 0x16, 0x0e, 0xad, 0x13, 0x9b, 0x36, 0xc4, 0x8a, 0xbd, 0x2f, 0xea, 0x1b,
-	0x10, 0xbf, 0x08, 0x7f, 0x3c, 0xcf, 0xe0, 0x58, 0x74, 0x3c, 0x62, 0x1d, 0x7f, 0x61, 0x3a, 0xa3,
-	0x91, 0xe9, 0xa8, 0x59, 0x88, 0x38, 0xdd, 0x1a, 0x6e, 0xe2, 0x86, 0xfc, 0xe3, 0x50, 0xd7, 0xe0,
-	0x78, 0xe8, 0x54, 0xa0, 0x5e, 0x81, 0xc3, 0x0e, 0x3f, 0x11, 0x80, 0xf9, 0xd8, 0x8b, 0xe6, 0x25,
-	0x89, 0x6b, 0x26, 0x12, 0x2a, 0x5f, 0xd2, 0x70, 0x88, 0x97, 0x44, 0xbb, 0x00, 0xc2, 0xae, 0x05,
-	0xa3, 0xf9, 0xd8, 0x1a, 0xf1, 0xef, 0x82, 0xdc, 0x42, 0xb2, 0x60, 0x0f, 0x57, 0x9d, 0x7b, 0xf9,
-	0xf9, 0xe7, 0xab, 0x81, 0x19, 0x54, 0xd0, 0x8f, 0x7f, 0x6d, 0xa1, 0xd7, 0x00, 0x66, 0xfc, 0x7c,
-	0x54, 0x4a, 0xd0, 0x44, 0x02, 0xcd, 0x27, 0x8a, 0x15, 0x3c, 0x15, 0xce, 0xb3, 0x80, 0x4a, 0x7d,
-	0x78, 0xf4, 0xb6, 0x74, 0x87, 0x1d, 0x8e, 0xe6, 0xbb, 0xde, 0x71, 0x68, 0x51, 0x63, 0x3e, 0x0e,
-	0xed, 0x88, 0x8d, 0xf6, 0x41, 0xeb, 0x3a, 0x6c, 0x10, 0xed, 0x2d, 0x80, 0x69, 0x59, 0x09, 0x9d,
-	0xef, 0xdf, 0x4d, 0x82, 0x95, 0x92, 0x84, 0x0a, 0xae, 0x6b, 0x9c, 0xeb, 0x2a, 0xba, 0x9c, 0x9c,
-	0x4b, 0x6f, 0x07, 0xcc, 0x73, 0x07, 0x7d, 0x00, 0x70, 0x2c, 0x6a, 0x4d, 0xa8, 0xdc, 0x1b, 0xa1,
-	0x87, 0x5f, 0xe6, 0x2a, 0x27, 0x49, 0x11, 0xf4, 0x1a, 0xa7, 0x2f, 0xa2, 0xd9, 0x58, 0xfa, 0x23,
-	0xa6, 0x88, 0x3e, 0x01, 0x38, 0x1a, 0x29, 0x86, 0x96, 0x12, 0xf7, 0x95, 0xa4, 0xe5, 0x13, 0x64,
-	0x08, 0xd0, 0x4b, 0x1c, 0x74, 0x09, 0x69, 0xc9, 0x40, 0xf5, 0x36, 0xf7, 0xb6, 0x1d, 0xf4, 0x02,
-	0xc0, 0x61, 0xef, 0xba, 0xa3, 0xb9, 0xde, 0x5d, 0x43, 0xde, 0x92, 0x2b, 0xf6, 0x0f, 0x14, 0x54,
-	0x67, 0x39, 0xd5, 0x14, 0xca, 0xc7, 0x52, 0x79, 0xc6, 0x52, 0x5d, 0xdd, 0x3b, 0x50, 0xc0, 0xfe,
-	0x81, 0x02, 0x7e, 0x1c, 0x28, 0x60, 0xf7, 0x50, 0x49, 0xed, 0x1f, 0x2a, 0xa9, 0xaf, 0x87, 0x4a,
-	0xea, 0xde, 0x62, 0xc0, 0x18, 0xbd, 0x02, 0xde, 0xcf, 0x56, 0xf9, 0xa2, 0xfe, 0x34, 0x58, 0x8c,
-	0x7b, 0x64, 0x7d, 0x98, 0xff, 0x23, 0xba, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xf5, 0x6f,
-	0x27, 0x89, 0x0b, 0x00, 0x00,
+	// 1113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0xf9, 0x35, 0xf9, 0xc5, 0x4f, 0x50, 0xe3, 0x4c, 0x43, 0x09, 0xeb, 0xc4, 0x49,
+	0x97, 0xd2, 0xb8, 0x49, 0xba, 0x1b, 0x3b, 0x01, 0x41, 0x0f, 0x08, 0xd2, 0xaa, 0x11, 0x07, 0xa4,
+	0x60, 0x71, 0x40, 0x15, 0x52, 0x98, 0xd8, 0xc3, 0xb2, 0x22, 0xde, 0xd9, 0x7a, 0x37, 0x86, 0xc8,
+	0x04, 0x21, 0x5e, 0x41, 0x25, 0x38, 0x70, 0xe0, 0x86, 0x90, 0x00, 0x09, 0x78, 0x15, 0xa0, 0x1e,
+	0x2b, 0x71, 0xe1, 0x04, 0x28, 0xe1, 0x80, 0x78, 0x15, 0xc8, 0x33, 0xcf, 0x8e, 0xd7, 0xeb, 0xb5,
+	0xb3, 0x46, 0xee, 0xa5, 0xf5, 0xee, 0x3e, 0x7f, 0x3e, 0xdf, 0xe7, 0x99, 0x99, 0x67, 0x14, 0x58,
+	0xe1, 0xad, 0x86, 0x08, 0x6c, 0xd7, 0xab, 0x71, 0x2f, 0x74, 0x5b, 0x3c, 0xb0, 0x5b, 0x65, 0xfb,
+	0xc1, 0x31, 0x6f, 0x9e, 0x58, 0x7e, 0x53, 0x84, 0x82, 0x5e, 0x91, 0x06, 0x56, 0xd7, 0xc0, 0x6a,
+	0x95, 0x8d, 0xf5, 0x9a, 0x08, 0x3a, 0x6e, 0x87, 0x2c, 0xe0, 0xca, 0xda, 0x6e, 0x95, 0x0f, 0x79,
+	0xc8, 0xca, 0xb6, 0xcf, 0x1c, 0xd7, 0x63, 0xa1, 0x2b, 0x3c, 0x15, 0xc0, 0x28, 0xc6, 0x6d, 0x23,
+	0xab, 0x9a, 0x70, 0xa3, 0xef, 0xd7, 0xd2, 0x08, 0x1c, 0xee, 0xf1, 0xc0, 0x0d, 0xd0, 0xe4, 0x7a,
+	0x9a, 0x49, 0xf7, 0x09, 0xad, 0x16, 0x1c, 0xe1, 0x08, 0xf9, 0xd3, 0xee, 0xfc, 0xc2, 0xb7, 0x4b,
+	0x8e, 0x10, 0xce, 0x11, 0xb7, 0x99, 0xef, 0xda, 0xcc, 0xf3, 0x44, 0x28, 0xd9, 0xd0, 0xc7, 0x7c,
+	0x17, 0xae, 0xbe, 0xd9, 0xc1, 0x7f, 0x5d, 0x07, 0xab, 0xf2, 0x07, 0xc7, 0x3c, 0x08, 0xe9, 0x3d,
+	0x80, 0xae, 0x94, 0x45, 0xb2, 0x4a, 0x4a, 0xb3, 0x95, 0x1b, 0x96, 0xd2, 0x62, 0x75, 0xb4, 0x58,
+	0xaa, 0x4a, 0xa8, 0xc8, 0xda, 0x67, 0x0e, 0x47, 0xdf, 0x6a, 0xcc, 0xd3, 0xfc, 0x96, 0xc0, 0x33,
+	0x7d, 0x29, 0x02, 0x5f, 0x78, 0x01, 0xa7, 0x77, 0x01, 0xba, 0x2a, 0x16, 0xc9, 0xea, 0xff, 0x4a,
+	0xb3, 0x95, 0xa2, 0x95, 0x52, 0x70, 0x4b, 0x3b, 0xef, 0x5e, 0x7a, 0xf4, 0xfb, 0xca, 0x44, 0x35,
+	0xe6, 0x47, 0xf7, 0x7a, 0x48, 0x27, 0x25, 0xe9, 0xda, 0x85, 0xa4, 0x0a, 0xa1, 0x07, 0x75, 0x1b,
+	0x9e, 0xee, 0x25, 0x8d, 0x6a, 0x61, 0xc0, 0x4c, 0x4d, 0x78, 0x61, 0x93, 0xd5, 0x42, 0x59, 0x89,
+	0x5c, 0x55, 0x3f, 0x9b, 0xef, 0x24, 0x2b, 0xa8, 0xd5, 0xed, 0x42, 0x4e, 0x53, 0x62, 0x01, 0xb3,
+	0x89, 0xeb, 0xba, 0x99, 0x6d, 0x44, 0xda, 0x63, 0xc1, 0x1b, 0x3c, 0xe4, 0xcd, 0x20, 0x03, 0x12,
+	0xbd, 0x97, 0x52, 0x90, 0xff, 0xd2, 0xba, 0x6f, 0x08, 0x5c, 0x4d, 0x66, 0xd7, 0xda, 0xc0, 0x61,
+	0xc1, 0x41, 0x43, 0xbe, 0xc5, 0xce, 0x2d, 0xa7, 0x8a, 0x8b, 0x7c, 0x23, 0x6d, 0x4e, 0x14, 0x6b,
+	0x7c, 0x7d, 0x7b, 0x0b, 0x16, 0x7a, 0x30, 0xb3, 0xd4, 0x68, 0x15, 0x66, 0x7d, 0xd6, 0x0c, 0xdd,
+	0x9a, 0xeb, 0x33, 0x2f, 0x94, 0xd9, 0x73, 0xd5, 0xf8, 0x2b, 0x73, 0x27, 0x51, 0x7a, 0xad, 0xbd,
+	0x00, 0x39, 0xad, 0x5d, 0xc6, 0xbd, 0x54, 0x9d, 0x89, 0x54, 0x99, 0xef, 0xc1, 0x92, 0xf4, 0x7a,
+	0xed, 0xe8, 0x48, 0xd4, 0x24, 0x5e, 0x6f, 0xdf, 0xc6, 0xb5, 0xad, 0xfe, 0x26, 0xb0, 0x3c, 0x20,
+	0x11, 0x62, 0x7e, 0x02, 0xf3, 0x4c, 0x7f, 0xeb, 0xed, 0xd4, 0x52, 0x4f, 0xc2, 0x28, 0xd5, 0x5d,
+	0x5e, 0xbb, 0x23, 0x5c, 0x6f, 0x77, 0xbb, 0xd3, 0xa8, 0xef, 0xff, 0x58, 0xd9, 0x70, 0xdc, 0xf0,
+	0xfd, 0xe3, 0x43, 0xab, 0x26, 0x1a, 0x36, 0x9e, 0x61, 0xea, 0xbf, 0x5b, 0x41, 0xfd, 0x03, 0x3b,
+	0x3c, 0xf1, 0x79, 0x10, 0xf9, 0x04, 0xd5, 0x3c, 0x4b, 0x70, 0x8c, 0x73, 0x5b, 0x16, 0xd2, 0x94,
+	0x46, 0x15, 0x5d, 0x80, 0xa9, 0x3a, 0xf7, 0x44, 0x03, 0x5b, 0xac, 0x1e, 0xcc, 0xaf, 0x48, 0x7a,
+	0x23, 0x74, 0x79, 0x3e, 0x86, 0x7c, 0xb2, 0x3c, 0xd8, 0x8e, 0x27, 0x50, 0x9d, 0xb9, 0x44, 0x75,
+	0x4c, 0x07, 0xbb, 0xa7, 0xb7, 0xfe, 0x7e, 0x53, 0x38, 0x4d, 0xd6, 0x18, 0xfb, 0x3a, 0xf9, 0x99,
+	0x40, 0x71, 0x50, 0x26, 0xac, 0xc4, 0x7d, 0xa0, 0x7a, 0xcb, 0x1e, 0xf8, 0xf8, 0x15, 0x57, 0xca,
+	0xf3, 0xc3, 0x0f, 0x2c, 0x8c, 0x85, 0x7b, 0x7b, 0xde, 0x4d, 0xe6, 0x18, 0xdf, 0x22, 0xb0, 0xb0,
+	0x9d, 0xc9, 0xd4, 0x51, 0xbd, 0x2e, 0xc3, 0xa4, 0x5b, 0xc7, 0xdd, 0x38, 0xe9, 0xd6, 0xcd, 0x93,
+	0x01, 0x05, 0xd6, 0xaa, 0xdf, 0x86, 0xf9, 0x3e, 0xd5, 0x58, 0xe7, 0x91, 0x44, 0xe7, 0x93, 0xa2,
+	0xcd, 0x57, 0xc0, 0x90, 0xa9, 0xf7, 0xb9, 0x57, 0x77, 0x3d, 0xa7, 0xca, 0x3f, 0x64, 0xcd, 0xba,
+	0x6e, 0x6c, 0xe2, 0xe0, 0x21, 0xfd, 0x07, 0xcf, 0x17, 0x04, 0xe6, 0xee, 0xe0, 0x39, 0x85, 0xce,
+	0x43, 0x8f, 0x32, 0x0e, 0xff, 0x6f, 0x2a, 0xb3, 0xc5, 0x49, 0xd9, 0xb4, 0x67, 0x53, 0x17, 0xb0,
+	0x5c, 0xbd, 0x5b, 0xb8, 0x7a, 0x4b, 0x19, 0x56, 0xaf, 0x5a, 0xba, 0x51, 0x6c, 0xf3, 0x17, 0x02,
+	0x85, 0x54, 0x5d, 0x7a, 0x98, 0x6b, 0x0c, 0xb5, 0x76, 0xae, 0xa7, 0x96, 0x31, 0xa1, 0x0c, 0xab,
+	0x18, 0xb9, 0x52, 0x06, 0x53, 0xa1, 0x08, 0xd9, 0xd1, 0x93, 0x90, 0xa2, 0x22, 0x9b, 0x0b, 0x40,
+	0x95, 0x0e, 0x16, 0xdb, 0x70, 0xe6, 0x3e, 0x5c, 0xe9, 0x79, 0x8b, 0xaa, 0x5e, 0x86, 0x69, 0x9f,
+	0xe1, 0x86, 0xe8, 0xac, 0x8d, 0x42, 0xaa, 0x28, 0xe5, 0x84, 0x5a, 0xd0, 0xa1, 0xf2, 0xcf, 0x53,
+	0x30, 0x25, 0x43, 0xd2, 0x87, 0x04, 0xa0, 0x7b, 0xfd, 0xa1, 0x1b, 0xa9, 0x31, 0xd2, 0xef, 0x61,
+	0xc6, 0x66, 0x36, 0x63, 0x85, 0x6b, 0xae, 0x7d, 0xf6, 0xeb, 0x5f, 0x9f, 0x4f, 0x5e, 0xa3, 0x2b,
+	0xf6, 0xf0, 0x2b, 0x23, 0xfd, 0x92, 0x40, 0x4e, 0xfb, 0xd3, 0xf5, 0x0c, 0x49, 0x22, 0xa0, 0x8d,
+	0x4c, 0xb6, 0xc8, 0x53, 0x91, 0x3c, 0x9b, 0x74, 0xfd, 0x02, 0x1e, 0xbb, 0x1d, 0x2d, 0xe7, 0x53,
+	0x89, 0xa6, 0x6f, 0x1c, 0xc3, 0xd0, 0x92, 0x97, 0x22, 0x63, 0x23, 0x93, 0x6d, 0x26, 0xb4, 0xee,
+	0xed, 0x26, 0x8e, 0xf6, 0x35, 0x81, 0x99, 0x28, 0x12, 0xbd, 0x79, 0x71, 0xb6, 0x08, 0x6c, 0x3d,
+	0x8b, 0x29, 0x72, 0xbd, 0x2a, 0xb9, 0x6e, 0xd3, 0x97, 0xb2, 0x73, 0xd9, 0xed, 0xd8, 0xf9, 0x71,
+	0x4a, 0xbf, 0x23, 0x90, 0x4f, 0x5e, 0x0b, 0x68, 0x79, 0x30, 0xc2, 0x80, 0xbb, 0x8a, 0x51, 0x19,
+	0xc5, 0x05, 0xe9, 0x2d, 0x49, 0x5f, 0xa2, 0x37, 0x52, 0xe9, 0xfb, 0x2e, 0x24, 0xf4, 0x47, 0x02,
+	0x73, 0x89, 0x60, 0x74, 0x2b, 0x73, 0xde, 0x88, 0xb4, 0x3c, 0x82, 0x07, 0x82, 0xbe, 0x28, 0x41,
+	0xb7, 0xa8, 0x95, 0x0d, 0xd4, 0x6e, 0xcb, 0x7b, 0xc5, 0x29, 0xfd, 0x81, 0xc0, 0x7c, 0xdf, 0x2c,
+	0xa5, 0x95, 0x0c, 0x9b, 0x22, 0x31, 0xe2, 0x8d, 0xed, 0x91, 0x7c, 0x10, 0xdb, 0x96, 0xd8, 0x37,
+	0xe9, 0xda, 0xf0, 0x0d, 0xa5, 0xe7, 0x38, 0xfd, 0x89, 0x40, 0x3e, 0x19, 0x6e, 0xd8, 0x62, 0x18,
+	0x30, 0x60, 0x8d, 0xca, 0x28, 0x2e, 0x08, 0xbb, 0x23, 0x61, 0x2d, 0xba, 0x99, 0x11, 0xd6, 0x6e,
+	0xbb, 0xf5, 0xd3, 0x0e, 0xf1, 0xe5, 0xde, 0x19, 0x43, 0xed, 0xc1, 0xc9, 0x53, 0xa7, 0xac, 0xb1,
+	0x95, 0xdd, 0x01, 0x59, 0x6f, 0x4b, 0xd6, 0x1d, 0x5a, 0x49, 0x65, 0xf5, 0x95, 0xd3, 0x01, 0x8e,
+	0xa9, 0xc4, 0x86, 0xfb, 0x94, 0xc0, 0xb4, 0x1a, 0x01, 0x74, 0x6d, 0x48, 0xe2, 0xf8, 0xbc, 0x31,
+	0x4a, 0x17, 0x1b, 0x22, 0xd9, 0x73, 0x92, 0x6c, 0x99, 0x16, 0xd2, 0xc9, 0xd4, 0xe8, 0xd9, 0x7b,
+	0x74, 0x56, 0x24, 0x8f, 0xcf, 0x8a, 0xe4, 0xcf, 0xb3, 0x22, 0x79, 0x78, 0x5e, 0x9c, 0x78, 0x7c,
+	0x5e, 0x9c, 0xf8, 0xed, 0xbc, 0x38, 0x71, 0xff, 0x56, 0x6c, 0x3e, 0xaa, 0x00, 0xea, 0xdf, 0x56,
+	0xf9, 0x05, 0xfb, 0xa3, 0x78, 0x30, 0x39, 0x2a, 0x0f, 0xa7, 0xe5, 0x1f, 0x06, 0xb6, 0xff, 0x1d,
+	0x00, 0xc0, 0x66, 0x1e, 0x03, 0x19, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -824,6 +1204,13 @@ type QueryClient interface {
 	AllocationMeters(ctx context.Context, in *QueryAllocationMetersRequest, opts ...grpc.CallOption) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(ctx context.Context, in *QueryAllocationMeterRequest, opts ...grpc.CallOption) (*QueryAllocationMeterResponse, error)
+	// IncentivePrograms retrieves the self-funded incentive programs
+	IncentivePrograms(ctx context.Context, in *QueryIncentiveProgramsRequest, opts ...grpc.CallOption) (*QueryIncentiveProgramsResponse, error)
+	// IncentiveProgram retrieves a self-funded incentive program
+	IncentiveProgram(ctx context.Context, in *QueryIncentiveProgramRequest, opts ...grpc.CallOption) (*QueryIncentiveProgramResponse, error)
+	// PendingRewards retrieves the rewards of a participant that are not claimed
+	// yet, for each incentivized contract
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
 	// Params retrieves the incentives module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -890,6 +1277,33 @@ func (c *queryClient) AllocationMeter(ctx context.Context, in *QueryAllocationMe
 	return out, nil
 }
 
+func (c *queryClient) IncentivePrograms(ctx context.Context, in *QueryIncentiveProgramsRequest, opts ...grpc.CallOption) (*QueryIncentiveProgramsResponse, error) {
+	out := new(QueryIncentiveProgramsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/IncentivePrograms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentiveProgram(ctx context.Context, in *QueryIncentiveProgramRequest, opts ...grpc.CallOption) (*QueryIncentiveProgramResponse, error) {
+	out := new(QueryIncentiveProgramResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/IncentiveProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/Params", in, out, opts...)
@@ -914,6 +1328,13 @@ type QueryServer interface {
 	AllocationMeters(context.Context, *QueryAllocationMetersRequest) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(context.Context, *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error)
+	// IncentivePrograms retrieves the self-funded incentive programs
+	IncentivePrograms(context.Context, *QueryIncentiveProgramsRequest) (*QueryIncentiveProgramsResponse, error)
+	// IncentiveProgram retrieves a self-funded incentive program
+	IncentiveProgram(context.Context, *QueryIncentiveProgramRequest) (*QueryIncentiveProgramResponse, error)
+	// PendingRewards retrieves the rewards of a participant that are not claimed
+	// yet, for each incentivized contract
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
 	// Params retrieves the incentives module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -940,6 +1361,15 @@ func (*UnimplementedQueryServer) AllocationMeters(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) AllocationMeter(ctx context.Context, req *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocationMeter not implemented")
 }
+func (*UnimplementedQueryServer) IncentivePrograms(ctx context.Context, req *QueryIncentiveProgramsRequest) (*QueryIncentiveProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivePrograms not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveProgram(ctx context.Context, req *QueryIncentiveProgramRequest) (*QueryIncentiveProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveProgram not implemented")
+}
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1056,44 +1486,98 @@ func _Query_AllocationMeter_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_IncentivePrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveProgramsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).IncentivePrograms(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/evmos.incentives.v1.Query/Params",
+		FullMethod: "/evmos.incentives.v1.Query/IncentivePrograms",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).IncentivePrograms(ctx, req.(*QueryIncentiveProgramsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.incentives.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Incentives",
-			Handler:    _Query_Incentives_Handler,
-		},
-		{
-			MethodName: "Incentive",
-			Handler:    _Query_Incentive_Handler,
-		},
-		{
-			MethodName: "GasMeters",
-			Handler:    _Query_GasMeters_Handler,
-		},
-		{
-			MethodName: "GasMeter",
-			Handler:    _Query_GasMeter_Handler,
-		},
+func _Query_IncentiveProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentiveProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/IncentiveProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveProgram(ctx, req.(*QueryIncentiveProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/PendingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.incentives.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Incentives",
+			Handler:    _Query_Incentives_Handler,
+		},
+		{
+			MethodName: "Incentive",
+			Handler:    _Query_Incentive_Handler,
+		},
+		{
+			MethodName: "GasMeters",
+			Handler:    _Query_GasMeters_Handler,
+		},
+		{
+			MethodName: "GasMeter",
+			Handler:    _Query_GasMeter_Handler,
+		},
 		{
 			MethodName: "AllocationMeters",
 			Handler:    _Query_AllocationMeters_Handler,
@@ -1102,6 +1586,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllocationMeter",
 			Handler:    _Query_AllocationMeter_Handler,
 		},
+		{
+			MethodName: "IncentivePrograms",
+			Handler:    _Query_IncentivePrograms_Handler,
+		},
+		{
+			MethodName: "IncentiveProgram",
+			Handler:    _Query_IncentiveProgram_Handler,
+		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1561,7 +2057,7 @@ func (m *QueryAllocationMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryIncentiveProgramsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1571,20 +2067,32 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIncentiveProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIncentiveProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryIncentiveProgramsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1594,134 +2102,392 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryIncentiveProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryIncentiveProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IncentivePrograms) > 0 {
+		for iNdEx := len(m.IncentivePrograms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePrograms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryIncentiveProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryIncentiveProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIncentiveProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIncentiveProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIncentiveProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Incentives) > 0 {
-		for _, e := range m.Incentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.IncentiveProgram.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentiveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentiveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Incentive.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGasMetersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGasMetersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GasMeters) > 0 {
-		for _, e := range m.GasMeters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ContractRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGasMeterRequest) Size() (n int) {
-	if m == nil {
+func (m *ContractRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Incentive.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasMetersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasMetersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasMeters) > 0 {
+		for _, e := range m.GasMeters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasMeterRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1774,64 +2540,964 @@ func (m *QueryAllocationMetersResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationMeterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllocationMeterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AllocationMeter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIncentiveProgramsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveProgramsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivePrograms) > 0 {
+		for _, e := range m.IncentivePrograms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryIncentiveProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentiveProgram.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, Incentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasMeters = append(m.GasMeters, GasMeter{})
+			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryAllocationMeterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryAllocationMeterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.AllocationMeter.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			}
+			m.GasMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,10 +3520,10 @@ func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMetersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1917,7 +3583,7 @@ func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,15 +3606,15 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMetersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1975,8 +3641,8 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Incentives = append(m.Incentives, Incentive{})
-			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AllocationMeters = append(m.AllocationMeters, types.DecCoin{})
+			if err := m.AllocationMeters[len(m.AllocationMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2037,7 +3703,7 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,15 +3726,15 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMeterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2096,7 +3762,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2119,7 +3785,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,15 +3808,15 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMeterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2177,7 +3843,7 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllocationMeter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2202,7 +3868,7 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveProgramsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2225,45 +3891,13 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveProgramsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2320,7 +3954,7 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveProgramsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,15 +3977,15 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveProgramsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePrograms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2378,8 +4012,8 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasMeters = append(m.GasMeters, GasMeter{})
-			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentivePrograms = append(m.IncentivePrograms, IncentiveProgram{})
+			if err := m.IncentivePrograms[len(m.IncentivePrograms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2440,7 +4074,7 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveProgramRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,49 +4097,17 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveProgramRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2515,24 +4117,11 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2554,7 +4143,7 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentiveProgramResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,17 +4166,17 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentiveProgramResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentiveProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveProgram", wireType)
 			}
-			m.GasMeter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2597,11 +4186,25 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasMeter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveProgram.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2623,7 +4226,7 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,17 +4249,17 @@ func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMetersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2666,27 +4269,23 @@ func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2709,7 +4308,7 @@ func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
+func (m *ContractRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2732,17 +4331,17 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMetersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2752,29 +4351,27 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllocationMeters = append(m.AllocationMeters, types.DecCoin{})
-			if err := m.AllocationMeters[len(m.AllocationMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2801,10 +4398,8 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2829,7 +4424,7 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2852,17 +4447,17 @@ func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMeterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2872,77 +4467,29 @@ func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Rewards = append(m.Rewards, ContractRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMeterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2969,7 +4516,8 @@ func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocationMeter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,34 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmostypes "github.com/evmos/evmos/v15/types"
+)
+
+// NewRewardIndex returns an instance of RewardIndex
+func NewRewardIndex(contract common.Address, epoch uint64, index sdk.DecCoins) RewardIndex {
+	return RewardIndex{
+		Contract: contract.String(),
+		Epoch:    epoch,
+		Index:    index,
+	}
+}
+
+// Validate performs a stateless validation of a RewardIndex
+func (ri RewardIndex) Validate() error {
+	if err := evmostypes.ValidateAddress(ri.Contract); err != nil {
+		return err
+	}
+
+	if err := ri.Index.Validate(); err != nil {
+		return fmt.Errorf("invalid reward index: %w", err)
+	}
+
+	return nil
+}
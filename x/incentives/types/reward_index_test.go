@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+type RewardIndexTestSuite struct {
+	suite.Suite
+}
+
+func TestRewardIndexSuite(t *testing.T) {
+	suite.Run(t, new(RewardIndexTestSuite))
+}
+
+func (suite *RewardIndexTestSuite) TestRewardIndexValidate() {
+	index := sdk.NewDecCoins(sdk.NewDecCoinFromDec("acoin", sdk.NewDecWithPrec(5, 1)))
+
+	testCases := []struct {
+		name       string
+		ri         types.RewardIndex
+		expectPass bool
+	}{
+		{"pass", types.NewRewardIndex(utiltx.GenerateAddress(), 1, index), true},
+		{"pass - empty index", types.NewRewardIndex(utiltx.GenerateAddress(), 1, sdk.DecCoins{}), true},
+		{"invalid contract", types.RewardIndex{Contract: "contract", Epoch: 1, Index: index}, false},
+		{
+			"invalid index",
+			types.RewardIndex{
+				Contract: utiltx.GenerateAddress().String(),
+				Epoch:    1,
+				Index:    sdk.DecCoins{{Denom: "acoin", Amount: sdk.NewDec(-1)}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.ri.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *RewardIndexTestSuite) TestMsgClaimIncentiveRewardsValidateBasic() {
+	participant := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	contract := utiltx.GenerateAddress()
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgClaimIncentiveRewards
+		expectPass bool
+	}{
+		{"pass - all contracts", types.NewMsgClaimIncentiveRewards(participant, nil), true},
+		{"pass - given contracts", types.NewMsgClaimIncentiveRewards(participant, []common.Address{contract}), true},
+		{"invalid participant", &types.MsgClaimIncentiveRewards{Participant: "participant"}, false},
+		{"invalid contract", &types.MsgClaimIncentiveRewards{Participant: participant.String(), Contracts: []string{"contract"}}, false},
+		{"zero contract", types.NewMsgClaimIncentiveRewards(participant, []common.Address{{}}), false},
+		{"duplicate contract", types.NewMsgClaimIncentiveRewards(participant, []common.Address{contract, contract}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
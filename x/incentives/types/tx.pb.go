@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -124,226 +126,2172 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.incentives.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.incentives.v1.MsgUpdateParamsResponse")
+// MsgCreateIncentiveProgram defines a Msg to create a self-funded incentive
+// program for a contract.
+type MsgCreateIncentiveProgram struct {
+	// creator is the bech32 address of the account funding the program
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount is the total amount of rewards escrowed for the program
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// participant_cap is the max amount of each denom rewarded to a participant per epoch
+	ParticipantCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=participant_cap,json=participantCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"participant_cap"`
+	// start_epoch is the first epoch of the incentives epoch identifier rewarded by the program
+	StartEpoch uint64 `protobuf:"varint,5,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch of the incentives epoch identifier rewarded by the program
+	EndEpoch uint64 `protobuf:"varint,6,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/incentives/v1/tx.proto", fileDescriptor_8acb8c4fd5b75ea3) }
+func (m *MsgCreateIncentiveProgram) Reset()         { *m = MsgCreateIncentiveProgram{} }
+func (m *MsgCreateIncentiveProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentiveProgram) ProtoMessage()    {}
+func (*MsgCreateIncentiveProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{2}
+}
+func (m *MsgCreateIncentiveProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentiveProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentiveProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateIncentiveProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentiveProgram.Merge(m, src)
+}
+func (m *MsgCreateIncentiveProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentiveProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentiveProgram.DiscardUnknown(m)
+}
 
-var fileDescriptor_8acb8c4fd5b75ea3 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0x2d, 0xd6, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06, 0xcb, 0xea, 0x21, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0x7a, 0x72, 0x8b, 0xd3, 0x41, 0x8a, 0x73, 0x8b,
-	0xd3, 0x21, 0xaa, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x52, 0xc4,
-	0x66, 0x4d, 0x7a, 0x6a, 0x5e, 0x6a, 0x71, 0x26, 0x4c, 0x89, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x44,
-	0x2b, 0x88, 0x05, 0x11, 0x55, 0x9a, 0xc2, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92,
-	0x58, 0x92, 0x1a, 0x90, 0x58, 0x94, 0x98, 0x5b, 0x2c, 0x64, 0xc6, 0xc5, 0x99, 0x58, 0x5a, 0x92,
-	0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0x71, 0x69, 0x8b,
-	0xae, 0x08, 0xd4, 0x46, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc,
-	0xf4, 0x20, 0x84, 0x52, 0x21, 0x4b, 0x2e, 0xb6, 0x02, 0xb0, 0x09, 0x12, 0x4c, 0x0a, 0x8c, 0x1a,
-	0xdc, 0x46, 0xd2, 0x7a, 0x58, 0xbc, 0xa7, 0x07, 0xb1, 0xc4, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86,
-	0x20, 0xa8, 0x06, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x46, 0x29, 0x49, 0x72, 0x89, 0xa3,
-	0xb9, 0x2a, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x28, 0x93, 0x8b, 0xd9, 0xb7, 0x38,
-	0x5d, 0x28, 0x89, 0x8b, 0x07, 0xc5, 0xd1, 0x2a, 0x58, 0x2d, 0x43, 0x33, 0x44, 0x4a, 0x87, 0x18,
-	0x55, 0x30, 0xab, 0x9c, 0xdc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x37, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x12, 0xf4, 0x10, 0xb2,
-	0xcc, 0xd0, 0x54, 0xbf, 0x02, 0x39, 0x1a, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x81,
-	0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xf4, 0xbd, 0xfb, 0x0e, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgCreateIncentiveProgram proto.InternalMessageInfo
+
+func (m *MsgCreateIncentiveProgram) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgCreateIncentiveProgram) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCreateIncentiveProgram) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgCreateIncentiveProgram) GetParticipantCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ParticipantCap
+	}
+	return nil
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCreateIncentiveProgram) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCreateIncentiveProgram) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCreateIncentiveProgramResponse defines the response structure for
+// executing a MsgCreateIncentiveProgram message.
+type MsgCreateIncentiveProgramResponse struct {
+	// id is the identifier of the created program
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateIncentiveProgramResponse) Reset()         { *m = MsgCreateIncentiveProgramResponse{} }
+func (m *MsgCreateIncentiveProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentiveProgramResponse) ProtoMessage()    {}
+func (*MsgCreateIncentiveProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{3}
+}
+func (m *MsgCreateIncentiveProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentiveProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentiveProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCreateIncentiveProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentiveProgramResponse.Merge(m, src)
+}
+func (m *MsgCreateIncentiveProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentiveProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentiveProgramResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgCreateIncentiveProgramResponse proto.InternalMessageInfo
+
+func (m *MsgCreateIncentiveProgramResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCancelIncentiveProgram defines a Msg to cancel a self-funded incentive
+// program.
+type MsgCancelIncentiveProgram struct {
+	// creator is the bech32 address of the account that created the program
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is the identifier of the program
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelIncentiveProgram) Reset()         { *m = MsgCancelIncentiveProgram{} }
+func (m *MsgCancelIncentiveProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIncentiveProgram) ProtoMessage()    {}
+func (*MsgCancelIncentiveProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{4}
+}
+func (m *MsgCancelIncentiveProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIncentiveProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIncentiveProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelIncentiveProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIncentiveProgram.Merge(m, src)
+}
+func (m *MsgCancelIncentiveProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIncentiveProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIncentiveProgram.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCancelIncentiveProgram proto.InternalMessageInfo
+
+func (m *MsgCancelIncentiveProgram) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelIncentiveProgram) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return 0
+}
+
+// MsgCancelIncentiveProgramResponse defines the response structure for
+// executing a MsgCancelIncentiveProgram message.
+type MsgCancelIncentiveProgramResponse struct {
+	// refund is the amount of remaining rewards refunded to the creator
+	Refund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refund"`
+}
+
+func (m *MsgCancelIncentiveProgramResponse) Reset()         { *m = MsgCancelIncentiveProgramResponse{} }
+func (m *MsgCancelIncentiveProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIncentiveProgramResponse) ProtoMessage()    {}
+func (*MsgCancelIncentiveProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{5}
+}
+func (m *MsgCancelIncentiveProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIncentiveProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIncentiveProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.incentives.v1.Msg/UpdateParams",
+}
+func (m *MsgCancelIncentiveProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIncentiveProgramResponse.Merge(m, src)
+}
+func (m *MsgCancelIncentiveProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIncentiveProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIncentiveProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelIncentiveProgramResponse proto.InternalMessageInfo
+
+func (m *MsgCancelIncentiveProgramResponse) GetRefund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refund
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return nil
+}
+
+// MsgClaimIncentiveRewards defines a Msg to claim the rewards of a participant
+// of incentivized contracts.
+type MsgClaimIncentiveRewards struct {
+	// participant is the bech32 address of the participant claiming the rewards
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// contracts are the hex addresses of the contracts to claim the rewards of.
+	// The rewards of all the contracts are claimed when empty.
+	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
+}
+
+func (m *MsgClaimIncentiveRewards) Reset()         { *m = MsgClaimIncentiveRewards{} }
+func (m *MsgClaimIncentiveRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewards) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{6}
+}
+func (m *MsgClaimIncentiveRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewards.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewards.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.incentives.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/incentives/v1/tx.proto",
+var xxx_messageInfo_MsgClaimIncentiveRewards proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewards) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimIncentiveRewards) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgClaimIncentiveRewardsResponse defines the response structure for
+// executing a MsgClaimIncentiveRewards message.
+type MsgClaimIncentiveRewardsResponse struct {
+	// amount is the amount of rewards claimed
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClaimIncentiveRewardsResponse) Reset()         { *m = MsgClaimIncentiveRewardsResponse{} }
+func (m *MsgClaimIncentiveRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{7}
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimIncentiveRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateWeightingStrategy defines a Msg for updating the weighting strategy
+// of an incentive.
+type MsgUpdateWeightingStrategy struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// weighting_strategy is the new weighting strategy of the incentive
+	WeightingStrategy WeightingStrategy `protobuf:"bytes,3,opt,name=weighting_strategy,json=weightingStrategy,proto3" json:"weighting_strategy"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateWeightingStrategy) Reset()         { *m = MsgUpdateWeightingStrategy{} }
+func (m *MsgUpdateWeightingStrategy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWeightingStrategy) ProtoMessage()    {}
+func (*MsgUpdateWeightingStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{8}
 }
+func (m *MsgUpdateWeightingStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWeightingStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWeightingStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWeightingStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWeightingStrategy.Merge(m, src)
+}
+func (m *MsgUpdateWeightingStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWeightingStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWeightingStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWeightingStrategy proto.InternalMessageInfo
+
+func (m *MsgUpdateWeightingStrategy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateWeightingStrategy) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgUpdateWeightingStrategy) GetWeightingStrategy() WeightingStrategy {
+	if m != nil {
+		return m.WeightingStrategy
+	}
+	return WeightingStrategy{}
+}
+
+// MsgUpdateWeightingStrategyResponse defines the response structure for
+// executing a MsgUpdateWeightingStrategy message.
+type MsgUpdateWeightingStrategyResponse struct {
+}
+
+func (m *MsgUpdateWeightingStrategyResponse) Reset()         { *m = MsgUpdateWeightingStrategyResponse{} }
+func (m *MsgUpdateWeightingStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWeightingStrategyResponse) ProtoMessage()    {}
+func (*MsgUpdateWeightingStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{9}
+}
+func (m *MsgUpdateWeightingStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateWeightingStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateWeightingStrategyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateWeightingStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWeightingStrategyResponse.Merge(m, src)
+}
+func (m *MsgUpdateWeightingStrategyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateWeightingStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWeightingStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateWeightingStrategyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.incentives.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.incentives.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateIncentiveProgram)(nil), "evmos.incentives.v1.MsgCreateIncentiveProgram")
+	proto.RegisterType((*MsgCreateIncentiveProgramResponse)(nil), "evmos.incentives.v1.MsgCreateIncentiveProgramResponse")
+	proto.RegisterType((*MsgCancelIncentiveProgram)(nil), "evmos.incentives.v1.MsgCancelIncentiveProgram")
+	proto.RegisterType((*MsgCancelIncentiveProgramResponse)(nil), "evmos.incentives.v1.MsgCancelIncentiveProgramResponse")
+	proto.RegisterType((*MsgClaimIncentiveRewards)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewards")
+	proto.RegisterType((*MsgClaimIncentiveRewardsResponse)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewardsResponse")
+	proto.RegisterType((*MsgUpdateWeightingStrategy)(nil), "evmos.incentives.v1.MsgUpdateWeightingStrategy")
+	proto.RegisterType((*MsgUpdateWeightingStrategyResponse)(nil), "evmos.incentives.v1.MsgUpdateWeightingStrategyResponse")
+}
+
+func init() { proto.RegisterFile("evmos/incentives/v1/tx.proto", fileDescriptor_8acb8c4fd5b75ea3) }
+
+var fileDescriptor_8acb8c4fd5b75ea3 = []byte{
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0xb7, 0x6c, 0xaf, 0x37, 0x1e, 0x87, 0x64, 0x57, 0x9b, 0xdd, 0xc8, 0x4a, 0x70, 0x1c, 0x11,
+	0x16, 0xb3, 0xac, 0xa5, 0xb5, 0x43, 0xb2, 0xd4, 0xb7, 0x3a, 0x94, 0xd2, 0x43, 0x20, 0x28, 0x94,
+	0x42, 0x7b, 0x30, 0x63, 0x69, 0x2a, 0x8b, 0x46, 0x33, 0x62, 0x66, 0xec, 0x24, 0x87, 0x42, 0x69,
+	0xa1, 0xed, 0xb1, 0x94, 0x7e, 0x8a, 0x9e, 0x7a, 0xe8, 0x87, 0xc8, 0x31, 0xf4, 0xd4, 0x4b, 0xff,
+	0x90, 0x1c, 0xfa, 0x05, 0xfa, 0x01, 0x8a, 0xa4, 0x91, 0xed, 0x38, 0x52, 0x52, 0x97, 0xf4, 0x92,
+	0x78, 0xe6, 0xf7, 0x7b, 0xef, 0xfd, 0x66, 0x7e, 0x7a, 0x8f, 0x01, 0xcb, 0x68, 0xe0, 0x11, 0x66,
+	0xb8, 0xd8, 0x42, 0x98, 0xbb, 0x03, 0xc4, 0x8c, 0x41, 0xc3, 0xe0, 0x07, 0xba, 0x4f, 0x09, 0x27,
+	0xf2, 0x1f, 0x21, 0xaa, 0x8f, 0x50, 0x7d, 0xd0, 0x50, 0x2b, 0x16, 0x61, 0x41, 0x4c, 0x17, 0x32,
+	0x64, 0x0c, 0x1a, 0x5d, 0xc4, 0x61, 0xc3, 0xb0, 0x88, 0x8b, 0xa3, 0x20, 0x75, 0x51, 0xe0, 0x1e,
+	0x73, 0x82, 0x64, 0x1e, 0x73, 0x04, 0x50, 0x8e, 0x80, 0x4e, 0xb8, 0x32, 0xa2, 0x85, 0x80, 0x56,
+	0x93, 0x64, 0x38, 0x08, 0x23, 0xe6, 0xc6, 0x94, 0xb5, 0x24, 0xca, 0x68, 0x25, 0x58, 0x0b, 0x0e,
+	0x71, 0x48, 0x54, 0x20, 0xf8, 0x15, 0xed, 0x6a, 0xaf, 0x24, 0x30, 0xbf, 0xcd, 0x9c, 0xdb, 0xbe,
+	0x0d, 0x39, 0xda, 0x81, 0x14, 0x7a, 0x4c, 0xde, 0x04, 0x45, 0xd8, 0xe7, 0x3d, 0x42, 0x5d, 0x7e,
+	0xa8, 0x48, 0x55, 0xa9, 0x56, 0x6c, 0x2b, 0xef, 0xde, 0xd6, 0x17, 0x84, 0xae, 0xeb, 0xb6, 0x4d,
+	0x11, 0x63, 0xbb, 0x9c, 0xba, 0xd8, 0x31, 0x47, 0x54, 0xf9, 0x1a, 0x28, 0xf8, 0x61, 0x06, 0x25,
+	0x5b, 0x95, 0x6a, 0xa5, 0xe6, 0x92, 0x9e, 0x70, 0x49, 0x7a, 0x54, 0xa4, 0x9d, 0x3f, 0xfa, 0xb8,
+	0x92, 0x31, 0x45, 0x40, 0x6b, 0xee, 0xf1, 0x97, 0x37, 0xff, 0x8c, 0x52, 0x69, 0x65, 0xb0, 0x38,
+	0xa1, 0xca, 0x44, 0xcc, 0x27, 0x98, 0x21, 0xed, 0x65, 0x0e, 0x94, 0xb7, 0x99, 0xb3, 0x45, 0x11,
+	0xe4, 0xe8, 0x56, 0x9c, 0x7a, 0x87, 0x12, 0x87, 0x42, 0x4f, 0x6e, 0x82, 0x5f, 0xad, 0x00, 0x21,
+	0xf4, 0x52, 0xe5, 0x31, 0x51, 0x56, 0xc1, 0x8c, 0x45, 0x30, 0xa7, 0xd0, 0xe2, 0xa1, 0xf2, 0xa2,
+	0x39, 0x5c, 0xcb, 0x16, 0x28, 0x40, 0x8f, 0xf4, 0x31, 0x57, 0x72, 0xd5, 0x5c, 0xad, 0xd4, 0x2c,
+	0xeb, 0x22, 0x57, 0xe0, 0xb1, 0x2e, 0x3c, 0xd6, 0xb7, 0x88, 0x8b, 0xdb, 0xff, 0x05, 0x27, 0x7a,
+	0xfd, 0x69, 0xa5, 0xe6, 0xb8, 0xbc, 0xd7, 0xef, 0xea, 0x16, 0xf1, 0x84, 0x95, 0xe2, 0x5f, 0x9d,
+	0xd9, 0x0f, 0x0c, 0x7e, 0xe8, 0x23, 0x16, 0x06, 0x30, 0x53, 0xa4, 0x96, 0x39, 0x98, 0xf7, 0x21,
+	0xe5, 0xae, 0xe5, 0xfa, 0x10, 0xf3, 0x8e, 0x05, 0x7d, 0x25, 0x7f, 0xf5, 0xd5, 0xe6, 0xc6, 0x6a,
+	0x6c, 0x41, 0x5f, 0x5e, 0x01, 0x25, 0xc6, 0x21, 0xe5, 0x1d, 0xe4, 0x13, 0xab, 0xa7, 0xfc, 0x52,
+	0x95, 0x6a, 0x79, 0x13, 0x84, 0x5b, 0x37, 0x82, 0x1d, 0x79, 0x09, 0x14, 0x11, 0xb6, 0x05, 0x5c,
+	0x08, 0xe1, 0x19, 0x84, 0xed, 0x10, 0x6c, 0xcd, 0x06, 0x8e, 0xc5, 0x57, 0xa8, 0xad, 0x83, 0xd5,
+	0x54, 0x4f, 0x62, 0xe7, 0xe4, 0x39, 0x90, 0x75, 0xed, 0xd0, 0x96, 0xbc, 0x99, 0x75, 0x6d, 0xcd,
+	0x8b, 0x8c, 0x84, 0xd8, 0x42, 0x7b, 0x57, 0x62, 0x64, 0x54, 0x20, 0x1b, 0x17, 0x98, 0xd0, 0xf8,
+	0x5c, 0x02, 0xab, 0xa9, 0xf5, 0x86, 0x22, 0x2d, 0x50, 0xa0, 0xe8, 0x7e, 0x1f, 0x07, 0x42, 0xaf,
+	0xde, 0xf0, 0x28, 0xb5, 0xf6, 0x54, 0x02, 0x4a, 0x20, 0x65, 0x0f, 0xba, 0xde, 0x50, 0x89, 0x89,
+	0xf6, 0x21, 0xb5, 0x99, 0xdc, 0x02, 0xa5, 0x31, 0xa7, 0x2e, 0x3d, 0xfd, 0x38, 0x59, 0x5e, 0x06,
+	0xc5, 0xf8, 0xd3, 0x0d, 0xba, 0x30, 0x57, 0x2b, 0x9a, 0xa3, 0x8d, 0xd6, 0x6f, 0xc1, 0x7d, 0x8c,
+	0xf3, 0xb5, 0x67, 0x12, 0xa8, 0xa6, 0x09, 0x19, 0xbf, 0x12, 0xd1, 0x03, 0xd2, 0x4f, 0xeb, 0x01,
+	0xed, 0x83, 0x04, 0xd4, 0x61, 0xcb, 0xdf, 0x41, 0xae, 0xd3, 0xe3, 0x2e, 0x76, 0x76, 0x39, 0x85,
+	0x1c, 0x39, 0x87, 0x3f, 0x3c, 0x93, 0x2e, 0xea, 0xed, 0x7b, 0x40, 0xde, 0x8f, 0x0b, 0x75, 0x98,
+	0xa8, 0xa4, 0xe4, 0xc2, 0xd9, 0xf5, 0x77, 0xe2, 0xec, 0x3a, 0xa7, 0x4b, 0x8c, 0xb1, 0xdf, 0xf7,
+	0x27, 0x81, 0x73, 0x13, 0x6d, 0x0d, 0x68, 0xe9, 0xc7, 0x8b, 0xaf, 0xba, 0xf9, 0x35, 0x0f, 0x72,
+	0xdb, 0xcc, 0x91, 0xbb, 0x60, 0xf6, 0xcc, 0x48, 0x5e, 0x4b, 0x94, 0x33, 0x31, 0x22, 0xd5, 0x7f,
+	0xbf, 0x87, 0x35, 0xb4, 0xf5, 0x91, 0x04, 0xfe, 0x4a, 0x99, 0xa2, 0x7a, 0x5a, 0xa2, 0x64, 0xbe,
+	0xba, 0x39, 0x1d, 0xff, 0xac, 0x84, 0xe4, 0xfe, 0x4f, 0x97, 0x90, 0xc8, 0x57, 0x37, 0xa7, 0xe3,
+	0x0f, 0x25, 0x3c, 0x04, 0x7f, 0x26, 0xb7, 0x61, 0x3d, 0x35, 0x61, 0x12, 0x5d, 0xdd, 0x98, 0x8a,
+	0x3e, 0x2c, 0xff, 0x44, 0x02, 0x8b, 0x69, 0xdf, 0xbc, 0x71, 0xb1, 0x9d, 0xe7, 0x02, 0xd4, 0xff,
+	0xa7, 0x0c, 0x88, 0x55, 0xb4, 0x6f, 0x1e, 0x9d, 0x54, 0xa4, 0xe3, 0x93, 0x8a, 0xf4, 0xf9, 0xa4,
+	0x22, 0xbd, 0x38, 0xad, 0x64, 0x8e, 0x4f, 0x2b, 0x99, 0xf7, 0xa7, 0x95, 0xcc, 0xdd, 0xfa, 0x58,
+	0x23, 0x47, 0xcf, 0x8c, 0xe8, 0xef, 0xa0, 0xb1, 0x61, 0x1c, 0x8c, 0x3f, 0x39, 0xc2, 0x9e, 0xee,
+	0x16, 0xc2, 0x57, 0xc5, 0xfa, 0xb7, 0x01, 0x00, 0xa3, 0x3f, 0x5e, 0xf3, 0x3d, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateIncentiveProgram defines a method to create a self-funded incentive
+	// program for a contract, escrowing the program rewards.
+	CreateIncentiveProgram(ctx context.Context, in *MsgCreateIncentiveProgram, opts ...grpc.CallOption) (*MsgCreateIncentiveProgramResponse, error)
+	// CancelIncentiveProgram defines a method for the creator of an incentive
+	// program to cancel it and get the remaining rewards refunded.
+	CancelIncentiveProgram(ctx context.Context, in *MsgCancelIncentiveProgram, opts ...grpc.CallOption) (*MsgCancelIncentiveProgramResponse, error)
+	// ClaimIncentiveRewards defines a method for a participant to claim the
+	// rewards of the incentivized contracts.
+	ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error)
+	// UpdateWeightingStrategy defined a governance operation for updating the
+	// weighting strategy of an incentive.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateWeightingStrategy(ctx context.Context, in *MsgUpdateWeightingStrategy, opts ...grpc.CallOption) (*MsgUpdateWeightingStrategyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateIncentiveProgram(ctx context.Context, in *MsgCreateIncentiveProgram, opts ...grpc.CallOption) (*MsgCreateIncentiveProgramResponse, error) {
+	out := new(MsgCreateIncentiveProgramResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/CreateIncentiveProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelIncentiveProgram(ctx context.Context, in *MsgCancelIncentiveProgram, opts ...grpc.CallOption) (*MsgCancelIncentiveProgramResponse, error) {
+	out := new(MsgCancelIncentiveProgramResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/CancelIncentiveProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error) {
+	out := new(MsgClaimIncentiveRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/ClaimIncentiveRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWeightingStrategy(ctx context.Context, in *MsgUpdateWeightingStrategy, opts ...grpc.CallOption) (*MsgUpdateWeightingStrategyResponse, error) {
+	out := new(MsgUpdateWeightingStrategyResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/UpdateWeightingStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defined a governance operation for updating the x/incentives module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateIncentiveProgram defines a method to create a self-funded incentive
+	// program for a contract, escrowing the program rewards.
+	CreateIncentiveProgram(context.Context, *MsgCreateIncentiveProgram) (*MsgCreateIncentiveProgramResponse, error)
+	// CancelIncentiveProgram defines a method for the creator of an incentive
+	// program to cancel it and get the remaining rewards refunded.
+	CancelIncentiveProgram(context.Context, *MsgCancelIncentiveProgram) (*MsgCancelIncentiveProgramResponse, error)
+	// ClaimIncentiveRewards defines a method for a participant to claim the
+	// rewards of the incentivized contracts.
+	ClaimIncentiveRewards(context.Context, *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error)
+	// UpdateWeightingStrategy defined a governance operation for updating the
+	// weighting strategy of an incentive.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateWeightingStrategy(context.Context, *MsgUpdateWeightingStrategy) (*MsgUpdateWeightingStrategyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateIncentiveProgram(ctx context.Context, req *MsgCreateIncentiveProgram) (*MsgCreateIncentiveProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIncentiveProgram not implemented")
+}
+func (*UnimplementedMsgServer) CancelIncentiveProgram(ctx context.Context, req *MsgCancelIncentiveProgram) (*MsgCancelIncentiveProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIncentiveProgram not implemented")
+}
+func (*UnimplementedMsgServer) ClaimIncentiveRewards(ctx context.Context, req *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimIncentiveRewards not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWeightingStrategy(ctx context.Context, req *MsgUpdateWeightingStrategy) (*MsgUpdateWeightingStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWeightingStrategy not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateIncentiveProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIncentiveProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIncentiveProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/CreateIncentiveProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIncentiveProgram(ctx, req.(*MsgCreateIncentiveProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelIncentiveProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelIncentiveProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelIncentiveProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/CancelIncentiveProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelIncentiveProgram(ctx, req.(*MsgCancelIncentiveProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimIncentiveRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimIncentiveRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/ClaimIncentiveRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, req.(*MsgClaimIncentiveRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWeightingStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWeightingStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWeightingStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/UpdateWeightingStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWeightingStrategy(ctx, req.(*MsgUpdateWeightingStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.incentives.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateIncentiveProgram",
+			Handler:    _Msg_CreateIncentiveProgram_Handler,
+		},
+		{
+			MethodName: "CancelIncentiveProgram",
+			Handler:    _Msg_CancelIncentiveProgram_Handler,
+		},
+		{
+			MethodName: "ClaimIncentiveRewards",
+			Handler:    _Msg_ClaimIncentiveRewards_Handler,
+		},
+		{
+			MethodName: "UpdateWeightingStrategy",
+			Handler:    _Msg_UpdateWeightingStrategy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/incentives/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentiveProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentiveProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentiveProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ParticipantCap) > 0 {
+		for iNdEx := len(m.ParticipantCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentiveProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentiveProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentiveProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIncentiveProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIncentiveProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIncentiveProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIncentiveProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIncentiveProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIncentiveProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Contracts[iNdEx])
+			copy(dAtA[i:], m.Contracts[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Contracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWeightingStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWeightingStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWeightingStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.WeightingStrategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWeightingStrategyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWeightingStrategyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWeightingStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateIncentiveProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ParticipantCap) > 0 {
+		for _, e := range m.ParticipantCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovTx(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovTx(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *MsgCreateIncentiveProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelIncentiveProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelIncentiveProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimIncentiveRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Contracts) > 0 {
+		for _, s := range m.Contracts {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateWeightingStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.WeightingStrategy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateWeightingStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIncentiveProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIncentiveProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIncentiveProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantCap = append(m.ParticipantCap, types.Coin{})
+			if err := m.ParticipantCap[len(m.ParticipantCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIncentiveProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIncentiveProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIncentiveProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelIncentiveProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelIncentiveProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelIncentiveProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelIncentiveProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelIncentiveProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelIncentiveProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimIncentiveRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgClaimIncentiveRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWeightingStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -366,10 +2314,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWeightingStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWeightingStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -406,7 +2354,39 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightingStrategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -433,7 +2413,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WeightingStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -458,7 +2438,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateWeightingStrategyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -481,10 +2461,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateWeightingStrategyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateWeightingStrategyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,65 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGasPercentile is the highest gas percentile of a weighting strategy
+const MaxGasPercentile = 100
+
+// NewWeightingStrategy returns an instance of WeightingStrategy
+func NewWeightingStrategy(
+	maxGasPerEpoch, minAccountAge uint64,
+	discount sdk.Dec,
+	gasPercentile uint32,
+) WeightingStrategy {
+	return WeightingStrategy{
+		MaxGasPerEpoch: maxGasPerEpoch,
+		MinAccountAge:  minAccountAge,
+		Discount:       discount,
+		GasPercentile:  gasPercentile,
+	}
+}
+
+// DefaultWeightingStrategy returns a weighting strategy that credits the raw gas
+// used by the participants
+func DefaultWeightingStrategy() WeightingStrategy {
+	return NewWeightingStrategy(0, 0, sdk.ZeroDec(), 0)
+}
+
+// Validate performs a stateless validation of a WeightingStrategy
+func (ws WeightingStrategy) Validate() error {
+	discount := ws.GetDiscount()
+	if discount.IsNegative() || discount.GT(sdk.OneDec()) {
+		return fmt.Errorf("discount must be between 0 and 1: %s", discount)
+	}
+
+	if ws.GasPercentile > MaxGasPercentile {
+		return fmt.Errorf("gas percentile cannot be above %d: %d", MaxGasPercentile, ws.GasPercentile)
+	}
+
+	if ws.GasPercentile > 0 && discount.IsZero() {
+		return fmt.Errorf("gas percentile requires a discount")
+	}
+
+	return nil
+}
+
+// GetDiscount returns the discount of the strategy, which is zero for the
+// incentives registered without weighting strategy.
+func (ws WeightingStrategy) GetDiscount() sdk.Dec {
+	if ws.Discount.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return ws.Discount
+}
+
+// IsEnabled returns true if any rule of the strategy is enabled
+func (ws WeightingStrategy) IsEnabled() bool {
+	return ws.MaxGasPerEpoch > 0 || ws.MinAccountAge > 0 || ws.GasPercentile > 0 || !ws.GetDiscount().IsZero()
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/x/incentives/types"
+)
+
+type WeightingStrategyTestSuite struct {
+	suite.Suite
+}
+
+func TestWeightingStrategySuite(t *testing.T) {
+	suite.Run(t, new(WeightingStrategyTestSuite))
+}
+
+func (suite *WeightingStrategyTestSuite) TestWeightingStrategyValidate() {
+	discount := sdk.NewDecWithPrec(5, 1)
+
+	testCases := []struct {
+		name       string
+		strategy   types.WeightingStrategy
+		expectPass bool
+		expEnabled bool
+	}{
+		{"pass - default", types.DefaultWeightingStrategy(), true, false},
+		{"pass - nil discount", types.WeightingStrategy{}, true, false},
+		{"pass - all rules", types.NewWeightingStrategy(1000, 10, discount, 90), true, true},
+		{"pass - gas cap", types.NewWeightingStrategy(1000, 0, sdk.ZeroDec(), 0), true, true},
+		{"pass - full discount", types.NewWeightingStrategy(0, 0, sdk.OneDec(), 100), true, true},
+		{"negative discount", types.NewWeightingStrategy(0, 0, sdk.NewDec(-1), 0), false, true},
+		{"discount above one", types.NewWeightingStrategy(0, 0, sdk.NewDec(2), 0), false, true},
+		{"gas percentile above 100", types.NewWeightingStrategy(0, 0, discount, 101), false, true},
+		{"gas percentile without discount", types.NewWeightingStrategy(0, 0, sdk.ZeroDec(), 90), false, true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.strategy.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+		suite.Require().Equal(tc.expEnabled, tc.strategy.IsEnabled(), tc.name)
+	}
+}
+
+func (suite *WeightingStrategyTestSuite) TestMsgUpdateWeightingStrategyValidateBasic() {
+	authority := sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String()
+	contract := utiltx.GenerateAddress().String()
+	strategy := types.NewWeightingStrategy(1000, 10, sdk.NewDecWithPrec(5, 1), 90)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgUpdateWeightingStrategy
+		expectPass bool
+	}{
+		{"pass", &types.MsgUpdateWeightingStrategy{Authority: authority, Contract: contract, WeightingStrategy: strategy}, true},
+		{"invalid authority", &types.MsgUpdateWeightingStrategy{Authority: "authority", Contract: contract, WeightingStrategy: strategy}, false},
+		{"invalid contract", &types.MsgUpdateWeightingStrategy{Authority: authority, Contract: "contract", WeightingStrategy: strategy}, false},
+		{
+			"invalid strategy",
+			&types.MsgUpdateWeightingStrategy{
+				Authority:         authority,
+				Contract:          contract,
+				WeightingStrategy: types.NewWeightingStrategy(0, 0, sdk.NewDec(2), 0),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
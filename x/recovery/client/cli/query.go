@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParamsCmd(),
+		GetRecoveryNonceCmd(),
 	)
 	return cmd
 }
@@ -58,3 +59,36 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetRecoveryNonceCmd queries the nonce signed to recover the funds of an
+// address
+func GetRecoveryNonceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recovery-nonce ADDRESS",
+		Short: "Gets the nonce signed to recover the funds of an address",
+		Long:  "Gets the nonce signed to recover the funds of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRecoveryNonceRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.RecoveryNonce(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
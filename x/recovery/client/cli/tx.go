@@ -0,0 +1,116 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v15/x/recovery/types"
+)
+
+// FlagRecoveryKey defines the keyring key signing the recovery of its funds
+const FlagRecoveryKey = "recovery-key"
+
+// NewTxCmd returns a root CLI command handler for recovery transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "recovery subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRecoverFundsCmd(),
+	)
+	return txCmd
+}
+
+// NewRecoverFundsCmd returns a CLI command handler for recovering the funds of
+// an address derived from a coin type 118 key
+func NewRecoverFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recover-funds RECEIVER --recovery-key KEY",
+		Short: "Recover the funds of the address of a coin type 118 key to the receiver",
+		Long: `Recover the bank and ERC-20 balances of the address of a coin type 118 key to the receiver.
+The key must be imported in the keyring with the secp256k1 algorithm, and only signs the recovery.
+The transaction is signed by the --from account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			receiver, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			keyName, err := cmd.Flags().GetString(FlagRecoveryKey)
+			if err != nil {
+				return err
+			}
+
+			record, err := cliCtx.Keyring.Key(keyName)
+			if err != nil {
+				return err
+			}
+
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+
+			if _, ok := pubKey.(*secp256k1.PubKey); !ok {
+				return fmt.Errorf("recovery key %s must be a secp256k1 key, got %s", keyName, pubKey.Type())
+			}
+
+			address := sdk.AccAddress(pubKey.Address())
+			queryClient := types.NewQueryClient(cliCtx)
+			res, err := queryClient.RecoveryNonce(cmd.Context(), &types.QueryRecoveryNonceRequest{
+				Address: address.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			signBytes := types.RecoverFundsSignBytes(cliCtx.ChainID, address, receiver, res.Nonce)
+			signature, _, err := cliCtx.Keyring.Sign(keyName, signBytes)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRecoverFunds{
+				Sender:    cliCtx.GetFromAddress().String(),
+				PubKey:    pubKey.Bytes(),
+				Receiver:  receiver.String(),
+				Signature: signature,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRecoveryKey, "", "Name of the coin type 118 key whose funds are recovered")
+	if err := cmd.MarkFlagRequired(FlagRecoveryKey); err != nil {
+		panic(err)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
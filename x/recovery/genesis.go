@@ -21,11 +21,16 @@ func InitGenesis(
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "cannot set parameters"))
 	}
+
+	for _, nonce := range data.RecoveryNonces {
+		k.SetRecoveryNonce(ctx, sdk.MustAccAddressFromBech32(nonce.Address), nonce.Nonce)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:         k.GetParams(ctx),
+		RecoveryNonces: k.GetRecoveryNonces(ctx),
 	}
 }
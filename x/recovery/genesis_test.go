@@ -89,6 +89,16 @@ func (suite *GenesisTestSuite) TestRecoveryInitGenesis() {
 			},
 			false,
 		},
+		{
+			"custom genesis - recovery nonces",
+			types.GenesisState{
+				Params: types.DefaultParams(),
+				RecoveryNonces: []types.RecoveryNonce{
+					types.NewRecoveryNonce(sdk.AccAddress(utiltx.GenerateAddress().Bytes()), 2),
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -106,6 +116,11 @@ func (suite *GenesisTestSuite) TestRecoveryInitGenesis() {
 
 				params := suite.app.RecoveryKeeper.GetParams(suite.ctx)
 				suite.Require().Equal(tc.genesis.Params, params)
+
+				for _, nonce := range tc.genesis.RecoveryNonces {
+					address := sdk.MustAccAddressFromBech32(nonce.Address)
+					suite.Require().Equal(nonce.Nonce, suite.app.RecoveryKeeper.GetRecoveryNonce(suite.ctx, address))
+				}
 			}
 		})
 	}
@@ -114,6 +129,10 @@ func (suite *GenesisTestSuite) TestRecoveryInitGenesis() {
 func (suite *GenesisTestSuite) TestRecoveryExportGenesis() {
 	recovery.InitGenesis(suite.ctx, *suite.app.RecoveryKeeper, suite.genesis)
 
+	address := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.app.RecoveryKeeper.SetRecoveryNonce(suite.ctx, address, 1)
+
 	genesisExported := recovery.ExportGenesis(suite.ctx, *suite.app.RecoveryKeeper)
 	suite.Require().Equal(genesisExported.Params, suite.genesis.Params)
+	suite.Require().Equal([]types.RecoveryNonce{types.NewRecoveryNonce(address, 1)}, genesisExported.RecoveryNonces)
 }
@@ -7,6 +7,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/evmos/v15/x/recovery/types"
 )
@@ -25,3 +27,23 @@ func (k Keeper) Params(
 		Params: params,
 	}, nil
 }
+
+// RecoveryNonce returns the nonce of the next recovery of an address
+func (k Keeper) RecoveryNonce(
+	c context.Context,
+	req *types.QueryRecoveryNonceRequest,
+) (*types.QueryRecoveryNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRecoveryNonceResponse{
+		Nonce: k.GetRecoveryNonce(ctx, address),
+	}, nil
+}
@@ -322,7 +322,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.app.GetKey(types.StoreKey),
 				suite.app.AppCodec(),
 				authtypes.NewModuleAddress(govtypes.ModuleName),
-				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper, mockTransferKeeper, suite.app.ClaimsKeeper)
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper, mockTransferKeeper, suite.app.ClaimsKeeper, suite.app.Erc20Keeper)
 
 			// Fund receiver account with EVMOS, ERC20 coins and IBC vouchers
 			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, secpAddr, coins)
@@ -581,7 +581,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketFailTransfer() {
 				suite.app.GetKey(types.StoreKey),
 				suite.app.AppCodec(),
 				authtypes.NewModuleAddress(govtypes.ModuleName),
-				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper, mockTransferKeeper, suite.app.ClaimsKeeper)
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper, mockTransferKeeper, suite.app.ClaimsKeeper, suite.app.Erc20Keeper)
 
 			// Fund receiver account with EVMOS
 			coins := sdk.NewCoins(
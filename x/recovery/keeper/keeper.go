@@ -36,6 +36,7 @@ type Keeper struct {
 	channelKeeper  types.ChannelKeeper
 	transferKeeper types.TransferKeeper
 	claimsKeeper   types.ClaimsKeeper
+	erc20Keeper    types.ERC20Keeper
 }
 
 // NewKeeper returns keeper
@@ -48,6 +49,7 @@ func NewKeeper(
 	ck types.ChannelKeeper,
 	tk types.TransferKeeper,
 	claimsKeeper types.ClaimsKeeper,
+	erc20Keeper types.ERC20Keeper,
 ) *Keeper {
 	// ensure gov module account is set and is not nil
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -62,6 +64,7 @@ func NewKeeper(
 		channelKeeper:  ck,
 		transferKeeper: tk,
 		claimsKeeper:   claimsKeeper,
+		erc20Keeper:    erc20Keeper,
 	}
 }
 
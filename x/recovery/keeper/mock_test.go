@@ -2,6 +2,10 @@ package keeper_test
 
 import (
 	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/stretchr/testify/mock"
 
@@ -12,10 +16,15 @@ import (
 
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 	"github.com/evmos/evmos/v15/x/recovery/types"
 )
 
-var _ types.TransferKeeper = &MockTransferKeeper{}
+var (
+	_ types.TransferKeeper = &MockTransferKeeper{}
+	_ types.ERC20Keeper    = &MockERC20Keeper{}
+)
 
 // MockTransferKeeper defines a mocked object that implements the TransferKeeper
 // interface. It's used on tests to abstract the complexity of IBC transfers.
@@ -49,3 +58,32 @@ func (m *MockTransferKeeper) Transfer(goCtx context.Context, msg *transfertypes.
 
 	return nil, args.Error(1)
 }
+
+// MockERC20Keeper defines a mocked object that implements the ERC20Keeper
+// interface. It's used on tests to abstract the EVM calls of the ERC-20
+// transfers.
+type MockERC20Keeper struct {
+	mock.Mock
+}
+
+func (m *MockERC20Keeper) GetTokenPairs(_ sdk.Context) []erc20types.TokenPair {
+	args := m.Called(mock.Anything)
+	return args.Get(0).([]erc20types.TokenPair)
+}
+
+func (m *MockERC20Keeper) BalanceOf(_ sdk.Context, _ abi.ABI, contract, account common.Address) *big.Int {
+	args := m.Called(mock.Anything, mock.Anything, contract, account)
+	return args.Get(0).(*big.Int)
+}
+
+func (m *MockERC20Keeper) CallEVM(
+	_ sdk.Context,
+	_ abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	callArgs ...interface{},
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	args := m.Called(mock.Anything, mock.Anything, from, contract, commit, method, callArgs)
+	return args.Get(0).(*evmtypes.MsgEthereumTxResponse), args.Error(1)
+}
@@ -1,9 +1,27 @@
 package keeper_test
 
 import (
+	"errors"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/evmos/evmos/v15/contracts"
+	"github.com/evmos/evmos/v15/crypto/ethsecp256k1"
+	"github.com/evmos/evmos/v15/testutil"
+	utiltx "github.com/evmos/evmos/v15/testutil/tx"
+	"github.com/evmos/evmos/v15/utils"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
+	"github.com/evmos/evmos/v15/x/recovery/keeper"
 	"github.com/evmos/evmos/v15/x/recovery/types"
+	vestingtypes "github.com/evmos/evmos/v15/x/vesting/types"
 )
 
 func (suite *KeeperTestSuite) TestUpdateParams() {
@@ -39,3 +57,248 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRecoverFunds() {
+	var (
+		privKey     *secp256k1.PrivKey
+		address     sdk.AccAddress
+		receiver    sdk.AccAddress
+		msg         *types.MsgRecoverFunds
+		erc20Keeper types.ERC20Keeper
+	)
+
+	coins := sdk.NewCoins(
+		sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1000)),
+		sdk.NewCoin(ibcAtomDenom, sdk.NewInt(1000)),
+	)
+	contract := utiltx.GenerateAddress()
+	erc20Balance := big.NewInt(500)
+	tokenPairs := []erc20types.TokenPair{
+		erc20types.NewTokenPair(contract, erc20Denom, erc20types.OWNER_EXTERNAL),
+	}
+
+	signRecovery := func(key *secp256k1.PrivKey, chainID string, to sdk.AccAddress, nonce uint64) []byte {
+		signature, err := key.Sign(types.RecoverFundsSignBytes(chainID, address, to, nonce))
+		suite.Require().NoError(err)
+		return signature
+	}
+
+	fundAddress := func() {
+		err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, address, coins)
+		suite.Require().NoError(err)
+	}
+
+	mockERC20Keeper := func(transferErr error) {
+		ret, err := contracts.ERC20MinterBurnerDecimalsContract.ABI.Methods["transfer"].Outputs.Pack(true)
+		suite.Require().NoError(err)
+
+		mockKeeper := &MockERC20Keeper{}
+		mockKeeper.On("GetTokenPairs", mock.Anything).Return(tokenPairs)
+		mockKeeper.On("BalanceOf", mock.Anything, mock.Anything, contract, common.BytesToAddress(address)).Return(erc20Balance)
+		mockKeeper.On(
+			"CallEVM", mock.Anything, mock.Anything, common.BytesToAddress(address), contract, true, "transfer", mock.Anything,
+		).Return(&evmtypes.MsgEthereumTxResponse{Ret: ret}, transferErr)
+		erc20Keeper = mockKeeper
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+		expCoins sdk.Coins
+		expERC20 []types.ERC20Balance
+	}{
+		{
+			"fail - recovery of funds disabled",
+			func() {
+				fundAddress()
+				params := suite.app.RecoveryKeeper.GetParams(suite.ctx)
+				params.EnableRecoverFunds = false
+				err := suite.app.RecoveryKeeper.SetParams(suite.ctx, params)
+				suite.Require().NoError(err)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - blocked receiver",
+			func() {
+				fundAddress()
+				receiver = authtypes.NewModuleAddress(distrtypes.ModuleName)
+				msg.Receiver = receiver.String()
+				msg.Signature = signRecovery(privKey, suite.ctx.ChainID(), receiver, 0)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - signed by another key",
+			func() {
+				fundAddress()
+				msg.Signature = signRecovery(secp256k1.GenPrivKey(), suite.ctx.ChainID(), receiver, 0)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - signed for another chain",
+			func() {
+				fundAddress()
+				msg.Signature = signRecovery(privKey, utils.MainnetChainID+"-1", receiver, 0)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - signed for another receiver",
+			func() {
+				fundAddress()
+				msg.Signature = signRecovery(privKey, suite.ctx.ChainID(), sdk.AccAddress(utiltx.GenerateAddress().Bytes()), 0)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - signed with another nonce",
+			func() {
+				fundAddress()
+				msg.Signature = signRecovery(privKey, suite.ctx.ChainID(), receiver, 1)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - account with a supported key",
+			func() {
+				fundAddress()
+				ethPk, err := ethsecp256k1.GenerateKey()
+				suite.Require().NoError(err)
+
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, address)
+				err = acc.SetPubKey(ethPk.PubKey())
+				suite.Require().NoError(err)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - vesting account",
+			func() {
+				bacc := authtypes.NewBaseAccount(address, nil, 0, 0)
+				acc := vestingtypes.NewClawbackVestingAccount(bacc, receiver, nil, suite.ctx.BlockTime(), nil, nil)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+				fundAddress()
+			},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"fail - no funds to recover",
+			func() {},
+			false,
+			nil,
+			nil,
+		},
+		{
+			"pass - recover bank balances",
+			func() {
+				fundAddress()
+			},
+			true,
+			coins,
+			[]types.ERC20Balance{},
+		},
+		{
+			"pass - recover bank balances of an account with the recovered key",
+			func() {
+				fundAddress()
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, address)
+				err := acc.SetPubKey(privKey.PubKey())
+				suite.Require().NoError(err)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			true,
+			coins,
+			[]types.ERC20Balance{},
+		},
+		{
+			"pass - recover ERC-20 balances",
+			func() {
+				mockERC20Keeper(nil)
+			},
+			true,
+			sdk.Coins{},
+			[]types.ERC20Balance{
+				{ContractAddress: contract.Hex(), Amount: sdk.NewIntFromBigInt(erc20Balance)},
+			},
+		},
+		{
+			"pass - failed ERC-20 transfer is skipped",
+			func() {
+				fundAddress()
+				mockERC20Keeper(errors.New("transfer failed"))
+			},
+			true,
+			coins,
+			[]types.ERC20Balance{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			privKey = secp256k1.GenPrivKey()
+			address = sdk.AccAddress(privKey.PubKey().Address())
+			receiver = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			erc20Keeper = suite.app.Erc20Keeper
+			msg = &types.MsgRecoverFunds{
+				Sender:    sdk.AccAddress(utiltx.GenerateAddress().Bytes()).String(),
+				PubKey:    privKey.PubKey().Bytes(),
+				Receiver:  receiver.String(),
+				Signature: signRecovery(privKey, suite.ctx.ChainID(), receiver, 0),
+			}
+
+			tc.malleate()
+
+			k := keeper.NewKeeper(
+				suite.app.GetKey(types.StoreKey),
+				suite.app.AppCodec(),
+				authtypes.NewModuleAddress(govtypes.ModuleName),
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.IBCKeeper.ChannelKeeper,
+				suite.app.TransferKeeper, suite.app.ClaimsKeeper, erc20Keeper,
+			)
+
+			suite.Require().NoError(msg.ValidateBasic())
+			res, err := k.RecoverFunds(sdk.WrapSDKContext(suite.ctx), msg)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(uint64(0), k.GetRecoveryNonce(suite.ctx, address))
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expCoins, res.Coins)
+			suite.Require().Equal(tc.expERC20, res.Erc20Balances)
+
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, address).IsZero())
+			suite.Require().Equal(tc.expCoins, suite.app.BankKeeper.GetAllBalances(suite.ctx, receiver))
+			suite.Require().Equal(uint64(1), k.GetRecoveryNonce(suite.ctx, address))
+
+			// the signature cannot be replayed once the nonce is incremented
+			fundAddress()
+			_, err = k.RecoverFunds(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().ErrorIs(err, types.ErrInvalidRecoverySignature)
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v15/contracts"
+	"github.com/evmos/evmos/v15/utils"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	"github.com/evmos/evmos/v15/x/recovery/types"
+)
+
+// RecoverFunds implements the gRPC MsgServer interface. It sends all the bank
+// balances and the ERC-20 token pair balances of an address derived from a
+// coin type 118 key to the receiver, once the owner proved the control of the
+// key by signing the recovery. The recovery nonce of the address is then
+// incremented, so that the signature cannot be replayed.
+func (k *Keeper) RecoverFunds(goCtx context.Context, msg *types.MsgRecoverFunds) (*types.MsgRecoverFundsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRecoverFunds {
+		return nil, types.ErrRecoverFundsDisabled
+	}
+
+	pubKey := msg.GetRecoveredPubKey()
+	address := msg.GetRecoveredAddress()
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return nil, errorsmod.Wrapf(types.ErrBlockedAddress, "receiver %s is in the deny list", msg.Receiver)
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, address)
+	if err := checkRecoverableAccount(account, pubKey.Bytes()); err != nil {
+		return nil, err
+	}
+
+	nonce := k.GetRecoveryNonce(ctx, address)
+	signBytes := types.RecoverFundsSignBytes(ctx.ChainID(), address, receiver, nonce)
+	if !pubKey.VerifySignature(signBytes, msg.Signature) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidRecoverySignature,
+			"signature of %s does not match the recovery to %s with nonce %d", address, receiver, nonce,
+		)
+	}
+
+	// the EVM calls of the ERC-20 transfers require an account
+	if account == nil {
+		account = k.accountKeeper.NewAccountWithAddress(ctx, address)
+		k.accountKeeper.SetAccount(ctx, account)
+	}
+
+	coins := k.bankKeeper.GetAllBalances(ctx, address)
+	if !coins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, address, receiver, coins); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to recover balances")
+		}
+	}
+
+	erc20Balances := k.recoverERC20Balances(ctx, address, receiver)
+
+	if coins.IsZero() && len(erc20Balances) == 0 {
+		return nil, errorsmod.Wrapf(types.ErrNoFundsToRecover, "address %s", address)
+	}
+
+	k.SetRecoveryNonce(ctx, address, nonce+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverFunds,
+			sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	)
+
+	return &types.MsgRecoverFundsResponse{
+		Coins:         coins,
+		Erc20Balances: erc20Balances,
+	}, nil
+}
+
+// checkRecoverableAccount checks that the funds of the account can be
+// recovered with the given key. The account must not be a vesting or module
+// account, and must not have signed a transaction with another key.
+func checkRecoverableAccount(account authtypes.AccountI, pubKey []byte) error {
+	if account == nil {
+		return nil
+	}
+
+	if _, isVestingAcc := account.(vestexported.VestingAccount); isVestingAcc {
+		return errorsmod.Wrapf(types.ErrRecoveryNotAllowed, "%s is a vesting account", account.GetAddress())
+	}
+
+	if _, isModuleAccount := account.(authtypes.ModuleAccountI); isModuleAccount {
+		return errorsmod.Wrapf(types.ErrRecoveryNotAllowed, "%s is a module account", account.GetAddress())
+	}
+
+	accountPubKey := account.GetPubKey()
+	if accountPubKey == nil {
+		return nil
+	}
+
+	// the funds are not stuck on chain for supported keys
+	if utils.IsSupportedKey(accountPubKey) {
+		return errorsmod.Wrapf(types.ErrRecoveryNotAllowed, "%s has a supported key", account.GetAddress())
+	}
+
+	if !bytes.Equal(accountPubKey.Bytes(), pubKey) {
+		return errorsmod.Wrapf(types.ErrRecoveryNotAllowed, "public key does not match the key of %s", account.GetAddress())
+	}
+
+	return nil
+}
+
+// recoverERC20Balances transfers the ERC-20 tokens of the registered token
+// pairs held by the address to the receiver. A failed transfer is skipped so
+// that a token cannot prevent the recovery of the other funds.
+func (k Keeper) recoverERC20Balances(ctx sdk.Context, address, receiver sdk.AccAddress) []types.ERC20Balance {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	from := common.BytesToAddress(address)
+	to := common.BytesToAddress(receiver)
+
+	balances := []types.ERC20Balance{}
+	for _, pair := range k.erc20Keeper.GetTokenPairs(ctx) {
+		contract := pair.GetERC20Contract()
+
+		balance := k.erc20Keeper.BalanceOf(ctx, erc20, contract, from)
+		if balance == nil || balance.Sign() <= 0 {
+			continue
+		}
+
+		cacheCtx, writeFn := ctx.CacheContext()
+		res, err := k.erc20Keeper.CallEVM(cacheCtx, erc20, from, contract, true, "transfer", to, balance)
+		if err != nil {
+			k.Logger(ctx).Error("failed to recover ERC-20 balance", "contract", contract.Hex(), "error", err.Error())
+			continue
+		}
+
+		var unpackedRet erc20types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil || !unpackedRet.Value {
+			k.Logger(ctx).Error("failed to recover ERC-20 balance", "contract", contract.Hex())
+			continue
+		}
+
+		writeFn()
+		balances = append(balances, types.ERC20Balance{
+			ContractAddress: contract.Hex(),
+			Amount:          sdkmath.NewIntFromBigInt(balance),
+		})
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRecoverERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, address.String()),
+				sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, contract.Hex()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, balance.String()),
+			),
+		)
+	}
+
+	return balances
+}
+
+// GetRecoveryNonces returns the recovery nonces of all the addresses whose
+// funds were recovered
+func (k Keeper) GetRecoveryNonces(ctx sdk.Context) []types.RecoveryNonce {
+	nonces := []types.RecoveryNonce{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryNonce)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := sdk.AccAddress(iterator.Key())
+		nonces = append(nonces, types.NewRecoveryNonce(address, sdk.BigEndianToUint64(iterator.Value())))
+	}
+
+	return nonces
+}
+
+// GetRecoveryNonce returns the nonce of the next recovery of the address
+func (k Keeper) GetRecoveryNonce(ctx sdk.Context, address sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryNonce)
+	bz := store.Get(address.Bytes())
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRecoveryNonce sets the nonce of the next recovery of the address
+func (k Keeper) SetRecoveryNonce(ctx sdk.Context, address sdk.AccAddress, nonce uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRecoveryNonce)
+	store.Set(address.Bytes(), sdk.Uint64ToBigEndian(nonce))
+}
@@ -85,7 +85,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(c client.Context, serveMux *runt
 }
 
 // GetTxCmd returns the root tx command for the recovery module.
-func (AppModuleBasic) GetTxCmd() *cobra.Command { return nil }
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return cli.NewTxCmd() }
 
 // GetQueryCmd returns no root query command for the recovery module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
@@ -9,5 +9,10 @@ import (
 
 // errors
 var (
-	ErrBlockedAddress = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrBlockedAddress           = errorsmod.Register(ModuleName, 2, "blocked address")
+	ErrRecoverFundsDisabled     = errorsmod.Register(ModuleName, 3, "recovery of funds is disabled")
+	ErrInvalidRecoverySignature = errorsmod.Register(ModuleName, 4, "invalid recovery signature")
+	ErrRecoveryNotAllowed       = errorsmod.Register(ModuleName, 5, "recovery of funds not allowed")
+	ErrNoFundsToRecover         = errorsmod.Register(ModuleName, 6, "no funds to recover")
+	ErrInvalidRecoveryNonce     = errorsmod.Register(ModuleName, 7, "invalid recovery nonce")
 )
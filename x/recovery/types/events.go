@@ -5,5 +5,10 @@ package types
 
 // recovery events
 const (
-	EventTypeRecovery = "recovery"
+	EventTypeRecovery     = "recovery"
+	EventTypeRecoverFunds = "recover_funds"
+	EventTypeRecoverERC20 = "recover_erc20"
+
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyERC20Token = "erc20_token"
 )
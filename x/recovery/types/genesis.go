@@ -3,22 +3,39 @@
 
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, recoveryNonces []RecoveryNonce) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:         params,
+		RecoveryNonces: recoveryNonces,
 	}
 }
 
 // DefaultGenesisState sets default recovery genesis state with default params
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:         DefaultParams(),
+		RecoveryNonces: []RecoveryNonce{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seenAddresses := make(map[string]bool)
+	for _, nonce := range gs.RecoveryNonces {
+		if seenAddresses[nonce.Address] {
+			return fmt.Errorf("duplicate recovery nonce for address %s", nonce.Address)
+		}
+
+		if err := nonce.Validate(); err != nil {
+			return err
+		}
+
+		seenAddresses[nonce.Address] = true
+	}
+
 	return gs.Params.Validate()
 }
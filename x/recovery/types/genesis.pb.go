@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// recovery_nonces are the nonces of the next recoveries of the addresses
+	// whose funds were recovered with MsgRecoverFunds
+	RecoveryNonces []RecoveryNonce `protobuf:"bytes,2,rep,name=recovery_nonces,json=recoveryNonces,proto3" json:"recovery_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -73,12 +76,22 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRecoveryNonces() []RecoveryNonce {
+	if m != nil {
+		return m.RecoveryNonces
+	}
+	return nil
+}
+
 // Params holds parameters for the recovery module
 type Params struct {
 	// enable_recovery IBC middleware
 	EnableRecovery bool `protobuf:"varint,1,opt,name=enable_recovery,json=enableRecovery,proto3" json:"enable_recovery,omitempty"`
 	// packet_timeout_duration is the duration added to timeout timestamp for balances recovered via IBC packets
 	PacketTimeoutDuration time.Duration `protobuf:"bytes,2,opt,name=packet_timeout_duration,json=packetTimeoutDuration,proto3,stdduration" json:"packet_timeout_duration"`
+	// enable_recover_funds enables the recovery of the funds of addresses derived
+	// from coin type 118 keys with MsgRecoverFunds
+	EnableRecoverFunds bool `protobuf:"varint,3,opt,name=enable_recover_funds,json=enableRecoverFunds,proto3" json:"enable_recover_funds,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -128,34 +141,103 @@ func (m *Params) GetPacketTimeoutDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetEnableRecoverFunds() bool {
+	if m != nil {
+		return m.EnableRecoverFunds
+	}
+	return false
+}
+
+// RecoveryNonce defines the nonce of the next recovery of an address, which is
+// signed with the recovery to prevent replays
+type RecoveryNonce struct {
+	// address is the recovered address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the nonce of the next recovery
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *RecoveryNonce) Reset()         { *m = RecoveryNonce{} }
+func (m *RecoveryNonce) String() string { return proto.CompactTextString(m) }
+func (*RecoveryNonce) ProtoMessage()    {}
+func (*RecoveryNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a3e70cb61e26f25, []int{2}
+}
+func (m *RecoveryNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveryNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveryNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveryNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveryNonce.Merge(m, src)
+}
+func (m *RecoveryNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveryNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveryNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveryNonce proto.InternalMessageInfo
+
+func (m *RecoveryNonce) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecoveryNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.recovery.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.recovery.v1.Params")
+	proto.RegisterType((*RecoveryNonce)(nil), "evmos.recovery.v1.RecoveryNonce")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/genesis.proto", fileDescriptor_8a3e70cb61e26f25) }
 
 var fileDescriptor_8a3e70cb61e26f25 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x1b, 0x91, 0x31, 0xa2, 0x28, 0x16, 0xc5, 0xb9, 0x43, 0x26, 0xbb, 0x28, 0x08, 0x09,
-	0x55, 0xc4, 0xfb, 0x50, 0x76, 0x95, 0xea, 0x49, 0x0f, 0x25, 0xad, 0xcf, 0x58, 0x5c, 0xfb, 0x4a,
-	0x9b, 0x16, 0xf7, 0x4f, 0x88, 0x47, 0xff, 0xa4, 0x1d, 0x77, 0xf4, 0xa4, 0xd2, 0xfe, 0x23, 0xb2,
-	0xa4, 0xd5, 0x83, 0x97, 0x90, 0xbc, 0xef, 0x97, 0xef, 0xfb, 0x78, 0x74, 0x04, 0x55, 0x82, 0x85,
-	0xc8, 0x21, 0xc2, 0x0a, 0xf2, 0xb9, 0xa8, 0x3c, 0xa1, 0x20, 0x85, 0x22, 0x2e, 0x78, 0x96, 0xa3,
-	0x46, 0x77, 0xc7, 0x00, 0xbc, 0x03, 0x78, 0xe5, 0x0d, 0x77, 0x15, 0x2a, 0x34, 0xaa, 0x58, 0xdd,
-	0x2c, 0x38, 0x64, 0x0a, 0x51, 0xcd, 0x40, 0x98, 0x57, 0x58, 0x3e, 0x8a, 0x87, 0x32, 0x97, 0x3a,
-	0xc6, 0xd4, 0xea, 0xe3, 0x29, 0xdd, 0x9c, 0x5a, 0xe7, 0x1b, 0x2d, 0x35, 0xb8, 0x17, 0xb4, 0x97,
-	0xc9, 0x5c, 0x26, 0xc5, 0x80, 0x1c, 0x92, 0xe3, 0x8d, 0xd3, 0x03, 0xfe, 0x2f, 0x89, 0x5f, 0x1b,
-	0x60, 0xb2, 0xbe, 0xf8, 0x1c, 0x39, 0x7e, 0x8b, 0x8f, 0x5f, 0x09, 0xed, 0x59, 0xc1, 0x3d, 0xa2,
-	0xdb, 0x90, 0xca, 0x70, 0x06, 0x41, 0xf7, 0xcb, 0x98, 0xf5, 0xfd, 0x2d, 0x3b, 0xf6, 0xdb, 0xa9,
-	0x7b, 0x4f, 0xf7, 0x33, 0x19, 0x3d, 0x83, 0x0e, 0x74, 0x9c, 0x00, 0x96, 0x3a, 0xe8, 0xda, 0x0d,
-	0xd6, 0xda, 0x74, 0x5b, 0x9f, 0x77, 0xf5, 0xf9, 0x65, 0x0b, 0x4c, 0xfa, 0xab, 0xf4, 0xf7, 0xaf,
-	0x11, 0xf1, 0xf7, 0xac, 0xc7, 0xad, 0xb5, 0xf8, 0x05, 0xae, 0x16, 0x35, 0x23, 0xcb, 0x9a, 0x91,
-	0xef, 0x9a, 0x91, 0xb7, 0x86, 0x39, 0xcb, 0x86, 0x39, 0x1f, 0x0d, 0x73, 0xee, 0x4e, 0x54, 0xac,
-	0x9f, 0xca, 0x90, 0x47, 0x98, 0x08, 0xbb, 0x68, 0x7b, 0x56, 0xde, 0xb9, 0x78, 0xf9, 0x5b, 0xba,
-	0x9e, 0x67, 0x50, 0x84, 0x3d, 0x13, 0x7d, 0xf6, 0x13, 0x00, 0x00, 0xff, 0xff, 0x98, 0x4f, 0xfa,
-	0xa4, 0x93, 0x01, 0x00, 0x00,
+	// 382 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xda, 0x30,
+	0x18, 0xc6, 0x63, 0x60, 0x8c, 0x99, 0x0d, 0x34, 0x8b, 0x69, 0x19, 0x87, 0x80, 0xb8, 0x0c, 0x69,
+	0x92, 0x3d, 0x98, 0xa6, 0x1d, 0x27, 0xa1, 0xad, 0xbd, 0xb5, 0x55, 0xda, 0x53, 0x7b, 0x88, 0xf2,
+	0xc7, 0xa4, 0x51, 0x49, 0x1c, 0xc5, 0x4e, 0x54, 0xbe, 0x45, 0x8f, 0x7c, 0x9c, 0x1e, 0x39, 0x72,
+	0xec, 0xa9, 0xad, 0xe0, 0x8b, 0x54, 0xb1, 0x63, 0xb5, 0xa8, 0xbd, 0x58, 0x7e, 0xfd, 0xfe, 0xf4,
+	0xf8, 0x79, 0x1f, 0xbd, 0x70, 0x40, 0x8b, 0x98, 0x71, 0x92, 0x51, 0x9f, 0x15, 0x34, 0x5b, 0x92,
+	0x62, 0x42, 0x42, 0x9a, 0x50, 0x1e, 0x71, 0x9c, 0x66, 0x4c, 0x30, 0xf4, 0x59, 0x02, 0x58, 0x03,
+	0xb8, 0x98, 0xf4, 0x7b, 0x21, 0x0b, 0x99, 0xec, 0x92, 0xf2, 0xa6, 0xc0, 0xbe, 0x15, 0x32, 0x16,
+	0x2e, 0x28, 0x91, 0x95, 0x97, 0xcf, 0x49, 0x90, 0x67, 0xae, 0x88, 0x58, 0xa2, 0xfa, 0xa3, 0x15,
+	0x80, 0x1f, 0x0f, 0x95, 0xf4, 0xa9, 0x70, 0x05, 0x45, 0x7f, 0x60, 0x33, 0x75, 0x33, 0x37, 0xe6,
+	0x26, 0x18, 0x82, 0x71, 0x7b, 0xfa, 0x0d, 0xbf, 0xfa, 0x0a, 0x9f, 0x48, 0x60, 0xd6, 0x58, 0xdf,
+	0x0f, 0x0c, 0xbb, 0xc2, 0xd1, 0x31, 0xec, 0x6a, 0xc6, 0x49, 0x58, 0xe2, 0x53, 0x6e, 0xd6, 0x86,
+	0xf5, 0x71, 0x7b, 0x3a, 0x7c, 0x43, 0xc1, 0xae, 0xee, 0x47, 0x25, 0x58, 0x09, 0x75, 0xb2, 0x97,
+	0x8f, 0x7c, 0x74, 0x0b, 0x60, 0x53, 0xfd, 0x84, 0xbe, 0xc3, 0x2e, 0x4d, 0x5c, 0x6f, 0x41, 0x1d,
+	0xcd, 0x48, 0x77, 0x2d, 0xbb, 0xa3, 0x9e, 0xb5, 0x1c, 0xba, 0x80, 0x5f, 0x53, 0xd7, 0xbf, 0xa2,
+	0xc2, 0x11, 0x51, 0x4c, 0x59, 0x2e, 0x1c, 0x3d, 0xaf, 0x59, 0xab, 0xc6, 0x51, 0x81, 0x60, 0x1d,
+	0x08, 0xfe, 0x57, 0x01, 0xb3, 0x56, 0xe9, 0x62, 0xf5, 0x30, 0x00, 0xf6, 0x17, 0xa5, 0x71, 0xa6,
+	0x24, 0x34, 0x80, 0x7e, 0xc2, 0xde, 0xbe, 0x0b, 0x67, 0x9e, 0x27, 0x01, 0x37, 0xeb, 0xd2, 0x0a,
+	0xda, 0xb3, 0x72, 0x50, 0x76, 0x46, 0x7f, 0xe1, 0xa7, 0xbd, 0x49, 0x91, 0x09, 0xdf, 0xbb, 0x41,
+	0x90, 0x51, 0xae, 0xe2, 0xfd, 0x60, 0xeb, 0x12, 0xf5, 0xe0, 0x3b, 0x99, 0x9a, 0xf4, 0xd9, 0xb0,
+	0x55, 0x31, 0xfb, 0xbf, 0xde, 0x5a, 0x60, 0xb3, 0xb5, 0xc0, 0xe3, 0xd6, 0x02, 0x37, 0x3b, 0xcb,
+	0xd8, 0xec, 0x2c, 0xe3, 0x6e, 0x67, 0x19, 0xe7, 0x3f, 0xc2, 0x48, 0x5c, 0xe6, 0x1e, 0xf6, 0x59,
+	0x4c, 0xd4, 0xb6, 0xa8, 0xb3, 0x98, 0xfc, 0x26, 0xd7, 0xcf, 0x9b, 0x23, 0x96, 0x29, 0xe5, 0x5e,
+	0x53, 0x4e, 0xfb, 0xeb, 0x69, 0x00, 0xe6, 0x29, 0x88, 0xaa, 0x58, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +260,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecoveryNonces) > 0 {
+		for iNdEx := len(m.RecoveryNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecoveryNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -211,6 +307,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableRecoverFunds {
+		i--
+		if m.EnableRecoverFunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PacketTimeoutDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PacketTimeoutDuration):])
 	if err2 != nil {
 		return 0, err2
@@ -232,6 +338,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RecoveryNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveryNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveryNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -251,6 +392,12 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RecoveryNonces) > 0 {
+		for _, e := range m.RecoveryNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -265,6 +412,25 @@ func (m *Params) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PacketTimeoutDuration)
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnableRecoverFunds {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecoveryNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -336,6 +502,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryNonces = append(m.RecoveryNonces, RecoveryNonce{})
+			if err := m.RecoveryNonces[len(m.RecoveryNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -439,6 +639,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRecoverFunds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableRecoverFunds = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveryNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveryNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveryNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -4,10 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisValidate(t *testing.T) {
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
 	testCases := []struct {
 		name     string
 		genesis  GenesisState
@@ -25,9 +29,39 @@ func TestGenesisValidate(t *testing.T) {
 		},
 		{
 			"custom genesis",
-			NewGenesisState(NewParams(true, time.Hour)),
+			NewGenesisState(NewParams(true, time.Hour, true), nil),
 			false,
 		},
+		{
+			"genesis with recovery nonces",
+			NewGenesisState(DefaultParams(), []RecoveryNonce{
+				NewRecoveryNonce(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 1),
+				NewRecoveryNonce(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 3),
+			}),
+			false,
+		},
+		{
+			"invalid genesis - duplicate recovery nonce",
+			NewGenesisState(DefaultParams(), []RecoveryNonce{
+				NewRecoveryNonce(addr, 1),
+				NewRecoveryNonce(addr, 2),
+			}),
+			true,
+		},
+		{
+			"invalid genesis - zero recovery nonce",
+			NewGenesisState(DefaultParams(), []RecoveryNonce{
+				NewRecoveryNonce(sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()), 0),
+			}),
+			true,
+		},
+		{
+			"invalid genesis - invalid recovery nonce address",
+			NewGenesisState(DefaultParams(), []RecoveryNonce{
+				{Address: "evmos1", Nonce: 1},
+			}),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
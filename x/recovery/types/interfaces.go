@@ -5,6 +5,10 @@ package types
 
 import (
 	context "context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -16,18 +20,24 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	claimstypes "github.com/evmos/evmos/v15/x/claims/types"
+	erc20types "github.com/evmos/evmos/v15/x/erc20/types"
+	evmtypes "github.com/evmos/evmos/v15/x/evm/types"
 )
 
 // BankKeeper defines the banking keeper that must be fulfilled when
 // creating a x/recovery keeper.
 type BankKeeper interface {
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 // AccountKeeper defines the expected account keeper
 type AccountKeeper interface {
 	GetAccount(sdk.Context, sdk.AccAddress) authtypes.AccountI
+	NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	SetAccount(ctx sdk.Context, account authtypes.AccountI)
 }
 
 // TransferKeeper defines the expected IBC transfer keeper.
@@ -41,6 +51,20 @@ type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 }
 
+// ERC20Keeper defines the expected ERC20 keeper.
+type ERC20Keeper interface {
+	GetTokenPairs(ctx sdk.Context) []erc20types.TokenPair
+	BalanceOf(ctx sdk.Context, abi abi.ABI, contract, account common.Address) *big.Int
+	CallEVM(
+		ctx sdk.Context,
+		abi abi.ABI,
+		from, contract common.Address,
+		commit bool,
+		method string,
+		args ...interface{},
+	) (*evmtypes.MsgEthereumTxResponse, error)
+}
+
 // ClaimsKeeper defines the expected claims keeper.
 type ClaimsKeeper interface {
 	GetParams(ctx sdk.Context) claimstypes.Params
@@ -14,3 +14,13 @@ const (
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
 )
+
+// prefix bytes for the recovery persistent store
+const (
+	prefixRecoveryNonce = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRecoveryNonce = []byte{prefixRecoveryNonce}
+)
@@ -5,10 +5,19 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+// secp256k1SignatureLength is the length of a secp256k1 signature in the
+// [R || S] format
+const secp256k1SignatureLength = 64
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRecoverFunds{}
+)
 
 // GetSigners returns the expected signers for a MsgUpdateParams message.
 func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
@@ -29,3 +38,46 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgRecoverFunds message.
+func (m *MsgRecoverFunds) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRecoverFunds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	if len(m.PubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidPubKey,
+			"expected compressed secp256k1 public key of %d bytes, got %d", secp256k1.PubKeySize, len(m.PubKey),
+		)
+	}
+
+	if len(m.Signature) != secp256k1SignatureLength {
+		return errorsmod.Wrapf(
+			ErrInvalidRecoverySignature,
+			"expected signature of %d bytes, got %d", secp256k1SignatureLength, len(m.Signature),
+		)
+	}
+
+	if receiver.Equals(m.GetRecoveredAddress()) {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, "receiver cannot be the recovered address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRecoverFunds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
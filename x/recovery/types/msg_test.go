@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRecoverFundsValidateBasic(t *testing.T) {
+	privKey := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiver := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	signature, err := privKey.Sign(RecoverFundsSignBytes("evmos_9001-2", address, receiver, 0))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		msg      MsgRecoverFunds
+		expError bool
+	}{
+		{
+			"valid msg",
+			MsgRecoverFunds{sender.String(), privKey.PubKey().Bytes(), receiver.String(), signature},
+			false,
+		},
+		{
+			"invalid sender",
+			MsgRecoverFunds{"invalid", privKey.PubKey().Bytes(), receiver.String(), signature},
+			true,
+		},
+		{
+			"invalid receiver",
+			MsgRecoverFunds{sender.String(), privKey.PubKey().Bytes(), "invalid", signature},
+			true,
+		},
+		{
+			"invalid public key length",
+			MsgRecoverFunds{sender.String(), privKey.PubKey().Bytes()[1:], receiver.String(), signature},
+			true,
+		},
+		{
+			"invalid signature length",
+			MsgRecoverFunds{sender.String(), privKey.PubKey().Bytes(), receiver.String(), signature[1:]},
+			true,
+		},
+		{
+			"receiver is the recovered address",
+			MsgRecoverFunds{sender.String(), privKey.PubKey().Bytes(), address.String(), signature},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expError {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
@@ -16,15 +16,17 @@ var ParamsKey = []byte("Params")
 var (
 	DefaultEnableRecovery        = true
 	DefaultPacketTimeoutDuration = 4 * time.Hour
+	DefaultEnableRecoverFunds    = true
 )
 
 // NewParams creates a new Params instance
 func NewParams(
-	enableRecovery bool, timeoutDuration time.Duration,
+	enableRecovery bool, timeoutDuration time.Duration, enableRecoverFunds bool,
 ) Params {
 	return Params{
 		EnableRecovery:        enableRecovery,
 		PacketTimeoutDuration: timeoutDuration,
+		EnableRecoverFunds:    enableRecoverFunds,
 	}
 }
 
@@ -33,6 +35,7 @@ func DefaultParams() Params {
 	return Params{
 		EnableRecovery:        DefaultEnableRecovery,
 		PacketTimeoutDuration: DefaultPacketTimeoutDuration,
+		EnableRecoverFunds:    DefaultEnableRecoverFunds,
 	}
 }
 
@@ -64,5 +67,9 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBool(p.EnableRecoverFunds); err != nil {
+		return err
+	}
+
 	return validateBool(p.EnableRecovery)
 }
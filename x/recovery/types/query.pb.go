@@ -112,33 +112,135 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryRecoveryNonceRequest is the request type for the Query/RecoveryNonce RPC
+// method.
+type QueryRecoveryNonceRequest struct {
+	// address is the bech32 address whose funds are recovered
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRecoveryNonceRequest) Reset()         { *m = QueryRecoveryNonceRequest{} }
+func (m *QueryRecoveryNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryNonceRequest) ProtoMessage()    {}
+func (*QueryRecoveryNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{2}
+}
+func (m *QueryRecoveryNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryNonceRequest.Merge(m, src)
+}
+func (m *QueryRecoveryNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryNonceRequest proto.InternalMessageInfo
+
+func (m *QueryRecoveryNonceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRecoveryNonceResponse is the response type for the Query/RecoveryNonce
+// RPC method.
+type QueryRecoveryNonceResponse struct {
+	// nonce is the nonce of the next recovery of the address
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *QueryRecoveryNonceResponse) Reset()         { *m = QueryRecoveryNonceResponse{} }
+func (m *QueryRecoveryNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecoveryNonceResponse) ProtoMessage()    {}
+func (*QueryRecoveryNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d6fffa62670b057, []int{3}
+}
+func (m *QueryRecoveryNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecoveryNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecoveryNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecoveryNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecoveryNonceResponse.Merge(m, src)
+}
+func (m *QueryRecoveryNonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecoveryNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecoveryNonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecoveryNonceResponse proto.InternalMessageInfo
+
+func (m *QueryRecoveryNonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.recovery.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.recovery.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRecoveryNonceRequest)(nil), "evmos.recovery.v1.QueryRecoveryNonceRequest")
+	proto.RegisterType((*QueryRecoveryNonceResponse)(nil), "evmos.recovery.v1.QueryRecoveryNonceResponse")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/query.proto", fileDescriptor_2d6fffa62670b057) }
 
 var fileDescriptor_2d6fffa62670b057 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2c,
-	0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0x4b, 0xeb, 0xc1, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0xe4, 0x31, 0x75, 0xa4, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0xf4,
-	0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x54, 0x54, 0x26, 0x3d, 0x3f,
-	0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31, 0x2f, 0x2f, 0xbf, 0x24, 0xb1, 0x24,
-	0x33, 0x3f, 0x0f, 0xaa, 0x47, 0x49, 0x84, 0x4b, 0x28, 0x10, 0x64, 0x6d, 0x40, 0x62, 0x51, 0x62,
-	0x6e, 0x71, 0x50, 0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x1f, 0x97, 0x30, 0x8a, 0x68, 0x71,
-	0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x39, 0x17, 0x5b, 0x01, 0x58, 0x44, 0x82, 0x51, 0x81, 0x51,
-	0x83, 0xdb, 0x48, 0x52, 0x0f, 0xc3, 0x95, 0x7a, 0x10, 0x2d, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33,
-	0x04, 0x41, 0x95, 0x1b, 0x35, 0x33, 0x72, 0xb1, 0x82, 0x0d, 0x14, 0xaa, 0xe2, 0x62, 0x83, 0xa8,
-	0x10, 0x52, 0xc5, 0xa2, 0x19, 0xd3, 0x29, 0x52, 0x6a, 0x84, 0x94, 0x41, 0xdc, 0xa6, 0xa4, 0xd8,
-	0x74, 0xf9, 0xc9, 0x64, 0x26, 0x69, 0x21, 0x49, 0x7d, 0xcc, 0x60, 0x82, 0xb8, 0xc2, 0xc9, 0xf5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xda, 0x21, 0x64, 0x99, 0xa1, 0xa9, 0x7e, 0x05, 0xc2, 0xa8,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xc8, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x91, 0x8a, 0xcd, 0x36, 0xc2, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0x93, 0xd2, 0x56, 0x5c, 0xf1, 0xe0, 0xda, 0x43, 0x1b, 0x35, 0xd5, 0x80, 0x22, 0xd4,
+	0x66, 0x69, 0x4b, 0xf1, 0x5e, 0xf0, 0x5a, 0x34, 0x47, 0x2f, 0x92, 0xb6, 0x4b, 0x0c, 0xd8, 0x9d,
+	0x34, 0x9b, 0x06, 0xab, 0x78, 0xf1, 0x09, 0x04, 0x9f, 0xc0, 0x93, 0xaf, 0xd2, 0x63, 0xc1, 0x8b,
+	0x27, 0x91, 0xd6, 0x07, 0x91, 0xee, 0x6e, 0x90, 0x92, 0x88, 0x5e, 0xc2, 0xec, 0xec, 0xfc, 0xff,
+	0x7c, 0x33, 0x59, 0xb4, 0x47, 0xe3, 0x21, 0x70, 0x12, 0xd2, 0x3e, 0xc4, 0x34, 0x9c, 0x90, 0xb8,
+	0x41, 0x46, 0x63, 0x1a, 0x4e, 0xec, 0x20, 0x84, 0x08, 0xf0, 0x96, 0xb8, 0xb6, 0x93, 0x6b, 0x3b,
+	0x6e, 0x18, 0xd5, 0xb4, 0xc2, 0xa3, 0x8c, 0x72, 0x9f, 0x4b, 0x8d, 0x51, 0xf2, 0xc0, 0x03, 0x11,
+	0x92, 0x65, 0xa4, 0xb2, 0xbb, 0x1e, 0x80, 0x77, 0x43, 0x89, 0x1b, 0xf8, 0xc4, 0x65, 0x0c, 0x22,
+	0x37, 0xf2, 0x81, 0x29, 0x8d, 0x55, 0x42, 0xf8, 0x62, 0xd9, 0xf6, 0xdc, 0x0d, 0xdd, 0x21, 0x77,
+	0xe8, 0x68, 0x4c, 0x79, 0x64, 0x75, 0xd1, 0xf6, 0x4a, 0x96, 0x07, 0xc0, 0x38, 0xc5, 0xa7, 0xa8,
+	0x18, 0x88, 0x4c, 0x59, 0xdf, 0xd7, 0x8f, 0x37, 0x9a, 0x15, 0x3b, 0x45, 0x69, 0x4b, 0x49, 0x27,
+	0x3f, 0xfd, 0xa8, 0x6a, 0x8e, 0x2a, 0xb7, 0xda, 0xa8, 0x22, 0xfc, 0x1c, 0x55, 0xd8, 0x05, 0xd6,
+	0xa7, 0xaa, 0x19, 0x2e, 0xa3, 0x35, 0x77, 0x30, 0x08, 0x29, 0x97, 0xb6, 0xeb, 0x4e, 0x72, 0xb4,
+	0x9a, 0xc8, 0xc8, 0x92, 0x29, 0x9a, 0x12, 0x2a, 0xb0, 0x65, 0x42, 0xa8, 0xf2, 0x8e, 0x3c, 0x34,
+	0x5f, 0x73, 0xa8, 0x20, 0x44, 0xf8, 0x0e, 0x15, 0x25, 0x0c, 0x3e, 0xcc, 0xe0, 0x4c, 0x4f, 0x6d,
+	0x1c, 0xfd, 0x55, 0x26, 0x1b, 0x5b, 0x07, 0x8f, 0x6f, 0x5f, 0xcf, 0xb9, 0x1d, 0x5c, 0x21, 0xe9,
+	0x3f, 0x22, 0x07, 0xc6, 0x2f, 0x3a, 0xda, 0x5c, 0xa1, 0xc6, 0x27, 0xbf, 0x99, 0x67, 0xed, 0xc4,
+	0xa8, 0xff, 0xb3, 0x5a, 0x11, 0xb5, 0x04, 0x51, 0x1d, 0xd7, 0x32, 0x88, 0x92, 0xf8, 0x4a, 0xec,
+	0x87, 0xdc, 0xab, 0xe5, 0x3e, 0x74, 0xce, 0xa6, 0x73, 0x53, 0x9f, 0xcd, 0x4d, 0xfd, 0x73, 0x6e,
+	0xea, 0x4f, 0x0b, 0x53, 0x9b, 0x2d, 0x4c, 0xed, 0x7d, 0x61, 0x6a, 0x97, 0x35, 0xcf, 0x8f, 0xae,
+	0xc7, 0x3d, 0xbb, 0x0f, 0x43, 0x65, 0x28, 0xbf, 0x71, 0xa3, 0x4d, 0x6e, 0x7f, 0xcc, 0xa3, 0x49,
+	0x40, 0x79, 0xaf, 0x28, 0x1e, 0x52, 0xeb, 0x7b, 0x00, 0x3e, 0x23, 0x48, 0x88, 0xd1, 0x02, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -155,6 +257,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params retrieves the total set of recovery parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RecoveryNonce retrieves the nonce signed to recover the funds of an address
+	RecoveryNonce(ctx context.Context, in *QueryRecoveryNonceRequest, opts ...grpc.CallOption) (*QueryRecoveryNonceResponse, error)
 }
 
 type queryClient struct {
@@ -174,10 +278,21 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) RecoveryNonce(ctx context.Context, in *QueryRecoveryNonceRequest, opts ...grpc.CallOption) (*QueryRecoveryNonceResponse, error) {
+	out := new(QueryRecoveryNonceResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Query/RecoveryNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params retrieves the total set of recovery parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RecoveryNonce retrieves the nonce signed to recover the funds of an address
+	RecoveryNonce(context.Context, *QueryRecoveryNonceRequest) (*QueryRecoveryNonceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -187,6 +302,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RecoveryNonce(ctx context.Context, req *QueryRecoveryNonceRequest) (*QueryRecoveryNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveryNonce not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -210,6 +328,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecoveryNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecoveryNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveryNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Query/RecoveryNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveryNonce(ctx, req.(*QueryRecoveryNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.recovery.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -218,6 +354,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RecoveryNonce",
+			Handler:    _Query_RecoveryNonce_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/recovery/v1/query.proto",
@@ -279,6 +419,64 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRecoveryNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecoveryNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecoveryNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecoveryNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -310,6 +508,31 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRecoveryNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecoveryNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -449,6 +672,157 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRecoveryNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecoveryNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecoveryNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecoveryNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
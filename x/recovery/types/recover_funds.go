@@ -0,0 +1,74 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recoverFundsSignDoc is the document signed by the coin type 118 key of an
+// address to recover its funds
+type recoverFundsSignDoc struct {
+	Type     string `json:"type"`
+	ChainID  string `json:"chain_id"`
+	Address  string `json:"address"`
+	Receiver string `json:"receiver"`
+	Nonce    string `json:"nonce"`
+}
+
+// RecoverFundsSignBytes returns the bytes signed by the coin type 118 key of
+// the address to recover its funds to the receiver. The chain ID and the
+// recovery nonce of the address prevent the signature from being replayed.
+func RecoverFundsSignBytes(chainID string, address, receiver sdk.AccAddress, nonce uint64) []byte {
+	bz, err := json.Marshal(recoverFundsSignDoc{
+		Type:     recoverFundsName,
+		ChainID:  chainID,
+		Address:  address.String(),
+		Receiver: receiver.String(),
+		Nonce:    strconv.FormatUint(nonce, 10),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// NewRecoveryNonce returns an instance of RecoveryNonce
+func NewRecoveryNonce(address sdk.AccAddress, nonce uint64) RecoveryNonce {
+	return RecoveryNonce{
+		Address: address.String(),
+		Nonce:   nonce,
+	}
+}
+
+// Validate performs a stateless validation of a RecoveryNonce
+func (n RecoveryNonce) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(n.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid recovery nonce address")
+	}
+
+	if n.Nonce == 0 {
+		return errorsmod.Wrapf(ErrInvalidRecoveryNonce, "nonce of %s cannot be 0", n.Address)
+	}
+
+	return nil
+}
+
+// GetRecoveredPubKey returns the coin type 118 public key of the recovered
+// address
+func (m MsgRecoverFunds) GetRecoveredPubKey() *secp256k1.PubKey {
+	return &secp256k1.PubKey{Key: m.PubKey}
+}
+
+// GetRecoveredAddress returns the address derived from the coin type 118
+// public key, whose funds are recovered
+func (m MsgRecoverFunds) GetRecoveredAddress() sdk.AccAddress {
+	return sdk.AccAddress(m.GetRecoveredPubKey().Address())
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -124,35 +126,236 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRecoverFunds defines a Msg for recovering the bank and ERC-20 balances of
+// an address derived from a coin type 118 (secp256k1) key, which cannot sign
+// transactions on Evmos. The owner of the key proves its control by signing the
+// recovery.
+type MsgRecoverFunds struct {
+	// sender is the address signing the transaction
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pub_key is the compressed secp256k1 public key of the recovered address
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// receiver is the address receiving the recovered funds
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// signature is the secp256k1 signature of the recovery sign bytes, which
+	// include the chain ID, the receiver and the recovery nonce of the address
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRecoverFunds) Reset()         { *m = MsgRecoverFunds{} }
+func (m *MsgRecoverFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverFunds) ProtoMessage()    {}
+func (*MsgRecoverFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{2}
+}
+func (m *MsgRecoverFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverFunds.Merge(m, src)
+}
+func (m *MsgRecoverFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverFunds proto.InternalMessageInfo
+
+func (m *MsgRecoverFunds) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRecoverFunds) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *MsgRecoverFunds) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgRecoverFunds) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRecoverFundsResponse defines the response structure for executing a
+// MsgRecoverFunds message.
+type MsgRecoverFundsResponse struct {
+	// coins are the recovered bank balances
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// erc20_balances are the recovered ERC-20 token balances
+	Erc20Balances []ERC20Balance `protobuf:"bytes,2,rep,name=erc20_balances,json=erc20Balances,proto3" json:"erc20_balances"`
+}
+
+func (m *MsgRecoverFundsResponse) Reset()         { *m = MsgRecoverFundsResponse{} }
+func (m *MsgRecoverFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverFundsResponse) ProtoMessage()    {}
+func (*MsgRecoverFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{3}
+}
+func (m *MsgRecoverFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverFundsResponse.Merge(m, src)
+}
+func (m *MsgRecoverFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverFundsResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverFundsResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgRecoverFundsResponse) GetErc20Balances() []ERC20Balance {
+	if m != nil {
+		return m.Erc20Balances
+	}
+	return nil
+}
+
+// ERC20Balance defines the balance of an ERC-20 token
+type ERC20Balance struct {
+	// contract_address is the hex address of the ERC-20 contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount is the balance of the token
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ERC20Balance) Reset()         { *m = ERC20Balance{} }
+func (m *ERC20Balance) String() string { return proto.CompactTextString(m) }
+func (*ERC20Balance) ProtoMessage()    {}
+func (*ERC20Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d25d0e60b916986f, []int{4}
+}
+func (m *ERC20Balance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Balance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ERC20Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Balance.Merge(m, src)
+}
+func (m *ERC20Balance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Balance proto.InternalMessageInfo
+
+func (m *ERC20Balance) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "evmos.recovery.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "evmos.recovery.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRecoverFunds)(nil), "evmos.recovery.v1.MsgRecoverFunds")
+	proto.RegisterType((*MsgRecoverFundsResponse)(nil), "evmos.recovery.v1.MsgRecoverFundsResponse")
+	proto.RegisterType((*ERC20Balance)(nil), "evmos.recovery.v1.ERC20Balance")
 }
 
 func init() { proto.RegisterFile("evmos/recovery/v1/tx.proto", fileDescriptor_d25d0e60b916986f) }
 
 var fileDescriptor_d25d0e60b916986f = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x4d, 0xce, 0x2f, 0x4b, 0x2d, 0xaa, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0xa9,
-	0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x04, 0xcb, 0xe9, 0xc1, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x41, 0xea, 0x73, 0x8b, 0xd3, 0x41, 0x4a, 0x73, 0x8b, 0xd3, 0x21,
-	0x6a, 0xa5, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x92, 0xc7, 0xb4, 0x22,
-	0x3d, 0x35, 0x2f, 0xb5, 0x38, 0x13, 0xa6, 0x40, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xa2, 0x11, 0xc4,
-	0x82, 0x88, 0x2a, 0x4d, 0x62, 0xe4, 0xe2, 0xf7, 0x2d, 0x4e, 0x0f, 0x2d, 0x48, 0x49, 0x2c, 0x49,
-	0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x16, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04,
-	0x6a, 0x9f, 0x63, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10,
-	0x42, 0xa9, 0x90, 0x39, 0x17, 0x5b, 0x01, 0xd8, 0x04, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x49, 0x3d, 0x0c, 0xaf, 0xe9, 0x41, 0xac, 0x70, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa,
-	0xdc, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x41, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x6e, 0x0a,
-	0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x4a, 0xe5, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0x8a,
-	0xe3, 0xe2, 0x41, 0x71, 0xb2, 0x12, 0x16, 0xab, 0xd0, 0x8c, 0x90, 0xd2, 0x22, 0xac, 0x06, 0x66,
-	0x8d, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x82, 0x1c, 0x42, 0x96, 0x19, 0x9a,
-	0xea, 0x57, 0x20, 0x82, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xc8, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x68, 0x3f, 0xcf, 0x00, 0x02, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x49, 0x09, 0xe4, 0x1a, 0x5a, 0x38, 0x55, 0x8a, 0x13, 0x21, 0x27, 0xf2, 0x80, 0x42,
+	0x50, 0xed, 0x24, 0xfc, 0x93, 0xba, 0xe1, 0xaa, 0x95, 0x10, 0x54, 0x42, 0x46, 0x2c, 0x0c, 0x8d,
+	0xce, 0xf6, 0xc9, 0xb5, 0x8a, 0xef, 0xac, 0xbb, 0xb3, 0xd5, 0x8c, 0xf0, 0x09, 0x10, 0x1f, 0x83,
+	0x89, 0x81, 0x95, 0x11, 0xa9, 0x62, 0xaa, 0x98, 0x10, 0x43, 0x41, 0xc9, 0xc0, 0xd7, 0x40, 0xf6,
+	0x5d, 0x9a, 0x34, 0x2d, 0x0a, 0x4b, 0xe2, 0x7b, 0xef, 0xf7, 0x7e, 0xef, 0xdf, 0xef, 0x81, 0x26,
+	0xce, 0x62, 0xca, 0x6d, 0x86, 0x7d, 0x9a, 0x61, 0x36, 0xb2, 0xb3, 0xbe, 0x2d, 0x8e, 0xac, 0x84,
+	0x51, 0x41, 0xe1, 0xad, 0xc2, 0x67, 0x4d, 0x7d, 0x56, 0xd6, 0x6f, 0x1a, 0x3e, 0xe5, 0x39, 0xde,
+	0x43, 0x1c, 0xdb, 0x59, 0xdf, 0xc3, 0x02, 0xf5, 0x6d, 0x9f, 0x46, 0x44, 0x86, 0x34, 0xeb, 0xca,
+	0x1f, 0xf3, 0x30, 0xa7, 0x8a, 0x79, 0xa8, 0x1c, 0x0d, 0xe9, 0x18, 0x16, 0x2f, 0x5b, 0x3e, 0x94,
+	0xab, 0x75, 0xb1, 0x84, 0x10, 0x13, 0xcc, 0xa3, 0x29, 0x60, 0x23, 0xa4, 0x21, 0x95, 0x81, 0xf9,
+	0x97, 0xb4, 0x9a, 0x1f, 0x34, 0xb0, 0xbe, 0xc7, 0xc3, 0x57, 0x49, 0x80, 0x04, 0x7e, 0x81, 0x18,
+	0x8a, 0x39, 0x7c, 0x04, 0xaa, 0x28, 0x15, 0x07, 0x94, 0x45, 0x62, 0xa4, 0x6b, 0x6d, 0xad, 0x53,
+	0x75, 0xf4, 0xef, 0x9f, 0x37, 0x37, 0x54, 0xbe, 0x27, 0x41, 0xc0, 0x30, 0xe7, 0x2f, 0x05, 0x8b,
+	0x48, 0xe8, 0xce, 0xa0, 0xf0, 0x31, 0xa8, 0x24, 0x05, 0x83, 0x7e, 0xa5, 0xad, 0x75, 0x56, 0x07,
+	0x0d, 0xeb, 0x42, 0xeb, 0x96, 0x4c, 0xe1, 0xac, 0x1c, 0x9f, 0xb6, 0x4a, 0xae, 0x82, 0x6f, 0xad,
+	0xbd, 0xfb, 0xf3, 0xa9, 0x3b, 0x23, 0x32, 0x1b, 0xa0, 0xbe, 0x50, 0x93, 0x8b, 0x79, 0x42, 0x09,
+	0xc7, 0xe6, 0x17, 0x59, 0xaf, 0x2b, 0x29, 0x77, 0x53, 0x12, 0x70, 0xd8, 0x03, 0x15, 0x8e, 0x49,
+	0x80, 0xd9, 0xd2, 0x62, 0x15, 0x0e, 0xd6, 0xc1, 0xb5, 0x24, 0xf5, 0x86, 0x87, 0x78, 0x54, 0x94,
+	0x5a, 0x73, 0x2b, 0x49, 0xea, 0x3d, 0xc3, 0x23, 0xf8, 0x00, 0x5c, 0x67, 0xd8, 0xc7, 0x51, 0x86,
+	0x99, 0x5e, 0x5e, 0x42, 0x76, 0x86, 0x84, 0xb7, 0x41, 0x95, 0x47, 0x21, 0x41, 0x22, 0x65, 0x58,
+	0x5f, 0x29, 0x08, 0x67, 0x86, 0xad, 0xd5, 0xbc, 0x3b, 0x95, 0xd9, 0xfc, 0xa6, 0x81, 0xfa, 0x42,
+	0xfd, 0xd3, 0xde, 0x20, 0x02, 0x57, 0x73, 0x11, 0x70, 0x5d, 0x6b, 0x97, 0x8b, 0xf1, 0xa9, 0xb4,
+	0xb9, 0x4c, 0x2c, 0x25, 0x13, 0x6b, 0x9b, 0x46, 0xc4, 0xe9, 0xe5, 0xe3, 0xfb, 0xf8, 0xab, 0xd5,
+	0x09, 0x23, 0x71, 0x90, 0x7a, 0x96, 0x4f, 0x63, 0xa5, 0x06, 0xf5, 0xb7, 0xc9, 0x83, 0x43, 0x5b,
+	0x8c, 0x12, 0xcc, 0x8b, 0x00, 0xee, 0x4a, 0x66, 0xf8, 0x1c, 0xac, 0x61, 0xe6, 0x0f, 0x7a, 0x43,
+	0x0f, 0xbd, 0x41, 0xc4, 0xc7, 0xf9, 0xaa, 0xf2, 0x5c, 0xad, 0x4b, 0x56, 0xb5, 0xe3, 0x6e, 0x0f,
+	0x7a, 0x8e, 0xc4, 0xa9, 0x85, 0xdd, 0x28, 0x82, 0x95, 0x8d, 0x9b, 0x6f, 0x35, 0x50, 0x9b, 0x47,
+	0xc1, 0xbb, 0xe0, 0xa6, 0x4f, 0x89, 0x60, 0xc8, 0x17, 0x43, 0x24, 0x87, 0x25, 0x77, 0xe2, 0xae,
+	0x4f, 0xed, 0x6a, 0x86, 0x70, 0x17, 0x54, 0x50, 0x4c, 0x53, 0x22, 0x8a, 0x0d, 0x54, 0x1d, 0x2b,
+	0x4f, 0xf0, 0xf3, 0xb4, 0x75, 0xe7, 0x3f, 0x5a, 0x7a, 0x4a, 0x84, 0xab, 0xa2, 0x07, 0x5f, 0x35,
+	0x50, 0xde, 0xe3, 0x21, 0xdc, 0x07, 0xb5, 0x73, 0x22, 0x36, 0x2f, 0xe9, 0x68, 0x41, 0x54, 0xcd,
+	0xee, 0x72, 0xcc, 0xd9, 0x72, 0xf6, 0x41, 0xed, 0x9c, 0xe8, 0xfe, 0xc1, 0x3f, 0x8f, 0x69, 0x76,
+	0x97, 0x63, 0xa6, 0xfc, 0xce, 0xce, 0xf1, 0xd8, 0xd0, 0x4e, 0xc6, 0x86, 0xf6, 0x7b, 0x6c, 0x68,
+	0xef, 0x27, 0x46, 0xe9, 0x64, 0x62, 0x94, 0x7e, 0x4c, 0x8c, 0xd2, 0xeb, 0x7b, 0x73, 0x13, 0x91,
+	0x47, 0x2e, 0x7f, 0xb3, 0xfe, 0x43, 0xfb, 0x68, 0x76, 0xf0, 0xc5, 0x68, 0xbc, 0x4a, 0x71, 0xd6,
+	0xf7, 0xff, 0x0e, 0x00, 0x79, 0xc3, 0x83, 0xea, 0x92, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +373,9 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RecoverFunds defines a method for recovering the funds of an address
+	// derived from a coin type 118 key to a new address.
+	RecoverFunds(ctx context.Context, in *MsgRecoverFunds, opts ...grpc.CallOption) (*MsgRecoverFundsResponse, error)
 }
 
 type msgClient struct {
@@ -189,11 +395,23 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RecoverFunds(ctx context.Context, in *MsgRecoverFunds, opts ...grpc.CallOption) (*MsgRecoverFundsResponse, error) {
+	out := new(MsgRecoverFundsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.recovery.v1.Msg/RecoverFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/recovery module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RecoverFunds defines a method for recovering the funds of an address
+	// derived from a coin type 118 key to a new address.
+	RecoverFunds(context.Context, *MsgRecoverFunds) (*MsgRecoverFundsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -203,6 +421,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RecoverFunds(ctx context.Context, req *MsgRecoverFunds) (*MsgRecoverFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverFunds not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -226,6 +447,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RecoverFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.recovery.v1.Msg/RecoverFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverFunds(ctx, req.(*MsgRecoverFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.recovery.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -234,6 +473,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RecoverFunds",
+			Handler:    _Msg_RecoverFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/recovery/v1/tx.proto",
@@ -302,6 +545,148 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRecoverFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Balances) > 0 {
+		for iNdEx := len(m.Erc20Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Balance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -337,6 +722,67 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRecoverFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRecoverFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Erc20Balances) > 0 {
+		for _, e := range m.Erc20Balances {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC20Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +954,422 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRecoverFunds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverFunds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverFunds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Balances = append(m.Erc20Balances, ERC20Balance{})
+			if err := m.Erc20Balances[len(m.Erc20Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -36,6 +36,7 @@ const (
 func init() {
 	RegisterLegacyAminoCodec(amino)
 	amino.Seal()
+	registerEIP712Schemas()
 }
 
 // RegisterInterfaces register implementations
@@ -0,0 +1,50 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/evmos/evmos/v15/ethereum/eip712"
+)
+
+// registerEIP712Schemas registers the EIP-712 schemas of the x/revenue messages,
+// so that wallets display human-readable types when signing them.
+func registerEIP712Schemas() {
+	eip712.RegisterMsgSchema(registerRevenueName, eip712.MsgSchema{
+		Name:  "MsgRegisterRevenue",
+		Value: "MsgRegisterRevenueValue",
+		Types: apitypes.Types{
+			"MsgRegisterRevenueValue": {
+				{Name: "contract_address", Type: "string"},
+				{Name: "deployer_address", Type: "string"},
+				{Name: "withdrawer_address", Type: "string"},
+				{Name: "nonces", Type: "uint64[]"},
+			},
+		},
+	})
+
+	eip712.RegisterMsgSchema(updateRevenueName, eip712.MsgSchema{
+		Name:  "MsgUpdateRevenue",
+		Value: "MsgUpdateRevenueValue",
+		Types: apitypes.Types{
+			"MsgUpdateRevenueValue": {
+				{Name: "contract_address", Type: "string"},
+				{Name: "deployer_address", Type: "string"},
+				{Name: "withdrawer_address", Type: "string"},
+			},
+		},
+	})
+
+	eip712.RegisterMsgSchema(cancelRevenueName, eip712.MsgSchema{
+		Name:  "MsgCancelRevenue",
+		Value: "MsgCancelRevenueValue",
+		Types: apitypes.Types{
+			"MsgCancelRevenueValue": {
+				{Name: "contract_address", Type: "string"},
+				{Name: "deployer_address", Type: "string"},
+			},
+		},
+	})
+}
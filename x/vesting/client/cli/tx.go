@@ -177,7 +177,8 @@ func NewMsgClawbackCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clawback ADDRESS",
 		Short: "Transfer unvested amount out of a ClawbackVestingAccount.",
-		Long: `Must be requested by the original funder address (--from).
+		Long: `Must be requested by a funder of the account (--from). A funder of a grant only claws back
+		the unvested coins of the grants it funded.
 		May provide a destination address (--dest), otherwise the coins return to the funder.
 		Delegated or undelegating staking tokens will be transferred in the delegated (undelegating) state.
 		The recipient is vulnerable to slashing, and must act to unbond the tokens if desired.`,
@@ -222,7 +223,7 @@ func NewMsgUpdateVestingFunderCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-vesting-funder VESTING_ACCOUNT_ADDRESS NEW_FUNDER_ADDRESS",
 		Short: "Update the funder account of an existing ClawbackVestingAccount.",
-		Long: `Must be requested by the original funder address (--from).
+		Long: `Must be requested by a current funder of the account (--from).
 		Need to provide the target VESTING_ACCOUNT_ADDRESS to update and the NEW_FUNDER_ADDRESS.`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
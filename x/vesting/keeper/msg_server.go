@@ -108,7 +108,8 @@ func (k Keeper) CreateClawbackVestingAccount(
 }
 
 // FundVestingAccount funds a ClawbackVestingAccount with the provided amount.
-// This can only be executed by the funder of the vesting account.
+// Grants from addresses other than the funder of the vesting account are
+// tracked individually, so that each funder can only clawback its own grants.
 //
 // Checks performed on the ValidateBasic include:
 //   - funder and vesting addresses are correct bech32 format
@@ -156,9 +157,11 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 		vestingCoins = lockupCoins
 	}
 
-	if msg.FunderAddress != vestingAcc.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "account %s can only accept grants from account %s", msg.VestingAddress, vestingAcc.FunderAddress)
-	}
+	// NOTE: the grant needs to be tracked before merging it into the account
+	// schedules, because the existing schedule is recorded as the funder's
+	// grant when the first grant from a different funder is added.
+	grantStartTime := time.Unix(msg.GetStartTime().Unix(), 0).UTC()
+	vestingAcc.TrackGrant(types.NewGrant(funderAddr, grantStartTime, msg.LockupPeriods, msg.VestingPeriods))
 
 	err = k.addGrant(ctx, vestingAcc, msg.GetStartTime().Unix(), msg.GetLockupPeriods(), msg.GetVestingPeriods(), vestingCoins)
 	if err != nil {
@@ -192,6 +195,8 @@ func (k Keeper) FundVestingAccount(goCtx context.Context, msg *types.MsgFundVest
 
 // Clawback removes the unvested amount from a ClawbackVestingAccount.
 // The destination defaults to the funder address, but can be overridden.
+// If the account has grants from multiple funders, only the unvested amount
+// of the grants from the given funder is removed.
 //
 // Checks performed on the ValidateBasic include:
 //   - funder and vesting addresses are correct bech32 format
@@ -252,13 +257,21 @@ func (k Keeper) Clawback(
 
 		dest = ak.GetModuleAddress(distributiontypes.ModuleName)
 
-		// Check if account funder is same as in msg
-	} else if va.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "clawback can only be requested by original funder: %s", va.FunderAddress)
+		// Check if the msg funder is the account funder or the funder of a grant
+	} else if !va.HasFunder(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized,
+			"clawback can only be requested by a funder of the account: %s did not fund %s", msg.FunderAddress, msg.AccountAddress,
+		)
 	}
 
 	// Perform clawback transfer
-	clawedBack, err := k.transferClawback(ctx, *va, dest)
+	var clawedBack sdk.Coins
+	if len(va.Grants) > 0 && k.authority.String() != msg.FunderAddress {
+		clawedBack, err = k.transferFunderClawback(ctx, *va, msg.FunderAddress, dest)
+	} else {
+		clawedBack, err = k.transferClawback(ctx, *va, dest)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -325,12 +338,15 @@ func (k Keeper) UpdateVestingFunder(
 	}
 
 	// Check if current funder is same as in msg
-	if va.FunderAddress != msg.FunderAddress {
-		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the current funder and cannot update the funder address", va.FunderAddress)
+	if !va.HasFunder(msg.FunderAddress) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the current funder and cannot update the funder address", msg.FunderAddress)
 	}
 
 	// Perform clawback account update
-	va.FunderAddress = msg.NewFunderAddress
+	if va.FunderAddress == msg.FunderAddress {
+		va.FunderAddress = msg.NewFunderAddress
+	}
+	va.UpdateGrantsFunder(msg.FunderAddress, msg.NewFunderAddress)
 	ak.SetAccount(ctx, va)
 
 	telemetry.IncrCounter(
@@ -397,13 +413,6 @@ func (k Keeper) addGrant(
 		va.StartTime = time.Unix(grantStartTime, 0).UTC()
 	}
 
-	// how much is really delegated?
-	vestingAddr := va.GetAddress()
-	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, vestingAddr)
-	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, vestingAddr)
-	delegatedAmt := bondedAmt.Add(unbondingAmt)
-	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
-
 	// modify schedules for the new grant
 	accStartTime := va.GetStartTime()
 	newLockupStart, newLockupEnd, newLockupPeriods := types.DisjunctPeriods(accStartTime, grantStartTime, va.LockupPeriods, grantLockupPeriods)
@@ -428,11 +437,24 @@ func (k Keeper) addGrant(
 	va.VestingPeriods = newVestingPeriods
 	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
 
+	k.updateDelegatedCoins(ctx, va)
+	return nil
+}
+
+// updateDelegatedCoins sets the delegated vesting and delegated free coins of
+// a ClawbackVestingAccount based on its current delegations and schedules.
+func (k Keeper) updateDelegatedCoins(ctx sdk.Context, va *types.ClawbackVestingAccount) {
+	// how much is really delegated?
+	vestingAddr := va.GetAddress()
+	bondedAmt := k.stakingKeeper.GetDelegatorBonded(ctx, vestingAddr)
+	unbondingAmt := k.stakingKeeper.GetDelegatorUnbonding(ctx, vestingAddr)
+	delegatedAmt := bondedAmt.Add(unbondingAmt)
+	delegated := sdk.NewCoins(sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), delegatedAmt))
+
 	// cap DV at the current unvested amount, DF rounds out to current delegated
 	unvested := va.GetVestingCoins(ctx.BlockTime())
 	va.DelegatedVesting = delegated.Min(unvested)
 	va.DelegatedFree = delegated.Sub(va.DelegatedVesting...)
-	return nil
 }
 
 // transferClawback transfers unvested tokens in a ClawbackVestingAccount to
@@ -477,3 +499,27 @@ func (k Keeper) transferClawback(
 	// Transfer clawback to the destination (funder)
 	return toClawBack, k.bankKeeper.SendCoins(ctx, address, destinationAddr, toClawBack)
 }
+
+// transferFunderClawback transfers the unvested tokens of the grants from the
+// given funder in a ClawbackVestingAccount to the destination address. The
+// grants from other funders remain untouched. If no grants are left after the
+// clawback, the account is converted back to a normal EthAccount as in
+// transferClawback.
+func (k Keeper) transferFunderClawback(
+	ctx sdk.Context,
+	vestingAccount types.ClawbackVestingAccount,
+	funder string,
+	destinationAddr sdk.AccAddress,
+) (sdk.Coins, error) {
+	updatedAcc, toClawBack := vestingAccount.ComputeFunderClawback(funder, ctx.BlockTime().Unix())
+	if updatedAcc.OriginalVesting.IsZero() {
+		return k.transferClawback(ctx, vestingAccount, destinationAddr)
+	}
+
+	// NOTE: setting the account with the updated schedules unlocks the clawed back
+	// coins, which allows the bank keeper to send them to the destination address.
+	k.updateDelegatedCoins(ctx, &updatedAcc)
+	k.accountKeeper.SetAccount(ctx, &updatedAcc)
+
+	return toClawBack, k.bankKeeper.SendCoins(ctx, updatedAcc.GetAddress(), destinationAddr, toClawBack)
+}
@@ -180,9 +180,9 @@ func (suite *KeeperTestSuite) TestMsgFundVestingAccountSpecialCases() {
 	})
 
 	// ---------------------------
-	// Test wrong funder by first creating a clawback vesting account
-	// and then trying to fund it with a different funder
-	suite.Run("fail - wrong funder", func() {
+	// Test grant from a different funder by first creating a clawback vesting account
+	// and then funding it with a different funder
+	suite.Run("pass - different funder", func() {
 		suite.SetupTest()
 
 		// fund the recipient account to set the account
@@ -192,6 +192,9 @@ func (suite *KeeperTestSuite) TestMsgFundVestingAccountSpecialCases() {
 		_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(suite.ctx, msgCreate)
 		suite.Require().NoError(err, "failed to create clawback vesting account")
 
+		err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr3, balances)
+		suite.Require().NoError(err, "failed to fund funder account")
+
 		msg := &types.MsgFundVestingAccount{
 			FunderAddress:  addr3.String(),
 			VestingAddress: vestingAddr.String(),
@@ -200,11 +203,81 @@ func (suite *KeeperTestSuite) TestMsgFundVestingAccountSpecialCases() {
 			VestingPeriods: vestingPeriods,
 		}
 		_, err = suite.app.VestingKeeper.FundVestingAccount(suite.ctx, msg)
-		suite.Require().Error(err, "expected wrong funder error")
-		suite.Require().ErrorContains(err, fmt.Sprintf("%s can only accept grants from account %s", vestingAddr, funder))
+		suite.Require().NoError(err, "expected grant from a different funder to succeed")
+
+		va, err := suite.app.VestingKeeper.GetClawbackVestingAccount(suite.ctx, vestingAddr)
+		suite.Require().NoError(err)
+		suite.Require().Len(va.Grants, 1, "expected the grant to be tracked")
+		suite.Require().Equal(addr3.String(), va.Grants[0].FunderAddress)
+		suite.Require().Equal(funder.String(), va.FunderAddress, "expected the account funder to be unchanged")
 	})
 }
 
+// TestMsgClawbackMultipleFunders tests that each funder of a clawback vesting
+// account can only clawback the unvested coins of its own grants.
+func (suite *KeeperTestSuite) TestMsgClawbackMultipleFunders() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// fund the vesting target address to initialize it as an account and
+	// then send all funds to the funder account
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, vestingAddr, balances)
+	suite.Require().NoError(err, "failed to fund target account")
+	err = suite.app.BankKeeper.SendCoins(suite.ctx, vestingAddr, funder, balances)
+	suite.Require().NoError(err, "failed to send coins to funder account")
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr3, balances)
+	suite.Require().NoError(err, "failed to fund second funder account")
+
+	createMsg := types.NewMsgCreateClawbackVestingAccount(funder, vestingAddr, true)
+	_, err = suite.app.VestingKeeper.CreateClawbackVestingAccount(ctx, createMsg)
+	suite.Require().NoError(err)
+
+	for _, f := range []sdk.AccAddress{funder, addr3} {
+		fundMsg := types.NewMsgFundVestingAccount(f, vestingAddr, suite.ctx.BlockTime(), lockupPeriods, vestingPeriods)
+		_, err = suite.app.VestingKeeper.FundVestingAccount(ctx, fundMsg)
+		suite.Require().NoError(err)
+	}
+
+	va, err := suite.app.VestingKeeper.GetClawbackVestingAccount(suite.ctx, vestingAddr)
+	suite.Require().NoError(err)
+	suite.Require().Len(va.Grants, 2, "expected the existing schedule and the new grant to be tracked")
+	suite.Require().Equal(balances.Add(balances...), va.OriginalVesting)
+
+	// an address that did not fund the account cannot clawback
+	msg := types.NewMsgClawback(addr4, vestingAddr, nil)
+	_, err = suite.app.VestingKeeper.Clawback(ctx, msg)
+	suite.Require().ErrorContains(err, "clawback can only be requested by a funder of the account")
+
+	// the second funder only claws back its own grant
+	msg = types.NewMsgClawback(addr3, vestingAddr, nil)
+	res, err := suite.app.VestingKeeper.Clawback(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balances, res.Coins)
+
+	balanceFunder := suite.app.BankKeeper.GetBalance(suite.ctx, addr3, "test")
+	suite.Require().Equal(sdk.NewInt64Coin("test", vestAmount), balanceFunder)
+	balanceVestingAcc := suite.app.BankKeeper.GetBalance(suite.ctx, vestingAddr, "test")
+	suite.Require().Equal(sdk.NewInt64Coin("test", vestAmount), balanceVestingAcc)
+
+	// the account remains a clawback vesting account with the grant of the first funder
+	va, err = suite.app.VestingKeeper.GetClawbackVestingAccount(suite.ctx, vestingAddr)
+	suite.Require().NoError(err)
+	suite.Require().Empty(va.Grants, "expected grants to be cleared when only the account funder is left")
+	suite.Require().Equal(balances, va.OriginalVesting)
+	suite.Require().Equal(lockupPeriods, va.LockupPeriods)
+	suite.Require().Equal(vestingPeriods, va.VestingPeriods)
+
+	// the second funder cannot clawback anymore
+	_, err = suite.app.VestingKeeper.Clawback(ctx, msg)
+	suite.Require().ErrorContains(err, "clawback can only be requested by a funder of the account")
+
+	// the first funder claws back the remaining coins
+	msg = types.NewMsgClawback(funder, vestingAddr, nil)
+	res, err = suite.app.VestingKeeper.Clawback(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balances, res.Coins)
+}
+
 func (suite *KeeperTestSuite) TestMsgCreateClawbackVestingAccount() {
 	funderAddr, _ := utiltx.NewAccAddressAndKey()
 	vestingAddr, _ := utiltx.NewAccAddressAndKey()
@@ -377,7 +450,7 @@ func (suite *KeeperTestSuite) TestMsgClawback() {
 			initClawback: true,
 			initVesting:  true,
 			expPass:      false,
-			errContains:  "clawback can only be requested by original funder",
+			errContains:  "clawback can only be requested by a funder of the account",
 		},
 		{
 			name:         "fail - clawback destination is blocked",
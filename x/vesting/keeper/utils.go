@@ -26,3 +26,25 @@ func (k Keeper) GetClawbackVestingAccount(ctx sdk.Context, addr sdk.AccAddress)
 
 	return clawbackAccount, nil
 }
+
+// ValidateVestedDelegation checks that a clawback vesting account only delegates
+// vested coins. Vested coins can be delegated even if they are still locked.
+// Delegations from any other account type are not restricted.
+func (k Keeper) ValidateVestedDelegation(ctx sdk.Context, delegator sdk.AccAddress, amount sdk.Coin) error {
+	clawbackAccount, isClawback := k.accountKeeper.GetAccount(ctx, delegator).(*types.ClawbackVestingAccount)
+	if !isClawback {
+		return nil
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delegator, amount.Denom)
+	vested := clawbackAccount.GetDelegatableBalance(ctx.BlockTime(), balance)
+	if vested.IsLT(amount) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientVestedCoins,
+			"cannot delegate unvested coins. coins vested < delegation amount (%s < %s)",
+			vested.Amount, amount.Amount,
+		)
+	}
+
+	return nil
+}
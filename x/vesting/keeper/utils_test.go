@@ -230,7 +230,7 @@ func delegate(account TestClawbackAccount, coins sdk.Coins) (*stakingtypes.MsgDe
 // validateEthVestingTransactionDecorator is a helper function to execute the eth vesting transaction decorator
 // with 1 or more given messages and return any occurring error.
 func validateEthVestingTransactionDecorator(msgs ...sdk.Msg) error {
-	dec := evmante.NewEthVestingTransactionDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.EvmKeeper)
+	dec := evmante.NewEthVestingTransactionDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.EvmKeeper, s.app.StakingKeeper)
 	err = testutil.ValidateAnteForMsgs(s.ctx, dec, msgs...)
 	return err
 }
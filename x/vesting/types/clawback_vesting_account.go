@@ -132,6 +132,20 @@ func (va ClawbackVestingAccount) Validate() error {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
 
+	if len(va.Grants) > 0 {
+		grantCoins := sdk.NewCoins()
+		for _, grant := range va.Grants {
+			if err := grant.Validate(); err != nil {
+				return err
+			}
+			grantCoins = grantCoins.Add(grant.GetOriginalVesting()...)
+		}
+
+		if !CoinEq(grantCoins, va.OriginalVesting) {
+			return errors.New("original vesting coins does not match the sum of all coins in grants")
+		}
+	}
+
 	return va.BaseVestingAccount.Validate()
 }
 
@@ -200,8 +214,132 @@ func (va ClawbackVestingAccount) ComputeClawback(
 	return va, totalUnvested
 }
 
+// GetDelegatableBalance returns the amount of the given balance that can be
+// delegated at blockTime. Vested coins can be delegated even if they are still
+// locked, so only the unvested coins are deducted from the balance.
+func (va ClawbackVestingAccount) GetDelegatableBalance(blockTime time.Time, balance sdk.Coin) sdk.Coin {
+	unvested := va.GetUnvestedOnly(blockTime).AmountOf(balance.Denom)
+	if balance.Amount.LTE(unvested) {
+		return sdk.NewCoin(balance.Denom, sdk.ZeroInt())
+	}
+
+	return balance.SubAmount(unvested)
+}
+
 // HasLockedCoins returns true if the block time has not passed all clawback
 // account's lockup periods
 func (va ClawbackVestingAccount) HasLockedCoins(blockTime time.Time) bool {
 	return !va.GetLockedOnly(blockTime).IsZero()
 }
+
+// HasFunder returns true if the given address is the funder of the account or
+// the funder of any of its grants.
+func (va ClawbackVestingAccount) HasFunder(funder string) bool {
+	if va.FunderAddress == funder {
+		return true
+	}
+
+	for _, grant := range va.Grants {
+		if grant.FunderAddress == funder {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TrackGrant records a new grant from the given funder. Grants are only tracked
+// individually once the account receives a grant from an address other than the
+// account funder. In that case, the already existing schedule is recorded as a
+// grant of the account funder.
+//
+// NOTE: the grant schedules still need to be merged into the account schedules.
+func (va *ClawbackVestingAccount) TrackGrant(grant Grant) {
+	if len(va.Grants) == 0 {
+		if grant.FunderAddress == va.FunderAddress {
+			return
+		}
+
+		if len(va.LockupPeriods) > 0 || len(va.VestingPeriods) > 0 {
+			va.Grants = append(va.Grants, Grant{
+				FunderAddress:  va.FunderAddress,
+				StartTime:      va.StartTime,
+				LockupPeriods:  va.LockupPeriods,
+				VestingPeriods: va.VestingPeriods,
+			})
+		}
+	}
+
+	va.Grants = append(va.Grants, grant)
+}
+
+// UpdateGrantsFunder replaces the funder of all grants funded by the given
+// funder with the new funder address.
+func (va *ClawbackVestingAccount) UpdateGrantsFunder(funder, newFunder string) {
+	for i := range va.Grants {
+		if va.Grants[i].FunderAddress == funder {
+			va.Grants[i].FunderAddress = newFunder
+		}
+	}
+}
+
+// ComputeFunderClawback returns an account with all future vesting events of the
+// grants from the given funder removed and the clawback amount (total sum of these
+// events). The schedules of the other grants are preserved. Grants left without
+// any coins are removed and the grants are no longer tracked individually once
+// the remaining ones all belong to the account funder.
+func (va ClawbackVestingAccount) ComputeFunderClawback(
+	funder string,
+	clawbackTime int64,
+) (ClawbackVestingAccount, sdk.Coins) {
+	toClawBack := sdk.NewCoins()
+	grants := make([]Grant, 0, len(va.Grants))
+	onlyAccountFunder := true
+
+	for _, grant := range va.Grants {
+		if grant.FunderAddress == funder {
+			var grantClawback sdk.Coins
+			grant, grantClawback = grant.ComputeClawback(clawbackTime)
+			toClawBack = toClawBack.Add(grantClawback...)
+		}
+
+		if grant.GetOriginalVesting().IsZero() {
+			continue
+		}
+
+		if grant.FunderAddress != va.FunderAddress {
+			onlyAccountFunder = false
+		}
+
+		grants = append(grants, grant)
+	}
+
+	// rebuild the account schedules as the union of the remaining grants
+	va.OriginalVesting = sdk.NewCoins()
+	va.LockupPeriods = sdkvesting.Periods{}
+	va.VestingPeriods = sdkvesting.Periods{}
+	va.EndTime = va.GetStartTime()
+
+	for i, grant := range grants {
+		if i == 0 {
+			va.StartTime = grant.StartTime
+		}
+
+		accStartTime := va.GetStartTime()
+		newStart, newLockupEnd, newLockupPeriods := DisjunctPeriods(accStartTime, grant.GetStartTime(), va.LockupPeriods, grant.LockupPeriods)
+		_, newVestingEnd, newVestingPeriods := DisjunctPeriods(accStartTime, grant.GetStartTime(), va.VestingPeriods, grant.VestingPeriods)
+
+		va.StartTime = time.Unix(newStart, 0).UTC()
+		va.EndTime = Max64(newLockupEnd, newVestingEnd)
+		va.LockupPeriods = newLockupPeriods
+		va.VestingPeriods = newVestingPeriods
+		va.OriginalVesting = va.OriginalVesting.Add(grant.GetOriginalVesting()...)
+	}
+
+	va.Grants = grants
+	if onlyAccountFunder {
+		va.Grants = nil
+	}
+
+	return va, toClawBack
+}
@@ -538,3 +538,83 @@ func (suite *VestingAccountTestSuite) TestComputeClawback() {
 		})
 	}
 }
+
+func (suite *VestingAccountTestSuite) TestComputeFunderClawback() {
+	fee := func(x int64) sdk.Coin { return sdk.NewInt64Coin(feeDenom, x) }
+	now := time.Unix(tmtime.Now().Unix(), 0).UTC()
+	funderA := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	funderB := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	grantA := types.NewGrant(
+		funderA,
+		now,
+		sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(400))}},
+		sdkvesting.Periods{
+			{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+			{Length: int64(8 * 3600), Amount: sdk.NewCoins(fee(200))},
+		},
+	)
+	grantB := types.NewGrant(
+		funderB,
+		now.Add(time.Hour),
+		sdkvesting.Periods{{Length: int64(12 * 3600), Amount: sdk.NewCoins(fee(600))}},
+		sdkvesting.Periods{
+			{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(300))},
+			{Length: int64(6 * 3600), Amount: sdk.NewCoins(fee(300))},
+		},
+	)
+
+	testCases := []struct {
+		name               string
+		funder             sdk.AccAddress
+		time               int64
+		expClawedBack      sdk.Coins
+		expOriginalVesting sdk.Coins
+		expGrants          int
+	}{
+		{
+			"should only claw back the grant of the given funder",
+			funderB,
+			now.Unix(),
+			sdk.NewCoins(fee(600)),
+			sdk.NewCoins(fee(400)),
+			0,
+		},
+		{
+			"should keep the vested coins of the funder grants",
+			funderA,
+			now.Add(9 * time.Hour).Unix(),
+			sdk.NewCoins(fee(200)),
+			sdk.NewCoins(fee(800)),
+			2,
+		},
+		{
+			"should claw back zero coins for a funder without grants",
+			sdk.AccAddress(utiltx.GenerateAddress().Bytes()),
+			now.Unix(),
+			sdk.NewCoins(),
+			sdk.NewCoins(fee(1000)),
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			addr := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+			bacc := authtypes.NewBaseAccountWithAddress(addr)
+			va := types.NewClawbackVestingAccount(bacc, funderA, grantA.GetOriginalVesting(), now, grantA.LockupPeriods, grantA.VestingPeriods)
+
+			va.TrackGrant(grantB)
+			suite.Require().Len(va.Grants, 2)
+			suite.Require().True(va.HasFunder(funderB.String()))
+
+			va2, amt := va.ComputeFunderClawback(tc.funder.String(), tc.time)
+
+			suite.Require().Equal(tc.expClawedBack, amt)
+			suite.Require().Equal(tc.expOriginalVesting, va2.OriginalVesting)
+			suite.Require().Len(va2.Grants, tc.expGrants)
+			suite.Require().Equal(tc.expOriginalVesting, va2.VestingPeriods.TotalAmount())
+			suite.Require().Equal(tc.expOriginalVesting, va2.LockupPeriods.TotalAmount())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Tharsis Labs Ltd.(Evmos)
+// SPDX-License-Identifier:ENCL-1.0(https://github.com/evmos/evmos/blob/main/LICENSE)
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewGrant returns a new Grant for the given funder and schedules
+func NewGrant(
+	funder sdk.AccAddress,
+	startTime time.Time,
+	lockupPeriods,
+	vestingPeriods sdkvesting.Periods,
+) Grant {
+	return Grant{
+		FunderAddress:  funder.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// GetStartTime returns the time when vesting of the grant starts.
+func (g Grant) GetStartTime() int64 {
+	return g.StartTime.Unix()
+}
+
+// GetEndTime returns the time when both the lockup and the vesting schedules
+// of the grant have concluded.
+func (g Grant) GetEndTime() int64 {
+	return g.GetStartTime() + Max64(g.LockupPeriods.TotalLength(), g.VestingPeriods.TotalLength())
+}
+
+// GetOriginalVesting returns the total amount of coins granted.
+func (g Grant) GetOriginalVesting() sdk.Coins {
+	return g.VestingPeriods.TotalAmount()
+}
+
+// Validate checks that the grant has a valid funder and that the lockup and
+// vesting schedules describe the same total amount.
+func (g Grant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.FunderAddress); err != nil {
+		return fmt.Errorf("invalid grant funder address: %w", err)
+	}
+
+	if !CoinEq(g.LockupPeriods.TotalAmount(), g.VestingPeriods.TotalAmount()) {
+		return errors.New("grant lockup and vesting periods must describe the same total amount")
+	}
+
+	return nil
+}
+
+// ComputeClawback returns the grant with all future vesting events removed and
+// the clawback amount (total sum of these events). It follows the same rules
+// as ClawbackVestingAccount.ComputeClawback.
+func (g Grant) ComputeClawback(clawbackTime int64) (Grant, sdk.Coins) {
+	va := ClawbackVestingAccount{
+		BaseVestingAccount: &sdkvesting.BaseVestingAccount{
+			OriginalVesting: g.GetOriginalVesting(),
+			EndTime:         g.GetEndTime(),
+		},
+		StartTime:      g.StartTime,
+		LockupPeriods:  g.LockupPeriods,
+		VestingPeriods: g.VestingPeriods,
+	}
+
+	updatedAcc, toClawBack := va.ComputeClawback(clawbackTime)
+
+	g.LockupPeriods = updatedAcc.LockupPeriods
+	g.VestingPeriods = updatedAcc.VestingPeriods
+
+	return g, toClawBack
+}
@@ -580,7 +580,7 @@ func init() {
 func init() { proto.RegisterFile("evmos/vesting/v2/tx.proto", fileDescriptor_a372bb0b868e4c86) }
 
 var fileDescriptor_a372bb0b868e4c86 = []byte{
-	// 848 bytes of a gzipped FileDescriptorProto
+	// 845 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xbf, 0x6f, 0x23, 0x45,
 	0x14, 0xf6, 0x9c, 0xb9, 0x53, 0x6e, 0xcc, 0x85, 0x63, 0xcd, 0x81, 0x6f, 0x75, 0xd9, 0x35, 0x16,
 	0x51, 0x7c, 0x26, 0xec, 0x9c, 0xcd, 0x81, 0x74, 0x11, 0x4d, 0x6c, 0x14, 0x2a, 0x4b, 0xc8, 0x02,
@@ -633,7 +633,7 @@ var fileDescriptor_a372bb0b868e4c86 = []byte{
 	0x42, 0x2a, 0xdf, 0xc5, 0x82, 0x76, 0xdf, 0x3d, 0x3a, 0x75, 0xc0, 0xf1, 0xa9, 0x03, 0x7e, 0x3f,
 	0x75, 0xc0, 0xe1, 0x99, 0x53, 0x3a, 0x3e, 0x73, 0x4a, 0xbf, 0x9c, 0x39, 0xa5, 0x8f, 0x5b, 0x17,
 	0xb6, 0x99, 0x8a, 0xa8, 0xe3, 0xb6, 0xdf, 0x42, 0xf3, 0x7f, 0x3e, 0x14, 0xa3, 0x3b, 0xf2, 0x45,
-	0x7d, 0xf3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x59, 0xff, 0x11, 0xaa, 0x09, 0x00, 0x00,
+	0x7d, 0xf3, 0xef, 0x01, 0x00, 0xfb, 0x59, 0xff, 0x11, 0xaa, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -653,10 +653,12 @@ type MsgClient interface {
 	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
 	// according to the vesting and lockup schedules.
 	FundVestingAccount(ctx context.Context, in *MsgFundVestingAccount, opts ...grpc.CallOption) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount. If the
+	// account was funded by multiple funders, only the unvested tokens of the
+	// grants from the given funder are removed.
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
+	// ClawbackVestingAccount and of the grants funded by the given funder.
 	UpdateVestingFunder(ctx context.Context, in *MsgUpdateVestingFunder, opts ...grpc.CallOption) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(ctx context.Context, in *MsgConvertVestingAccount, opts ...grpc.CallOption) (*MsgConvertVestingAccountResponse, error)
@@ -722,10 +724,12 @@ type MsgServer interface {
 	// FundVestingAccount funds an existing ClawbackVestingAccount with tokens
 	// according to the vesting and lockup schedules.
 	FundVestingAccount(context.Context, *MsgFundVestingAccount) (*MsgFundVestingAccountResponse, error)
-	// Clawback removes the unvested tokens from a ClawbackVestingAccount.
+	// Clawback removes the unvested tokens from a ClawbackVestingAccount. If the
+	// account was funded by multiple funders, only the unvested tokens of the
+	// grants from the given funder are removed.
 	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 	// UpdateVestingFunder updates the funder address of an existing
-	// ClawbackVestingAccount.
+	// ClawbackVestingAccount and of the grants funded by the given funder.
 	UpdateVestingFunder(context.Context, *MsgUpdateVestingFunder) (*MsgUpdateVestingFunderResponse, error)
 	// ConvertVestingAccount converts a ClawbackVestingAccount to an Eth account
 	ConvertVestingAccount(context.Context, *MsgConvertVestingAccount) (*MsgConvertVestingAccountResponse, error)
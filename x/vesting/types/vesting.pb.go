@@ -45,6 +45,10 @@ type ClawbackVestingAccount struct {
 	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
 	// vesting_periods defines the vesting schedule relative to the start_time
 	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+	// grants defines the individual grants of the account, each with its own funder
+	// and schedule. It is only populated once the account has been funded by an
+	// address other than funder_address.
+	Grants []Grant `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants"`
 }
 
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
@@ -79,6 +83,54 @@ func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
 
+// Grant defines a single funding of a ClawbackVestingAccount. Grants are tracked
+// separately so that each funder can only clawback the unvested coins of its own
+// grants.
+type Grant struct {
+	// funder_address specifies the account which funded the grant and can perform
+	// clawback on it
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start_time defines the time at which the vesting period of the grant begins
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// lockup_periods defines the unlocking schedule of the grant relative to the start_time
+	LockupPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,3,rep,name=lockup_periods,json=lockupPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"lockup_periods"`
+	// vesting_periods defines the vesting schedule of the grant relative to the start_time
+	VestingPeriods github_com_cosmos_cosmos_sdk_x_auth_vesting_types.Periods `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=github.com/cosmos/cosmos-sdk/x/auth/vesting/types.Periods" json:"vesting_periods"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0001d894a8ee0c72, []int{1}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
 // ClawbackProposal is a gov Content type to clawback funds
 // from a vesting account that has this functionality enabled.
 type ClawbackProposal struct {
@@ -100,7 +152,7 @@ func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
 func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
 func (*ClawbackProposal) ProtoMessage()    {}
 func (*ClawbackProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0001d894a8ee0c72, []int{1}
+	return fileDescriptor_0001d894a8ee0c72, []int{2}
 }
 func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,44 +211,48 @@ func (m *ClawbackProposal) GetDestinationAddress() string {
 
 func init() {
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "evmos.vesting.v2.ClawbackVestingAccount")
+	proto.RegisterType((*Grant)(nil), "evmos.vesting.v2.Grant")
 	proto.RegisterType((*ClawbackProposal)(nil), "evmos.vesting.v2.ClawbackProposal")
 }
 
 func init() { proto.RegisterFile("evmos/vesting/v2/vesting.proto", fileDescriptor_0001d894a8ee0c72) }
 
 var fileDescriptor_0001d894a8ee0c72 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x11, 0x17, 0x9a, 0x8b, 0x1a, 0xaa, 0x23, 0x42, 0x56, 0x06, 0x3b, 0xaa, 0x40, 0x8a,
-	0x2a, 0xe1, 0x53, 0x82, 0x18, 0xe8, 0x16, 0x97, 0x0f, 0x50, 0x59, 0x88, 0x81, 0xc5, 0x3a, 0xdb,
-	0x57, 0xd7, 0x8a, 0x93, 0xb3, 0x7c, 0xe7, 0x50, 0xbe, 0x41, 0xd5, 0xa9, 0x23, 0x12, 0x4b, 0x66,
-	0x3e, 0x49, 0xc7, 0x8c, 0x4c, 0x2d, 0x4a, 0x16, 0x3e, 0x06, 0xf2, 0xfd, 0x29, 0x89, 0x10, 0x6b,
-	0xa7, 0x7b, 0xef, 0xf7, 0xfe, 0xdc, 0xef, 0xbd, 0xdf, 0x1d, 0x74, 0xe9, 0x62, 0xc6, 0x38, 0x5e,
-	0x50, 0x2e, 0xf2, 0x79, 0x86, 0x17, 0x63, 0x63, 0xfa, 0x65, 0xc5, 0x04, 0x43, 0x87, 0x32, 0xee,
-	0x1b, 0x70, 0x31, 0xee, 0xbf, 0x4a, 0x18, 0xdf, 0x29, 0x19, 0xc5, 0x54, 0x90, 0xd1, 0x6e, 0x5d,
-	0xbf, 0x97, 0xb1, 0x8c, 0x49, 0x13, 0x37, 0x96, 0x46, 0xbd, 0x8c, 0xb1, 0xac, 0xa0, 0x58, 0x7a,
-	0x71, 0x7d, 0x8e, 0x45, 0x3e, 0xa3, 0x5c, 0x90, 0x59, 0xa9, 0x12, 0x8e, 0xae, 0x6d, 0xf8, 0xf2,
-	0xb4, 0x20, 0x5f, 0x62, 0x92, 0x4c, 0x3f, 0xa9, 0x86, 0x93, 0x24, 0x61, 0xf5, 0x5c, 0xa0, 0x18,
-	0xf6, 0x62, 0xc2, 0x69, 0xa4, 0xef, 0x89, 0x88, 0xc2, 0x1d, 0x30, 0x00, 0xc3, 0xce, 0xf8, 0xd8,
-	0x57, 0xb4, 0xfe, 0x32, 0x55, 0xb4, 0xfc, 0x80, 0x70, 0xba, 0xdb, 0x29, 0xb0, 0x57, 0x77, 0x1e,
-	0x08, 0x51, 0xfc, 0x4f, 0x04, 0xbd, 0x86, 0xdd, 0xf3, 0x7a, 0x9e, 0xd2, 0x2a, 0x22, 0x69, 0x5a,
-	0x51, 0xce, 0x9d, 0x27, 0x03, 0x30, 0x6c, 0x87, 0x07, 0x0a, 0x9d, 0x28, 0x10, 0x9d, 0x42, 0xc8,
-	0x05, 0xa9, 0x44, 0xd4, 0xd0, 0x77, 0x5a, 0x92, 0x40, 0xdf, 0x57, 0xb3, 0xf9, 0x66, 0x36, 0xff,
-	0xa3, 0x99, 0x2d, 0xd8, 0xbf, 0xbd, 0xf3, 0xac, 0x9b, 0x7b, 0x0f, 0x84, 0x6d, 0x59, 0xd7, 0x44,
-	0xd0, 0x15, 0x80, 0xdd, 0x82, 0x25, 0xd3, 0xba, 0x8c, 0x4a, 0x5a, 0xe5, 0x2c, 0xe5, 0x8e, 0x3d,
-	0x68, 0x0d, 0x3b, 0x63, 0xf7, 0x7f, 0xa3, 0x9c, 0xc9, 0xb4, 0x60, 0xd2, 0x74, 0xfb, 0x71, 0xef,
-	0xbd, 0xcf, 0x72, 0x71, 0x51, 0xc7, 0x7e, 0xc2, 0x66, 0x58, 0x6b, 0xa2, 0x8e, 0x37, 0x3c, 0x9d,
-	0xe2, 0x4b, 0x4c, 0x6a, 0x71, 0xf1, 0xa0, 0x92, 0xf8, 0x5a, 0x52, 0xae, 0x3b, 0xf0, 0xf0, 0x40,
-	0x5d, 0xac, 0x5d, 0x74, 0x0d, 0xe0, 0x73, 0xb3, 0x56, 0xc3, 0x65, 0xef, 0xb1, 0xb8, 0x74, 0x35,
-	0xac, 0xfd, 0x93, 0xfd, 0xab, 0xa5, 0x67, 0x7d, 0x5b, 0x7a, 0xd6, 0xd1, 0x77, 0x00, 0x0f, 0xcd,
-	0x63, 0x38, 0xab, 0x58, 0xc9, 0x38, 0x29, 0x50, 0x0f, 0xee, 0x89, 0x5c, 0x14, 0x54, 0xea, 0xde,
-	0x0e, 0x95, 0x83, 0x06, 0xb0, 0x93, 0x52, 0x9e, 0x54, 0x79, 0x29, 0x72, 0x36, 0xd7, 0xaa, 0x6d,
-	0x43, 0xc8, 0x81, 0xcf, 0x8c, 0xa6, 0x2d, 0x19, 0x35, 0x2e, 0xc2, 0xf0, 0x45, 0x2a, 0x29, 0x90,
-	0x26, 0xf1, 0x41, 0x79, 0x5b, 0x66, 0xa1, 0xad, 0x90, 0x96, 0xff, 0xc4, 0xfe, 0xbd, 0xf4, 0xac,
-	0xe0, 0xc3, 0xed, 0xda, 0x05, 0xab, 0xb5, 0x0b, 0x7e, 0xad, 0x5d, 0x70, 0xb3, 0x71, 0xad, 0xd5,
-	0xc6, 0xb5, 0x7e, 0x6e, 0x5c, 0xeb, 0xf3, 0xf1, 0xd6, 0x32, 0xd4, 0xf7, 0xd2, 0x9f, 0x6c, 0xf4,
-	0x0e, 0x5f, 0xee, 0x6e, 0x21, 0x7e, 0x2a, 0x9f, 0xcb, 0xdb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x3c, 0xc6, 0xe9, 0x88, 0x03, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xf6, 0x35, 0x4e, 0x7e, 0xed, 0x45, 0xcd, 0xaf, 0x3a, 0x22, 0xb0, 0x32, 0xd8, 0x51, 0x04,
+	0x52, 0x54, 0x09, 0x5b, 0x09, 0xea, 0x40, 0xb6, 0xb8, 0x48, 0xac, 0x55, 0x84, 0x18, 0x58, 0xa2,
+	0xb3, 0x7d, 0x75, 0xad, 0xfc, 0x39, 0xcb, 0x77, 0x36, 0xe5, 0x1b, 0x54, 0x4c, 0x65, 0x43, 0x62,
+	0xc9, 0xcc, 0xb7, 0x60, 0xeb, 0x98, 0x91, 0xa9, 0x45, 0xc9, 0xc2, 0xc7, 0x40, 0xbe, 0x3f, 0x25,
+	0xe1, 0x8f, 0x58, 0x10, 0xb0, 0x24, 0xf7, 0x3e, 0xef, 0x73, 0xef, 0xfb, 0xbc, 0xf7, 0xdc, 0x19,
+	0xda, 0xa4, 0x98, 0x51, 0xe6, 0x15, 0x84, 0xf1, 0x64, 0x1e, 0x7b, 0x45, 0x5f, 0x2f, 0xdd, 0x34,
+	0xa3, 0x9c, 0xa2, 0x03, 0x91, 0x77, 0x35, 0x58, 0xf4, 0x5b, 0xf7, 0x43, 0xca, 0xb6, 0xb6, 0xf4,
+	0x02, 0xc2, 0x71, 0x6f, 0x7b, 0x5f, 0xab, 0x19, 0xd3, 0x98, 0x8a, 0xa5, 0x57, 0xae, 0x14, 0xea,
+	0xc4, 0x94, 0xc6, 0x53, 0xe2, 0x89, 0x28, 0xc8, 0x4f, 0x3d, 0x9e, 0xcc, 0x08, 0xe3, 0x78, 0x96,
+	0x4a, 0x42, 0xe7, 0x83, 0x09, 0xef, 0x1e, 0x4f, 0xf1, 0xcb, 0x00, 0x87, 0x93, 0xe7, 0xb2, 0xe0,
+	0x30, 0x0c, 0x69, 0x3e, 0xe7, 0x28, 0x80, 0xcd, 0x00, 0x33, 0x32, 0x56, 0x7d, 0xc6, 0x58, 0xe2,
+	0x16, 0x68, 0x83, 0x6e, 0xbd, 0x7f, 0xe8, 0x4a, 0x59, 0x5f, 0x95, 0x4a, 0x59, 0xae, 0x8f, 0x19,
+	0xd9, 0xae, 0xe4, 0x9b, 0xcb, 0x6b, 0x07, 0x8c, 0x50, 0xf0, 0x5d, 0x06, 0x3d, 0x80, 0x8d, 0xd3,
+	0x7c, 0x1e, 0x91, 0x6c, 0x8c, 0xa3, 0x28, 0x23, 0x8c, 0x59, 0x3b, 0x6d, 0xd0, 0xdd, 0x1b, 0xed,
+	0x4b, 0x74, 0x28, 0x41, 0x74, 0x0c, 0x21, 0xe3, 0x38, 0xe3, 0xe3, 0x52, 0xbe, 0x55, 0x11, 0x02,
+	0x5a, 0xae, 0x9c, 0xcd, 0xd5, 0xb3, 0xb9, 0xcf, 0xf4, 0x6c, 0xfe, 0xee, 0xd5, 0xb5, 0x63, 0x5c,
+	0xde, 0x38, 0x60, 0xb4, 0x27, 0xf6, 0x95, 0x19, 0x74, 0x01, 0x60, 0x63, 0x4a, 0xc3, 0x49, 0x9e,
+	0x8e, 0x53, 0x92, 0x25, 0x34, 0x62, 0x96, 0xd9, 0xae, 0x74, 0xeb, 0x7d, 0xfb, 0x67, 0xa3, 0x9c,
+	0x08, 0x9a, 0x3f, 0x2c, 0xab, 0xbd, 0xbf, 0x71, 0x1e, 0xc7, 0x09, 0x3f, 0xcb, 0x03, 0x37, 0xa4,
+	0x33, 0x4f, 0x79, 0x22, 0xff, 0x1e, 0xb2, 0x68, 0xe2, 0x9d, 0x7b, 0x38, 0xe7, 0x67, 0xb7, 0x2e,
+	0xf1, 0x57, 0x29, 0x61, 0xaa, 0x02, 0x1b, 0xed, 0xcb, 0xc6, 0x2a, 0x44, 0xaf, 0x01, 0xfc, 0x5f,
+	0x1f, 0xab, 0xd6, 0x52, 0xfd, 0x53, 0x5a, 0x1a, 0x0a, 0xd6, 0x62, 0x8e, 0x60, 0x2d, 0xce, 0xf0,
+	0x9c, 0x33, 0xab, 0x26, 0x24, 0xdc, 0x73, 0xbf, 0xbd, 0x82, 0xee, 0xd3, 0x32, 0xef, 0x9b, 0x65,
+	0xef, 0x91, 0x22, 0x0f, 0x76, 0x2f, 0x16, 0x8e, 0xf1, 0x76, 0xe1, 0x18, 0x9d, 0x37, 0x15, 0x58,
+	0x15, 0x8c, 0x1f, 0xd8, 0x09, 0x7e, 0x6d, 0xe7, 0xce, 0x6f, 0xb3, 0xb3, 0xf2, 0x0f, 0xd9, 0x69,
+	0xfe, 0x25, 0x3b, 0x07, 0x66, 0xe9, 0x4b, 0xe7, 0x1d, 0x80, 0x07, 0xfa, 0x5d, 0x9f, 0x64, 0x34,
+	0xa5, 0x0c, 0x4f, 0x51, 0x13, 0x56, 0x79, 0xc2, 0xa7, 0x44, 0xb9, 0x22, 0x03, 0xd4, 0x86, 0xf5,
+	0x88, 0xb0, 0x30, 0x4b, 0x52, 0x9e, 0xd0, 0xb9, 0x7a, 0x80, 0x9b, 0x10, 0xb2, 0xe0, 0x7f, 0xda,
+	0xcf, 0x8a, 0xc8, 0xea, 0x10, 0x79, 0xf0, 0x4e, 0x24, 0xda, 0xe3, 0x92, 0x78, 0xeb, 0xba, 0x29,
+	0x58, 0x68, 0x23, 0xa5, 0xac, 0x1f, 0x98, 0x9f, 0x17, 0x8e, 0xe1, 0x3f, 0xb9, 0x5a, 0xd9, 0x60,
+	0xb9, 0xb2, 0xc1, 0xa7, 0x95, 0x0d, 0x2e, 0xd7, 0xb6, 0xb1, 0x5c, 0xdb, 0xc6, 0xc7, 0xb5, 0x6d,
+	0xbc, 0x38, 0xdc, 0x38, 0x08, 0xf9, 0xa5, 0x94, 0xbf, 0x45, 0xef, 0xc8, 0x3b, 0xdf, 0x3e, 0x81,
+	0xa0, 0x26, 0xae, 0xca, 0xa3, 0x2f, 0x03, 0x00, 0xb6, 0x9a, 0x7a, 0xe4, 0x53, 0x05, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -219,6 +275,20 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.VestingPeriods) > 0 {
 		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -277,6 +347,72 @@ func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintVesting(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClawbackProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -367,6 +503,39 @@ func (m *ClawbackVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovVesting(uint64(l))
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -599,6 +768,223 @@ func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, types.Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, types.Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])